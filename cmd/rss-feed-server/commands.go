@@ -0,0 +1,557 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/darkkaiser/rss-feed-server/internal/config"
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+	"github.com/darkkaiser/rss-feed-server/internal/store"
+	"github.com/darkkaiser/rss-feed-server/internal/store/jsonl"
+	"github.com/darkkaiser/rss-feed-server/internal/store/sqlite"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// command 서버를 구동하는 대신 실행하는 관리용 하위 명령어입니다.
+type command struct {
+	// summary 사용법 출력에 표시할 한 줄 설명입니다.
+	summary string
+
+	// run 명령어를 실행합니다. args는 명령어 이름을 제외한 나머지 인자입니다.
+	run func(ctx context.Context, args []string, stdin io.Reader, stdout, stderr io.Writer) error
+}
+
+// commands 지원하는 하위 명령어 목록입니다.
+var commands = map[string]command{
+	"backup":        {summary: "SQLite 데이터베이스를 서버 중단 없이 시각이 붙은 파일로 백업합니다", run: runBackup},
+	"export":        {summary: "게시글을 JSON Lines 형식으로 내보냅니다", run: runExport},
+	"import":        {summary: "JSON Lines 형식의 게시글을 데이터베이스로 가져옵니다", run: runImport},
+	"token":         {summary: "비공개 피드 구독용 접근 토큰을 발급, 조회, 폐기합니다", run: runToken},
+	"hash-password": {summary: "관리자 계정(admin.users)에 설정할 비밀번호의 bcrypt 해시를 생성합니다", run: runHashPassword},
+}
+
+// isCommand 실행 인자가 하위 명령어 호출인지 여부를 반환합니다.
+// 플래그('-'로 시작)로 시작하는 인자는 서버 구동으로 간주합니다. (go test가 넘기는 -test.* 플래그 포함)
+func isCommand(args []string) bool {
+	return len(args) > 0 && !strings.HasPrefix(args[0], "-")
+}
+
+// runCommand args[0]에 해당하는 하위 명령어를 실행합니다.
+func runCommand(ctx context.Context, args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	cmd, ok := commands[args[0]]
+	if !ok {
+		printUsage(stderr)
+		return fmt.Errorf("알 수 없는 명령어입니다: '%s'", args[0])
+	}
+
+	// '-h' 옵션으로 사용법만 출력한 경우는 정상 종료로 처리합니다.
+	if err := cmd.run(ctx, args[1:], stdin, stdout, stderr); err != nil && !errors.Is(err, flag.ErrHelp) {
+		return err
+	}
+
+	return nil
+}
+
+// printUsage 지원하는 하위 명령어 목록을 출력합니다.
+func printUsage(w io.Writer) {
+	names := make([]string, 0, len(commands))
+	width := 0
+	for name := range commands {
+		names = append(names, name)
+		width = max(width, len(name))
+	}
+	sort.Strings(names)
+
+	// 명령어가 추가되어도 설명 열이 어긋나지 않도록, 가장 긴 명령어 이름에 맞춰 이름 열의 너비를 정합니다.
+	fmt.Fprintf(w, "사용법: %s [<명령어> [옵션]]\n\n명령어를 생략하면 RSS 피드 서버를 구동합니다.\n\n명령어:\n", config.AppName)
+	for _, name := range names {
+		fmt.Fprintf(w, "  %-*s  %s\n", width, name, commands[name].summary)
+	}
+	fmt.Fprintf(w, "\n명령어별 옵션은 '%s <명령어> -h'로 확인할 수 있습니다.\n", config.AppName)
+}
+
+// newFlagSet 하위 명령어의 플래그 집합을 생성합니다. 모든 명령어는 설정 파일 경로(-config)를 공통으로 받습니다.
+func newFlagSet(name string, stderr io.Writer) (*flag.FlagSet, *string) {
+	fs := flag.NewFlagSet(config.AppName+" "+name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	configFile := fs.String("config", config.DefaultFilename, "설정 파일 경로")
+
+	return fs, configFile
+}
+
+// openStore 설정 파일을 읽어 데이터베이스에 연결하고 저장소를 생성합니다. 반환된 *sql.DB는 호출자가 닫아야 합니다.
+func openStore(ctx context.Context, configFile string) (*config.AppConfig, *sql.DB, store.Store, error) {
+	appConfig, _, err := config.LoadWithFile(configFile)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("환경설정 파일을 로드하는 중 오류가 발생했습니다: %w", err)
+	}
+
+	db, err := store.Open(ctx, &appConfig.Database)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("데이터베이스(%s)에 연결하는 중 오류가 발생했습니다: %w", appConfig.Database.DriverOrDefault(), err)
+	}
+
+	feedStore, err := store.New(appConfig.Database.DriverOrDefault(), db)
+	if err != nil {
+		_ = db.Close()
+		return nil, nil, nil, fmt.Errorf("RSS 피드 저장소 객체 생성 중 오류가 발생했습니다: %w", err)
+	}
+
+	return appConfig, db, feedStore, nil
+}
+
+// runBackup SQLite 온라인 백업 API로 데이터베이스를 '<out-dir>/rss-feed-server-YYYYMMDD-HHMMSS.db' 파일에 복사합니다.
+func runBackup(ctx context.Context, args []string, _ io.Reader, stdout, stderr io.Writer) error {
+	fs, configFile := newFlagSet("backup", stderr)
+	outDir := fs.String("out-dir", ".", "백업 파일을 생성할 디렉터리")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	appConfig, db, _, err := openStore(ctx, *configFile)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	if driver := appConfig.Database.DriverOrDefault(); driver != config.DatabaseDriverSQLite {
+		return fmt.Errorf("backup 명령어는 SQLite 데이터베이스만 지원합니다 (현재: %s). PostgreSQL은 pg_dump를 사용하세요", driver)
+	}
+
+	if err := os.MkdirAll(*outDir, 0o755); err != nil {
+		return fmt.Errorf("백업 디렉터리 생성 실패: %w", err)
+	}
+
+	destPath := filepath.Join(*outDir, fmt.Sprintf("%s-%s.db", config.AppName, time.Now().Format("20060102-150405")))
+	if err := sqlite.Backup(ctx, db, destPath); err != nil {
+		return fmt.Errorf("데이터베이스 백업 중 오류가 발생했습니다: %w", err)
+	}
+
+	fmt.Fprintf(stdout, "백업 완료: %s\n", destPath)
+
+	return nil
+}
+
+// runExport 조건에 맞는 게시글을 JSON Lines 형식으로 내보냅니다. 출력 파일을 지정하지 않으면 표준 출력으로 기록합니다.
+//
+// 실행 중인 서버에 영향을 주지 않도록 스키마 마이그레이션이나 VACUUM은 수행하지 않으므로,
+// 현재 버전의 서버가 한 번 이상 구동되어 스키마가 최신 상태인 데이터베이스에서 실행해야 합니다.
+func runExport(ctx context.Context, args []string, _ io.Reader, stdout, stderr io.Writer) error {
+	fs, configFile := newFlagSet("export", stderr)
+	out := fs.String("out", "-", "출력 파일 경로 ('-'는 표준 출력)")
+	providers := fs.String("provider", "", "내보낼 공급자 ID 목록 (쉼표로 구분, 생략 시 전체)")
+	boards := fs.String("board", "", "내보낼 게시판 ID 목록 (쉼표로 구분, 생략 시 전체)")
+	from := fs.String("from", "", "작성일시 시작 (포함, YYYY-MM-DD 또는 RFC3339)")
+	to := fs.String("to", "", "작성일시 끝 (미포함, YYYY-MM-DD 또는 RFC3339)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	filter := feed.ArticleFilter{
+		ProviderIDs: splitList(*providers),
+		BoardIDs:    splitList(*boards),
+	}
+	var err error
+	if filter.Since, err = parseDateFlag("from", *from); err != nil {
+		return err
+	}
+	if filter.Until, err = parseDateFlag("to", *to); err != nil {
+		return err
+	}
+	if !filter.Since.IsZero() && !filter.Until.IsZero() && !filter.Since.Before(filter.Until) {
+		return errors.New("-from은 -to보다 이전 시각이어야 합니다")
+	}
+
+	_, db, feedStore, err := openStore(ctx, *configFile)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	var f *os.File
+	w := stdout
+	if *out != "-" {
+		f, err = os.Create(*out)
+		if err != nil {
+			return fmt.Errorf("출력 파일 생성 실패: %w", err)
+		}
+		defer f.Close() // 오류로 중단되는 경우의 정리용이며, 정상 완료 시에는 아래에서 닫고 결과를 확인합니다.
+		w = f
+	}
+
+	count, err := jsonl.Export(ctx, feedStore, filter, w)
+	if err != nil {
+		return fmt.Errorf("게시글 내보내기 중 오류가 발생했습니다 (%d건 기록됨): %w", count, err)
+	}
+
+	// 디스크 공간 부족 등으로 기록이 끝까지 반영되지 못한 경우 Close에서 오류가 보고되므로, 완료를 알리기 전에 확인합니다.
+	if f != nil {
+		if err := f.Close(); err != nil {
+			return fmt.Errorf("출력 파일 닫기 실패 (%d건 기록됨): %w", count, err)
+		}
+	}
+
+	// 표준 출력으로 내보내는 경우에도 데이터와 섞이지 않도록 결과 요약은 표준 에러로 출력합니다.
+	fmt.Fprintf(stderr, "내보내기 완료: 게시글 %d건\n", count)
+
+	return nil
+}
+
+// runImport JSON Lines 형식의 게시글을 데이터베이스로 가져옵니다. 입력 파일을 지정하지 않으면 표준 입력에서 읽습니다.
+//
+// 새 호스트의 빈 데이터베이스에도 가져올 수 있도록, 서버 기동 시와 같이 스키마 마이그레이션과 공급자 동기화를 먼저 수행합니다.
+// 게시글은 설정 파일에 정의된 공급자와 게시판에 속한 경우에만 저장됩니다.
+func runImport(ctx context.Context, args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	fs, configFile := newFlagSet("import", stderr)
+	in := fs.String("in", "-", "입력 파일 경로 ('-'는 표준 입력)")
+	batchSize := fs.Int("batch-size", jsonl.DefaultBatchSize, "한 트랜잭션으로 저장할 게시글 수")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	appConfig, db, feedStore, err := openStore(ctx, *configFile)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	if err := feedStore.Initialize(ctx); err != nil {
+		return fmt.Errorf("RSS 피드 저장소 스키마 생성 중 오류가 발생했습니다: %w", err)
+	}
+	if err := feedStore.SyncProviders(ctx, appConfig.RSSFeed.Providers); err != nil {
+		return fmt.Errorf("RSS 피드 마스터 정보 동기화 중 오류가 발생했습니다: %w", err)
+	}
+
+	var f *os.File
+	r := stdin
+	if *in != "-" {
+		f, err = os.Open(*in)
+		if err != nil {
+			return fmt.Errorf("입력 파일 열기 실패: %w", err)
+		}
+		defer f.Close() // 오류로 중단되는 경우의 정리용이며, 정상 완료 시에는 아래에서 닫고 결과를 확인합니다.
+		r = f
+	}
+
+	result, err := jsonl.Import(ctx, feedStore, r, *batchSize)
+	if result != nil {
+		fmt.Fprintf(stdout, "가져오기 결과: 읽음 %d건, 저장 %d건, 실패 %d건, 삭제 표시 복원 %d건\n", result.Read, result.Saved, result.Failed, result.MarkedDeleted)
+	}
+	if err != nil {
+		return fmt.Errorf("게시글 가져오기 중 오류가 발생했습니다: %w", err)
+	}
+
+	if f != nil {
+		if err := f.Close(); err != nil {
+			return fmt.Errorf("입력 파일 닫기 실패: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// tokenCommands token 명령어의 하위 명령어 목록입니다.
+var tokenCommands = map[string]func(ctx context.Context, args []string, stdout, stderr io.Writer) error{
+	"create": runTokenCreate,
+	"list":   runTokenList,
+	"revoke": runTokenRevoke,
+	"usage":  runTokenUsage,
+}
+
+// runToken 비공개 피드 접근 토큰을 관리하는 하위 명령어(create, list, revoke, usage)를 실행합니다.
+func runToken(ctx context.Context, args []string, _ io.Reader, stdout, stderr io.Writer) error {
+	if len(args) == 0 || tokenCommands[args[0]] == nil {
+		fmt.Fprintf(stderr, "사용법: %s token <create|list|revoke|usage> [옵션]\n\n", config.AppName)
+		fmt.Fprintln(stderr, "  create  접근 토큰을 발급합니다 (토큰 원문은 발급 시 한 번만 출력됩니다)")
+		fmt.Fprintln(stderr, "  list    발급한 접근 토큰 목록을 출력합니다")
+		fmt.Fprintln(stderr, "  revoke  접근 토큰을 폐기합니다")
+		fmt.Fprintln(stderr, "  usage   접근 토큰의 최근 사용 기록을 출력합니다")
+		if len(args) > 0 && (args[0] == "-h" || args[0] == "-help" || args[0] == "--help") {
+			return flag.ErrHelp
+		}
+		return errors.New("token 명령어의 하위 명령어(create, list, revoke, usage)를 지정해야 합니다")
+	}
+
+	return tokenCommands[args[0]](ctx, args[1:], stdout, stderr)
+}
+
+// openAccessTokenStore 저장소를 열고 접근 토큰 저장소로 사용할 수 있는지 확인합니다. 반환된 *sql.DB는 호출자가 닫아야 합니다.
+//
+// 서버보다 먼저 실행되더라도 토큰 테이블이 존재하도록 스키마 마이그레이션을 수행합니다. (이미 최신 스키마이면 아무 작업도 하지 않습니다)
+func openAccessTokenStore(ctx context.Context, configFile string) (*config.AppConfig, *sql.DB, feed.AccessTokenRepository, error) {
+	appConfig, db, feedStore, err := openStore(ctx, configFile)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	repo, ok := feedStore.(feed.AccessTokenRepository)
+	if !ok {
+		_ = db.Close()
+		return nil, nil, nil, fmt.Errorf("현재 저장소(%s)는 접근 토큰을 지원하지 않습니다", appConfig.Database.DriverOrDefault())
+	}
+
+	if err := feedStore.Initialize(ctx); err != nil {
+		_ = db.Close()
+		return nil, nil, nil, fmt.Errorf("RSS 피드 저장소 스키마 생성 중 오류가 발생했습니다: %w", err)
+	}
+
+	return appConfig, db, repo, nil
+}
+
+// runTokenCreate 접근 토큰을 발급하고 토큰 원문과 구독 주소 형식을 출력합니다.
+func runTokenCreate(ctx context.Context, args []string, stdout, stderr io.Writer) error {
+	fs, configFile := newFlagSet("token create", stderr)
+	name := fs.String("name", "", "토큰을 발급받을 구독자 이름 (필수)")
+	scopes := fs.String("scope", "", "접근 범위 목록 (쉼표로 구분, '*', '<공급자 ID>', '<공급자 ID>/<게시판 ID>')")
+	expires := fs.String("expires", "", "만료 일시 (YYYY-MM-DD 또는 RFC3339, 생략 시 만료되지 않음)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	token := &feed.AccessToken{Name: *name, Scopes: splitList(*scopes), CreatedAt: now}
+
+	var err error
+	if token.ExpiresAt, err = parseDateFlag("expires", *expires); err != nil {
+		return err
+	}
+	if err := token.Validate(now); err != nil {
+		return err
+	}
+
+	appConfig, db, repo, err := openAccessTokenStore(ctx, *configFile)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	if err := checkAccessTokenScopes(appConfig, token.Scopes); err != nil {
+		return err
+	}
+
+	secret, err := feed.NewAccessTokenSecret()
+	if err != nil {
+		return err
+	}
+	token.Hash = feed.HashAccessToken(secret)
+
+	id, err := repo.CreateAccessToken(ctx, token)
+	if err != nil {
+		return fmt.Errorf("접근 토큰 저장 중 오류가 발생했습니다: %w", err)
+	}
+
+	fmt.Fprintf(stdout, "접근 토큰 발급 완료 (ID: %d, 이름: %s, 범위: %s)\n", id, token.Name, strings.Join(token.Scopes, ","))
+	fmt.Fprintf(stdout, "토큰: %s\n", secret)
+	fmt.Fprintf(stdout, "구독 주소: <서버 주소>/t/%s/<공급자 ID>.xml\n", secret)
+	fmt.Fprintln(stdout, "토큰 원문은 다시 확인할 수 없으므로 구독자에게 전달한 뒤 안전하게 보관하세요.")
+
+	return nil
+}
+
+// checkAccessTokenScopes 토큰 범위의 공급자와 게시판이 설정 파일에 정의되어 있는지 확인합니다. 오타로 쓸모없는 토큰이 발급되는 것을 막습니다.
+func checkAccessTokenScopes(appConfig *config.AppConfig, scopes []string) error {
+	for _, scope := range scopes {
+		if scope == feed.AccessTokenScopeAll {
+			continue
+		}
+
+		providerID, boardID, hasBoard := strings.Cut(scope, "/")
+
+		var provider *config.ProviderConfig
+		for _, p := range appConfig.RSSFeed.Providers {
+			if strings.EqualFold(p.ID, providerID) {
+				provider = p
+				break
+			}
+		}
+		if provider == nil {
+			return fmt.Errorf("토큰 범위('%s')의 공급자(%s)가 설정 파일에 없습니다", scope, providerID)
+		}
+		if hasBoard && !provider.Config.HasBoard(boardID) {
+			return fmt.Errorf("토큰 범위('%s')의 게시판(%s)이 공급자(%s) 설정에 없습니다", scope, boardID, provider.ID)
+		}
+	}
+
+	return nil
+}
+
+// runTokenList 발급한 접근 토큰 목록을 출력합니다. 토큰 원문과 해시는 출력하지 않습니다.
+func runTokenList(ctx context.Context, args []string, stdout, stderr io.Writer) error {
+	fs, configFile := newFlagSet("token list", stderr)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	_, db, repo, err := openAccessTokenStore(ctx, *configFile)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	tokens, err := repo.ListAccessTokens(ctx)
+	if err != nil {
+		return fmt.Errorf("접근 토큰 목록 조회 중 오류가 발생했습니다: %w", err)
+	}
+
+	formatTime := func(t time.Time) string {
+		if t.IsZero() {
+			return "-"
+		}
+		return t.Local().Format("2006-01-02 15:04")
+	}
+
+	now := time.Now()
+	tw := tabwriter.NewWriter(stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\t이름\t범위\t상태\t만료\t마지막 사용")
+	for _, t := range tokens {
+		status := "사용 중"
+		switch {
+		case !t.RevokedAt.IsZero():
+			status = "폐기됨"
+		case !t.IsActive(now):
+			status = "만료됨"
+		}
+		fmt.Fprintf(tw, "%d\t%s\t%s\t%s\t%s\t%s\n", t.ID, t.Name, strings.Join(t.Scopes, ","), status, formatTime(t.ExpiresAt), formatTime(t.LastUsedAt))
+	}
+
+	return tw.Flush()
+}
+
+// runTokenRevoke 접근 토큰을 폐기합니다. 폐기된 토큰으로 요청한 비공개 피드는 즉시 거부됩니다.
+func runTokenRevoke(ctx context.Context, args []string, stdout, stderr io.Writer) error {
+	fs, configFile := newFlagSet("token revoke", stderr)
+	id := fs.Int64("id", 0, "폐기할 토큰 ID (필수)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *id <= 0 {
+		return errors.New("-id는 1 이상의 정수여야 합니다")
+	}
+
+	_, db, repo, err := openAccessTokenStore(ctx, *configFile)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	revoked, err := repo.RevokeAccessToken(ctx, *id)
+	if err != nil {
+		return fmt.Errorf("접근 토큰 폐기 중 오류가 발생했습니다: %w", err)
+	}
+	if !revoked {
+		return fmt.Errorf("토큰(ID: %d)을 찾을 수 없거나 이미 폐기되었습니다", *id)
+	}
+
+	fmt.Fprintf(stdout, "접근 토큰 폐기 완료 (ID: %d)\n", *id)
+
+	return nil
+}
+
+// runTokenUsage 접근 토큰의 최근 사용 기록을 출력합니다.
+func runTokenUsage(ctx context.Context, args []string, stdout, stderr io.Writer) error {
+	fs, configFile := newFlagSet("token usage", stderr)
+	id := fs.Int64("id", 0, "사용 기록을 조회할 토큰 ID (필수)")
+	limit := fs.Int("limit", 20, "출력할 최대 기록 수")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *id <= 0 {
+		return errors.New("-id는 1 이상의 정수여야 합니다")
+	}
+	if *limit <= 0 {
+		return errors.New("-limit은 1 이상의 정수여야 합니다")
+	}
+
+	_, db, repo, err := openAccessTokenStore(ctx, *configFile)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	usages, err := repo.ListAccessTokenUsage(ctx, *id, *limit)
+	if err != nil {
+		return fmt.Errorf("접근 토큰 사용 기록 조회 중 오류가 발생했습니다: %w", err)
+	}
+
+	tw := tabwriter.NewWriter(stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "일시\t경로\tIP\tUser-Agent")
+	for _, u := range usages {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", u.UsedAt.Local().Format("2006-01-02 15:04:05"), u.Path, u.RemoteIP, u.UserAgent)
+	}
+
+	return tw.Flush()
+}
+
+// runHashPassword 표준 입력의 첫 줄을 비밀번호로 읽어 bcrypt 해시를 출력합니다.
+//
+// 비밀번호가 셸 기록이나 프로세스 목록에 남지 않도록 인자 대신 표준 입력으로 받습니다.
+//
+//	echo -n 'my-password' | rss-feed-server hash-password
+func runHashPassword(_ context.Context, args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet(config.AppName+" hash-password", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	cost := fs.Int("cost", bcrypt.DefaultCost, fmt.Sprintf("bcrypt 비용 (%d~%d)", bcrypt.MinCost, bcrypt.MaxCost))
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *cost < bcrypt.MinCost || *cost > bcrypt.MaxCost {
+		return fmt.Errorf("-cost는 %d 이상 %d 이하의 정수여야 합니다", bcrypt.MinCost, bcrypt.MaxCost)
+	}
+
+	line, err := bufio.NewReader(stdin).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("비밀번호 읽기 실패: %w", err)
+	}
+	password := strings.TrimRight(line, "\r\n")
+	if password == "" {
+		return errors.New("표준 입력으로 비밀번호를 전달해야 합니다")
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), *cost)
+	if err != nil {
+		return fmt.Errorf("비밀번호 해시 생성 실패: %w", err)
+	}
+
+	fmt.Fprintln(stdout, string(hash))
+
+	return nil
+}
+
+// splitList 쉼표로 구분된 목록을 공백을 제거하여 분리합니다. 빈 문자열이면 nil을 반환합니다.
+func splitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
+
+// parseDateFlag 날짜(YYYY-MM-DD, 로컬 시간대의 자정) 또는 RFC3339 형식의 플래그 값을 해석합니다. 빈 문자열이면 zero value를 반환합니다.
+func parseDateFlag(name, value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	if t, err := time.ParseInLocation("2006-01-02", value, time.Local); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+
+	return time.Time{}, fmt.Errorf("-%s 값(%s)은 YYYY-MM-DD 또는 RFC3339 형식이어야 합니다", name, value)
+}
@@ -0,0 +1,183 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// commandTestConfig 하위 명령어 테스트용 설정입니다. 데이터베이스는 작업 디렉터리의 기본 SQLite 파일을 사용합니다.
+const commandTestConfig = `{
+	"rss_feed": {
+		"providers": [
+			{
+				"id":   "p1",
+				"site": "YeosuCityHall",
+				"config": {
+					"id":     "cfg1",
+					"name":   "테스트공급자",
+					"url":    "http://example.com",
+					"boards": [ { "id": "b1", "name": "게시판" } ]
+				},
+				"scheduler": { "time_spec": "@every 5m" }
+			}
+		]
+	},
+	"ws": { "listen_port": 18080 }
+}`
+
+func TestIsCommand(t *testing.T) {
+	assert.False(t, isCommand(nil))
+	assert.False(t, isCommand([]string{"-test.run=TestX"}))
+	assert.True(t, isCommand([]string{"backup"}))
+}
+
+func TestRunCommand_Unknown(t *testing.T) {
+	var stderr bytes.Buffer
+	err := runCommand(context.Background(), []string{"restore"}, nil, &bytes.Buffer{}, &stderr)
+	require.Error(t, err)
+	assert.Contains(t, stderr.String(), "backup")
+	assert.Contains(t, stderr.String(), "export")
+	assert.Contains(t, stderr.String(), "import")
+}
+
+// TestPrintUsage 명령어 이름의 길이와 관계없이 모든 명령어의 설명이 같은 열에서 시작해야 합니다.
+func TestPrintUsage(t *testing.T) {
+	var out bytes.Buffer
+	printUsage(&out)
+
+	column := -1
+	for name, cmd := range commands {
+		var line string
+		for _, l := range strings.Split(out.String(), "\n") {
+			if strings.HasPrefix(l, "  "+name+" ") {
+				line = l
+				break
+			}
+		}
+		require.NotEmpty(t, line, "'%s' 명령어가 사용법에 없습니다", name)
+
+		idx := strings.Index(line, cmd.summary)
+		require.Positive(t, idx)
+		assert.Greater(t, idx, len("  "+name), "'%s' 명령어의 이름과 설명 사이에 공백이 있어야 합니다", name)
+		if column == -1 {
+			column = idx
+		}
+		assert.Equal(t, column, idx, "'%s' 명령어의 설명이 다른 명령어와 다른 열에서 시작합니다", name)
+	}
+}
+
+func TestRunCommand_Help(t *testing.T) {
+	var stderr bytes.Buffer
+	err := runCommand(context.Background(), []string{"export", "-h"}, nil, &bytes.Buffer{}, &stderr)
+	assert.NoError(t, err)
+	assert.Contains(t, stderr.String(), "-provider")
+}
+
+// TestRunCommand_ImportExportBackup 가져오기 → 내보내기 → 백업으로 이어지는 호스트 이전 절차를 검증합니다.
+func TestRunCommand_ImportExportBackup(t *testing.T) {
+	tempDir := setupEnv(t, commandTestConfig)
+	ctx := context.Background()
+
+	input := strings.Join([]string{
+		`{"provider_id":"p1","board_id":"b1","article_id":"1","title":"3월 글","link":"http://example.com/1","created_at":"2024-03-15T09:30:00+09:00"}`,
+		`{"provider_id":"p1","board_id":"b1","article_id":"2","title":"4월 글","link":"http://example.com/2","created_at":"2024-04-15T09:30:00+09:00"}`,
+	}, "\n")
+
+	// 1. 가져오기 (빈 데이터베이스에 스키마 생성 및 공급자 동기화 후 저장)
+	var stdout bytes.Buffer
+	require.NoError(t, runCommand(ctx, []string{"import"}, strings.NewReader(input), &stdout, &bytes.Buffer{}))
+	assert.Contains(t, stdout.String(), "저장 2건")
+
+	// 2. 기간을 지정하여 내보내기
+	outPath := filepath.Join(tempDir, "export.jsonl")
+	var stderr bytes.Buffer
+	require.NoError(t, runCommand(ctx, []string{"export", "-out", outPath, "-provider", "p1", "-from", "2024-04-01"}, nil, &bytes.Buffer{}, &stderr))
+	assert.Contains(t, stderr.String(), "1건")
+
+	exported, err := os.ReadFile(outPath)
+	require.NoError(t, err)
+	assert.Contains(t, string(exported), `"article_id":"2"`)
+	assert.NotContains(t, string(exported), `"article_id":"1"`)
+
+	// 3. 백업
+	stdout.Reset()
+	require.NoError(t, runCommand(ctx, []string{"backup", "-out-dir", "backups"}, nil, &stdout, &bytes.Buffer{}))
+
+	matches, err := filepath.Glob(filepath.Join(tempDir, "backups", "rss-feed-server-*.db"))
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+	assert.Contains(t, stdout.String(), filepath.Base(matches[0]))
+}
+
+func TestRunCommand_ExportInvalidDate(t *testing.T) {
+	setupEnv(t, commandTestConfig)
+
+	err := runCommand(context.Background(), []string{"export", "-from", "2024/04/01"}, nil, &bytes.Buffer{}, &bytes.Buffer{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "-from")
+
+	err = runCommand(context.Background(), []string{"export", "-from", "2024-04-01", "-to", "2024-03-01"}, nil, &bytes.Buffer{}, &bytes.Buffer{})
+	assert.Error(t, err)
+}
+
+// TestRunCommand_Token 접근 토큰의 발급 → 목록 → 폐기 절차와 범위 검증을 확인합니다.
+func TestRunCommand_Token(t *testing.T) {
+	setupEnv(t, commandTestConfig)
+	ctx := context.Background()
+
+	// 설정 파일에 없는 공급자나 게시판을 범위로 지정하면 발급하지 않습니다.
+	err := runCommand(ctx, []string{"token", "create", "-name", "구독자", "-scope", "unknown"}, nil, &bytes.Buffer{}, &bytes.Buffer{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown")
+
+	err = runCommand(ctx, []string{"token", "create", "-name", "구독자", "-scope", "p1/b9"}, nil, &bytes.Buffer{}, &bytes.Buffer{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "b9")
+
+	// 1. 발급
+	var stdout bytes.Buffer
+	require.NoError(t, runCommand(ctx, []string{"token", "create", "-name", "구독자", "-scope", "p1/b1", "-expires", "2999-01-01"}, nil, &stdout, &bytes.Buffer{}))
+	assert.Contains(t, stdout.String(), "ID: 1")
+	assert.Contains(t, stdout.String(), "토큰: rft_")
+
+	// 2. 목록 (토큰 원문은 출력하지 않습니다)
+	stdout.Reset()
+	require.NoError(t, runCommand(ctx, []string{"token", "list"}, nil, &stdout, &bytes.Buffer{}))
+	assert.Contains(t, stdout.String(), "p1/b1")
+	assert.Contains(t, stdout.String(), "사용 중")
+	assert.NotContains(t, stdout.String(), "rft_")
+
+	// 3. 폐기 (두 번째 폐기는 실패합니다)
+	stdout.Reset()
+	require.NoError(t, runCommand(ctx, []string{"token", "revoke", "-id", "1"}, nil, &stdout, &bytes.Buffer{}))
+	assert.Contains(t, stdout.String(), "폐기 완료")
+	assert.Error(t, runCommand(ctx, []string{"token", "revoke", "-id", "1"}, nil, &bytes.Buffer{}, &bytes.Buffer{}))
+
+	stdout.Reset()
+	require.NoError(t, runCommand(ctx, []string{"token", "list"}, nil, &stdout, &bytes.Buffer{}))
+	assert.Contains(t, stdout.String(), "폐기됨")
+
+	// 4. 하위 명령어 누락
+	var stderr bytes.Buffer
+	assert.Error(t, runCommand(ctx, []string{"token"}, nil, &bytes.Buffer{}, &stderr))
+	assert.Contains(t, stderr.String(), "revoke")
+}
+
+func TestRunCommand_HashPassword(t *testing.T) {
+	var stdout bytes.Buffer
+	require.NoError(t, runCommand(context.Background(), []string{"hash-password", "-cost", "4"}, strings.NewReader("my-password\n"), &stdout, &bytes.Buffer{}))
+
+	hash := strings.TrimSpace(stdout.String())
+	assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(hash), []byte("my-password")), "줄바꿈을 제외한 입력이 비밀번호로 사용되어야 합니다")
+
+	assert.Error(t, runCommand(context.Background(), []string{"hash-password"}, strings.NewReader(""), &bytes.Buffer{}, &bytes.Buffer{}))
+	assert.Error(t, runCommand(context.Background(), []string{"hash-password", "-cost", "99"}, strings.NewReader("x"), &bytes.Buffer{}, &bytes.Buffer{}))
+}
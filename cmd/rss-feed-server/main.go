@@ -16,7 +16,8 @@ import (
 	"github.com/darkkaiser/rss-feed-server/internal/service"
 	"github.com/darkkaiser/rss-feed-server/internal/service/api"
 	"github.com/darkkaiser/rss-feed-server/internal/service/crawl"
-	"github.com/darkkaiser/rss-feed-server/internal/store/sqlite"
+	"github.com/darkkaiser/rss-feed-server/internal/store"
+	"github.com/darkkaiser/rss-feed-server/internal/tracing"
 	"github.com/darkkaiser/rss-feed-server/internal/version"
 )
 
@@ -31,7 +32,8 @@ import (
 // @description 1. **통합 RSS 피드 제공**: 여러 형태의 웹 게시판들을 RSS 2.0 표준 규격으로 일원화하여 서빙합니다.
 // @description 2. **피드 목록 요약 페이지**: 서비스 중인 전체 RSS 피드 목록을 HTML 화면(`GET /`)으로 제공합니다.
 // @description 3. **백그라운드 크롤링 엔진**: 설정된 스케줄에 따라 게시글을 미리 수집하므로, 요청 시 별도 외부 서버 조회 없이 빠르게 응답합니다.
-// @description 4. **표준 에러 응답 포맷**: 모든 에러 응답은 `{"result_code": <HTTP 상태 코드>, "message": "<에러 메시지>"}` JSON 형식을 따릅니다.
+// @description 4. **JSON REST API**: 공급자, 게시판, 게시글, 게시글 수 통계를 `/api/v1` 경로에서 JSON으로 제공합니다.
+// @description 5. **표준 에러 응답 포맷**: 모든 에러 응답은 `{"result_code": <HTTP 상태 코드>, "message": "<에러 메시지>"}` JSON 형식을 따릅니다.
 // @description
 // @description ## 🚀 사용 안내
 // @description
@@ -52,6 +54,14 @@ import (
 // @BasePath /
 // @schemes http https
 
+// @securityDefinitions.apikey AdminAPIKey
+// @in header
+// @name X-API-Key
+// @description 관리 엔드포인트(/admin)용 API 키 (설정 파일의 admin.api_keys)
+
+// @securityDefinitions.basic AdminBasicAuth
+// @description 관리 엔드포인트(/admin)용 관리자 계정 (설정 파일의 admin.users)
+
 const (
 	banner = `
   ____   ____   ____    _____                 _   ____
@@ -73,6 +83,15 @@ var (
 )
 
 func main() {
+	// 백업, 내보내기 등 관리용 하위 명령어가 지정되면 서버를 구동하지 않고 해당 명령어만 실행합니다.
+	if isCommand(os.Args[1:]) {
+		if err := runCommand(context.Background(), os.Args[1:], os.Stdin, os.Stdout, os.Stderr); err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	if err := run(nil, nil, nil); err != nil {
 		fmt.Fprintf(os.Stderr, "%v\n", err)
 		os.Exit(1)
@@ -134,7 +153,25 @@ func run(testDB *sql.DB, testServices []service.Service, testTermC <-chan os.Sig
 		"arch":         buildInfo.Arch,
 	}).Info("RSS Feed Server 초기화 프로세스를 시작합니다")
 
-	// 7. 알림 서비스 클라이언트(NotifyClient) 초기화
+	// 7. 분산 추적(OpenTelemetry) 초기화
+	// 설정에서 추적이 활성화된 경우 API 요청, 크롤링 실행, HTTP 요청, DB 쿼리 구간(Span)을 지정된 대상으로 내보냅니다.
+	// 종료 시에는 서비스와 DB가 모두 정리된 뒤 마지막으로 실행되어, 종료 과정에서 기록된 Span까지 빠짐없이 내보냅니다.
+	shutdownTracing, err := tracing.Setup(context.Background(), &appConfig.Tracing, buildInfo.Version)
+	if err != nil {
+		return fmt.Errorf("분산 추적을 초기화하는 중 치명적인 오류가 발생했습니다: %w", err)
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		if err := shutdownTracing(ctx); err != nil {
+			applog.WithComponentAndFields(component, applog.Fields{
+				"error": err,
+			}).Warn("분산 추적 종료 중 내보내지 못한 Span이 있습니다")
+		}
+	}()
+
+	// 8. 알림 서비스 클라이언트(NotifyClient) 초기화
 	notifyClient, err := notify.NewClient(&notify.Config{
 		URL:           appConfig.NotifyAPI.URL,
 		AppKey:        appConfig.NotifyAPI.AppKey,
@@ -150,14 +187,15 @@ func run(testDB *sql.DB, testServices []service.Service, testTermC <-chan os.Sig
 		}
 	}
 
-	// 8. 데이터베이스 초기화
+	// 9. 데이터베이스 초기화
+	// 설정된 데이터베이스 종류(sqlite, postgres)에 맞는 드라이버로 연결합니다.
 	var db *sql.DB
 	if testDB != nil {
 		db = testDB
 	} else {
-		db, err = sqlite.Open(context.Background(), fmt.Sprintf("./%s.db?_fk=1&_journal_mode=WAL&_busy_timeout=5000", config.AppName))
+		db, err = store.Open(context.Background(), &appConfig.Database)
 		if err != nil {
-			m := "SQLite 데이터베이스 초기화 중 치명적인 오류가 발생했습니다"
+			m := fmt.Sprintf("데이터베이스(%s) 초기화 중 치명적인 오류가 발생했습니다", appConfig.Database.DriverOrDefault())
 
 			if notifyClient != nil {
 				ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
@@ -171,7 +209,7 @@ func run(testDB *sql.DB, testServices []service.Service, testTermC <-chan os.Sig
 	}
 	defer func(db *sql.DB) {
 		if err := db.Close(); err != nil {
-			m := "SQLite 데이터베이스 연결 해제 중에 오류가 발생하였습니다"
+			m := "데이터베이스 연결 해제 중에 오류가 발생하였습니다"
 
 			applog.WithComponentAndFields(component, applog.Fields{
 				"error": err,
@@ -183,8 +221,8 @@ func run(testDB *sql.DB, testServices []service.Service, testTermC <-chan os.Sig
 		}
 	}(db)
 
-	// 9. RSS Feed Store 초기화
-	store, err := sqlite.New(db)
+	// 10. RSS Feed Store 초기화
+	feedStore, err := store.New(appConfig.Database.DriverOrDefault(), db)
 	if err != nil {
 		m := "RSS 피드 저장소 객체 생성 중 치명적인 오류가 발생했습니다"
 
@@ -198,8 +236,8 @@ func run(testDB *sql.DB, testServices []service.Service, testTermC <-chan os.Sig
 		return fmt.Errorf("%s: %w", m, err)
 	}
 
-	// 10. RSS Feed Store 스키마 마이그레이션
-	if err := store.Initialize(context.Background()); err != nil {
+	// 11. RSS Feed Store 스키마 마이그레이션
+	if err := feedStore.Initialize(context.Background()); err != nil {
 		m := "RSS 피드 저장소 스키마 생성 중 치명적인 오류가 발생했습니다"
 
 		if notifyClient != nil {
@@ -209,8 +247,8 @@ func run(testDB *sql.DB, testServices []service.Service, testTermC <-chan os.Sig
 		return fmt.Errorf("%s: %w", m, err)
 	}
 
-	// 11. RSS Feed Provider 설정 데이터 동기화
-	if err := store.SyncProviders(context.Background(), appConfig.RSSFeed.Providers); err != nil {
+	// 12. RSS Feed Provider 설정 데이터 동기화
+	if err := feedStore.SyncProviders(context.Background(), appConfig.RSSFeed.Providers); err != nil {
 		m := "RSS 피드 마스터 정보 동기화 중 치명적인 오류가 발생했습니다"
 
 		if notifyClient != nil {
@@ -220,35 +258,38 @@ func run(testDB *sql.DB, testServices []service.Service, testTermC <-chan os.Sig
 		return fmt.Errorf("%s: %w", m, err)
 	}
 
-	// 12. RSS Feed 보관 기한 만료 데이터 정리
-	if err := store.PurgeOldArticles(context.Background(), appConfig.RSSFeed.Providers); err != nil {
-		m := "RSS 피드 만료 데이터 정리 중 치명적인 오류가 발생했습니다"
-
-		if notifyClient != nil {
-			notifyClient.NotifyError(context.Background(), fmt.Sprintf("%s\r\n\r\n%s", m, err))
-		}
-
-		return fmt.Errorf("%s: %w", m, err)
+	// 13. RSS Feed 보관 정책(보관 기간, 보관 개수)을 벗어난 데이터 정리
+	//     삭제 내역과 오류는 LogPurgeResults가 로그와 알림으로 함께 보고합니다.
+	purgeResults, err := feedStore.PurgeOldArticles(context.Background(), appConfig.RSSFeed.Providers)
+	crawl.LogPurgeResults(context.Background(), notifyClient, purgeResults, err)
+	if err != nil {
+		return fmt.Errorf("RSS 피드 만료 데이터 정리 중 치명적인 오류가 발생했습니다: %w", err)
 	}
 
-	// 13. 서비스 객체 생성 및 연결
+	// 14. 서비스 객체 생성 및 연결
 	var services []service.Service
 	if testServices != nil {
 		services = testServices
 	} else {
+		crawlService := crawl.NewService(&appConfig.RSSFeed, feedStore, notifyClient)
+
+		// 관리 API에서 파싱 실패 스냅샷을 현재 파서로 재생할 수 있도록 크롤링 서비스를 연결합니다.
+		apiService := api.NewService(appConfig, feedStore, notifyClient)
+		apiService.SetParseSnapshotReplayer(crawlService)
+
 		services = []service.Service{
-			api.NewService(appConfig, store, notifyClient),
-			crawl.NewService(&appConfig.RSSFeed, store, notifyClient),
+			apiService,
+			crawlService,
 		}
 	}
 
-	// 14. 서비스 생명주기 관리 컨텍스트 설정
+	// 15. 서비스 생명주기 관리 컨텍스트 설정
 	// 전체 서비스의 종료 신호를 전파하는 Context(serviceStopCtx)와
 	// 모든 서비스가 안전하게 종료될 때까지 대기하는 WaitGroup(serviceStopWG)을 초기화합니다.
 	serviceStopCtx, serviceStopCancel := context.WithCancel(context.Background())
 	serviceStopWG := &sync.WaitGroup{}
 
-	// 15. 서비스 병렬 기동
+	// 16. 서비스 병렬 기동
 	// 준비된 모든 서비스를 별도의 고루틴 또는 비동기 컨텍스트에서 시작합니다.
 	// 하나라도 초기화에 실패하면 즉시 전체 서버 구동을 중단하고 롤백(종료) 절차를 밟습니다.
 	for _, s := range services {
@@ -260,7 +301,7 @@ func run(testDB *sql.DB, testServices []service.Service, testTermC <-chan os.Sig
 		}
 	}
 
-	// 16. OS 시그널 처리기 등록
+	// 17. OS 시그널 처리기 등록
 	// 운영체제로부터의 종료 신호(SIGTERM: 정상 종료, SIGINT: Ctrl+C)를 수신할 채널을 생성합니다.
 	// 이는 서버가 즉시 종료되지 않고, 진행 중인 작업을 마무리할 시간을 확보(Graceful Shutdown)하기 위함입니다.
 	var termC <-chan os.Signal
@@ -274,19 +315,19 @@ func run(testDB *sql.DB, testServices []service.Service, testTermC <-chan os.Sig
 
 	applog.WithComponent(component).Info("RSS Feed Server 초기화가 성공적으로 완료되었습니다 (Ready to Serve)")
 
-	// 17. 메인 루프 대기
+	// 18. 메인 루프 대기
 	// 종료 신호가 들어올 때까지 메인 고루틴을 블로킹 상태로 유지합니다.
 	sig := <-termC
 	applog.WithComponentAndFields(component, applog.Fields{
 		"signal": sig,
 	}).Info("종료 신호(Signal)를 수신했습니다. Graceful Shutdown 프로세스를 시작합니다")
 
-	// 18. 서비스 종료 전파
+	// 19. 서비스 종료 전파
 	// 취소 함수(serviceStopCancel)를 호출하여 `serviceStopCtx`를 대기하고 있는 모든 하위 서비스에 종료를 알립니다.
 	// 각 서비스는 이를 감지하고 리소스 정리, 연결 해제 등의 정리 작업을 수행해야 합니다.
 	serviceStopCancel()
 
-	// 19. 종료 타임아웃 프로세스
+	// 20. 종료 타임아웃 프로세스
 	// 서비스들이 무한정 종료되지 않는 상황(Deadlock 등)을 방지하기 위해 강제 종료 타임아웃(30초)을 설정합니다.
 	// `serviceStopCtx`는 이미 취소되었으므로, 타임아웃 카운트는 별도의 독립적인 Context(Background)에서 시작해야 합니다.
 	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), shutdownTimeout)
@@ -299,7 +340,7 @@ func run(testDB *sql.DB, testServices []service.Service, testTermC <-chan os.Sig
 		close(done)
 	}()
 
-	// 20. 종료 완료 대기 또는 강제 종료
+	// 21. 종료 완료 대기 또는 강제 종료
 	select {
 	case <-done:
 		applog.WithComponent(component).Info("모든 서비스가 리소스를 정리하고 정상적으로 종료되었습니다")
@@ -50,21 +50,94 @@ const docTemplate = `{
                 }
             }
         },
-        "/{id}": {
+        "/admin/snapshots": {
             "get": {
-                "description": "지정된 식별자(id)에 해당하는 게시판의 최신 게시글을 RSS 2.0 규격의 XML 형식으로 반환합니다.\nRSS 리더 앱(Feedly, Inoreader 등)의 구독 주소로 직접 사용할 수 있습니다.\n\n**식별자 형식**: ` + "`" + `/{id}` + "`" + ` 와 ` + "`" + `/{id}.xml` + "`" + ` 형식 모두 동일하게 처리됩니다.",
+                "security": [
+                    {
+                        "AdminAPIKey": []
+                    },
+                    {
+                        "AdminBasicAuth": []
+                    }
+                ],
+                "description": "크롤링 중 게시글 파싱에 실패한 페이지의 스냅샷 목록을 최근 기록 순으로 반환합니다. 본문은 포함되지 않습니다.\nviewer 이상의 권한 등급이 필요합니다. (인증 수단이 설정되지 않은 서버는 로컬 요청만 허용)",
                 "produces": [
-                    "application/xml"
+                    "application/json"
                 ],
                 "tags": [
-                    "RSS"
+                    "Admin"
                 ],
-                "summary": "개별 RSS 피드 조회",
+                "summary": "파싱 실패 스냅샷 목록 조회",
                 "parameters": [
                     {
                         "type": "string",
-                        "example": "naver-cafe",
-                        "description": "RSS 피드 고유 식별자",
+                        "description": "RSS 피드 공급자 식별자 (생략 시 전체)",
+                        "name": "provider_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "최대 반환 개수 (기본 50, 최대 200)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.ParseSnapshotListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 limit 값",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "인증 정보 누락 또는 불일치",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "권한 등급 부족 또는 로컬이 아닌 주소에서의 접근",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "저장소가 스냅샷 기록을 지원하지 않음",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/snapshots/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "AdminAPIKey": []
+                    },
+                    {
+                        "AdminBasicAuth": []
+                    }
+                ],
+                "description": "스냅샷에 보관된 원본 응답 본문(HTML 또는 JSON)을 첨부 파일로 내려받습니다.\nviewer 이상의 권한 등급이 필요합니다. (인증 수단이 설정되지 않은 서버는 로컬 요청만 허용)",
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "파싱 실패 스냅샷 원본 내려받기",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "스냅샷 식별자",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -72,42 +145,1521 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "RSS 2.0 규격 XML 문서 (\u003crss version=\\\"2.0\\\"\u003e\u003cchannel\u003e...\u003c/channel\u003e\u003c/rss\u003e)",
+                        "description": "스냅샷 원본 본문",
                         "schema": {
-                            "type": "string"
+                            "type": "file"
                         }
                     },
                     "400": {
-                        "description": "유효하지 않은 피드 식별자 (등록되지 않은 ID)",
+                        "description": "잘못된 식별자",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "서버 내부 오류 (DB 조회 실패 또는 XML 직렬화 오류)",
+                    "401": {
+                        "description": "인증 정보 누락 또는 불일치",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "권한 등급 부족 또는 로컬이 아닌 주소에서의 접근",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "스냅샷 없음 (보관 기한 만료 포함)",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "저장소가 스냅샷 기록을 지원하지 않음",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "response.ErrorResponse": {
-            "type": "object",
-            "properties": {
-                "message": {
-                    "description": "Message 에러 메시지",
-                    "type": "string",
-                    "example": "요청한 RSS 피드(ID:naver-cafe)를 찾을 수 없습니다."
-                },
-                "result_code": {
-                    "description": "ResultCode HTTP 상태 코드 (예: 400, 401, 500)",
-                    "type": "integer",
-                    "example": 400
+        },
+        "/admin/snapshots/{id}/replay": {
+            "post": {
+                "security": [
+                    {
+                        "AdminAPIKey": []
+                    },
+                    {
+                        "AdminBasicAuth": []
+                    }
+                ],
+                "description": "스냅샷에 보관된 원본을 해당 공급자의 현재 목록 파서에 다시 통과시키고, 추출된 게시글과 실패한 행을 반환합니다.\n파서를 수정한 뒤 실패 당시의 원본으로 수정 결과를 확인하는 데 사용합니다. 네트워크 요청은 발생하지 않습니다.\noperator 이상의 권한 등급이 필요합니다. (인증 수단이 설정되지 않은 서버는 로컬 요청만 허용)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "파싱 실패 스냅샷 재생",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "스냅샷 식별자",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.ParseReplayResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 식별자 또는 재생할 수 없는 스냅샷",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "인증 정보 누락 또는 불일치",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "권한 등급 부족 또는 로컬이 아닌 주소에서의 접근",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "스냅샷 또는 공급자 없음",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "스냅샷 재생을 지원하지 않음",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
                 }
             }
+        },
+        "/admin/tokens": {
+            "get": {
+                "security": [
+                    {
+                        "AdminAPIKey": []
+                    },
+                    {
+                        "AdminBasicAuth": []
+                    }
+                ],
+                "description": "비공개 피드 구독용으로 발급한 접근 토큰 목록을 발급 순으로 반환합니다. 토큰 원문은 포함되지 않습니다.\nviewer 이상의 권한 등급이 필요합니다. (인증 수단이 설정되지 않은 서버는 로컬 요청만 허용)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "접근 토큰 목록 조회",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.AccessTokenListResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "인증 정보 누락 또는 불일치",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "권한 등급 부족 또는 로컬이 아닌 주소에서의 접근",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "저장소가 접근 토큰을 지원하지 않음",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "AdminAPIKey": []
+                    },
+                    {
+                        "AdminBasicAuth": []
+                    }
+                ],
+                "description": "비공개 피드를 구독할 수 있는 접근 토큰을 발급합니다. 서버에는 해시만 저장되므로 토큰 원문(secret)은 이 응답에서만 확인할 수 있습니다.\n구독 주소는 '/t/{secret}/{id}.xml' 또는 '/{id}.xml?token={secret}' 형식으로 만듭니다.\nadmin 이상의 권한 등급이 필요합니다. (인증 수단이 설정되지 않은 서버는 로컬 요청만 허용)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "접근 토큰 발급",
+                "parameters": [
+                    {
+                        "description": "발급할 토큰의 이름, 범위, 만료 일시",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.CreateAccessTokenRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/response.AccessTokenCreatedResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청 본문 (이름 누락, 범위 형식 오류, 지난 만료 일시 등)",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "인증 정보 누락 또는 불일치",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "권한 등급 부족 또는 로컬이 아닌 주소에서의 접근",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "저장소가 접근 토큰을 지원하지 않음",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/tokens/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "AdminAPIKey": []
+                    },
+                    {
+                        "AdminBasicAuth": []
+                    }
+                ],
+                "description": "접근 토큰을 폐기합니다. 폐기된 토큰으로 요청한 비공개 피드는 즉시 401 Unauthorized로 거부됩니다.\nadmin 이상의 권한 등급이 필요합니다. (인증 수단이 설정되지 않은 서버는 로컬 요청만 허용)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "접근 토큰 폐기",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "토큰 식별자",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 식별자",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "인증 정보 누락 또는 불일치",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "권한 등급 부족 또는 로컬이 아닌 주소에서의 접근",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "토큰이 없거나 이미 폐기됨",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "저장소가 접근 토큰을 지원하지 않음",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/tokens/{id}/usage": {
+            "get": {
+                "security": [
+                    {
+                        "AdminAPIKey": []
+                    },
+                    {
+                        "AdminBasicAuth": []
+                    }
+                ],
+                "description": "접근 토큰으로 요청한 기록을 최근 순으로 반환합니다. 토큰별로 최근 1,000건까지 보관됩니다.\nviewer 이상의 권한 등급이 필요합니다. (인증 수단이 설정되지 않은 서버는 로컬 요청만 허용)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "접근 토큰 사용 기록 조회",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "토큰 식별자",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "최대 반환 개수 (기본 100, 최대 1000)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.AccessTokenUsageListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 식별자 또는 limit 값",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "인증 정보 누락 또는 불일치",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "권한 등급 부족 또는 로컬이 아닌 주소에서의 접근",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "저장소가 접근 토큰을 지원하지 않음",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/providers": {
+            "get": {
+                "description": "설정 파일에 정의된 모든 RSS 피드 공급자와 게시판, 수집 스케줄을 반환합니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "API v1"
+                ],
+                "summary": "공급자 목록 조회",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProviderListResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/providers/{id}": {
+            "get": {
+                "description": "지정한 RSS 피드 공급자의 게시판과 수집 스케줄을 반환합니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "API v1"
+                ],
+                "summary": "공급자 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "RSS 피드 공급자 식별자",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProviderDetailResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "등록되지 않은 공급자",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/providers/{id}/articles": {
+            "get": {
+                "description": "공급자의 게시글을 최근 작성 순으로 반환합니다. 응답의 next_cursor 값을 cursor 파라미터로 넘기면 다음 페이지를 조회할 수 있습니다.\n설정 파일에서 제외된 게시판의 게시글은 조회되지 않으며, 원문에서 삭제된 게시글은 deleted_at 필드로 구분합니다.\n삭제된 게시글 처리 정책(deleted_article_policy)이 hide인 공급자는 피드와 마찬가지로 삭제된 게시글을 목록에서 제외합니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "API v1"
+                ],
+                "summary": "게시글 목록 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "RSS 피드 공급자 식별자",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "게시판 식별자 목록 (쉼표로 구분, 생략 시 전체)",
+                        "name": "board",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "작성일시 시작 (포함, YYYY-MM-DD 또는 RFC3339)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "작성일시 끝 (미포함, YYYY-MM-DD 또는 RFC3339)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "이전 응답의 next_cursor 값",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "최대 반환 개수 (기본 50, 최대 200)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.ArticleListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 파라미터 (등록되지 않은 게시판, 날짜 형식, 커서 등)",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "등록되지 않은 공급자",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 내부 오류 (DB 조회 실패)",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "저장소가 게시글 조회를 지원하지 않음",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/providers/{id}/boards/{boardID}/articles/{articleID}": {
+            "get": {
+                "description": "공급자, 게시판, 게시글 식별자로 게시글 하나를 반환합니다.\n삭제된 게시글 처리 정책(deleted_article_policy)이 hide인 공급자의 삭제된 게시글은 존재하지 않는 게시글과 같이 404를 반환합니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "API v1"
+                ],
+                "summary": "게시글 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "RSS 피드 공급자 식별자",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "게시판 식별자",
+                        "name": "boardID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "게시글 식별자",
+                        "name": "articleID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.ArticleDetailResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "등록되지 않은 공급자나 게시판, 또는 존재하지 않는 게시글",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 내부 오류 (DB 조회 실패)",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "저장소가 게시글 조회를 지원하지 않음",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/providers/{id}/stats": {
+            "get": {
+                "description": "공급자의 게시판별, 날짜별(서버 시간대 기준) 게시글 수를 반환합니다. 기간을 지정하지 않으면 오늘을 포함한 최근 30일을 집계합니다.\n설정 파일에 정의된 모든 게시판이 포함되며, 게시글이 없는 날짜는 daily 목록에서 생략됩니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "API v1"
+                ],
+                "summary": "게시글 수 통계 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "RSS 피드 공급자 식별자",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "집계 시작 날짜 (포함, YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "집계 끝 날짜 (포함, YYYY-MM-DD, 기본값: 오늘)",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.ArticleStatsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 날짜 형식 또는 최대 366일을 초과하는 기간",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "등록되지 않은 공급자",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 내부 오류 (DB 조회 실패)",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "저장소가 통계 조회를 지원하지 않음",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/{id}": {
+            "get": {
+                "description": "지정된 식별자(id)에 해당하는 게시판의 최신 게시글을 RSS 2.0 규격의 XML 형식으로 반환합니다.\nRSS 리더 앱(Feedly, Inoreader 등)의 구독 주소로 직접 사용할 수 있습니다.\n\n**식별자 형식**: ` + "`" + `/{id}` + "`" + ` 와 ` + "`" + `/{id}.xml` + "`" + ` 형식 모두 동일하게 처리됩니다.",
+                "produces": [
+                    "application/xml"
+                ],
+                "tags": [
+                    "RSS"
+                ],
+                "summary": "개별 RSS 피드 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "naver-cafe",
+                        "description": "RSS 피드 고유 식별자",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "RSS 2.0 규격 XML 문서 (\u003crss version=\\\"2.0\\\"\u003e\u003cchannel\u003e...\u003c/channel\u003e\u003c/rss\u003e)",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "유효하지 않은 피드 식별자 (등록되지 않은 ID)",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 내부 오류 (DB 조회 실패 또는 XML 직렬화 오류)",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/{id}/archive/{date}": {
+            "get": {
+                "description": "지정한 날짜(서버 시간대 기준)에 작성된 게시글을 RFC 5005 아카이브 피드(RSS 2.0)로 반환합니다.\n개별 피드와 각 아카이브 피드는 prev-archive/next-archive 링크로 게시글이 있는 이웃 날짜의 아카이브를 가리키므로,\nRSS 리더는 링크를 따라가며 피드 노출 한도(max_item_count)를 넘어 보관 중인 과거 게시글을 가져갈 수 있습니다.\n아직 끝나지 않은 오늘 이후의 날짜는 조회할 수 없습니다.\n삭제된 게시글 처리 정책(deleted_article_policy)이 hide인 피드는 삭제가 감지된 게시글을 아카이브와 이웃 날짜 계산에서 제외하고, mark이면 제목에 표시합니다.",
+                "produces": [
+                    "application/xml"
+                ],
+                "tags": [
+                    "RSS"
+                ],
+                "summary": "날짜별 아카이브 RSS 피드 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "naver-cafe",
+                        "description": "RSS 피드 고유 식별자",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "2024-03-15",
+                        "description": "아카이브 날짜 (YYYY-MM-DD)",
+                        "name": "date",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "RSS 2.0 규격 XML 문서 (\u003cfh:archive/\u003e 포함)",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 날짜 형식",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "등록되지 않은 피드 또는 게시글이 없는 날짜",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 내부 오류 (DB 조회 실패 또는 XML 직렬화 오류)",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "저장소가 게시글 이력 조회를 지원하지 않음",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/{id}/articles/{boardID}/{articleID}": {
+            "get": {
+                "description": "서버에 보관된 게시글 하나를 본문, 작성 정보, 첨부 파일, 원문 링크와 함께 HTML 페이지로 제공합니다.\n로그인이 필요하거나(네이버 카페) 원문이 삭제된 게시글도 보관 기간 동안은 이 페이지에서 볼 수 있습니다.\n공급자의 use_permalink 설정을 켜면 피드 항목의 링크와 GUID가 이 페이지 주소로 바뀝니다.",
+                "produces": [
+                    "text/html"
+                ],
+                "tags": [
+                    "RSS"
+                ],
+                "summary": "게시글 페이지",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "naver-cafe",
+                        "description": "RSS 피드 고유 식별자",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "게시판 식별자",
+                        "name": "boardID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "게시글 식별자",
+                        "name": "articleID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "게시글 HTML 페이지",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "등록되지 않은 피드나 게시판, 또는 존재하지 않는(숨김 처리된) 게시글",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 내부 오류 (DB 조회 실패 또는 템플릿 렌더링 실패)",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "저장소가 게시글 조회를 지원하지 않음",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/{id}/history": {
+            "get": {
+                "description": "피드 노출 한도(max_item_count)와 관계없이 보관 기간(archive_days) 동안 저장된 게시글을 최신순으로 한 페이지씩 반환합니다.\n응답의 next_cursor 값을 cursor 파라미터로 전달하면 다음 페이지를 조회합니다. 조회 도중 새 게시글이 수집되어도 이미 받은 게시글이 다시 나오지 않습니다.\n삭제된 게시글 처리 정책(deleted_article_policy)이 hide인 피드는 삭제가 감지된 게시글을 제외하며, 그 외에는 deleted_at 필드로 삭제 시각을 표시합니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RSS"
+                ],
+                "summary": "게시글 이력 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "naver-cafe",
+                        "description": "RSS 피드 고유 식별자",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "게시판 식별자 목록 (쉼표로 구분, 생략 시 전체)",
+                        "name": "board",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "작성일시 시작 (포함, YYYY-MM-DD 또는 RFC3339)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "작성일시 끝 (미포함, YYYY-MM-DD 또는 RFC3339)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "이전 응답의 next_cursor 값",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "최대 반환 개수 (기본 50, 최대 200)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.ArticleListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 파라미터 (등록되지 않은 게시판, 날짜 형식, 커서 등)",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "등록되지 않은 피드",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 내부 오류 (DB 조회 실패)",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "저장소가 게시글 이력 조회를 지원하지 않음",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "request.CreateAccessTokenRequest": {
+            "type": "object",
+            "properties": {
+                "expires_at": {
+                    "description": "ExpiresAt 토큰 만료 일시 (생략 시 만료되지 않음)",
+                    "type": "string",
+                    "example": "2025-03-15T00:00:00+09:00"
+                },
+                "name": {
+                    "description": "Name 토큰을 발급받을 구독자를 구분하기 위한 이름",
+                    "type": "string",
+                    "example": "홍길동 (Inoreader)"
+                },
+                "scopes": {
+                    "description": "Scopes 토큰으로 접근할 수 있는 범위 목록 ('*', '\u003c공급자 ID\u003e', '\u003c공급자 ID\u003e/\u003c게시판 ID\u003e')",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "ludypang",
+                        "yeosu-cityhall/notice"
+                    ]
+                }
+            }
+        },
+        "response.AccessTokenCreatedResponse": {
+            "type": "object",
+            "properties": {
+                "result_code": {
+                    "description": "ResultCode 처리 결과 코드 (0: 성공)",
+                    "type": "integer",
+                    "example": 0
+                },
+                "secret": {
+                    "description": "Secret 토큰 원문. 서버에는 해시만 저장되므로 이 응답에서만 확인할 수 있습니다.",
+                    "type": "string",
+                    "example": "rft_3q2-7wEvx3tJd9kY0aQ1bZc8LmN4pR6s"
+                },
+                "token": {
+                    "description": "Token 발급된 토큰 정보",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/response.AccessTokenResponse"
+                        }
+                    ]
+                }
+            }
+        },
+        "response.AccessTokenListResponse": {
+            "type": "object",
+            "properties": {
+                "result_code": {
+                    "description": "ResultCode 처리 결과 코드 (0: 성공)",
+                    "type": "integer",
+                    "example": 0
+                },
+                "tokens": {
+                    "description": "Tokens 발급 순으로 정렬된 토큰 목록",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.AccessTokenResponse"
+                    }
+                }
+            }
+        },
+        "response.AccessTokenResponse": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "description": "Active 폐기되지 않았고 만료되지 않아 현재 사용할 수 있는지 여부",
+                    "type": "boolean",
+                    "example": true
+                },
+                "created_at": {
+                    "description": "CreatedAt 토큰 발급 일시",
+                    "type": "string",
+                    "example": "2024-03-15T09:30:00+09:00"
+                },
+                "expires_at": {
+                    "description": "ExpiresAt 토큰 만료 일시 (만료되지 않는 토큰은 생략)",
+                    "type": "string",
+                    "example": "2025-03-15T00:00:00+09:00"
+                },
+                "id": {
+                    "description": "ID 토큰 고유 식별자",
+                    "type": "integer",
+                    "example": 3
+                },
+                "last_used_at": {
+                    "description": "LastUsedAt 토큰이 마지막으로 사용된 일시 (사용된 적이 없으면 생략)",
+                    "type": "string",
+                    "example": "2024-03-16T07:00:00+09:00"
+                },
+                "name": {
+                    "description": "Name 토큰을 발급받은 구독자 이름",
+                    "type": "string",
+                    "example": "홍길동 (Inoreader)"
+                },
+                "revoked_at": {
+                    "description": "RevokedAt 토큰 폐기 일시 (폐기되지 않았으면 생략)",
+                    "type": "string"
+                },
+                "scopes": {
+                    "description": "Scopes 토큰으로 접근할 수 있는 범위 목록",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "ludypang",
+                        "yeosu-cityhall/notice"
+                    ]
+                }
+            }
+        },
+        "response.AccessTokenUsageListResponse": {
+            "type": "object",
+            "properties": {
+                "result_code": {
+                    "description": "ResultCode 처리 결과 코드 (0: 성공)",
+                    "type": "integer",
+                    "example": 0
+                },
+                "token_id": {
+                    "description": "TokenID 토큰 식별자",
+                    "type": "integer",
+                    "example": 3
+                },
+                "usages": {
+                    "description": "Usages 최근 순으로 정렬된 사용 기록 목록",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.AccessTokenUsageResponse"
+                    }
+                }
+            }
+        },
+        "response.AccessTokenUsageResponse": {
+            "type": "object",
+            "properties": {
+                "path": {
+                    "description": "Path 요청 경로 (토큰 제외)",
+                    "type": "string",
+                    "example": "/ludypang.xml"
+                },
+                "remote_ip": {
+                    "description": "RemoteIP 요청한 클라이언트의 IP 주소",
+                    "type": "string",
+                    "example": "203.0.113.10"
+                },
+                "used_at": {
+                    "description": "UsedAt 요청 일시",
+                    "type": "string",
+                    "example": "2024-03-16T07:00:00+09:00"
+                },
+                "user_agent": {
+                    "description": "UserAgent 요청한 클라이언트의 User-Agent",
+                    "type": "string",
+                    "example": "Inoreader/1.0"
+                }
+            }
+        },
+        "response.ArticleDetailResponse": {
+            "type": "object",
+            "properties": {
+                "article": {
+                    "description": "Article 게시글",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/response.ArticleResponse"
+                        }
+                    ]
+                },
+                "provider_id": {
+                    "description": "ProviderID RSS 피드 공급자 식별자",
+                    "type": "string",
+                    "example": "yeosu-cityhall"
+                },
+                "result_code": {
+                    "description": "ResultCode 처리 결과 코드 (0: 성공)",
+                    "type": "integer",
+                    "example": 0
+                }
+            }
+        },
+        "response.ArticleListResponse": {
+            "type": "object",
+            "properties": {
+                "articles": {
+                    "description": "Articles 최신 작성일시 순으로 정렬된 게시글 목록",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.ArticleResponse"
+                    }
+                },
+                "next_cursor": {
+                    "description": "NextCursor 다음 페이지를 조회할 때 cursor 파라미터로 전달할 값 (마지막 페이지이면 생략)",
+                    "type": "string",
+                    "example": "MjAyNC0wMy0xNVQwMDozMDowMFoKbm90aWNlCjEyMzQ1"
+                },
+                "provider_id": {
+                    "description": "ProviderID RSS 피드 공급자 식별자",
+                    "type": "string",
+                    "example": "yeosu-cityhall"
+                },
+                "result_code": {
+                    "description": "ResultCode 처리 결과 코드 (0: 성공)",
+                    "type": "integer",
+                    "example": 0
+                }
+            }
+        },
+        "response.ArticleResponse": {
+            "type": "object",
+            "properties": {
+                "article_id": {
+                    "description": "ArticleID 게시글 식별자",
+                    "type": "string",
+                    "example": "12345"
+                },
+                "author": {
+                    "description": "Author 작성자",
+                    "type": "string",
+                    "example": "총무과"
+                },
+                "board_id": {
+                    "description": "BoardID 게시판 식별자",
+                    "type": "string",
+                    "example": "notice"
+                },
+                "board_name": {
+                    "description": "BoardName 게시판 이름",
+                    "type": "string",
+                    "example": "공지사항"
+                },
+                "content": {
+                    "description": "Content 게시글 본문",
+                    "type": "string",
+                    "example": "공지사항 본문입니다."
+                },
+                "created_at": {
+                    "description": "CreatedAt 작성일시",
+                    "type": "string",
+                    "example": "2024-03-15T09:30:00+09:00"
+                },
+                "deleted_at": {
+                    "description": "DeletedAt 원문 삭제가 감지된 일시 (삭제가 감지되지 않았으면 생략)",
+                    "type": "string",
+                    "example": "2024-03-20T08:00:00+09:00"
+                },
+                "deleted_reason": {
+                    "description": "DeletedReason 삭제 판정 근거 (removed: 원문 삭제, access_denied: 원문 접근 제한, 삭제가 감지되지 않았으면 생략)",
+                    "type": "string",
+                    "example": "removed"
+                },
+                "link": {
+                    "description": "Link 게시글 원문 주소",
+                    "type": "string",
+                    "example": "https://www.yeosu.go.kr/www/govt/news/notice?mode=view\u0026idx=12345"
+                },
+                "title": {
+                    "description": "Title 게시글 제목",
+                    "type": "string",
+                    "example": "2024년 상반기 공지사항"
+                },
+                "updated_at": {
+                    "description": "UpdatedAt 원문 수정이 감지된 가장 최근 일시 (수정이 감지되지 않았으면 생략)",
+                    "type": "string",
+                    "example": "2024-03-16T10:00:00+09:00"
+                }
+            }
+        },
+        "response.ArticleStatsResponse": {
+            "type": "object",
+            "properties": {
+                "boards": {
+                    "description": "Boards 설정 파일에 정의된 순서의 게시판별 통계",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.BoardStatsResponse"
+                    }
+                },
+                "from": {
+                    "description": "From 집계 시작 날짜 (포함)",
+                    "type": "string",
+                    "example": "2024-03-01"
+                },
+                "provider_id": {
+                    "description": "ProviderID RSS 피드 공급자 식별자",
+                    "type": "string",
+                    "example": "yeosu-cityhall"
+                },
+                "result_code": {
+                    "description": "ResultCode 처리 결과 코드 (0: 성공)",
+                    "type": "integer",
+                    "example": 0
+                },
+                "to": {
+                    "description": "To 집계 끝 날짜 (포함)",
+                    "type": "string",
+                    "example": "2024-03-30"
+                }
+            }
+        },
+        "response.BoardResponse": {
+            "type": "object",
+            "properties": {
+                "archive_days": {
+                    "description": "ArchiveDays 게시글 보관 일수 (0: 기간 제한 없음)",
+                    "type": "integer",
+                    "example": 90
+                },
+                "category": {
+                    "description": "Category 게시판 분류 (설정되지 않았으면 생략)",
+                    "type": "string",
+                    "example": "시정소식"
+                },
+                "id": {
+                    "description": "ID 게시판 식별자",
+                    "type": "string",
+                    "example": "notice"
+                },
+                "max_item_count": {
+                    "description": "MaxItemCount 공급자 피드에 노출하는 이 게시판 게시글의 최대 수 (0: 제한 없음)",
+                    "type": "integer",
+                    "example": 0
+                },
+                "name": {
+                    "description": "Name 게시판 이름",
+                    "type": "string",
+                    "example": "공지사항"
+                }
+            }
+        },
+        "response.BoardStatsResponse": {
+            "type": "object",
+            "properties": {
+                "board_id": {
+                    "description": "BoardID 게시판 식별자",
+                    "type": "string",
+                    "example": "notice"
+                },
+                "board_name": {
+                    "description": "BoardName 게시판 이름",
+                    "type": "string",
+                    "example": "공지사항"
+                },
+                "daily": {
+                    "description": "Daily 게시글이 있는 날짜별 게시글 수 (날짜 순)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.DailyCountResponse"
+                    }
+                },
+                "total": {
+                    "description": "Total 조회 기간 동안 작성된 게시글 수",
+                    "type": "integer",
+                    "example": 34
+                }
+            }
+        },
+        "response.DailyCountResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "description": "Count 작성된 게시글 수",
+                    "type": "integer",
+                    "example": 12
+                },
+                "date": {
+                    "description": "Date 날짜 (서버 시간대 기준, YYYY-MM-DD)",
+                    "type": "string",
+                    "example": "2024-03-15"
+                }
+            }
+        },
+        "response.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "description": "Message 에러 메시지",
+                    "type": "string",
+                    "example": "요청한 RSS 피드(ID:naver-cafe)를 찾을 수 없습니다."
+                },
+                "result_code": {
+                    "description": "ResultCode HTTP 상태 코드 (예: 400, 401, 500)",
+                    "type": "integer",
+                    "example": 400
+                }
+            }
+        },
+        "response.ParseReplayResponse": {
+            "type": "object",
+            "properties": {
+                "articles": {
+                    "description": "Articles 현재 파서가 추출에 성공한 게시글 목록",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.ReplayedArticleResponse"
+                    }
+                },
+                "errors": {
+                    "description": "Errors 현재 파서가 추출에 실패한 행의 에러 메시지 목록",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "result_code": {
+                    "description": "ResultCode 처리 결과 코드 (0: 성공)",
+                    "type": "integer",
+                    "example": 0
+                },
+                "snapshot_id": {
+                    "description": "SnapshotID 재생한 스냅샷 식별자",
+                    "type": "integer",
+                    "example": 17
+                }
+            }
+        },
+        "response.ParseSnapshotListResponse": {
+            "type": "object",
+            "properties": {
+                "result_code": {
+                    "description": "ResultCode 처리 결과 코드 (0: 성공)",
+                    "type": "integer",
+                    "example": 0
+                },
+                "snapshots": {
+                    "description": "Snapshots 최근 기록 순으로 정렬된 스냅샷 목록",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.ParseSnapshotResponse"
+                    }
+                }
+            }
+        },
+        "response.ParseSnapshotResponse": {
+            "type": "object",
+            "properties": {
+                "board_id": {
+                    "description": "BoardID 파싱에 실패한 게시판 식별자 (게시판 구분 없이 수집하는 공급자는 빈 문자열)",
+                    "type": "string",
+                    "example": "notice"
+                },
+                "content_type": {
+                    "description": "ContentType 스냅샷 본문의 미디어 타입",
+                    "type": "string",
+                    "example": "text/html; charset=utf-8"
+                },
+                "created_at": {
+                    "description": "CreatedAt 스냅샷 기록 일시",
+                    "type": "string",
+                    "example": "2024-03-15T09:30:00+09:00"
+                },
+                "error": {
+                    "description": "Error 파싱 실패 사유",
+                    "type": "string",
+                    "example": "3번째 행: [ParsingFailed] 지원되지 않는 작성일 데이터 포맷('홍길동')이 감지되어 시간 변환에 실패하였습니다."
+                },
+                "header": {
+                    "description": "Header 응답을 얻기 위해 전송한 요청 헤더",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        }
+                    }
+                },
+                "id": {
+                    "description": "ID 스냅샷 고유 식별자",
+                    "type": "integer",
+                    "example": 17
+                },
+                "page": {
+                    "description": "Page 파싱에 실패한 목록 페이지 번호",
+                    "type": "integer",
+                    "example": 1
+                },
+                "provider_id": {
+                    "description": "ProviderID 파싱에 실패한 RSS 피드 공급자 식별자",
+                    "type": "string",
+                    "example": "yeosu-cityhall"
+                },
+                "truncated": {
+                    "description": "Truncated 본문이 저장 용량 제한으로 잘렸는지 여부",
+                    "type": "boolean",
+                    "example": false
+                },
+                "url": {
+                    "description": "URL 응답을 요청한 주소",
+                    "type": "string",
+                    "example": "https://www.yeosu.go.kr/www/govt/news/notice?page=1"
+                }
+            }
+        },
+        "response.ProviderDetailResponse": {
+            "type": "object",
+            "properties": {
+                "provider": {
+                    "description": "Provider 공급자",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/response.ProviderResponse"
+                        }
+                    ]
+                },
+                "result_code": {
+                    "description": "ResultCode 처리 결과 코드 (0: 성공)",
+                    "type": "integer",
+                    "example": 0
+                }
+            }
+        },
+        "response.ProviderListResponse": {
+            "type": "object",
+            "properties": {
+                "providers": {
+                    "description": "Providers 설정 파일에 정의된 순서의 공급자 목록",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.ProviderResponse"
+                    }
+                },
+                "result_code": {
+                    "description": "ResultCode 처리 결과 코드 (0: 성공)",
+                    "type": "integer",
+                    "example": 0
+                }
+            }
+        },
+        "response.ProviderResponse": {
+            "type": "object",
+            "properties": {
+                "archive_days": {
+                    "description": "ArchiveDays 게시글 보관 일수 (0: 기간 제한 없음)",
+                    "type": "integer",
+                    "example": 90
+                },
+                "boards": {
+                    "description": "Boards 수집하는 게시판 목록",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.BoardResponse"
+                    }
+                },
+                "description": {
+                    "description": "Description 피드 설명",
+                    "type": "string",
+                    "example": "여수시청 공지사항"
+                },
+                "feed_url": {
+                    "description": "FeedURL RSS 피드 구독 주소",
+                    "type": "string",
+                    "example": "https://rss.darkkaiser.com:3443/yeosu-cityhall"
+                },
+                "id": {
+                    "description": "ID RSS 피드 공급자 식별자",
+                    "type": "string",
+                    "example": "yeosu-cityhall"
+                },
+                "max_item_count": {
+                    "description": "MaxItemCount 피드에 노출하는 최대 게시글 수",
+                    "type": "integer",
+                    "example": 100
+                },
+                "name": {
+                    "description": "Name 피드 이름",
+                    "type": "string",
+                    "example": "여수시청"
+                },
+                "schedule": {
+                    "description": "Schedule 게시글 수집 스케줄 (초 단위를 포함한 6필드 Cron 표현식)",
+                    "type": "string",
+                    "example": "0 */10 * * * *"
+                },
+                "site": {
+                    "description": "Site 수집 대상 사이트 종류",
+                    "type": "string",
+                    "example": "YeosuCityHall"
+                },
+                "url": {
+                    "description": "URL 수집 대상 사이트 주소",
+                    "type": "string",
+                    "example": "https://www.yeosu.go.kr"
+                }
+            }
+        },
+        "response.ReplayedArticleResponse": {
+            "type": "object",
+            "properties": {
+                "article_id": {
+                    "description": "ArticleID 게시글 식별자",
+                    "type": "string",
+                    "example": "12345"
+                },
+                "author": {
+                    "description": "Author 작성자",
+                    "type": "string",
+                    "example": "총무과"
+                },
+                "board_id": {
+                    "description": "BoardID 게시판 식별자",
+                    "type": "string",
+                    "example": "notice"
+                },
+                "created_at": {
+                    "description": "CreatedAt 작성일",
+                    "type": "string",
+                    "example": "2024-03-15T00:00:00+09:00"
+                },
+                "link": {
+                    "description": "Link 게시글 상세 페이지 주소",
+                    "type": "string",
+                    "example": "https://www.yeosu.go.kr/www/govt/news/notice?mode=view\u0026idx=12345"
+                },
+                "title": {
+                    "description": "Title 게시글 제목",
+                    "type": "string",
+                    "example": "2024년 상반기 공지사항"
+                }
+            }
+        },
+        "response.SuccessResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "description": "Message 성공 메시지",
+                    "type": "string",
+                    "example": "성공"
+                },
+                "result_code": {
+                    "description": "ResultCode 처리 결과 코드 (0: 성공)",
+                    "type": "integer",
+                    "example": 0
+                }
+            }
+        }
+    },
+    "securityDefinitions": {
+        "AdminAPIKey": {
+            "description": "관리 엔드포인트(/admin)용 API 키 (설정 파일의 admin.api_keys)",
+            "type": "apiKey",
+            "name": "X-API-Key",
+            "in": "header"
+        },
+        "AdminBasicAuth": {
+            "type": "basic"
         }
     }
 }`
@@ -119,7 +1671,7 @@ var SwaggerInfo = &swag.Spec{
 	BasePath:         "/",
 	Schemes:          []string{"http", "https"},
 	Title:            "RSS Feed Server API",
-	Description:      "네이버 카페, 여수 시청, 여수 쌍봉초등학교 등 다양한 웹 게시판을 자동으로 모니터링하고 크롤링하여 **RSS 피드**를 제공하는 서비스입니다.\n\n각 게시판의 새로운 글을 주기적으로 수집(Polling)하여 자체 데이터베이스에 보관하며, 클라이언트 요청 시 즉시 RSS 2.0 규격의 XML 문서를 생성하여 반환합니다.\n\n## 📌 핵심 기능\n\n1. **통합 RSS 피드 제공**: 여러 형태의 웹 게시판들을 RSS 2.0 표준 규격으로 일원화하여 서빙합니다.\n2. **피드 목록 요약 페이지**: 서비스 중인 전체 RSS 피드 목록을 HTML 화면(`GET /`)으로 제공합니다.\n3. **백그라운드 크롤링 엔진**: 설정된 스케줄에 따라 게시글을 미리 수집하므로, 요청 시 별도 외부 서버 조회 없이 빠르게 응답합니다.\n4. **표준 에러 응답 포맷**: 모든 에러 응답은 `{\"result_code\": <HTTP 상태 코드>, \"message\": \"<에러 메시지>\"}` JSON 형식을 따릅니다.\n\n## 🚀 사용 안내\n\n- `/{id}` 또는 `/{id}.xml` 형식 모두 동일하게 처리됩니다. (예: `/naver-cafe` = `/naver-cafe.xml`)\n- 지원하지 않는 식별자의 경우 400 Bad Request를 반환합니다.\n",
+	Description:      "관리 엔드포인트(/admin)용 관리자 계정 (설정 파일의 admin.users)",
 	InfoInstanceName: "swagger",
 	SwaggerTemplate:  docTemplate,
 	LeftDelim:        "{{",
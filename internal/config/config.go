@@ -29,6 +29,17 @@ const (
 	// DefaultMaxItemCount RSS 피드 수집 시 최대로 유지할 아이템(게시글) 개수의 기본값입니다.
 	DefaultMaxItemCount = 10
 
+	// DefaultPurgeTimeSpec 보관 기간이 지난 게시글을 삭제하는 작업의 기본 실행 주기입니다. (매일 04:30:00)
+	DefaultPurgeTimeSpec = "0 30 4 * * *"
+
+	// ------------------------------------------------------------------------------------------------
+	// 데이터베이스 설정
+	// ------------------------------------------------------------------------------------------------
+
+	// DefaultSQLiteDSN SQLite 사용 시 접속 정보(dsn)가 생략되었을 때 사용하는 기본 DSN입니다.
+	// 외래 키 제약(_fk), WAL 저널 모드, 잠금 대기 시간(_busy_timeout)을 활성화합니다.
+	DefaultSQLiteDSN = "./" + AppName + ".db?_fk=1&_journal_mode=WAL&_busy_timeout=5000"
+
 	// ------------------------------------------------------------------------------------------------
 	// 웹 서비스 설정
 	// ------------------------------------------------------------------------------------------------
@@ -44,6 +55,12 @@ func newDefaultConfig() AppConfig {
 		Debug: false,
 		RSSFeed: RSSFeedConfig{
 			MaxItemCount: DefaultMaxItemCount,
+			Purge: PurgeConfig{
+				TimeSpec: DefaultPurgeTimeSpec,
+			},
+		},
+		Database: DatabaseConfig{
+			Driver: DatabaseDriverSQLite,
 		},
 		WS: WSConfig{
 			ListenPort: DefaultListenPort,
@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -61,10 +62,20 @@ func TestNewDefaultConfig(t *testing.T) {
 		assert.Equal(t, uint(DefaultMaxItemCount), cfg.RSSFeed.MaxItemCount)
 	})
 
+	t.Run("게시글 삭제 작업은 기본 주기로 활성화", func(t *testing.T) {
+		assert.True(t, cfg.RSSFeed.Purge.Enabled())
+		assert.Equal(t, DefaultPurgeTimeSpec, cfg.RSSFeed.Purge.TimeSpec)
+	})
+
 	t.Run("ListenPort 기본값 확인", func(t *testing.T) {
 		assert.Equal(t, DefaultListenPort, cfg.WS.ListenPort)
 	})
 
+	t.Run("데이터베이스 기본값은 SQLite", func(t *testing.T) {
+		assert.Equal(t, DatabaseDriverSQLite, cfg.Database.Driver)
+		assert.Empty(t, cfg.Database.DSN)
+	})
+
 	t.Run("Providers 기본값은 nil (빈 슬라이스)", func(t *testing.T) {
 		assert.Empty(t, cfg.RSSFeed.Providers)
 	})
@@ -199,6 +210,26 @@ func TestLoadWithFile_Success_URLTrailingSlashTrimmed(t *testing.T) {
 	assert.Equal(t, "http://example.com", cfg.RSSFeed.Providers[0].Config.URL)
 }
 
+func TestLoadWithFile_Success_HTTPConfig(t *testing.T) {
+	// 공통 HTTP 설정과 공급자별 HTTP 설정의 시간 문자열이 time.Duration으로 변환되는지 확인합니다.
+	content := strings.Replace(minimalValidConfigJSON, `"rss_feed": {`, `"rss_feed": {
+		"http": { "timeout": "10s", "max_retries": 2 },`, 1)
+	content = strings.Replace(content, `"scheduler": { "time_spec": "@every 5m" }`, `"scheduler": { "time_spec": "@every 5m" },
+				"http": { "timeout": "1m30s", "proxy_url": "http://proxy:8080" }`, 1)
+	path := writeTempConfig(t, content)
+
+	cfg, _, err := LoadWithFile(path)
+	require.NoError(t, err)
+
+	require.NotNil(t, cfg.RSSFeed.HTTP.Timeout)
+	assert.Equal(t, 10*time.Second, *cfg.RSSFeed.HTTP.Timeout)
+
+	merged := cfg.RSSFeed.HTTP.Merge(cfg.RSSFeed.Providers[0].HTTP)
+	assert.Equal(t, 90*time.Second, *merged.Timeout)
+	assert.Equal(t, 2, *merged.MaxRetries)
+	assert.Equal(t, "http://proxy:8080", *merged.ProxyURL)
+}
+
 func TestLoadWithFile_EnvOverride(t *testing.T) {
 	path := writeTempConfig(t, minimalValidConfigJSON)
 
@@ -2,8 +2,11 @@ package config
 
 import (
 	"fmt"
+	"mime"
+	"net/url"
 	"reflect"
 	"strings"
+	"time"
 
 	apperrors "github.com/darkkaiser/rss-feed-server/internal/errors"
 	"github.com/go-playground/validator/v10"
@@ -89,3 +92,96 @@ func checkStruct(v *validator.Validate, s interface{}, contextName string, field
 	}
 	return nil
 }
+
+const (
+	// httpMaxRetries HTTP 설정에서 허용하는 최대 재시도 횟수입니다. (Fetcher의 재시도 상한과 같습니다)
+	httpMaxRetries = 10
+
+	// httpNoProxy 프록시를 사용하지 않고 직접 연결하도록 지정하는 proxy_url 값입니다.
+	httpNoProxy = "DIRECT"
+
+	// httpNoByteLimit 응답 본문 크기를 제한하지 않도록 지정하는 max_bytes 값입니다.
+	httpNoByteLimit = -1
+)
+
+// validateHTTPConfig HTTP 클라이언트 설정 항목들의 값 범위와 형식을 검증합니다.
+//
+// Fetcher는 범위를 벗어난 값을 조용히 기본값으로 보정하므로, 의도와 다르게 동작하는 설정이
+// 서버 기동 시점에 드러나도록 보정 대상이 되는 값은 모두 오류로 처리합니다.
+func validateHTTPConfig(c *HTTPConfig, contextName string) error {
+	// 1단계: 프록시 주소
+	if c.ProxyURL != nil && *c.ProxyURL != "" && *c.ProxyURL != httpNoProxy {
+		u, err := url.Parse(*c.ProxyURL)
+		if err != nil || u.Host == "" {
+			// 프록시 주소에는 인증 정보가 포함될 수 있으므로 원문을 오류 메시지에 포함하지 않습니다.
+			return apperrors.New(apperrors.InvalidInput, fmt.Sprintf("%s의 프록시 주소(proxy_url) 형식이 올바르지 않습니다", contextName))
+		}
+		switch u.Scheme {
+		case "http", "https", "socks5":
+		default:
+			return apperrors.New(apperrors.InvalidInput, fmt.Sprintf("%s의 프록시 주소(proxy_url)는 http, https, socks5 중 하나의 스킴을 사용해야 합니다: '%s'", contextName, u.Redacted()))
+		}
+	}
+
+	// 2단계: 시간 항목 (음수 불가)
+	durations := []struct {
+		name  string
+		value *time.Duration
+	}{
+		{"timeout", c.Timeout},
+		{"tls_handshake_timeout", c.TLSHandshakeTimeout},
+		{"response_header_timeout", c.ResponseHeaderTimeout},
+		{"idle_conn_timeout", c.IdleConnTimeout},
+		{"min_retry_delay", c.MinRetryDelay},
+		{"max_retry_delay", c.MaxRetryDelay},
+	}
+	for _, d := range durations {
+		if d.value != nil && *d.value < 0 {
+			return apperrors.New(apperrors.InvalidInput, fmt.Sprintf("%s의 %s는 0 이상이어야 합니다: '%s'", contextName, d.name, *d.value))
+		}
+	}
+
+	// 3단계: 개수 항목 (음수 불가)
+	counts := []struct {
+		name  string
+		value *int
+	}{
+		{"max_redirects", c.MaxRedirects},
+		{"max_idle_conns", c.MaxIdleConns},
+		{"max_idle_conns_per_host", c.MaxIdleConnsPerHost},
+		{"max_conns_per_host", c.MaxConnsPerHost},
+	}
+	for _, n := range counts {
+		if n.value != nil && *n.value < 0 {
+			return apperrors.New(apperrors.InvalidInput, fmt.Sprintf("%s의 %s는 0 이상이어야 합니다: '%d'", contextName, n.name, *n.value))
+		}
+	}
+
+	// 4단계: 재시도 정책
+	if c.MaxRetries != nil && (*c.MaxRetries < 0 || *c.MaxRetries > httpMaxRetries) {
+		return apperrors.New(apperrors.InvalidInput, fmt.Sprintf("%s의 재시도 횟수(max_retries)는 0에서 %d 사이의 값이어야 합니다: '%d'", contextName, httpMaxRetries, *c.MaxRetries))
+	}
+	if c.MinRetryDelay != nil && *c.MinRetryDelay < time.Second {
+		return apperrors.New(apperrors.InvalidInput, fmt.Sprintf("%s의 최소 재시도 대기 시간(min_retry_delay)은 1초 이상이어야 합니다: '%s'", contextName, *c.MinRetryDelay))
+	}
+	if c.MinRetryDelay != nil && c.MaxRetryDelay != nil && *c.MaxRetryDelay < *c.MinRetryDelay {
+		return apperrors.New(apperrors.InvalidInput, fmt.Sprintf("%s의 최대 재시도 대기 시간(max_retry_delay)은 최소 재시도 대기 시간(min_retry_delay)보다 작을 수 없습니다", contextName))
+	}
+
+	// 5단계: 응답 검증 및 제한
+	if c.MaxBytes != nil && *c.MaxBytes <= 0 && *c.MaxBytes != httpNoByteLimit {
+		return apperrors.New(apperrors.InvalidInput, fmt.Sprintf("%s의 응답 본문 최대 크기(max_bytes)는 양수 또는 -1(제한 없음)이어야 합니다: '%d'", contextName, *c.MaxBytes))
+	}
+	for _, ua := range c.UserAgents {
+		if strings.TrimSpace(ua) == "" {
+			return apperrors.New(apperrors.InvalidInput, fmt.Sprintf("%s의 User-Agent 목록(user_agents)에 빈 값이 포함되어 있습니다", contextName))
+		}
+	}
+	for _, mt := range c.AllowedMimeTypes {
+		if parsed, params, err := mime.ParseMediaType(mt); err != nil || len(params) > 0 || !strings.Contains(parsed, "/") {
+			return apperrors.New(apperrors.InvalidInput, fmt.Sprintf("%s의 허용 MIME 타입(allowed_mime_types)은 파라미터 없는 'type/subtype' 형식이어야 합니다: '%s'", contextName, mt))
+		}
+	}
+
+	return nil
+}
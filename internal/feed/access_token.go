@@ -0,0 +1,178 @@
+package feed
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// AccessTokenScopeAll 모든 공급자와 게시판에 접근할 수 있는 토큰 범위입니다.
+	AccessTokenScopeAll = "*"
+
+	// accessTokenPrefix 발급한 토큰 원문의 접두사입니다. 로그나 설정 파일에 섞여 들어간 토큰을 눈으로 식별하기 쉽게 합니다.
+	accessTokenPrefix = "rft_"
+
+	// accessTokenBytes 토큰 원문을 만드는 무작위 바이트 수입니다.
+	accessTokenBytes = 24
+)
+
+// AccessToken 비공개(private)로 설정된 공급자나 게시판의 피드를 구독할 수 있도록 구독자별로 발급하는 접근 토큰입니다.
+//
+// 대부분의 RSS 리더는 요청 헤더를 지정할 수 없으므로 토큰은 구독 주소(쿼리 파라미터 또는 경로)에 포함되어 전달됩니다.
+// 주소가 유출되더라도 저장소에서 원문을 복원할 수 없도록 토큰 원문은 발급 시 한 번만 보여 주고 해시(Hash)만 저장합니다.
+type AccessToken struct {
+	// ID 토큰의 고유 식별자입니다. 저장 시 저장소가 발급합니다.
+	ID int64
+
+	// Name 토큰을 발급받은 구독자를 구분하기 위한 이름입니다.
+	Name string
+
+	// Hash 토큰 원문의 SHA-256 해시(16진수 문자열)입니다. HashAccessToken으로 계산합니다.
+	Hash string
+
+	// Scopes 토큰으로 접근할 수 있는 범위 목록입니다.
+	// AccessTokenScopeAll("*"), 공급자 ID("ludypang"), 공급자 ID와 게시판 ID("ludypang/26") 형식을 사용합니다.
+	Scopes []string
+
+	// ExpiresAt 토큰의 만료 일시입니다. zero value이면 만료되지 않습니다.
+	ExpiresAt time.Time
+
+	// CreatedAt 토큰이 발급된 일시입니다.
+	CreatedAt time.Time
+
+	// LastUsedAt 토큰이 마지막으로 사용된 일시입니다. 한 번도 사용되지 않았으면 zero value입니다.
+	LastUsedAt time.Time
+
+	// RevokedAt 토큰이 폐기된 일시입니다. 폐기되지 않았으면 zero value입니다.
+	RevokedAt time.Time
+}
+
+// IsActive 토큰이 폐기되지 않았고 now 시점에 만료되지 않았는지 여부를 반환합니다.
+func (t *AccessToken) IsActive(now time.Time) bool {
+	if !t.RevokedAt.IsZero() {
+		return false
+	}
+	return t.ExpiresAt.IsZero() || now.Before(t.ExpiresAt)
+}
+
+// Allows 토큰의 범위에 지정한 공급자의 게시판이 포함되는지 여부를 반환합니다. 식별자는 대소문자를 구분하지 않습니다.
+func (t *AccessToken) Allows(providerID, boardID string) bool {
+	for _, scope := range t.Scopes {
+		p, b, hasBoard := strings.Cut(scope, "/")
+		if scope == AccessTokenScopeAll || (strings.EqualFold(p, providerID) && (!hasBoard || b == boardID)) {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsProvider 토큰의 범위에 지정한 공급자의 게시판이 하나라도 포함되는지 여부를 반환합니다.
+func (t *AccessToken) AllowsProvider(providerID string) bool {
+	for _, scope := range t.Scopes {
+		p, _, _ := strings.Cut(scope, "/")
+		if scope == AccessTokenScopeAll || strings.EqualFold(p, providerID) {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate 새로 발급할 토큰의 이름, 범위, 만료 일시가 올바른지 검사합니다. 만료 일시는 now 이후여야 합니다.
+func (t *AccessToken) Validate(now time.Time) error {
+	if strings.TrimSpace(t.Name) == "" {
+		return errors.New("토큰 이름은 필수입니다")
+	}
+	if len(t.Scopes) == 0 {
+		return errors.New("토큰 범위를 하나 이상 지정해야 합니다")
+	}
+	for _, scope := range t.Scopes {
+		if err := ValidateAccessTokenScope(scope); err != nil {
+			return err
+		}
+	}
+	if !t.ExpiresAt.IsZero() && !t.ExpiresAt.After(now) {
+		return fmt.Errorf("토큰 만료 일시(%s)는 현재 시각 이후여야 합니다", t.ExpiresAt.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+// ValidateAccessTokenScope 토큰 범위 문자열의 형식을 검사합니다. 공급자나 게시판이 실제로 존재하는지는 확인하지 않습니다.
+func ValidateAccessTokenScope(scope string) error {
+	if scope == AccessTokenScopeAll {
+		return nil
+	}
+
+	p, b, hasBoard := strings.Cut(scope, "/")
+	if p == "" || strings.TrimSpace(scope) != scope || strings.Contains(p, "*") || (hasBoard && (b == "" || strings.Contains(b, "/"))) {
+		return fmt.Errorf("토큰 범위('%s')는 '*', '<공급자 ID>', '<공급자 ID>/<게시판 ID>' 형식이어야 합니다", scope)
+	}
+
+	return nil
+}
+
+// NewAccessTokenSecret 새 토큰 원문을 생성합니다. 주소에 그대로 넣을 수 있도록 URL 안전 문자만 사용합니다.
+func NewAccessTokenSecret() (string, error) {
+	buf := make([]byte, accessTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("접근 토큰 생성에 필요한 난수 생성 실패: %w", err)
+	}
+
+	return accessTokenPrefix + base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// HashAccessToken 토큰 원문의 SHA-256 해시(16진수 문자열)를 계산합니다.
+// 토큰 원문은 충분히 긴 무작위 값이므로 별도의 솔트(Salt)나 느린 해시 함수를 사용하지 않습니다.
+func HashAccessToken(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// AccessTokenUsage 접근 토큰이 사용된 요청 한 건의 기록입니다.
+type AccessTokenUsage struct {
+	// TokenID 사용된 토큰의 ID입니다.
+	TokenID int64
+
+	// Path 요청 경로입니다. 토큰 원문은 포함하지 않습니다.
+	Path string
+
+	// RemoteIP 요청한 클라이언트의 IP 주소입니다.
+	RemoteIP string
+
+	// UserAgent 요청한 클라이언트의 User-Agent입니다.
+	UserAgent string
+
+	// UsedAt 요청 일시입니다.
+	UsedAt time.Time
+}
+
+// AccessTokenRepository 비공개 피드의 접근 토큰을 보관하고 사용 기록을 남기는 저장소 인터페이스입니다.
+//
+// 선택적(Optional) 인터페이스이며, 비공개 피드는 주입받은 Repository가 이 인터페이스를 함께 구현하는 경우에만 토큰으로 열람할 수 있습니다.
+type AccessTokenRepository interface {
+	// CreateAccessToken 토큰을 저장하고 발급된 ID를 반환합니다. 토큰 원문이 아닌 해시(Hash)를 저장합니다.
+	CreateAccessToken(ctx context.Context, token *AccessToken) (int64, error)
+
+	// GetAccessTokenByHash 지정한 해시의 토큰을 반환합니다. 폐기되었거나 만료된 토큰도 반환하며, 존재하지 않으면 nil, nil을 반환합니다.
+	GetAccessTokenByHash(ctx context.Context, hash string) (*AccessToken, error)
+
+	// ListAccessTokens 모든 토큰을 발급 순으로 반환합니다.
+	ListAccessTokens(ctx context.Context) ([]*AccessToken, error)
+
+	// RevokeAccessToken 지정한 ID의 토큰을 폐기합니다. 토큰이 없거나 이미 폐기되었으면 false를 반환합니다.
+	RevokeAccessToken(ctx context.Context, id int64) (bool, error)
+
+	// RecordAccessTokenUsage 토큰 사용 기록을 남기고 토큰의 마지막 사용 일시를 갱신합니다.
+	// 해당 토큰의 사용 기록이 keep개를 초과하면 오래된 기록부터 삭제합니다.
+	RecordAccessTokenUsage(ctx context.Context, usage *AccessTokenUsage, keep int) error
+
+	// ListAccessTokenUsage 지정한 토큰의 사용 기록을 최근 순으로 최대 limit개 반환합니다.
+	ListAccessTokenUsage(ctx context.Context, tokenID int64, limit int) ([]*AccessTokenUsage, error)
+}
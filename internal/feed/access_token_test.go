@@ -0,0 +1,93 @@
+package feed_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+)
+
+func TestAccessToken_Allows(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		scopes   []string
+		provider string
+		board    string
+		allowed  bool
+		anyBoard bool
+	}{
+		{"전체 범위", []string{"*"}, "ludypang", "26", true, true},
+		{"공급자 범위는 모든 게시판을 포함한다", []string{"ludypang"}, "ludypang", "26", true, true},
+		{"공급자 ID는 대소문자를 구분하지 않는다", []string{"LudyPang"}, "ludypang", "26", true, true},
+		{"게시판 범위는 해당 게시판만 포함한다", []string{"ludypang/26"}, "ludypang", "26", true, true},
+		{"다른 게시판은 포함하지 않는다", []string{"ludypang/26"}, "ludypang", "27", false, true},
+		{"다른 공급자는 포함하지 않는다", []string{"ludypang"}, "yeosu", "1", false, false},
+		{"범위가 없으면 아무것도 포함하지 않는다", nil, "ludypang", "26", false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			token := &feed.AccessToken{Scopes: tt.scopes}
+			assert.Equal(t, tt.allowed, token.Allows(tt.provider, tt.board))
+			assert.Equal(t, tt.anyBoard, token.AllowsProvider(tt.provider))
+		})
+	}
+}
+
+func TestAccessToken_IsActive(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	assert.True(t, (&feed.AccessToken{}).IsActive(now), "만료 일시가 없으면 만료되지 않습니다")
+	assert.True(t, (&feed.AccessToken{ExpiresAt: now.Add(time.Hour)}).IsActive(now))
+	assert.False(t, (&feed.AccessToken{ExpiresAt: now}).IsActive(now), "만료 일시부터는 사용할 수 없습니다")
+	assert.False(t, (&feed.AccessToken{RevokedAt: now.Add(-time.Hour)}).IsActive(now))
+}
+
+func TestAccessToken_Validate(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	assert.NoError(t, (&feed.AccessToken{Name: "구독자", Scopes: []string{"ludypang"}}).Validate(now))
+	assert.NoError(t, (&feed.AccessToken{Name: "구독자", Scopes: []string{"*"}, ExpiresAt: now.Add(time.Hour)}).Validate(now))
+
+	assert.Error(t, (&feed.AccessToken{Name: " ", Scopes: []string{"*"}}).Validate(now), "이름은 필수입니다")
+	assert.Error(t, (&feed.AccessToken{Name: "구독자"}).Validate(now), "범위는 필수입니다")
+	assert.Error(t, (&feed.AccessToken{Name: "구독자", Scopes: []string{"ludypang/"}}).Validate(now))
+	assert.Error(t, (&feed.AccessToken{Name: "구독자", Scopes: []string{"*"}, ExpiresAt: now}).Validate(now), "이미 만료된 토큰은 발급할 수 없습니다")
+}
+
+func TestValidateAccessTokenScope(t *testing.T) {
+	t.Parallel()
+
+	for _, scope := range []string{"*", "ludypang", "ludypang/26"} {
+		assert.NoError(t, feed.ValidateAccessTokenScope(scope), scope)
+	}
+	for _, scope := range []string{"", " ludypang", "ludypang/", "/26", "ludy*", "ludypang/26/1"} {
+		assert.Error(t, feed.ValidateAccessTokenScope(scope), scope)
+	}
+}
+
+func TestNewAccessTokenSecret(t *testing.T) {
+	t.Parallel()
+
+	a, err := feed.NewAccessTokenSecret()
+	require.NoError(t, err)
+	b, err := feed.NewAccessTokenSecret()
+	require.NoError(t, err)
+
+	assert.True(t, strings.HasPrefix(a, "rft_"))
+	assert.Len(t, a, len("rft_")+32)
+	assert.NotEqual(t, a, b)
+	assert.Len(t, feed.HashAccessToken(a), 64)
+	assert.NotEqual(t, feed.HashAccessToken(a), feed.HashAccessToken(b))
+}
@@ -0,0 +1,64 @@
+package feed
+
+// SourcedArticle 공급자 ID를 함께 담은 게시글입니다. 여러 공급자의 게시글을 한 목록에서 다룰 때 사용합니다.
+type SourcedArticle struct {
+	// ProviderID 게시글을 수집한 공급자 ID입니다.
+	ProviderID string
+
+	*Article
+}
+
+// Cluster 내용이 거의 같은 것으로 판단되어 하나로 묶인 게시글들입니다.
+type Cluster struct {
+	// Article 묶음을 대표하여 피드에 노출할 게시글입니다.
+	Article *Article
+
+	// Duplicates 대표 게시글과 내용이 거의 같은 나머지 게시글입니다. 같은 공급자의 다른 게시판이나 다른 공급자의 게시글일 수 있습니다.
+	Duplicates []*SourcedArticle
+}
+
+// ClusterArticles 공급자(providerID)의 게시글 목록을 지문(Fingerprint)의 해밍 거리가 threshold 이하인 것끼리 묶습니다.
+//
+// articles의 순서는 유지되며, 각 묶음의 대표 게시글은 묶음에서 목록상 가장 앞선 게시글입니다.
+// others는 다른 공급자의 게시글로, 대표 게시글이 되지는 않고 거리가 가까운 묶음의 중복 게시글로만 추가됩니다.
+// 지문이 없는(0) 게시글은 다른 게시글과 묶이지 않습니다.
+func ClusterArticles(providerID string, articles []*Article, others []*SourcedArticle, threshold int) []*Cluster {
+	clusters := make([]*Cluster, 0, len(articles))
+
+	findCluster := func(fingerprint uint64) *Cluster {
+		if fingerprint == 0 {
+			return nil
+		}
+		for _, c := range clusters {
+			if c.Article.Fingerprint != 0 && FingerprintDistance(c.Article.Fingerprint, fingerprint) <= threshold {
+				return c
+			}
+		}
+		return nil
+	}
+
+	for _, article := range articles {
+		if article == nil {
+			continue
+		}
+
+		if c := findCluster(article.Fingerprint); c != nil {
+			c.Duplicates = append(c.Duplicates, &SourcedArticle{ProviderID: providerID, Article: article})
+			continue
+		}
+
+		clusters = append(clusters, &Cluster{Article: article})
+	}
+
+	for _, other := range others {
+		if other == nil || other.Article == nil {
+			continue
+		}
+
+		if c := findCluster(other.Fingerprint); c != nil {
+			c.Duplicates = append(c.Duplicates, other)
+		}
+	}
+
+	return clusters
+}
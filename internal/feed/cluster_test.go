@@ -0,0 +1,60 @@
+package feed_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+)
+
+func TestClusterArticles(t *testing.T) {
+	t.Parallel()
+
+	a1 := &feed.Article{BoardID: "b1", ArticleID: "1", Fingerprint: 0b0000_1111}
+	a2 := &feed.Article{BoardID: "b2", ArticleID: "2", Fingerprint: 0b0000_1110} // a1과 거리 1
+	a3 := &feed.Article{BoardID: "b1", ArticleID: "3", Fingerprint: 0b1111_0000} // a1과 거리 8
+	a4 := &feed.Article{BoardID: "b1", ArticleID: "4"}                           // 지문 없음
+	a5 := &feed.Article{BoardID: "b2", ArticleID: "5"}                           // 지문 없음
+
+	t.Run("거리가 threshold 이하인 게시글을 순서를 유지하며 묶는다", func(t *testing.T) {
+		t.Parallel()
+
+		clusters := feed.ClusterArticles("p1", []*feed.Article{a1, a2, a3, nil, a4, a5}, nil, 2)
+		require.Len(t, clusters, 4)
+
+		assert.Same(t, a1, clusters[0].Article)
+		require.Len(t, clusters[0].Duplicates, 1)
+		assert.Equal(t, "p1", clusters[0].Duplicates[0].ProviderID)
+		assert.Same(t, a2, clusters[0].Duplicates[0].Article)
+
+		assert.Same(t, a3, clusters[1].Article)
+		assert.Empty(t, clusters[1].Duplicates)
+		assert.Same(t, a4, clusters[2].Article, "지문이 없는 게시글은 묶이지 않아야 합니다")
+		assert.Same(t, a5, clusters[3].Article)
+	})
+
+	t.Run("threshold가 0이면 지문이 같은 게시글만 묶는다", func(t *testing.T) {
+		t.Parallel()
+
+		clusters := feed.ClusterArticles("p1", []*feed.Article{a1, a2}, nil, 0)
+		assert.Len(t, clusters, 2)
+	})
+
+	t.Run("다른 공급자의 게시글은 중복으로만 추가된다", func(t *testing.T) {
+		t.Parallel()
+
+		others := []*feed.SourcedArticle{
+			{ProviderID: "p2", Article: &feed.Article{BoardID: "x", ArticleID: "9", Fingerprint: 0b1111_0001}},
+			{ProviderID: "p2", Article: &feed.Article{BoardID: "x", ArticleID: "10", Fingerprint: ^uint64(0)}},
+		}
+
+		clusters := feed.ClusterArticles("p1", []*feed.Article{a1, a3}, others, 2)
+		require.Len(t, clusters, 2)
+		assert.Empty(t, clusters[0].Duplicates)
+		require.Len(t, clusters[1].Duplicates, 1)
+		assert.Equal(t, "p2", clusters[1].Duplicates[0].ProviderID)
+		assert.Equal(t, "9", clusters[1].Duplicates[0].ArticleID)
+	})
+}
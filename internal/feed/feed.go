@@ -2,7 +2,11 @@ package feed
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
+	"strings"
 	"time"
 )
 
@@ -34,6 +38,55 @@ type Article struct {
 
 	// CreatedAt 게시글이 최초 작성된 일시입니다.
 	CreatedAt time.Time
+
+	// UpdatedAt 최초 수집 이후 원문 본문의 수정이 감지된 가장 최근 일시입니다.
+	// 한 번도 수정이 감지되지 않은 게시글은 zero value(time.Time{})를 가집니다.
+	UpdatedAt time.Time
+
+	// DeletedAt 원문 사이트에서 게시글이 삭제(또는 접근 제한)된 것으로 감지된 일시입니다.
+	// 삭제가 감지되지 않은 게시글은 zero value(time.Time{})를 가집니다.
+	DeletedAt time.Time
+
+	// DeletedReason 삭제로 판정한 근거(원문 삭제, 접근 제한)입니다. 삭제가 감지되지 않은 게시글은 빈 문자열을 가집니다.
+	DeletedReason DeletedReason
+
+	// Fingerprint 제목과 본문으로 계산한 근사 중복 판별용 지문(SimHash)입니다. 저장소가 게시글을 저장할 때 계산합니다.
+	// 지문이 계산되지 않은 게시글(지문 도입 이전에 저장된 게시글 등)은 0을 가집니다.
+	Fingerprint uint64
+}
+
+// IsEdited 최초 수집 이후 원문 본문의 수정이 한 번 이상 감지되었는지 여부를 반환합니다.
+func (a Article) IsEdited() bool {
+	return !a.UpdatedAt.IsZero()
+}
+
+// IsDeleted 원문 사이트에서 게시글의 삭제가 감지되었는지 여부를 반환합니다. (접근 제한 포함)
+func (a Article) IsDeleted() bool {
+	return !a.DeletedAt.IsZero()
+}
+
+// IsAccessDenied 게시글이 원문 삭제가 아니라 접근 제한으로 인해 삭제된 것으로 판정되었는지 여부를 반환합니다.
+func (a Article) IsAccessDenied() bool {
+	return a.IsDeleted() && a.DeletedReason == DeletedReasonAccessDenied
+}
+
+// DeletedReason 원문 삭제 감지(CheckDeleted)가 게시글을 삭제된 것으로 판정한 근거입니다.
+type DeletedReason string
+
+const (
+	// DeletedReasonRemoved 원문 게시글이 삭제되었음이 확인된 경우입니다. (HTTP 404, 사이트의 삭제 안내 등)
+	// 근거가 기록되기 전에 삭제가 감지된 게시글도 이 값으로 취급합니다.
+	DeletedReasonRemoved DeletedReason = "removed"
+
+	// DeletedReasonAccessDenied 이전에 본문을 수집했던 게시글에 더 이상 접근할 수 없게 된 경우입니다. (HTTP 401/403)
+	// 작성자나 관리자가 게시글을 비공개로 돌린 경우가 대부분이지만, 원문이 실제로 삭제되었는지는 알 수 없습니다.
+	DeletedReasonAccessDenied DeletedReason = "access_denied"
+)
+
+// ContentHash 게시글 본문의 변경 여부를 비교하기 위한 SHA-256 해시(16진수 문자열)를 계산합니다.
+func ContentHash(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
 }
 
 func (a Article) String() string {
@@ -58,3 +111,303 @@ type Repository interface {
 	// 다음 크롤링 때 이전에 수집한 글을 중복해서 가져오지 않도록 반드시 호출해야 합니다.
 	UpsertLatestCrawledArticleID(ctx context.Context, providerID, boardID, articleID string) error
 }
+
+// RevisionRepository 이미 수집한 게시글의 원문 수정 이력(Revision)을 조회하고 기록하는 저장소 인터페이스입니다.
+//
+// 모든 저장소가 수정 이력 관리를 지원할 필요는 없으므로 Repository와 분리된 선택적(Optional) 인터페이스로 정의합니다.
+// 재검증(Revalidation) 작업은 주입받은 Repository가 이 인터페이스를 함께 구현하는 경우에만 동작합니다.
+type RevisionRepository interface {
+	// GetRecentArticles 지정한 providerID에서 since 이후에 작성된 게시글 목록을 본문과 함께 최신 작성일시 순으로 반환합니다.
+	GetRecentArticles(ctx context.Context, providerID string, since time.Time) ([]*Article, error)
+
+	// SaveArticleRevision 원문 수정이 감지된 게시글의 새 본문을 수정 이력으로 기록하고, 게시글의 본문과 수정일시(UpdatedAt)를 갱신합니다.
+	SaveArticleRevision(ctx context.Context, providerID string, article *Article) error
+}
+
+// DeletionRepository 원문 사이트에서 삭제된 게시글을 감지하기 위해 점검 대상을 추출하고 삭제 사실을 기록하는 저장소 인터페이스입니다.
+//
+// RevisionRepository와 마찬가지로 선택적(Optional) 인터페이스이며,
+// 삭제 감지 작업은 주입받은 Repository가 이 인터페이스를 함께 구현하는 경우에만 동작합니다.
+type DeletionRepository interface {
+	// SampleRecentArticles 지정한 providerID에서 since 이후에 작성되었고 아직 삭제가 감지되지 않은 게시글 중 최대 limit개를 무작위로 추출합니다.
+	SampleRecentArticles(ctx context.Context, providerID string, since time.Time, limit uint) ([]*Article, error)
+
+	// MarkArticleDeleted 지정한 게시글의 삭제 감지 일시(DeletedAt)와 판정 근거(DeletedReason)를 기록합니다.
+	MarkArticleDeleted(ctx context.Context, providerID, boardID, articleID string, deletedAt time.Time, reason DeletedReason) error
+}
+
+// VisibleArticleRepository 삭제가 감지된 게시글을 제외하고 피드에 노출할 게시글을 조회하는 저장소 인터페이스입니다.
+//
+// 삭제된 게시글을 숨기는 공급자(deleted_article_policy: hide)의 피드는 조회 결과에서 삭제된 게시글을 걸러내야 하는데,
+// 조회 후에 걸러내면 LIMIT으로 잘린 목록에서 다시 빠지므로 피드의 게시글 수가 max_item_count보다 적어집니다.
+// 이 인터페이스를 구현한 저장소는 조회 조건에서 삭제된 게시글을 제외하여 항상 limit개를 채웁니다.
+// 선택적(Optional) 인터페이스이며, 구현하지 않은 저장소는 GetArticles의 결과를 걸러내는 방식으로 대체됩니다.
+type VisibleArticleRepository interface {
+	// GetVisibleArticles 삭제가 감지되지 않은 게시글만 대상으로 GetArticles와 같은 순서와 개수로 게시글을 반환합니다.
+	GetVisibleArticles(ctx context.Context, providerID string, boardIDs []string, limit uint) ([]*Article, error)
+}
+
+// LayoutStats 한 번의 크롤링에서 게시판 목록 페이지를 파싱하며 측정한 구조적 지표(Fingerprint)입니다.
+//
+// 셀렉터가 요소를 하나도 찾지 못하는 명백한 구조 변경과 달리, 컬럼이 한 칸 밀리는 식의 미묘한 레이아웃 변경은
+// 파싱 자체는 성공하는 것처럼 보입니다. 이 지표들을 게시판별로 누적해 두고 과거 기준선(Baseline)과 비교하면
+// 그러한 변화를 감지할 수 있습니다.
+type LayoutStats struct {
+	// BoardID 지표를 측정한 게시판의 고유 식별자입니다.
+	BoardID string
+
+	// RowCount 목록 셀렉터가 선택한 게시글 행(Row)의 수입니다.
+	RowCount int
+
+	// EmptyFieldRatio 파싱에 성공한 게시글의 주요 필드(게시글 ID, 제목, 작성자, 링크) 중 값이 비어 있는 필드의 비율(0~1)입니다.
+	EmptyFieldRatio float64
+
+	// DateParseFailureRatio 선택된 행 중 작성일 파싱에 실패한 행의 비율(0~1)입니다.
+	DateParseFailureRatio float64
+
+	// SelectorHitRatio 선택된 행 중 모든 필드 셀렉터가 적중하여 게시글로 변환된 행의 비율(0~1)입니다.
+	SelectorHitRatio float64
+
+	// Drifted 이 측정값이 기준선에서 벗어난 것으로 판정되었는지 여부입니다.
+	Drifted bool
+
+	// Snapshot 레이아웃 변경이 의심될 때 보존하는 목록 페이지의 HTML 원문입니다. 정상 측정값에는 저장하지 않습니다.
+	Snapshot string
+
+	// RecordedAt 지표를 측정한 일시입니다.
+	RecordedAt time.Time
+}
+
+// LayoutStatsRepository 게시판별 레이아웃 지표를 누적 기록하고, 최근 기록을 기준선으로 조회하는 저장소 인터페이스입니다.
+//
+// 선택적(Optional) 인터페이스이며, 레이아웃 변경 감지는 주입받은 Repository가 이 인터페이스를 함께 구현하는 경우에만 동작합니다.
+type LayoutStatsRepository interface {
+	// GetLayoutStats 지정한 게시판의 레이아웃 지표를 최근 측정 순으로 최대 limit개 반환합니다.
+	GetLayoutStats(ctx context.Context, providerID, boardID string, limit int) ([]*LayoutStats, error)
+
+	// SaveLayoutStats 레이아웃 지표를 기록하고, 해당 게시판의 기록이 keep개를 초과하면 오래된 기록부터 삭제합니다.
+	SaveLayoutStats(ctx context.Context, providerID string, stats *LayoutStats, keep int) error
+}
+
+// ParseSnapshot 게시글 파싱에 실패한 시점의 원본 응답(HTML 또는 JSON)과 요청 정보를 보존한 디버깅용 스냅샷입니다.
+//
+// 파싱 실패는 로그 한 줄로만 남기 때문에, 담당자가 확인할 즈음에는 원본 페이지가 이미 바뀌어 원인을 재현할 수 없는 경우가 많습니다.
+// 스냅샷은 실패 당시의 응답 본문을 그대로 남겨, 나중에 내려받아 살펴보거나 파서에 다시 통과시켜(Replay) 볼 수 있게 합니다.
+type ParseSnapshot struct {
+	// ID 스냅샷의 고유 식별자입니다. 저장 시 저장소가 발급합니다.
+	ID int64
+
+	// ProviderID 파싱에 실패한 RSS 피드 공급자의 고유 식별자입니다.
+	ProviderID string
+
+	// BoardID 파싱에 실패한 게시판의 고유 식별자입니다. 게시판 구분 없이 목록을 수집하는 크롤러는 빈 문자열입니다.
+	BoardID string
+
+	// Page 파싱에 실패한 목록 페이지 번호입니다.
+	Page int
+
+	// URL 응답을 요청한 주소입니다.
+	URL string
+
+	// Header 응답을 얻기 위해 전송한 요청 헤더입니다.
+	Header map[string][]string
+
+	// ContentType 본문(Body)의 미디어 타입입니다. (예: "text/html; charset=utf-8", "application/json")
+	ContentType string
+
+	// Body 파싱에 실패한 원본 응답 본문입니다. 저장 용량 제한을 넘으면 잘린 상태로 보관됩니다.
+	Body []byte
+
+	// Truncated 본문이 저장 용량 제한으로 잘렸는지 여부입니다.
+	Truncated bool
+
+	// Error 파싱 실패 사유입니다.
+	Error string
+
+	// CreatedAt 스냅샷이 기록된 일시입니다.
+	CreatedAt time.Time
+}
+
+// ParseReplayResult 저장된 스냅샷을 현재의 파서에 다시 통과시킨 결과입니다.
+type ParseReplayResult struct {
+	// Articles 파서가 추출에 성공한 게시글 목록입니다.
+	Articles []*Article
+
+	// Errors 추출에 실패한 행의 에러 메시지 목록입니다.
+	Errors []string
+}
+
+// ParseSnapshotRepository 파싱 실패 스냅샷을 보관하고 조회하는 저장소 인터페이스입니다.
+//
+// 선택적(Optional) 인터페이스이며, 스냅샷 기록은 주입받은 Repository가 이 인터페이스를 함께 구현하는 경우에만 동작합니다.
+type ParseSnapshotRepository interface {
+	// SaveParseSnapshot 스냅샷을 기록하고 발급된 ID를 반환합니다.
+	// 기록과 함께 ttl보다 오래된 스냅샷을 삭제하고, 전체 스냅샷이 keep개를 초과하면 오래된 것부터 삭제합니다.
+	SaveParseSnapshot(ctx context.Context, snapshot *ParseSnapshot, keep int, ttl time.Duration) (int64, error)
+
+	// ListParseSnapshots 스냅샷 목록을 최근 기록 순으로 최대 limit개 반환합니다. 목록에는 본문(Body)이 포함되지 않습니다.
+	// providerID가 빈 문자열이면 모든 공급자의 스냅샷을 반환합니다.
+	ListParseSnapshots(ctx context.Context, providerID string, limit int) ([]*ParseSnapshot, error)
+
+	// GetParseSnapshot 지정한 ID의 스냅샷을 본문과 함께 반환합니다. 존재하지 않으면 nil, nil을 반환합니다.
+	GetParseSnapshot(ctx context.Context, id int64) (*ParseSnapshot, error)
+}
+
+// ArticleFilter 여러 공급자에 걸쳐 게시글을 일괄 조회할 때 적용할 조건입니다. 값이 비어 있는 조건은 적용하지 않습니다.
+type ArticleFilter struct {
+	// ProviderIDs 조회할 공급자 ID 목록입니다.
+	ProviderIDs []string
+
+	// BoardIDs 조회할 게시판 ID 목록입니다. 공급자와 무관하게 게시판 ID만으로 비교합니다.
+	BoardIDs []string
+
+	// Since 작성일시가 이 시각 이후(포함)인 게시글만 조회합니다.
+	Since time.Time
+
+	// Until 작성일시가 이 시각 이전(미포함)인 게시글만 조회합니다.
+	Until time.Time
+}
+
+// ExportRepository 저장된 게시글을 조건에 따라 순차적으로 읽어 내보내는 저장소 인터페이스입니다.
+//
+// 호스트 이전이나 테스트 환경 데이터 준비처럼 서비스 운영 외의 용도로만 사용되므로 선택적(Optional) 인터페이스로 정의합니다.
+type ExportRepository interface {
+	// ExportArticles 조건에 맞는 게시글을 공급자, 게시판, 작성일시 순으로 한 건씩 fn에 전달합니다.
+	// 삭제가 감지된 게시글도 포함되며, fn이 에러를 반환하면 조회를 중단하고 해당 에러를 그대로 반환합니다.
+	ExportArticles(ctx context.Context, filter ArticleFilter, fn func(providerID string, article *Article) error) error
+}
+
+// PurgeResult 보관 정책에 따른 게시글 정리 작업에서 게시판 하나의 게시글이 삭제된 내역입니다.
+type PurgeResult struct {
+	// ProviderID 게시글이 삭제된 공급자 ID입니다.
+	ProviderID string
+
+	// BoardID 게시글이 삭제된 게시판 ID입니다.
+	BoardID string
+
+	// Expired 보관 기간(archive_days)이 지나 삭제된 게시글 수입니다.
+	Expired int64
+
+	// Overflow 보관 개수(max_rows)를 초과하여 오래된 순으로 삭제된 게시글 수입니다.
+	Overflow int64
+}
+
+// Total 삭제된 게시글의 총 개수를 반환합니다.
+func (r *PurgeResult) Total() int64 {
+	return r.Expired + r.Overflow
+}
+
+// DuplicateRepository 여러 공급자에 걸쳐 근사 중복 게시글을 찾기 위해 지문(Fingerprint)이 있는 게시글을 조회하는 저장소 인터페이스입니다.
+//
+// 해밍 거리 비교는 데이터베이스가 아닌 호출 측(ClusterArticles)에서 수행하므로, 조회 범위를 작성일시와 건수로 제한합니다.
+type DuplicateRepository interface {
+	// GetFingerprintedArticles since 이후에 작성되고 지문이 있는 게시글 중 excludeProviderID 이외의 공급자 게시글을 최신순으로 최대 limit개 반환합니다.
+	// 삭제가 감지된 게시글은 제외하며, 비교와 링크 표시에 필요하지 않은 본문(Content)과 작성자(Author)는 채우지 않습니다.
+	GetFingerprintedArticles(ctx context.Context, since time.Time, excludeProviderID string, limit uint) ([]*SourcedArticle, error)
+}
+
+// ArticleCursor 게시글 목록을 키셋(Keyset) 방식으로 이어서 조회하기 위한 위치 정보입니다.
+//
+// 게시글은 작성일시, 게시판 ID, 게시글 ID 순으로 정렬되며, 같은 작성일시의 게시글이 여러 게시판에 있어도
+// 세 값의 조합으로 순서가 하나로 정해집니다. OFFSET 방식과 달리 조회 도중 새 게시글이 저장되거나
+// 오래된 게시글이 정리되어도 이미 읽은 게시글이 다시 나오거나 건너뛰어지지 않습니다.
+type ArticleCursor struct {
+	// CreatedAt 마지막으로 읽은 게시글의 작성일시입니다.
+	CreatedAt time.Time
+
+	// BoardID 마지막으로 읽은 게시글의 게시판 ID입니다.
+	BoardID string
+
+	// ArticleID 마지막으로 읽은 게시글의 ID입니다.
+	ArticleID string
+}
+
+// CursorOf 게시글의 정렬 위치를 가리키는 커서를 반환합니다.
+func CursorOf(article *Article) ArticleCursor {
+	return ArticleCursor{CreatedAt: article.CreatedAt, BoardID: article.BoardID, ArticleID: article.ArticleID}
+}
+
+// Encode 커서를 URL 쿼리 파라미터로 그대로 쓸 수 있는 불투명(Opaque) 문자열로 변환합니다.
+// 클라이언트가 커서의 내부 구조에 의존하지 않도록, 값은 base64url로 인코딩합니다.
+func (c ArticleCursor) Encode() string {
+	raw := strings.Join([]string{c.CreatedAt.UTC().Format(time.RFC3339Nano), c.BoardID, c.ArticleID}, "\n")
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// ParseArticleCursor Encode로 만든 문자열을 커서로 복원합니다.
+func ParseArticleCursor(s string) (ArticleCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return ArticleCursor{}, fmt.Errorf("커서 디코딩 실패: %w", err)
+	}
+
+	parts := strings.Split(string(raw), "\n")
+	if len(parts) != 3 || parts[2] == "" {
+		return ArticleCursor{}, fmt.Errorf("커서 형식이 올바르지 않습니다")
+	}
+
+	createdAt, err := time.Parse(time.RFC3339Nano, parts[0])
+	if err != nil {
+		return ArticleCursor{}, fmt.Errorf("커서의 작성일시 해석 실패: %w", err)
+	}
+
+	return ArticleCursor{CreatedAt: createdAt.Local(), BoardID: parts[1], ArticleID: parts[2]}, nil
+}
+
+// ArticlePageQuery 한 공급자의 게시글을 키셋 방식으로 한 페이지씩 조회할 때 적용할 조건입니다.
+type ArticlePageQuery struct {
+	// BoardIDs 조회할 게시판 ID 목록입니다. 비어 있으면 공급자의 모든 게시판을 조회합니다.
+	BoardIDs []string
+
+	// Since 작성일시가 이 시각 이후(포함)인 게시글만 조회합니다. zero value이면 적용하지 않습니다.
+	Since time.Time
+
+	// Until 작성일시가 이 시각 이전(미포함)인 게시글만 조회합니다. zero value이면 적용하지 않습니다.
+	Until time.Time
+
+	// After 정렬 순서상 이 커서 다음에 오는 게시글부터 조회합니다. nil이면 처음부터 조회합니다.
+	After *ArticleCursor
+
+	// Ascending 오래된 순으로 조회할지 여부입니다. 기본값(false)은 최신순입니다.
+	Ascending bool
+
+	// ExcludeDeleted 삭제가 감지된 게시글을 조회 대상에서 제외할지 여부입니다.
+	// 삭제된 게시글을 숨기는(hide) 공급자는 조회 단계에서 제외해야 페이지 크기와 아카이브 날짜 탐색이 숨긴 게시글의 영향을 받지 않습니다.
+	ExcludeDeleted bool
+
+	// Limit 한 번에 조회할 최대 게시글 수입니다.
+	Limit uint
+}
+
+// HistoryRepository 피드 노출 한도(max_item_count)를 넘어 보관 중인 과거 게시글을 페이지 단위로 조회하는 저장소 인터페이스입니다.
+//
+// 선택적(Optional) 인터페이스이며, 게시글 이력 API와 아카이브 피드는 주입받은 Repository가 이 인터페이스를 함께 구현하는 경우에만 동작합니다.
+type HistoryRepository interface {
+	// ListArticles 지정한 providerID의 게시글 중 조건에 맞는 게시글을 (작성일시, 게시판 ID, 게시글 ID) 순서로 최대 query.Limit개 반환합니다.
+	// query.ExcludeDeleted가 false이면 삭제가 감지된 게시글도 포함됩니다.
+	ListArticles(ctx context.Context, providerID string, query ArticlePageQuery) ([]*Article, error)
+
+	// GetArticle 지정한 게시글 하나를 본문과 함께 반환합니다. 존재하지 않으면 nil, nil을 반환합니다.
+	GetArticle(ctx context.Context, providerID, boardID, articleID string) (*Article, error)
+}
+
+// StatsRepository 게시판별 게시글 수 통계를 집계하는 저장소 인터페이스입니다.
+//
+// 선택적(Optional) 인터페이스이며, 통계 API는 주입받은 Repository가 이 인터페이스를 함께 구현하는 경우에만 동작합니다.
+type StatsRepository interface {
+	// CountArticlesByDay 지정한 providerID에서 since 이후(포함), until 이전(미포함)에 작성된 게시글 수를
+	// loc 시간대의 날짜와 게시판별로 집계하여 게시판 ID, 날짜 순으로 반환합니다. 게시글이 없는 날짜는 포함되지 않습니다.
+	CountArticlesByDay(ctx context.Context, providerID string, since, until time.Time, loc *time.Location) ([]*DailyArticleCount, error)
+}
+
+// JobLockRepository 여러 서버 인스턴스가 하나의 데이터베이스를 공유할 때, 주기 작업(크롤링, 게시글 삭제 등)이
+// 동시에 하나의 인스턴스에서만 실행되도록 작업 단위의 잠금을 제공하는 저장소 인터페이스입니다.
+//
+// 선택적(Optional) 인터페이스이며, 한 프로세스만 접근하는 SQLite 저장소처럼 이 인터페이스를 구현하지 않는 저장소에서는
+// 잠금 없이 모든 주기 작업을 그대로 실행합니다.
+type JobLockRepository interface {
+	// TryLockJob name으로 식별되는 작업의 잠금 획득을 시도하며, 대기하지 않고 즉시 반환합니다.
+	// 다른 인스턴스가 이미 잠금을 보유하고 있으면 acquired는 false입니다.
+	// 잠금을 획득한 경우 작업이 끝난 뒤 반드시 unlock을 호출해야 합니다.
+	TryLockJob(ctx context.Context, name string) (unlock func(), acquired bool, err error)
+}
@@ -2,6 +2,7 @@ package feed_test
 
 import (
 	"context"
+	"encoding/base64"
 	"testing"
 	"time"
 
@@ -99,6 +100,35 @@ func TestArticle_String_ImplementsStringer(t *testing.T) {
 	t.Log("Article이 fmt.Stringer 인터페이스를 올바르게 구현하고 있습니다.")
 }
 
+// =============================================================================
+// Revision Helper Tests
+// =============================================================================
+
+// TestContentHash는 본문 해시가 결정적(Deterministic)이며 내용 변경에 민감하게 반응하는지 검증합니다.
+func TestContentHash(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, feed.ContentHash("마감일: 3월 5일"), feed.ContentHash("마감일: 3월 5일"))
+	assert.NotEqual(t, feed.ContentHash("마감일: 3월 5일"), feed.ContentHash("마감일: 3월 7일"))
+	assert.Len(t, feed.ContentHash(""), 64, "SHA-256 해시는 64자리 16진수 문자열이어야 합니다")
+}
+
+// TestArticle_IsEdited는 UpdatedAt 설정 여부에 따라 수정 여부가 올바르게 판별되는지 검증합니다.
+func TestArticle_IsEdited(t *testing.T) {
+	t.Parallel()
+
+	assert.False(t, feed.Article{}.IsEdited())
+	assert.True(t, feed.Article{UpdatedAt: time.Date(2025, 3, 17, 12, 0, 0, 0, time.UTC)}.IsEdited())
+}
+
+// TestArticle_IsDeleted는 DeletedAt 설정 여부에 따라 삭제 여부가 올바르게 판별되는지 검증합니다.
+func TestArticle_IsDeleted(t *testing.T) {
+	t.Parallel()
+
+	assert.False(t, feed.Article{}.IsDeleted())
+	assert.True(t, feed.Article{DeletedAt: time.Date(2025, 3, 17, 12, 0, 0, 0, time.UTC)}.IsDeleted())
+}
+
 // =============================================================================
 // Repository Interface Contract Tests
 // =============================================================================
@@ -186,3 +216,43 @@ func TestRepository_InterfaceContract(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+// =============================================================================
+// ArticleCursor Tests
+// =============================================================================
+
+func TestArticleCursor_EncodeAndParse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("인코딩한 커서를 그대로 복원한다", func(t *testing.T) {
+		t.Parallel()
+
+		article := &feed.Article{BoardID: "notice", ArticleID: "12345", CreatedAt: time.Date(2025, 1, 15, 9, 30, 0, 500, time.UTC)}
+		encoded := feed.CursorOf(article).Encode()
+
+		assert.NotContains(t, encoded, "notice", "커서의 내부 구조가 드러나지 않아야 합니다")
+
+		cursor, err := feed.ParseArticleCursor(encoded)
+		assert.NoError(t, err)
+		assert.True(t, article.CreatedAt.Equal(cursor.CreatedAt))
+		assert.Equal(t, "notice", cursor.BoardID)
+		assert.Equal(t, "12345", cursor.ArticleID)
+	})
+
+	tests := []struct {
+		name  string
+		value string
+	}{
+		{"base64가 아닌 문자열", "not a cursor!"},
+		{"구분자 개수가 맞지 않는 값", "MjAyNS0wMS0xNVQwOTozMDowMFo"},
+		{"작성일시 형식이 잘못된 값", base64.RawURLEncoding.EncodeToString([]byte("2025-01-15\nnotice\n1"))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name+"은 에러를 반환한다", func(t *testing.T) {
+			t.Parallel()
+
+			_, err := feed.ParseArticleCursor(tt.value)
+			assert.Error(t, err)
+		})
+	}
+}
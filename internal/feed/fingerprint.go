@@ -0,0 +1,80 @@
+package feed
+
+import (
+	"hash/fnv"
+	"html"
+	"math/bits"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// fingerprintShingleSize 지문 계산 시 특징(feature)으로 사용하는 문자 n-gram의 길이입니다.
+// 한국어는 조사와 어미 때문에 단어 단위 비교가 불안정하므로, 띄어쓰기에 덜 민감한 문자 단위 n-gram을 사용합니다.
+const fingerprintShingleSize = 3
+
+// fingerprintTagRegex 지문 계산 전에 본문에서 제거할 HTML 태그를 찾는 정규표현식입니다.
+var fingerprintTagRegex = regexp.MustCompile(`<[^>]*>`)
+
+// Fingerprint 게시글의 제목과 본문으로 근사 중복 판별용 64비트 SimHash 지문을 계산합니다.
+//
+// HTML 태그, 문장 부호, 대소문자, 공백의 차이는 무시되므로, 같은 글을 여러 게시판에 옮겨 올리면서
+// 서식이나 말머리가 조금 달라진 게시글은 해밍 거리(FingerprintDistance)가 작은 지문을 가지게 됩니다.
+// 비교할 내용이 없으면 0을 반환하며, 0은 '지문 없음'으로 취급하여 중복 판별에서 제외합니다.
+func Fingerprint(title, content string) uint64 {
+	runes := []rune(normalizeFingerprintText(title + " " + content))
+	if len(runes) == 0 {
+		return 0
+	}
+
+	var weights [64]int
+	addFeature := func(feature string) {
+		h := fnv.New64a()
+		_, _ = h.Write([]byte(feature))
+		sum := h.Sum64()
+		for i := range weights {
+			if sum&(1<<uint(i)) != 0 {
+				weights[i]++
+			} else {
+				weights[i]--
+			}
+		}
+	}
+
+	if len(runes) < fingerprintShingleSize {
+		addFeature(string(runes))
+	} else {
+		for i := 0; i+fingerprintShingleSize <= len(runes); i++ {
+			addFeature(string(runes[i : i+fingerprintShingleSize]))
+		}
+	}
+
+	var fingerprint uint64
+	for i, w := range weights {
+		if w > 0 {
+			fingerprint |= 1 << uint(i)
+		}
+	}
+
+	return fingerprint
+}
+
+// FingerprintDistance 두 지문의 해밍 거리(서로 다른 비트 수)를 반환합니다. 값이 작을수록 내용이 비슷합니다.
+func FingerprintDistance(a, b uint64) int {
+	return bits.OnesCount64(a ^ b)
+}
+
+// normalizeFingerprintText 지문 계산에 영향을 주지 않아야 하는 서식 차이를 제거합니다.
+// HTML 태그와 엔티티를 풀고, 문자와 숫자 이외의 문자는 공백으로 바꾼 뒤 연속된 공백을 하나로 줄입니다.
+func normalizeFingerprintText(s string) string {
+	s = fingerprintTagRegex.ReplaceAllString(s, " ")
+	s = html.UnescapeString(s)
+	s = strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return ' '
+	}, s)
+
+	return strings.Join(strings.Fields(s), " ")
+}
@@ -0,0 +1,59 @@
+package feed_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+)
+
+const (
+	announcementTitle   = "2025년 여수시 청년 월세 지원사업 신청 안내"
+	announcementContent = "여수시는 청년층의 주거비 부담을 덜기 위해 청년 월세 지원사업 신청을 받습니다.\n" +
+		"지원 대상은 여수시에 주민등록을 둔 만 19세부터 34세까지의 무주택 청년이며, 월 최대 20만원을 12개월간 지원합니다.\n" +
+		"신청 기간은 3월 4일부터 3월 29일까지이며, 거주지 읍면동 행정복지센터를 방문하거나 복지로 누리집에서 신청할 수 있습니다.\n" +
+		"자세한 사항은 여수시청 누리집 고시공고를 참고하시기 바랍니다."
+)
+
+func TestFingerprint(t *testing.T) {
+	t.Parallel()
+
+	base := feed.Fingerprint(announcementTitle, announcementContent)
+	assert.NotZero(t, base)
+
+	t.Run("서식과 말머리만 다른 게시글은 거리가 작다", func(t *testing.T) {
+		t.Parallel()
+
+		reposted := feed.Fingerprint("[공유] "+announcementTitle, "<p>"+announcementContent+"</p><br/>&nbsp;출처: 여수시청")
+		assert.LessOrEqual(t, feed.FingerprintDistance(base, reposted), 3, "distance=%d", feed.FingerprintDistance(base, reposted))
+	})
+
+	t.Run("대소문자, 문장 부호, 공백 차이는 무시한다", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, feed.Fingerprint("Hello, World!", "Go  is\nfun."), feed.Fingerprint("hello world", "go is fun"))
+	})
+
+	t.Run("내용이 다른 게시글은 거리가 크다", func(t *testing.T) {
+		t.Parallel()
+
+		other := feed.Fingerprint("쌍봉초등학교 3월 급식 식단표", "3월 급식 식단표를 안내드립니다. 알레르기 유발 식품은 식단표 하단을 확인해 주세요.")
+		assert.Greater(t, feed.FingerprintDistance(base, other), 16, "distance=%d", feed.FingerprintDistance(base, other))
+	})
+
+	t.Run("비교할 내용이 없으면 0", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Zero(t, feed.Fingerprint("", ""))
+		assert.Zero(t, feed.Fingerprint(" ", "<br/> ... "))
+	})
+}
+
+func TestFingerprintDistance(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, 0, feed.FingerprintDistance(0xFF, 0xFF))
+	assert.Equal(t, 1, feed.FingerprintDistance(0b1000, 0b1100))
+	assert.Equal(t, 64, feed.FingerprintDistance(0, ^uint64(0)))
+}
@@ -0,0 +1,64 @@
+package feed
+
+import (
+	"cmp"
+	"slices"
+	"time"
+)
+
+// DailyArticleCount 게시판 하나에 하루 동안 작성된 게시글 수입니다.
+type DailyArticleCount struct {
+	// BoardID 게시판 ID입니다.
+	BoardID string
+
+	// Date 집계한 날짜의 자정(집계 시간대 기준)입니다.
+	Date time.Time
+
+	// Count 작성된 게시글 수입니다.
+	Count int64
+}
+
+// DailyArticleCounter 시(hour) 단위로 집계한 게시글 수를 지정한 시간대의 날짜 단위로 합산합니다.
+//
+// 데이터베이스마다 세션 시간대 설정이 달라 날짜 경계를 SQL에서 정하면 결과가 어긋날 수 있으므로,
+// 저장소는 UTC 기준 시 단위로 집계하고 날짜 구분은 이 타입에 맡깁니다.
+// 시 단위 경계를 사용하므로 UTC와의 차이가 정시 단위인 시간대(예: Asia/Seoul)에서 정확합니다.
+type DailyArticleCounter struct {
+	loc    *time.Location
+	counts map[dailyCountKey]int64
+}
+
+type dailyCountKey struct {
+	boardID string
+	date    time.Time
+}
+
+// NewDailyArticleCounter loc 시간대의 날짜로 합산하는 DailyArticleCounter를 생성합니다.
+func NewDailyArticleCounter(loc *time.Location) *DailyArticleCounter {
+	if loc == nil {
+		panic("time.Location은 필수입니다")
+	}
+
+	return &DailyArticleCounter{loc: loc, counts: make(map[dailyCountKey]int64)}
+}
+
+// Add hour부터 한 시간 동안 boardID 게시판에 작성된 게시글 수를 더합니다.
+func (c *DailyArticleCounter) Add(boardID string, hour time.Time, count int64) {
+	t := hour.In(c.loc)
+	key := dailyCountKey{boardID: boardID, date: time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, c.loc)}
+	c.counts[key] += count
+}
+
+// Result 합산 결과를 게시판 ID, 날짜 순으로 반환합니다.
+func (c *DailyArticleCounter) Result() []*DailyArticleCount {
+	result := make([]*DailyArticleCount, 0, len(c.counts))
+	for key, count := range c.counts {
+		result = append(result, &DailyArticleCount{BoardID: key.boardID, Date: key.date, Count: count})
+	}
+
+	slices.SortFunc(result, func(a, b *DailyArticleCount) int {
+		return cmp.Or(cmp.Compare(a.BoardID, b.BoardID), a.Date.Compare(b.Date))
+	})
+
+	return result
+}
@@ -0,0 +1,52 @@
+package feed_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+)
+
+func TestDailyArticleCounter(t *testing.T) {
+	t.Parallel()
+
+	seoul := time.FixedZone("KST", 9*60*60)
+
+	t.Run("UTC 시 단위 집계를 지정한 시간대의 날짜로 합산한다", func(t *testing.T) {
+		t.Parallel()
+
+		c := feed.NewDailyArticleCounter(seoul)
+		c.Add("b1", time.Date(2024, 3, 14, 14, 0, 0, 0, time.UTC), 2) // KST 3/14 23시
+		c.Add("b1", time.Date(2024, 3, 14, 15, 0, 0, 0, time.UTC), 3) // KST 3/15 00시
+		c.Add("b1", time.Date(2024, 3, 15, 1, 0, 0, 0, time.UTC), 1)  // KST 3/15 10시
+		c.Add("a1", time.Date(2024, 3, 15, 1, 0, 0, 0, time.UTC), 5)
+
+		result := c.Result()
+		require.Len(t, result, 3)
+
+		assert.Equal(t, "a1", result[0].BoardID, "게시판 ID 순으로 정렬되어야 합니다")
+		assert.Equal(t, int64(5), result[0].Count)
+
+		assert.Equal(t, "b1", result[1].BoardID)
+		assert.True(t, time.Date(2024, 3, 14, 0, 0, 0, 0, seoul).Equal(result[1].Date))
+		assert.Equal(t, int64(2), result[1].Count)
+
+		assert.True(t, time.Date(2024, 3, 15, 0, 0, 0, 0, seoul).Equal(result[2].Date))
+		assert.Equal(t, int64(4), result[2].Count)
+	})
+
+	t.Run("집계가 없으면 빈 목록을 반환한다", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, feed.NewDailyArticleCounter(seoul).Result())
+	})
+
+	t.Run("시간대가 nil이면 panic", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Panics(t, func() { feed.NewDailyArticleCounter(nil) })
+	})
+}
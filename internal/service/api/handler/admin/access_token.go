@@ -0,0 +1,217 @@
+package admin
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+	"github.com/darkkaiser/rss-feed-server/internal/service/api/httputil"
+	"github.com/darkkaiser/rss-feed-server/internal/service/api/model/request"
+	"github.com/darkkaiser/rss-feed-server/internal/service/api/model/response"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// defaultTokenUsageListLimit 토큰 사용 기록 조회 시 limit 파라미터가 없을 때 반환하는 최대 개수입니다.
+	defaultTokenUsageListLimit = 100
+
+	// maxTokenUsageListLimit 토큰 사용 기록 조회 시 한 번에 반환할 수 있는 최대 개수입니다.
+	maxTokenUsageListLimit = 1000
+)
+
+// ListAccessTokens godoc
+// @Summary 접근 토큰 목록 조회
+// @Description 비공개 피드 구독용으로 발급한 접근 토큰 목록을 발급 순으로 반환합니다. 토큰 원문은 포함되지 않습니다.
+// @Description viewer 이상의 권한 등급이 필요합니다. (인증 수단이 설정되지 않은 서버는 로컬 요청만 허용)
+// @Tags Admin
+// @Security AdminAPIKey
+// @Security AdminBasicAuth
+// @Produce json
+// @Success 200 {object} response.AccessTokenListResponse
+// @Failure 401 {object} response.ErrorResponse "인증 정보 누락 또는 불일치"
+// @Failure 403 {object} response.ErrorResponse "권한 등급 부족 또는 로컬이 아닌 주소에서의 접근"
+// @Failure 503 {object} response.ErrorResponse "저장소가 접근 토큰을 지원하지 않음"
+// @Router /admin/tokens [get]
+func (h *Handler) ListAccessTokens(c echo.Context) error {
+	if h.tokens == nil {
+		return httputil.NewServiceUnavailableError("현재 저장소는 접근 토큰을 지원하지 않습니다")
+	}
+
+	tokens, err := h.tokens.ListAccessTokens(c.Request().Context())
+	if err != nil {
+		h.logger(c).Errorf("접근 토큰 목록 조회 실패: %v", err)
+		return httputil.NewInternalServerError("접근 토큰 목록을 조회하는 과정에서 오류가 발생했습니다")
+	}
+
+	now := time.Now()
+	resp := response.AccessTokenListResponse{
+		ResultCode: 0,
+		Tokens:     make([]response.AccessTokenResponse, 0, len(tokens)),
+	}
+	for _, t := range tokens {
+		resp.Tokens = append(resp.Tokens, response.NewAccessTokenResponse(t, now))
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// CreateAccessToken godoc
+// @Summary 접근 토큰 발급
+// @Description 비공개 피드를 구독할 수 있는 접근 토큰을 발급합니다. 서버에는 해시만 저장되므로 토큰 원문(secret)은 이 응답에서만 확인할 수 있습니다.
+// @Description 구독 주소는 '/t/{secret}/{id}.xml' 또는 '/{id}.xml?token={secret}' 형식으로 만듭니다.
+// @Description admin 이상의 권한 등급이 필요합니다. (인증 수단이 설정되지 않은 서버는 로컬 요청만 허용)
+// @Tags Admin
+// @Security AdminAPIKey
+// @Security AdminBasicAuth
+// @Accept json
+// @Produce json
+// @Param request body request.CreateAccessTokenRequest true "발급할 토큰의 이름, 범위, 만료 일시"
+// @Success 201 {object} response.AccessTokenCreatedResponse
+// @Failure 400 {object} response.ErrorResponse "잘못된 요청 본문 (이름 누락, 범위 형식 오류, 지난 만료 일시 등)"
+// @Failure 401 {object} response.ErrorResponse "인증 정보 누락 또는 불일치"
+// @Failure 403 {object} response.ErrorResponse "권한 등급 부족 또는 로컬이 아닌 주소에서의 접근"
+// @Failure 503 {object} response.ErrorResponse "저장소가 접근 토큰을 지원하지 않음"
+// @Router /admin/tokens [post]
+func (h *Handler) CreateAccessToken(c echo.Context) error {
+	if h.tokens == nil {
+		return httputil.NewServiceUnavailableError("현재 저장소는 접근 토큰을 지원하지 않습니다")
+	}
+
+	var req request.CreateAccessTokenRequest
+	if err := c.Bind(&req); err != nil {
+		return httputil.NewBadRequestError("요청 본문을 해석할 수 없습니다. JSON 형식을 확인해 주시기 바랍니다.")
+	}
+
+	now := time.Now()
+	token := &feed.AccessToken{Name: req.Name, Scopes: req.Scopes, CreatedAt: now}
+	if req.ExpiresAt != nil {
+		token.ExpiresAt = *req.ExpiresAt
+	}
+	if err := token.Validate(now); err != nil {
+		return httputil.NewBadRequestError(err.Error())
+	}
+
+	secret, err := feed.NewAccessTokenSecret()
+	if err != nil {
+		h.logger(c).Errorf("접근 토큰 생성 실패: %v", err)
+		return httputil.NewInternalServerError("접근 토큰을 생성하는 과정에서 오류가 발생했습니다")
+	}
+	token.Hash = feed.HashAccessToken(secret)
+
+	if token.ID, err = h.tokens.CreateAccessToken(c.Request().Context(), token); err != nil {
+		h.logger(c).Errorf("접근 토큰 저장 실패 (name: %s): %v", token.Name, err)
+		return httputil.NewInternalServerError("접근 토큰을 저장하는 과정에서 오류가 발생했습니다")
+	}
+
+	h.logger(c).Infof("접근 토큰 발급 (ID: %d, name: %s, scopes: %v)", token.ID, token.Name, token.Scopes)
+
+	return c.JSON(http.StatusCreated, response.AccessTokenCreatedResponse{
+		ResultCode: 0,
+		Token:      response.NewAccessTokenResponse(token, now),
+		Secret:     secret,
+	})
+}
+
+// RevokeAccessToken godoc
+// @Summary 접근 토큰 폐기
+// @Description 접근 토큰을 폐기합니다. 폐기된 토큰으로 요청한 비공개 피드는 즉시 401 Unauthorized로 거부됩니다.
+// @Description admin 이상의 권한 등급이 필요합니다. (인증 수단이 설정되지 않은 서버는 로컬 요청만 허용)
+// @Tags Admin
+// @Security AdminAPIKey
+// @Security AdminBasicAuth
+// @Produce json
+// @Param id path int true "토큰 식별자"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse "잘못된 식별자"
+// @Failure 401 {object} response.ErrorResponse "인증 정보 누락 또는 불일치"
+// @Failure 403 {object} response.ErrorResponse "권한 등급 부족 또는 로컬이 아닌 주소에서의 접근"
+// @Failure 404 {object} response.ErrorResponse "토큰이 없거나 이미 폐기됨"
+// @Failure 503 {object} response.ErrorResponse "저장소가 접근 토큰을 지원하지 않음"
+// @Router /admin/tokens/{id} [delete]
+func (h *Handler) RevokeAccessToken(c echo.Context) error {
+	if h.tokens == nil {
+		return httputil.NewServiceUnavailableError("현재 저장소는 접근 토큰을 지원하지 않습니다")
+	}
+
+	id, err := parseTokenID(c)
+	if err != nil {
+		return err
+	}
+
+	revoked, err := h.tokens.RevokeAccessToken(c.Request().Context(), id)
+	if err != nil {
+		h.logger(c).Errorf("접근 토큰(ID: %d) 폐기 실패: %v", id, err)
+		return httputil.NewInternalServerError("접근 토큰을 폐기하는 과정에서 오류가 발생했습니다")
+	}
+	if !revoked {
+		return httputil.NewNotFoundError(fmt.Sprintf("토큰(ID: %d)을 찾을 수 없거나 이미 폐기되었습니다", id))
+	}
+
+	h.logger(c).Infof("접근 토큰 폐기 (ID: %d)", id)
+
+	return httputil.Success(c)
+}
+
+// ListAccessTokenUsage godoc
+// @Summary 접근 토큰 사용 기록 조회
+// @Description 접근 토큰으로 요청한 기록을 최근 순으로 반환합니다. 토큰별로 최근 1,000건까지 보관됩니다.
+// @Description viewer 이상의 권한 등급이 필요합니다. (인증 수단이 설정되지 않은 서버는 로컬 요청만 허용)
+// @Tags Admin
+// @Security AdminAPIKey
+// @Security AdminBasicAuth
+// @Produce json
+// @Param id path int true "토큰 식별자"
+// @Param limit query int false "최대 반환 개수 (기본 100, 최대 1000)"
+// @Success 200 {object} response.AccessTokenUsageListResponse
+// @Failure 400 {object} response.ErrorResponse "잘못된 식별자 또는 limit 값"
+// @Failure 401 {object} response.ErrorResponse "인증 정보 누락 또는 불일치"
+// @Failure 403 {object} response.ErrorResponse "권한 등급 부족 또는 로컬이 아닌 주소에서의 접근"
+// @Failure 503 {object} response.ErrorResponse "저장소가 접근 토큰을 지원하지 않음"
+// @Router /admin/tokens/{id}/usage [get]
+func (h *Handler) ListAccessTokenUsage(c echo.Context) error {
+	if h.tokens == nil {
+		return httputil.NewServiceUnavailableError("현재 저장소는 접근 토큰을 지원하지 않습니다")
+	}
+
+	id, err := parseTokenID(c)
+	if err != nil {
+		return err
+	}
+	limit, err := httputil.ParseLimitQuery(c, defaultTokenUsageListLimit, maxTokenUsageListLimit)
+	if err != nil {
+		return err
+	}
+
+	usages, err := h.tokens.ListAccessTokenUsage(c.Request().Context(), id, limit)
+	if err != nil {
+		h.logger(c).Errorf("접근 토큰(ID: %d) 사용 기록 조회 실패: %v", id, err)
+		return httputil.NewInternalServerError("접근 토큰 사용 기록을 조회하는 과정에서 오류가 발생했습니다")
+	}
+
+	resp := response.AccessTokenUsageListResponse{
+		ResultCode: 0,
+		TokenID:    id,
+		Usages:     make([]response.AccessTokenUsageResponse, 0, len(usages)),
+	}
+	for _, u := range usages {
+		resp.Usages = append(resp.Usages, response.AccessTokenUsageResponse{
+			Path:      u.Path,
+			RemoteIP:  u.RemoteIP,
+			UserAgent: u.UserAgent,
+			UsedAt:    u.UsedAt,
+		})
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// parseTokenID 경로 파라미터(id)를 토큰 식별자로 해석합니다.
+func parseTokenID(c echo.Context) (int64, error) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || id <= 0 {
+		return 0, httputil.NewBadRequestError(fmt.Sprintf("토큰 식별자('%s')는 1 이상의 정수여야 합니다", c.Param("id")))
+	}
+	return id, nil
+}
@@ -0,0 +1,194 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+	"github.com/darkkaiser/rss-feed-server/internal/service/api/model/response"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockTokenRepository feed.Repository와 feed.AccessTokenRepository를 함께 만족하는 메모리 저장소입니다.
+type mockTokenRepository struct {
+	mockFeedRepository
+
+	tokens []*feed.AccessToken
+	usages []*feed.AccessTokenUsage
+
+	listedLimit int
+}
+
+func (m *mockTokenRepository) CreateAccessToken(ctx context.Context, token *feed.AccessToken) (int64, error) {
+	saved := *token
+	saved.ID = int64(len(m.tokens) + 1)
+	m.tokens = append(m.tokens, &saved)
+	return saved.ID, nil
+}
+
+func (m *mockTokenRepository) GetAccessTokenByHash(ctx context.Context, hash string) (*feed.AccessToken, error) {
+	for _, t := range m.tokens {
+		if t.Hash == hash {
+			return t, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockTokenRepository) ListAccessTokens(ctx context.Context) ([]*feed.AccessToken, error) {
+	return m.tokens, nil
+}
+
+func (m *mockTokenRepository) RevokeAccessToken(ctx context.Context, id int64) (bool, error) {
+	for _, t := range m.tokens {
+		if t.ID == id && t.RevokedAt.IsZero() {
+			t.RevokedAt = time.Now()
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *mockTokenRepository) RecordAccessTokenUsage(ctx context.Context, usage *feed.AccessTokenUsage, keep int) error {
+	m.usages = append(m.usages, usage)
+	return nil
+}
+
+func (m *mockTokenRepository) ListAccessTokenUsage(ctx context.Context, tokenID int64, limit int) ([]*feed.AccessTokenUsage, error) {
+	m.listedLimit = limit
+	return m.usages, nil
+}
+
+// serveToken 접근 토큰 관리 라우트를 등록한 Echo 인스턴스로 요청을 처리하고 응답을 반환합니다.
+func serveToken(h *Handler, method, target, body string) *httptest.ResponseRecorder {
+	e := echo.New()
+	e.GET("/admin/tokens", h.ListAccessTokens)
+	e.POST("/admin/tokens", h.CreateAccessToken)
+	e.DELETE("/admin/tokens/:id", h.RevokeAccessToken)
+	e.GET("/admin/tokens/:id/usage", h.ListAccessTokenUsage)
+
+	var r io.Reader
+	if body != "" {
+		r = strings.NewReader(body)
+	}
+	req := httptest.NewRequest(method, target, r)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestHandler_AccessTokens_Unsupported(t *testing.T) {
+	t.Parallel()
+
+	h := New(&mockFeedRepository{}, nil)
+	for _, tc := range []struct{ method, target string }{
+		{http.MethodGet, "/admin/tokens"},
+		{http.MethodPost, "/admin/tokens"},
+		{http.MethodDelete, "/admin/tokens/1"},
+		{http.MethodGet, "/admin/tokens/1/usage"},
+	} {
+		assert.Equal(t, http.StatusServiceUnavailable, serveToken(h, tc.method, tc.target, "").Code, tc.method+" "+tc.target)
+	}
+}
+
+func TestHandler_CreateAccessToken(t *testing.T) {
+	t.Parallel()
+
+	t.Run("발급한 토큰의 원문은 응답으로만 전달하고 해시를 저장한다", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &mockTokenRepository{}
+		rec := serveToken(New(repo, nil), http.MethodPost, "/admin/tokens", `{"name":"구독자","scopes":["ludypang","yeosu/notice"],"expires_at":"2999-01-01T00:00:00Z"}`)
+		require.Equal(t, http.StatusCreated, rec.Code)
+
+		var resp response.AccessTokenCreatedResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.True(t, strings.HasPrefix(resp.Secret, "rft_"))
+		assert.Equal(t, int64(1), resp.Token.ID)
+		assert.Equal(t, []string{"ludypang", "yeosu/notice"}, resp.Token.Scopes)
+		assert.True(t, resp.Token.Active)
+		require.NotNil(t, resp.Token.ExpiresAt)
+		assert.NotContains(t, rec.Body.String(), feed.HashAccessToken(resp.Secret), "해시는 응답에 포함되지 않아야 합니다")
+
+		require.Len(t, repo.tokens, 1)
+		assert.Equal(t, feed.HashAccessToken(resp.Secret), repo.tokens[0].Hash)
+	})
+
+	tests := []struct {
+		name string
+		body string
+	}{
+		{"JSON 형식 오류", `{"name":`},
+		{"이름 누락", `{"scopes":["*"]}`},
+		{"범위 누락", `{"name":"구독자"}`},
+		{"범위 형식 오류", `{"name":"구독자","scopes":["ludypang/"]}`},
+		{"지난 만료 일시", `{"name":"구독자","scopes":["*"],"expires_at":"2000-01-01T00:00:00Z"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name+"이면 400", func(t *testing.T) {
+			t.Parallel()
+
+			repo := &mockTokenRepository{}
+			rec := serveToken(New(repo, nil), http.MethodPost, "/admin/tokens", tt.body)
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+			assert.Empty(t, repo.tokens)
+		})
+	}
+}
+
+func TestHandler_ListAndRevokeAccessTokens(t *testing.T) {
+	t.Parallel()
+
+	repo := &mockTokenRepository{}
+	h := New(repo, nil)
+	_, _ = repo.CreateAccessToken(context.Background(), &feed.AccessToken{Name: "구독자", Hash: "h1", Scopes: []string{"*"}})
+
+	rec := serveToken(h, http.MethodDelete, "/admin/tokens/1", "")
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	rec = serveToken(h, http.MethodDelete, "/admin/tokens/1", "")
+	assert.Equal(t, http.StatusNotFound, rec.Code, "이미 폐기된 토큰은 404")
+
+	rec = serveToken(h, http.MethodDelete, "/admin/tokens/abc", "")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = serveToken(h, http.MethodGet, "/admin/tokens", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var resp response.AccessTokenListResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	require.Len(t, resp.Tokens, 1)
+	assert.False(t, resp.Tokens[0].Active)
+	assert.NotNil(t, resp.Tokens[0].RevokedAt)
+	assert.Nil(t, resp.Tokens[0].ExpiresAt)
+}
+
+func TestHandler_ListAccessTokenUsage(t *testing.T) {
+	t.Parallel()
+
+	usedAt := time.Date(2024, 3, 15, 9, 30, 0, 0, time.UTC)
+	repo := &mockTokenRepository{usages: []*feed.AccessTokenUsage{
+		{TokenID: 1, Path: "/ludypang.xml", RemoteIP: "203.0.113.10", UserAgent: "reader", UsedAt: usedAt},
+	}}
+
+	rec := serveToken(New(repo, nil), http.MethodGet, "/admin/tokens/1/usage?limit=10", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, 10, repo.listedLimit)
+
+	var resp response.AccessTokenUsageListResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Equal(t, int64(1), resp.TokenID)
+	require.Len(t, resp.Usages, 1)
+	assert.Equal(t, "/ludypang.xml", resp.Usages[0].Path)
+	assert.True(t, usedAt.Equal(resp.Usages[0].UsedAt))
+}
@@ -0,0 +1,244 @@
+package admin
+
+import (
+	"fmt"
+	"mime"
+	"net/http"
+	"strconv"
+
+	applog "github.com/darkkaiser/notify-server/pkg/log"
+	apperrors "github.com/darkkaiser/rss-feed-server/internal/errors"
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+	"github.com/darkkaiser/rss-feed-server/internal/service/api/httputil"
+	"github.com/darkkaiser/rss-feed-server/internal/service/api/model/response"
+	"github.com/labstack/echo/v4"
+)
+
+// component 관리자 핸들러의 로깅용 컴포넌트 이름
+const component = "api.handler.admin"
+
+const (
+	// defaultSnapshotListLimit 스냅샷 목록 조회 시 limit 파라미터가 없을 때 반환하는 최대 개수입니다.
+	defaultSnapshotListLimit = 50
+
+	// maxSnapshotListLimit 스냅샷 목록 조회 시 한 번에 반환할 수 있는 최대 개수입니다.
+	maxSnapshotListLimit = 200
+)
+
+// ParseSnapshotReplayer 저장된 파싱 실패 스냅샷을 해당 공급자의 현재 파서에 다시 통과시키는 컴포넌트입니다.
+// 크롤링 서비스(crawl.Service)가 이 인터페이스를 구현합니다.
+type ParseSnapshotReplayer interface {
+	ReplayParseSnapshot(snapshot *feed.ParseSnapshot) (*feed.ParseReplayResult, error)
+}
+
+// Handler 관리자 전용 HTTP 요청을 처리하는 핸들러입니다.
+type Handler struct {
+	// snapshots 파싱 실패 스냅샷 저장소입니다. 저장소가 스냅샷 기록을 지원하지 않으면 nil입니다.
+	snapshots feed.ParseSnapshotRepository
+
+	// replayer 스냅샷 재생을 담당하는 컴포넌트입니다. 주입되지 않으면 재생 요청은 503으로 거부됩니다.
+	replayer ParseSnapshotReplayer
+
+	// tokens 비공개 피드 접근 토큰 저장소입니다. 저장소가 접근 토큰을 지원하지 않으면 nil입니다.
+	tokens feed.AccessTokenRepository
+}
+
+// New Handler 인스턴스를 생성하고 반환합니다.
+func New(feedRepo feed.Repository, replayer ParseSnapshotReplayer) *Handler {
+	if feedRepo == nil {
+		panic("feed.Repository는 필수입니다")
+	}
+
+	snapshots, _ := feedRepo.(feed.ParseSnapshotRepository)
+	tokens, _ := feedRepo.(feed.AccessTokenRepository)
+
+	return &Handler{
+		snapshots: snapshots,
+		replayer:  replayer,
+		tokens:    tokens,
+	}
+}
+
+// ListParseSnapshots godoc
+// @Summary 파싱 실패 스냅샷 목록 조회
+// @Description 크롤링 중 게시글 파싱에 실패한 페이지의 스냅샷 목록을 최근 기록 순으로 반환합니다. 본문은 포함되지 않습니다.
+// @Description viewer 이상의 권한 등급이 필요합니다. (인증 수단이 설정되지 않은 서버는 로컬 요청만 허용)
+// @Tags Admin
+// @Security AdminAPIKey
+// @Security AdminBasicAuth
+// @Produce json
+// @Param provider_id query string false "RSS 피드 공급자 식별자 (생략 시 전체)"
+// @Param limit query int false "최대 반환 개수 (기본 50, 최대 200)"
+// @Success 200 {object} response.ParseSnapshotListResponse
+// @Failure 400 {object} response.ErrorResponse "잘못된 limit 값"
+// @Failure 401 {object} response.ErrorResponse "인증 정보 누락 또는 불일치"
+// @Failure 403 {object} response.ErrorResponse "권한 등급 부족 또는 로컬이 아닌 주소에서의 접근"
+// @Failure 503 {object} response.ErrorResponse "저장소가 스냅샷 기록을 지원하지 않음"
+// @Router /admin/snapshots [get]
+func (h *Handler) ListParseSnapshots(c echo.Context) error {
+	if h.snapshots == nil {
+		return httputil.NewServiceUnavailableError("현재 저장소는 파싱 실패 스냅샷 기록을 지원하지 않습니다")
+	}
+
+	limit, err := httputil.ParseLimitQuery(c, defaultSnapshotListLimit, maxSnapshotListLimit)
+	if err != nil {
+		return err
+	}
+
+	snapshots, err := h.snapshots.ListParseSnapshots(c.Request().Context(), c.QueryParam("provider_id"), limit)
+	if err != nil {
+		h.logger(c).Errorf("파싱 실패 스냅샷 목록 조회 실패: %v", err)
+		return httputil.NewInternalServerError("파싱 실패 스냅샷 목록을 조회하는 과정에서 오류가 발생했습니다")
+	}
+
+	resp := response.ParseSnapshotListResponse{
+		ResultCode: 0,
+		Snapshots:  make([]response.ParseSnapshotResponse, 0, len(snapshots)),
+	}
+	for _, s := range snapshots {
+		resp.Snapshots = append(resp.Snapshots, response.ParseSnapshotResponse{
+			ID:          s.ID,
+			ProviderID:  s.ProviderID,
+			BoardID:     s.BoardID,
+			Page:        s.Page,
+			URL:         s.URL,
+			Header:      s.Header,
+			ContentType: s.ContentType,
+			Truncated:   s.Truncated,
+			Error:       s.Error,
+			CreatedAt:   s.CreatedAt,
+		})
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// DownloadParseSnapshot godoc
+// @Summary 파싱 실패 스냅샷 원본 내려받기
+// @Description 스냅샷에 보관된 원본 응답 본문(HTML 또는 JSON)을 첨부 파일로 내려받습니다.
+// @Description viewer 이상의 권한 등급이 필요합니다. (인증 수단이 설정되지 않은 서버는 로컬 요청만 허용)
+// @Tags Admin
+// @Security AdminAPIKey
+// @Security AdminBasicAuth
+// @Produce octet-stream
+// @Param id path int true "스냅샷 식별자"
+// @Success 200 {file} file "스냅샷 원본 본문"
+// @Failure 400 {object} response.ErrorResponse "잘못된 식별자"
+// @Failure 401 {object} response.ErrorResponse "인증 정보 누락 또는 불일치"
+// @Failure 403 {object} response.ErrorResponse "권한 등급 부족 또는 로컬이 아닌 주소에서의 접근"
+// @Failure 404 {object} response.ErrorResponse "스냅샷 없음 (보관 기한 만료 포함)"
+// @Failure 503 {object} response.ErrorResponse "저장소가 스냅샷 기록을 지원하지 않음"
+// @Router /admin/snapshots/{id} [get]
+func (h *Handler) DownloadParseSnapshot(c echo.Context) error {
+	snapshot, err := h.findParseSnapshot(c)
+	if err != nil {
+		return err
+	}
+
+	ext := ".html"
+	if mediaType, _, _ := mime.ParseMediaType(snapshot.ContentType); mediaType == echo.MIMEApplicationJSON {
+		ext = ".json"
+	}
+
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="snapshot-%d%s"`, snapshot.ID, ext))
+
+	return c.Blob(http.StatusOK, snapshot.ContentType, snapshot.Body)
+}
+
+// ReplayParseSnapshot godoc
+// @Summary 파싱 실패 스냅샷 재생
+// @Description 스냅샷에 보관된 원본을 해당 공급자의 현재 목록 파서에 다시 통과시키고, 추출된 게시글과 실패한 행을 반환합니다.
+// @Description 파서를 수정한 뒤 실패 당시의 원본으로 수정 결과를 확인하는 데 사용합니다. 네트워크 요청은 발생하지 않습니다.
+// @Description operator 이상의 권한 등급이 필요합니다. (인증 수단이 설정되지 않은 서버는 로컬 요청만 허용)
+// @Tags Admin
+// @Security AdminAPIKey
+// @Security AdminBasicAuth
+// @Produce json
+// @Param id path int true "스냅샷 식별자"
+// @Success 200 {object} response.ParseReplayResponse
+// @Failure 400 {object} response.ErrorResponse "잘못된 식별자 또는 재생할 수 없는 스냅샷"
+// @Failure 401 {object} response.ErrorResponse "인증 정보 누락 또는 불일치"
+// @Failure 403 {object} response.ErrorResponse "권한 등급 부족 또는 로컬이 아닌 주소에서의 접근"
+// @Failure 404 {object} response.ErrorResponse "스냅샷 또는 공급자 없음"
+// @Failure 503 {object} response.ErrorResponse "스냅샷 재생을 지원하지 않음"
+// @Router /admin/snapshots/{id}/replay [post]
+func (h *Handler) ReplayParseSnapshot(c echo.Context) error {
+	if h.replayer == nil {
+		return httputil.NewServiceUnavailableError("스냅샷 재생 기능이 활성화되어 있지 않습니다")
+	}
+
+	snapshot, err := h.findParseSnapshot(c)
+	if err != nil {
+		return err
+	}
+
+	result, err := h.replayer.ReplayParseSnapshot(snapshot)
+	if err != nil {
+		switch {
+		case apperrors.Is(err, apperrors.NotFound):
+			return httputil.NewNotFoundError(err.Error())
+		case apperrors.Is(err, apperrors.InvalidInput):
+			return httputil.NewBadRequestError(err.Error())
+		case apperrors.Is(err, apperrors.Unavailable):
+			return httputil.NewServiceUnavailableError(err.Error())
+		}
+
+		h.logger(c).Errorf("파싱 실패 스냅샷(ID: %d) 재생 실패: %v", snapshot.ID, err)
+		return httputil.NewInternalServerError("파싱 실패 스냅샷을 재생하는 과정에서 오류가 발생했습니다")
+	}
+
+	resp := response.ParseReplayResponse{
+		ResultCode: 0,
+		SnapshotID: snapshot.ID,
+		Articles:   make([]response.ReplayedArticleResponse, 0, len(result.Articles)),
+		Errors:     result.Errors,
+	}
+	if resp.Errors == nil {
+		resp.Errors = []string{}
+	}
+	for _, a := range result.Articles {
+		resp.Articles = append(resp.Articles, response.ReplayedArticleResponse{
+			BoardID:   a.BoardID,
+			ArticleID: a.ArticleID,
+			Title:     a.Title,
+			Author:    a.Author,
+			Link:      a.Link,
+			CreatedAt: a.CreatedAt,
+		})
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// findParseSnapshot 경로 파라미터(id)에 해당하는 스냅샷을 본문과 함께 조회합니다.
+// 조회할 수 없는 경우에는 그대로 반환할 수 있는 HTTP 에러를 반환합니다.
+func (h *Handler) findParseSnapshot(c echo.Context) (*feed.ParseSnapshot, error) {
+	if h.snapshots == nil {
+		return nil, httputil.NewServiceUnavailableError("현재 저장소는 파싱 실패 스냅샷 기록을 지원하지 않습니다")
+	}
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || id <= 0 {
+		return nil, httputil.NewBadRequestError(fmt.Sprintf("스냅샷 식별자('%s')는 1 이상의 정수여야 합니다", c.Param("id")))
+	}
+
+	snapshot, err := h.snapshots.GetParseSnapshot(c.Request().Context(), id)
+	if err != nil {
+		h.logger(c).Errorf("파싱 실패 스냅샷(ID: %d) 조회 실패: %v", id, err)
+		return nil, httputil.NewInternalServerError("파싱 실패 스냅샷을 조회하는 과정에서 오류가 발생했습니다")
+	}
+	if snapshot == nil {
+		return nil, httputil.NewNotFoundError(fmt.Sprintf("스냅샷(ID: %d)을 찾을 수 없습니다. 보관 기한이 지나 정리되었을 수 있습니다.", id))
+	}
+
+	return snapshot, nil
+}
+
+// logger 요청 정보를 바인딩한 로거를 반환합니다.
+func (h *Handler) logger(c echo.Context) *applog.Entry {
+	return applog.WithComponentAndFields(component, applog.Fields{
+		"request_id": c.Response().Header().Get(echo.HeaderXRequestID),
+		"path":       c.Request().URL.Path,
+		"method":     c.Request().Method,
+	})
+}
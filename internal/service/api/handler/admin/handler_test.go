@@ -0,0 +1,253 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	apperrors "github.com/darkkaiser/rss-feed-server/internal/errors"
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+	"github.com/darkkaiser/rss-feed-server/internal/service/api/model/response"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// --- Mocks ---
+
+// mockFeedRepository 파싱 실패 스냅샷 기록을 지원하지 않는 저장소입니다.
+type mockFeedRepository struct{}
+
+func (m *mockFeedRepository) SaveArticles(ctx context.Context, _ string, _ []*feed.Article) (int, error) {
+	return 0, nil
+}
+
+func (m *mockFeedRepository) GetArticles(ctx context.Context, _ string, _ []string, _ uint) ([]*feed.Article, error) {
+	return nil, nil
+}
+
+func (m *mockFeedRepository) GetCrawlingCursor(ctx context.Context, _ string, _ string) (string, time.Time, error) {
+	return "", time.Time{}, nil
+}
+
+func (m *mockFeedRepository) UpsertLatestCrawledArticleID(ctx context.Context, _ string, _ string, _ string) error {
+	return nil
+}
+
+// mockSnapshotRepository feed.Repository와 feed.ParseSnapshotRepository를 함께 만족하는 저장소입니다.
+type mockSnapshotRepository struct {
+	mockFeedRepository
+
+	snapshots map[int64]*feed.ParseSnapshot
+
+	listedProviderID string
+	listedLimit      int
+}
+
+func (m *mockSnapshotRepository) SaveParseSnapshot(ctx context.Context, snapshot *feed.ParseSnapshot, keep int, ttl time.Duration) (int64, error) {
+	return 0, nil
+}
+
+func (m *mockSnapshotRepository) ListParseSnapshots(ctx context.Context, providerID string, limit int) ([]*feed.ParseSnapshot, error) {
+	m.listedProviderID = providerID
+	m.listedLimit = limit
+
+	list := make([]*feed.ParseSnapshot, 0, len(m.snapshots))
+	for _, s := range m.snapshots {
+		list = append(list, s)
+	}
+	return list, nil
+}
+
+func (m *mockSnapshotRepository) GetParseSnapshot(ctx context.Context, id int64) (*feed.ParseSnapshot, error) {
+	return m.snapshots[id], nil
+}
+
+type mockReplayer struct {
+	result *feed.ParseReplayResult
+	err    error
+}
+
+func (m *mockReplayer) ReplayParseSnapshot(snapshot *feed.ParseSnapshot) (*feed.ParseReplayResult, error) {
+	return m.result, m.err
+}
+
+func newTestSnapshotRepository() *mockSnapshotRepository {
+	return &mockSnapshotRepository{
+		snapshots: map[int64]*feed.ParseSnapshot{
+			7: {
+				ID:          7,
+				ProviderID:  "yeosu-cityhall",
+				BoardID:     "notice",
+				Page:        1,
+				URL:         "https://example.com/notice?page=1",
+				ContentType: "text/html; charset=utf-8",
+				Body:        []byte("<html>원본</html>"),
+				Error:       "3번째 행: 작성일 파싱 실패",
+				CreatedAt:   time.Date(2024, 3, 15, 9, 30, 0, 0, time.UTC),
+			},
+		},
+	}
+}
+
+// serve 관리 라우트를 등록한 Echo 인스턴스로 요청을 처리하고 응답을 반환합니다.
+func serve(h *Handler, method, target string) *httptest.ResponseRecorder {
+	e := echo.New()
+	e.GET("/admin/snapshots", h.ListParseSnapshots)
+	e.GET("/admin/snapshots/:id", h.DownloadParseSnapshot)
+	e.POST("/admin/snapshots/:id/replay", h.ReplayParseSnapshot)
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(method, target, nil))
+	return rec
+}
+
+// --- Tests ---
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	t.Run("feed.Repository가 nil이면 panic이 발생한다", func(t *testing.T) {
+		t.Parallel()
+		assert.Panics(t, func() { New(nil, nil) })
+	})
+
+	t.Run("스냅샷 기록을 지원하는 저장소만 스냅샷 저장소로 사용된다", func(t *testing.T) {
+		t.Parallel()
+		assert.Nil(t, New(&mockFeedRepository{}, nil).snapshots)
+		assert.NotNil(t, New(newTestSnapshotRepository(), nil).snapshots)
+	})
+}
+
+func TestHandler_ListParseSnapshots(t *testing.T) {
+	t.Parallel()
+
+	t.Run("스냅샷 목록을 본문 없이 반환한다", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newTestSnapshotRepository()
+		rec := serve(New(repo, nil), http.MethodGet, "/admin/snapshots?provider_id=yeosu-cityhall")
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.NotContains(t, rec.Body.String(), "원본")
+
+		var resp response.ParseSnapshotListResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		require.Len(t, resp.Snapshots, 1)
+		assert.Equal(t, int64(7), resp.Snapshots[0].ID)
+		assert.Equal(t, "notice", resp.Snapshots[0].BoardID)
+		assert.Equal(t, "yeosu-cityhall", repo.listedProviderID)
+		assert.Equal(t, defaultSnapshotListLimit, repo.listedLimit)
+	})
+
+	t.Run("limit은 최대값으로 제한된다", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newTestSnapshotRepository()
+		rec := serve(New(repo, nil), http.MethodGet, "/admin/snapshots?limit=100000")
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, maxSnapshotListLimit, repo.listedLimit)
+	})
+
+	t.Run("잘못된 limit은 400을 반환한다", func(t *testing.T) {
+		t.Parallel()
+
+		rec := serve(New(newTestSnapshotRepository(), nil), http.MethodGet, "/admin/snapshots?limit=abc")
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("저장소가 스냅샷 기록을 지원하지 않으면 503을 반환한다", func(t *testing.T) {
+		t.Parallel()
+
+		rec := serve(New(&mockFeedRepository{}, nil), http.MethodGet, "/admin/snapshots")
+		assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	})
+}
+
+func TestHandler_DownloadParseSnapshot(t *testing.T) {
+	t.Parallel()
+
+	t.Run("원본 본문을 첨부 파일로 반환한다", func(t *testing.T) {
+		t.Parallel()
+
+		rec := serve(New(newTestSnapshotRepository(), nil), http.MethodGet, "/admin/snapshots/7")
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "<html>원본</html>", rec.Body.String())
+		assert.Equal(t, "text/html; charset=utf-8", rec.Header().Get(echo.HeaderContentType))
+		assert.Equal(t, `attachment; filename="snapshot-7.html"`, rec.Header().Get(echo.HeaderContentDisposition))
+	})
+
+	t.Run("JSON 스냅샷은 .json 확장자로 내려받는다", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newTestSnapshotRepository()
+		repo.snapshots[8] = &feed.ParseSnapshot{ID: 8, ContentType: "application/json; charset=utf-8", Body: []byte(`{}`)}
+		rec := serve(New(repo, nil), http.MethodGet, "/admin/snapshots/8")
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, `attachment; filename="snapshot-8.json"`, rec.Header().Get(echo.HeaderContentDisposition))
+	})
+
+	t.Run("잘못된 식별자는 400, 존재하지 않는 식별자는 404를 반환한다", func(t *testing.T) {
+		t.Parallel()
+
+		h := New(newTestSnapshotRepository(), nil)
+		assert.Equal(t, http.StatusBadRequest, serve(h, http.MethodGet, "/admin/snapshots/abc").Code)
+		assert.Equal(t, http.StatusBadRequest, serve(h, http.MethodGet, "/admin/snapshots/0").Code)
+		assert.Equal(t, http.StatusNotFound, serve(h, http.MethodGet, "/admin/snapshots/99").Code)
+	})
+}
+
+func TestHandler_ReplayParseSnapshot(t *testing.T) {
+	t.Parallel()
+
+	t.Run("현재 파서의 재생 결과를 반환한다", func(t *testing.T) {
+		t.Parallel()
+
+		replayer := &mockReplayer{result: &feed.ParseReplayResult{
+			Articles: []*feed.Article{{BoardID: "notice", ArticleID: "100", Title: "복구된 게시글"}},
+		}}
+		rec := serve(New(newTestSnapshotRepository(), replayer), http.MethodPost, "/admin/snapshots/7/replay")
+
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var resp response.ParseReplayResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, int64(7), resp.SnapshotID)
+		require.Len(t, resp.Articles, 1)
+		assert.Equal(t, "복구된 게시글", resp.Articles[0].Title)
+		assert.NotNil(t, resp.Errors, "에러가 없어도 빈 배열로 직렬화되어야 합니다")
+		assert.Empty(t, resp.Errors)
+	})
+
+	t.Run("재생 컴포넌트가 없으면 503을 반환한다", func(t *testing.T) {
+		t.Parallel()
+
+		rec := serve(New(newTestSnapshotRepository(), nil), http.MethodPost, "/admin/snapshots/7/replay")
+		assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	})
+
+	tests := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{"공급자를 찾을 수 없으면 404", apperrors.New(apperrors.NotFound, "공급자 없음"), http.StatusNotFound},
+		{"재생할 수 없는 스냅샷이면 400", apperrors.New(apperrors.InvalidInput, "HTML 아님"), http.StatusBadRequest},
+		{"재생을 지원하지 않는 공급자면 503", apperrors.New(apperrors.Unavailable, "재생 미지원"), http.StatusServiceUnavailable},
+		{"그 외의 에러는 500", apperrors.New(apperrors.Internal, "알 수 없는 오류"), http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rec := serve(New(newTestSnapshotRepository(), &mockReplayer{err: tt.err}), http.MethodPost, "/admin/snapshots/7/replay")
+			assert.Equal(t, tt.expected, rec.Code)
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package rss
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/darkkaiser/rss-feed-server/internal/config"
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+	"github.com/darkkaiser/rss-feed-server/internal/service/api/httputil"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_GetFeed_PrivateBoard(t *testing.T) {
+	cfg := newHistoryTestConfig()
+	cfg.Providers[0].Config.Boards[1].Private = true
+
+	t.Run("토큰이 없으면 비공개 게시판을 제외하고 피드를 구성한다", func(t *testing.T) {
+		mockRepo := new(MockFeedRepo)
+		mockRepo.On("GetArticles", mock.Anything, "provider1", []string{"b1"}, uint(10)).Return([]*feed.Article{}, nil)
+
+		c, rec := newHistoryTestContext("/provider1", []string{"id"}, []string{"provider1"})
+		require.NoError(t, New(cfg, mockRepo, nil).GetFeed(c))
+
+		assert.Equal(t, "public, max-age=60", rec.Header().Get("Cache-Control"))
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("토큰의 범위에 포함된 비공개 게시판을 함께 제공하고, 링크에 토큰 경로를 붙인다", func(t *testing.T) {
+		mockRepo := new(MockFeedRepo)
+		mockRepo.On("GetArticles", mock.Anything, "provider1", []string{"b1", "b2"}, uint(10)).Return([]*feed.Article{}, nil)
+
+		c, rec := newHistoryTestContext("/provider1", []string{"id"}, []string{"provider1"})
+		httputil.SetAccessTokenSecret(c, "rft_secret")
+		httputil.SetAccessToken(c, &feed.AccessToken{ID: 1, Scopes: []string{"provider1/b2"}})
+		require.NoError(t, New(cfg, mockRepo, nil).GetFeed(c))
+
+		assert.Equal(t, "private, max-age=60", rec.Header().Get("Cache-Control"), "구독자 전용 응답은 공유 캐시에 저장되지 않아야 합니다")
+		assert.Contains(t, rec.Body.String(), `rel="self" href="http://example.com/t/rft_secret/provider1"`)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestHandler_ViewArticle_PrivateBoard(t *testing.T) {
+	cfg := newHistoryTestConfig()
+	cfg.Providers[0].Config.Boards[1].Private = true
+
+	mockRepo := new(MockHistoryFeedRepo)
+	c, _ := newHistoryTestContext("/provider1/articles/b2/1", []string{"id", "boardID", "articleID"}, []string{"provider1", "b2", "1"})
+
+	err := New(cfg, mockRepo, nil).ViewArticle(c)
+	httpErr, ok := err.(*echo.HTTPError)
+	require.True(t, ok)
+	assert.Equal(t, http.StatusNotFound, httpErr.Code, "토큰 없이 요청하면 비공개 게시판은 등록되지 않은 게시판으로 취급해야 합니다")
+	mockRepo.AssertNotCalled(t, "GetArticle", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestHandler_GetFeed_HidesPrivateDuplicateSources(t *testing.T) {
+	cfg := newHistoryTestConfig()
+	cfg.Providers[0].Config.DuplicateThreshold = 3
+	cfg.Providers = append(cfg.Providers,
+		&config.ProviderConfig{ID: "public", Config: &config.ProviderDetailConfig{Name: "Public", Boards: []*config.BoardConfig{{ID: "x", Name: "Notice"}}}},
+		&config.ProviderConfig{ID: "members", Config: &config.ProviderDetailConfig{Name: "Members", Private: true, Boards: []*config.BoardConfig{{ID: "m1", Name: "Notice"}}}},
+	)
+
+	now := time.Now()
+	mockRepo := new(MockDuplicateFeedRepo)
+	mockRepo.On("GetArticles", mock.Anything, "provider1", []string{"b1", "b2"}, uint(10)).Return([]*feed.Article{
+		{BoardID: "b1", ArticleID: "1", Title: "같은 공지", Link: "http://test.com/1", CreatedAt: now, Fingerprint: 0b1111},
+	}, nil)
+	mockRepo.On("GetFingerprintedArticles", mock.Anything, mock.Anything, "provider1", mock.Anything).Return([]*feed.SourcedArticle{
+		{ProviderID: "public", Article: &feed.Article{BoardID: "x", ArticleID: "8", Title: "같은 공지", Link: "http://public.com/8", Fingerprint: 0b1111}},
+		{ProviderID: "members", Article: &feed.Article{BoardID: "m1", ArticleID: "9", Title: "같은 공지", Link: "http://members.com/9", Fingerprint: 0b1111}},
+	}, nil)
+
+	c, rec := newHistoryTestContext("/provider1", []string{"id"}, []string{"provider1"})
+	require.NoError(t, New(cfg, mockRepo, nil).GetFeed(c))
+
+	body := rec.Body.String()
+	assert.Contains(t, body, "http://public.com/8")
+	assert.NotContains(t, body, "http://members.com/9", "비공개 공급자의 게시글은 출처 링크로도 노출되지 않아야 합니다")
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"html"
 	"net/http"
 	"regexp"
+	"slices"
 	"strings"
 	"time"
 
@@ -22,6 +24,24 @@ import (
 // component RSS 핸들러의 로깅용 컴포넌트 이름
 const component = "api.handler.rss"
 
+// editedTitleMarker 원문 수정이 감지된 게시글의 피드 제목 앞에 붙이는 표식입니다.
+const editedTitleMarker = "[수정]"
+
+// deletedTitleMarker 원문 사이트에서 삭제가 감지된 게시글의 피드 제목 앞에 붙이는 표식입니다. (DeletedArticlePolicyMark 정책)
+const deletedTitleMarker = "[삭제됨]"
+
+// accessDeniedTitleMarker 원문 접근이 거부되어 접근 제한(feed.DeletedReasonAccessDenied)으로 기록된 게시글의 피드 제목 앞에 붙이는 표식입니다. (DeletedArticlePolicyMark 정책)
+const accessDeniedTitleMarker = "[접근 제한]"
+
+const (
+	// duplicateLookback 다른 공급자의 중복 게시글을 찾을 때, 피드에 노출되는 가장 오래된 게시글보다 얼마나 더 이전까지 살펴볼지를 나타냅니다.
+	// 관공서 공지가 다른 게시판에 옮겨 올라오기까지 며칠이 걸리는 경우를 고려한 값입니다.
+	duplicateLookback = 72 * time.Hour
+
+	// duplicateCandidateLimit 다른 공급자의 중복 게시글 후보를 한 번에 조회할 최대 건수입니다.
+	duplicateCandidateLimit = 1000
+)
+
 var (
 	// nl2brReplacer 게시글 본문의 줄바꿈 문자(\r\n, \n)를 HTML <br/> 태그로 치환합니다.
 	nl2brReplacer = strings.NewReplacer("\r\n", "<br/>", "\n", "<br/>")
@@ -41,6 +61,65 @@ type providerCache struct {
 
 	// boardNameByID 게시판 ID를 표시용 이름으로 빠르게 치환하기 위한 맵입니다.
 	boardNameByID map[string]string
+
+	// itemLimit 이 프로바이더의 피드에 노출할 최대 게시글 수입니다. (프로바이더 설정이 없으면 전역 설정값)
+	itemLimit uint
+
+	// queries 피드를 구성하기 위해 실행할 게시글 조회 목록입니다.
+	// 게시판별 노출 한도(max_item_count)가 설정된 게시판은 개별 조회로 분리하고, 나머지 게시판은 한 번에 조회합니다.
+	queries []articleQuery
+}
+
+// hidesDeleted 이 프로바이더가 삭제가 감지된 게시글을 피드와 조회 결과에서 숨기는지(DeletedArticlePolicyHide) 여부를 반환합니다.
+func (p providerCache) hidesDeleted() bool {
+	return p.cfg.Config.DeletedPolicy() == config.DeletedArticlePolicyHide
+}
+
+// articleQuery 게시글 조회 한 번의 대상 게시판과 최대 조회 건수입니다.
+type articleQuery struct {
+	boardIDs []string
+	limit    uint
+}
+
+// newArticleQueries 프로바이더의 게시판 설정으로 피드 구성에 필요한 게시글 조회 목록을 만듭니다.
+// 게시판별 노출 한도가 프로바이더 한도보다 크면 프로바이더 한도를 적용합니다.
+func newArticleQueries(boards []*config.BoardConfig, itemLimit uint) []articleQuery {
+	var (
+		queries  []articleQuery
+		uncapped []string
+	)
+	for _, b := range boards {
+		if b.MaxItemCount == 0 {
+			uncapped = append(uncapped, b.ID)
+			continue
+		}
+		queries = append(queries, articleQuery{boardIDs: []string{b.ID}, limit: min(b.MaxItemCount, itemLimit)})
+	}
+	if len(uncapped) > 0 {
+		queries = append([]articleQuery{{boardIDs: uncapped, limit: itemLimit}}, queries...)
+	}
+
+	return queries
+}
+
+// newProviderCache 프로바이더 설정 중 지정한 게시판(boards)만으로 피드를 구성하는 providerCache를 만듭니다.
+func newProviderCache(p *config.ProviderConfig, boards []*config.BoardConfig, maxItemCount uint) providerCache {
+	var boardIDs []string
+	var boardNameByID = make(map[string]string, len(boards))
+	for _, b := range boards {
+		boardIDs = append(boardIDs, b.ID)
+		boardNameByID[b.ID] = b.Name
+	}
+
+	itemLimit := p.Config.ItemLimit(maxItemCount)
+
+	return providerCache{
+		cfg:           p,
+		boardIDs:      boardIDs,
+		boardNameByID: boardNameByID,
+		itemLimit:     itemLimit,
+		queries:       newArticleQueries(boards, itemLimit),
+	}
 }
 
 // Handler RSS 피드 관련 HTTP 요청을 처리하는 핸들러입니다.
@@ -55,6 +134,14 @@ type Handler struct {
 	// feedRepo 게시글의 영속성을 담당하는 저장소 인터페이스입니다.
 	feedRepo feed.Repository
 
+	// duplicateRepo 다른 공급자의 중복 게시글을 찾기 위한 저장소입니다. 저장소가 feed.DuplicateRepository를 구현하지 않으면 nil이며,
+	// 이 경우 같은 공급자 안의 게시판 사이에서만 중복 게시글을 묶습니다.
+	duplicateRepo feed.DuplicateRepository
+
+	// historyRepo 피드 노출 한도를 넘어 보관 중인 게시글을 조회하기 위한 저장소입니다. 저장소가 feed.HistoryRepository를 구현하지 않으면 nil이며,
+	// 이 경우 게시글 이력 API와 아카이브 피드는 503으로 응답하고 개별 피드에는 이전 아카이브 링크를 포함하지 않습니다.
+	historyRepo feed.HistoryRepository
+
 	// notifyClient 텔레그램 등 외부 알림 채널과 통신하는 클라이언트입니다.
 	notifyClient *notify.Client
 
@@ -75,27 +162,21 @@ func New(cfg *config.RSSFeedConfig, feedRepo feed.Repository, notifyClient *noti
 	// 서버 기동 시점에 프로바이더 조회용 맵을 미리 구성합니다.
 	providers := make(map[string]providerCache, len(cfg.Providers))
 	for _, p := range cfg.Providers {
-		var boardIDs []string
-		var boardNameByID = make(map[string]string, len(p.Config.Boards))
-		for _, b := range p.Config.Boards {
-			boardIDs = append(boardIDs, b.ID)
-			boardNameByID[b.ID] = b.Name
-		}
-
 		// 피드 ID 비교 시 대소문자를 구분하지 않도록 소문자로 정규화하여 저장합니다.
-		providers[strings.ToLower(p.ID)] = providerCache{
-			cfg:           p,
-			boardIDs:      boardIDs,
-			boardNameByID: boardNameByID,
-		}
+		providers[strings.ToLower(p.ID)] = newProviderCache(p, p.Config.Boards, cfg.MaxItemCount)
 	}
 
+	duplicateRepo, _ := feedRepo.(feed.DuplicateRepository)
+	historyRepo, _ := feedRepo.(feed.HistoryRepository)
+
 	return &Handler{
-		cfg:          cfg,
-		providers:    providers,
-		feedRepo:     feedRepo,
-		notifyClient: notifyClient,
-		startedAt:    time.Now(),
+		cfg:           cfg,
+		providers:     providers,
+		feedRepo:      feedRepo,
+		duplicateRepo: duplicateRepo,
+		historyRepo:   historyRepo,
+		notifyClient:  notifyClient,
+		startedAt:     time.Now(),
 	}
 }
 
@@ -117,12 +198,26 @@ func (h *Handler) ViewSummary(c echo.Context) error {
 		"user_agent": c.Request().UserAgent(),
 	}).Debug("RSS 피드 목록 요약 페이지 조회")
 
+	// 비공개 피드는 열람할 수 있는 토큰으로 요청한 경우에만 목록에 표시합니다.
+	feedConfig := *h.cfg
+	feedConfig.Providers = httputil.VisibleProviders(c, h.cfg.Providers)
+
 	return c.Render(http.StatusOK, "rss_summary.tmpl", map[string]any{
-		"baseURL":    fmt.Sprintf("%s://%s", c.Scheme(), c.Request().Host),
-		"feedConfig": h.cfg,
+		"baseURL":    baseURL(c),
+		"feedConfig": &feedConfig,
 	})
 }
 
+// visibleProvider 요청이 열람할 수 없는 비공개 게시판을 제외한 providerCache를 반환합니다.
+// 제외할 게시판이 없으면 서버 기동 시 만들어 둔 provider를 그대로 반환합니다.
+func (h *Handler) visibleProvider(c echo.Context, provider providerCache) providerCache {
+	boards := httputil.VisibleBoards(c, provider.cfg)
+	if len(boards) == len(provider.cfg.Config.Boards) {
+		return provider
+	}
+	return newProviderCache(provider.cfg, boards, h.cfg.MaxItemCount)
+}
+
 // GetFeed godoc
 // @Summary 개별 RSS 피드 조회
 // @Description 지정된 식별자(id)에 해당하는 게시판의 최신 게시글을 RSS 2.0 규격의 XML 형식으로 반환합니다.
@@ -166,6 +261,7 @@ func (h *Handler) GetFeed(c echo.Context) error {
 	if !ok {
 		return httputil.NewNotFoundError(fmt.Sprintf("요청하신 식별자(%s)에 해당하는 RSS 피드 제공자 정보를 찾을 수 없습니다. 올바른 주소인지 확인해 주시기 바랍니다.", id))
 	}
+	provider = h.visibleProvider(c, provider)
 
 	var err error
 	var articles []*feed.Article
@@ -175,7 +271,7 @@ func (h *Handler) GetFeed(c echo.Context) error {
 	// =========================================================================
 	// 게시판이 설정된 경우에만 캐싱 로직 없이 매 요청마다 최신 데이터를 조회하여 정합성을 보장합니다.
 	if len(provider.boardIDs) > 0 {
-		articles, err = h.feedRepo.GetArticles(c.Request().Context(), provider.cfg.ID, provider.boardIDs, h.cfg.MaxItemCount)
+		articles, err = h.getArticles(c.Request().Context(), provider)
 		if err != nil {
 			// 클라이언트 측 요청 취소/타임아웃은 서버 장애가 아니므로 경고 로그만 남깁니다.
 			if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
@@ -189,7 +285,56 @@ func (h *Handler) GetFeed(c echo.Context) error {
 	}
 
 	// =========================================================================
-	// 4단계: RSS 갱신 기준일(LastBuildDate) 계산
+	// 4단계: RSS 피드 객체 조립
+	// =========================================================================
+	feed := h.buildFeed(c, logger, provider, articles)
+
+	// 보관 중인 과거 게시글을 RSS 리더가 거슬러 올라가며 가져갈 수 있도록, 가장 최근 아카이브 피드의 주소를 함께 알립니다. (RFC 5005)
+	links := []*atomLink{{Rel: "self", Href: feedURL(c, provider)}}
+	if prev, err := h.latestArchiveDate(c.Request().Context(), provider, time.Now()); err != nil {
+		logger.Warnf("아카이브 피드 조회 실패: 이전 아카이브 링크 없이 피드를 제공합니다 (p_id:%s, error:%s)", provider.cfg.ID, err)
+	} else if !prev.IsZero() {
+		links = append(links, &atomLink{Rel: "prev-archive", Href: archiveURL(c, provider, prev)})
+	}
+
+	// =========================================================================
+	// 5단계: XML 직렬화
+	// =========================================================================
+	rssXML, err := renderRSS(feed, links, false)
+	if err != nil {
+		return h.notifyError(logger, fmt.Sprintf("시스템 내부 오류로 인해 RSS 피드 문서를 정상적으로 생성할 수 없습니다. (제공자 식별자: %s)", id), err)
+	}
+
+	// =========================================================================
+	// 6단계: HTTP 응답 반환
+	// =========================================================================
+	// RSS 리더의 과도한 반복 풀링을 막기 위해 60초 캐싱 헤더를 주입합니다.
+	c.Response().Header().Set("Cache-Control", httputil.CacheControl(c, "60"))
+
+	return c.Blob(http.StatusOK, "application/rss+xml; charset=UTF-8", []byte(rssXML))
+}
+
+// buildFeed 조회한 게시글 목록으로 RSS 피드 객체를 조립합니다. 개별 피드와 아카이브 피드가 같은 규칙으로 게시글을 표시합니다.
+func (h *Handler) buildFeed(c echo.Context, logger *applog.Entry, provider providerCache, articles []*feed.Article) *feeds.Feed {
+	// 원문에서 삭제가 감지된 게시글을 숨기도록 설정된 공급자라면 피드 조립 전에 목록에서 제외합니다.
+	// 개별 피드는 조회 단계(getArticles)에서 이미 제외되지만, 저장소가 feed.VisibleArticleRepository를 구현하지 않았거나
+	// 아카이브 피드처럼 다른 경로로 조회한 목록에도 같은 규칙을 적용하기 위해 여기서 한 번 더 거릅니다.
+	deletedPolicy := provider.cfg.Config.DeletedPolicy()
+	if deletedPolicy == config.DeletedArticlePolicyHide {
+		visible := articles[:0]
+		for _, article := range articles {
+			if article != nil && !article.IsDeleted() {
+				visible = append(visible, article)
+			}
+		}
+		articles = visible
+	}
+
+	// 같은 글이 여러 게시판이나 다른 공급자에 올라온 경우 하나의 피드 항목으로 묶습니다.
+	clusters := h.clusterArticles(c, logger, provider, articles)
+
+	// =========================================================================
+	// 1단계: RSS 갱신 기준일(LastBuildDate) 계산
 	// =========================================================================
 	// RSS 리더가 갱신 여부를 판단할 수 있도록 수집된 게시글 중 가장 최신 날짜를 찾습니다.
 	var lastBuildDate time.Time
@@ -201,6 +346,11 @@ func (h *Handler) GetFeed(c echo.Context) error {
 		if article.CreatedAt.After(lastBuildDate) {
 			lastBuildDate = article.CreatedAt
 		}
+
+		// 원문 수정이 감지된 게시글도 피드 갱신으로 간주하여 RSS 리더가 변경 사항을 다시 가져가도록 합니다.
+		if article.UpdatedAt.After(lastBuildDate) {
+			lastBuildDate = article.UpdatedAt
+		}
 	}
 
 	// 게시글이 없을 때 현재 시각(time.Now)을 넘기면, RSS 리더기가 매번 피드가 갱신된 것으로 착각할 수 있습니다.
@@ -210,7 +360,7 @@ func (h *Handler) GetFeed(c echo.Context) error {
 	}
 
 	// =========================================================================
-	// 5단계: RSS 피드 객체 조립
+	// 2단계: 피드 항목 조립
 	// =========================================================================
 	// DB에서 조회한 게시글들을 바탕으로 RSS 2.0 객체를 라이브러리 스펙에 맞게 조립합니다.
 	feed := &feeds.Feed{
@@ -222,18 +372,10 @@ func (h *Handler) GetFeed(c echo.Context) error {
 		Created:     lastBuildDate,
 	}
 
-	for _, article := range articles {
-		if article == nil {
-			continue
-		}
+	for _, cluster := range clusters {
+		article := cluster.Article
 
-		// 본문 줄바꿈 브라우저 렌더링 호환성 처리
-		// - 텍스트 단락: RSS 리더가 개행을 무시하지 않도록 <br/> 태그 치환
-		// - 완전한 HTML: 크롤러가 HTML 구조(p, div 등)를 유지한 경우 이중 치환으로 본문이 깨지지 않도록 방어
-		content := article.Content
-		if !htmlTagRegex.MatchString(content) {
-			content = nl2brReplacer.Replace(content)
-		}
+		content := formatContent(article.Content) + h.duplicateSourcesHTML(provider, cluster.Duplicates)
 
 		// 게시판 ID(영문/숫자 등)를 사람이 읽기 좋은 표시용 이름으로 변환합니다.
 		boardName, exists := provider.boardNameByID[article.BoardID]
@@ -241,34 +383,191 @@ func (h *Handler) GetFeed(c echo.Context) error {
 			boardName = article.BoardID
 		}
 
+		// 재검증 작업에서 원문 수정이 감지된 게시글은 수정 일시를 Updated로 넘겨 renderRSS가 atom:updated 요소로 노출하도록 하고,
+		// 설정에 따라 제목 앞에 "[수정]" 표식을 붙여 구독자가 변경 사실을 인지할 수 있도록 합니다.
+		title := fmt.Sprintf("[%s] %s", boardName, article.Title)
+		updated := article.CreatedAt
+		if article.IsEdited() {
+			updated = article.UpdatedAt
+			if h.cfg.Revalidation.MarkEditedTitle {
+				title = editedTitleMarker + " " + title
+			}
+		}
+		if article.IsDeleted() && deletedPolicy == config.DeletedArticlePolicyMark {
+			if article.IsAccessDenied() {
+				title = accessDeniedTitleMarker + " " + title
+			} else {
+				title = deletedTitleMarker + " " + title
+			}
+		}
+
+		// 공급자가 게시글 페이지 사용을 설정했다면 원문 대신 이 서버가 보관한 게시글 페이지로 연결합니다.
+		link := article.Link
+		if provider.cfg.Config.UsePermalink {
+			link = permalinkURL(c, provider, article)
+		}
+
 		feed.Items = append(feed.Items, &feeds.Item{
-			Title:       fmt.Sprintf("[%s] %s", boardName, article.Title),
-			Link:        &feeds.Link{Href: article.Link},
+			Title:       title,
+			Link:        &feeds.Link{Href: link},
 			Author:      &feeds.Author{Name: article.Author},
 			Description: content,
-			Id:          article.Link,
+			Id:          link,
 			Created:     article.CreatedAt,
-			Updated:     article.CreatedAt,
+			Updated:     updated,
 			Content:     content,
 		})
 	}
 
-	// =========================================================================
-	// 6단계: XML 직렬화
-	// =========================================================================
-	rssXML, err := feed.ToRss()
-	if err != nil {
-		return h.notifyError(logger, fmt.Sprintf("시스템 내부 오류로 인해 RSS 피드 문서를 정상적으로 생성할 수 없습니다. (제공자 식별자: %s)", id), err)
+	return feed
+}
+
+// formatContent 게시글 본문을 브라우저에서 줄바꿈이 유지되도록 HTML로 변환합니다.
+//   - 텍스트 단락: RSS 리더와 브라우저가 개행을 무시하지 않도록 <br/> 태그로 치환합니다.
+//   - 완전한 HTML: 크롤러가 HTML 구조(p, div 등)를 유지한 경우 이중 치환으로 본문이 깨지지 않도록 그대로 반환합니다.
+func formatContent(content string) string {
+	if !htmlTagRegex.MatchString(content) {
+		return nl2brReplacer.Replace(content)
 	}
+	return content
+}
 
-	// =========================================================================
-	// 7단계: HTTP 응답 반환
-	// =========================================================================
-	// RSS 리더의 과도한 반복 풀링을 막기 위해 60초 캐싱 헤더를 주입합니다.
-	c.Response().Header().Set("Cache-Control", "public, max-age=60")
+// clusterArticles 게시글 목록을 지문이 가까운 것끼리 묶어 피드 항목 단위(feed.Cluster)로 반환합니다.
+//
+// 프로바이더의 duplicate_threshold가 0이면 게시글마다 하나의 항목을 만듭니다. 다른 공급자의 중복 게시글 조회에
+// 실패하더라도 피드 제공을 중단하지 않고, 같은 공급자 안에서만 묶은 결과를 반환합니다.
+// 요청이 열람할 수 없는 비공개 공급자나 게시판의 게시글은 출처 링크로도 노출되지 않도록 묶음 대상에서 제외합니다.
+func (h *Handler) clusterArticles(c echo.Context, logger *applog.Entry, provider providerCache, articles []*feed.Article) []*feed.Cluster {
+	threshold := provider.cfg.Config.DuplicateThreshold
+	if threshold == 0 {
+		clusters := make([]*feed.Cluster, 0, len(articles))
+		for _, article := range articles {
+			if article != nil {
+				clusters = append(clusters, &feed.Cluster{Article: article})
+			}
+		}
+		return clusters
+	}
 
-	// gorilla/feeds의 ToRss()는 기본적으로 <?xml ... ?> 선언 헤더를 포함하여 반환합니다.
-	return c.Blob(http.StatusOK, "application/rss+xml; charset=UTF-8", []byte(rssXML))
+	var others []*feed.SourcedArticle
+	if h.duplicateRepo != nil && len(articles) > 0 {
+		var oldest time.Time
+		for _, article := range articles {
+			if article != nil && (oldest.IsZero() || article.CreatedAt.Before(oldest)) {
+				oldest = article.CreatedAt
+			}
+		}
+
+		var err error
+		others, err = h.duplicateRepo.GetFingerprintedArticles(c.Request().Context(), oldest.Add(-duplicateLookback), provider.cfg.ID, duplicateCandidateLimit)
+		if err != nil {
+			logger.Warnf("다른 공급자의 중복 게시글 조회 실패: 같은 공급자 안에서만 중복 게시글을 묶습니다 (p_id:%s, error:%s)", provider.cfg.ID, err)
+			others = nil
+		}
+
+		visible := others[:0]
+		for _, other := range others {
+			if h.canViewSource(c, other) {
+				visible = append(visible, other)
+			}
+		}
+		others = visible
+	}
+
+	return feed.ClusterArticles(provider.cfg.ID, articles, others, int(threshold))
+}
+
+// canViewSource 다른 공급자의 게시글을 요청이 열람할 수 있는지 여부를 반환합니다.
+// 현재 설정에 없는 공급자의 게시글은 비공개 여부를 알 수 없지만, 설정에서 제거되기 전까지 공개되어 있던 게시글이므로 그대로 노출합니다.
+func (h *Handler) canViewSource(c echo.Context, article *feed.SourcedArticle) bool {
+	source, ok := h.providers[strings.ToLower(article.ProviderID)]
+	if !ok {
+		return true
+	}
+
+	board := source.cfg.Config.Board(article.BoardID)
+	if board == nil {
+		return !source.cfg.Config.Private
+	}
+	return httputil.CanViewBoard(c, source.cfg, board)
+}
+
+// duplicateSourcesHTML 대표 게시글과 같은 내용으로 묶인 나머지 게시글의 출처 링크 목록을 HTML로 만듭니다. 묶인 게시글이 없으면 빈 문자열을 반환합니다.
+func (h *Handler) duplicateSourcesHTML(provider providerCache, duplicates []*feed.SourcedArticle) string {
+	if len(duplicates) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+	sb.WriteString("<hr/><p>같은 내용의 게시글</p><ul>")
+	for _, d := range duplicates {
+		source := provider
+		if !strings.EqualFold(d.ProviderID, provider.cfg.ID) {
+			source = h.providers[strings.ToLower(d.ProviderID)]
+		}
+
+		boardName := d.BoardName
+		if name, ok := source.boardNameByID[d.BoardID]; ok {
+			boardName = name
+		}
+		if boardName == "" {
+			boardName = d.BoardID
+		}
+
+		// 다른 공급자의 게시글은 공급자 이름을 함께 표시합니다. (현재 설정에 없는 공급자는 ID로 표시)
+		label := boardName
+		switch {
+		case source.cfg == nil:
+			label = d.ProviderID + " > " + boardName
+		case source.cfg != provider.cfg:
+			label = source.cfg.Config.Name + " > " + boardName
+		}
+
+		fmt.Fprintf(&sb, `<li><a href="%s">[%s] %s</a></li>`, html.EscapeString(d.Link), html.EscapeString(label), html.EscapeString(d.Title))
+	}
+	sb.WriteString("</ul>")
+
+	return sb.String()
+}
+
+// getArticles 프로바이더의 조회 목록(queries)을 차례로 실행하여 피드에 노출할 게시글을 최신순으로 모아 반환합니다.
+//
+// 게시판별 노출 한도가 설정되지 않은 프로바이더는 조회가 한 번뿐이므로 결과를 그대로 반환하고,
+// 조회가 여러 번이면 결과를 작성일시 역순으로 병합한 뒤 프로바이더 한도(itemLimit)만큼만 남깁니다.
+//
+// 삭제된 게시글을 숨기는 공급자는 저장소가 feed.VisibleArticleRepository를 구현하면 조회 단계에서 삭제된 게시글을 제외하여,
+// 숨긴 게시글 때문에 피드의 게시글 수가 한도보다 적어지지 않도록 합니다.
+func (h *Handler) getArticles(ctx context.Context, provider providerCache) ([]*feed.Article, error) {
+	fetch := h.feedRepo.GetArticles
+	if provider.hidesDeleted() {
+		if repo, ok := h.feedRepo.(feed.VisibleArticleRepository); ok {
+			fetch = repo.GetVisibleArticles
+		}
+	}
+
+	if len(provider.queries) == 1 {
+		q := provider.queries[0]
+		return fetch(ctx, provider.cfg.ID, q.boardIDs, q.limit)
+	}
+
+	var articles []*feed.Article
+	for _, q := range provider.queries {
+		queried, err := fetch(ctx, provider.cfg.ID, q.boardIDs, q.limit)
+		if err != nil {
+			return nil, err
+		}
+		articles = append(articles, queried...)
+	}
+
+	articles = slices.DeleteFunc(articles, func(a *feed.Article) bool { return a == nil })
+	slices.SortStableFunc(articles, func(a, b *feed.Article) int {
+		return b.CreatedAt.Compare(a.CreatedAt)
+	})
+	if uint(len(articles)) > provider.itemLimit {
+		articles = articles[:provider.itemLimit]
+	}
+
+	return articles, nil
 }
 
 // notifyError 핸들러 내부에서 복구 불가능한 오류가 발생했을 때 호출되는 공통 에러 처리 헬퍼입니다.
@@ -6,6 +6,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -45,6 +46,34 @@ func (m *MockFeedRepo) UpsertLatestCrawledArticleID(ctx context.Context, provide
 	return args.Error(0)
 }
 
+// MockDuplicateFeedRepo feed.DuplicateRepository를 함께 구현하는 저장소 Mock입니다.
+type MockDuplicateFeedRepo struct {
+	MockFeedRepo
+}
+
+func (m *MockDuplicateFeedRepo) GetFingerprintedArticles(ctx context.Context, since time.Time, excludeProviderID string, limit uint) ([]*feed.SourcedArticle, error) {
+	args := m.Called(ctx, since, excludeProviderID, limit)
+	var res []*feed.SourcedArticle
+	if v := args.Get(0); v != nil {
+		res = v.([]*feed.SourcedArticle)
+	}
+	return res, args.Error(1)
+}
+
+// MockVisibleFeedRepo feed.VisibleArticleRepository를 함께 구현하는 저장소 Mock입니다.
+type MockVisibleFeedRepo struct {
+	MockFeedRepo
+}
+
+func (m *MockVisibleFeedRepo) GetVisibleArticles(ctx context.Context, providerID string, boardIDs []string, limit uint) ([]*feed.Article, error) {
+	args := m.Called(ctx, providerID, boardIDs, limit)
+	var res []*feed.Article
+	if v := args.Get(0); v != nil {
+		res = v.([]*feed.Article)
+	}
+	return res, args.Error(1)
+}
+
 type dummyTemplateRenderer struct{}
 
 func (t *dummyTemplateRenderer) Render(w io.Writer, name string, data interface{}, c echo.Context) error {
@@ -167,6 +196,26 @@ func TestNew(t *testing.T) {
 	})
 }
 
+func TestNewArticleQueries(t *testing.T) {
+	t.Run("게시판별 노출 한도가 없으면 모든 게시판을 한 번에 조회", func(t *testing.T) {
+		boards := []*config.BoardConfig{{ID: "b1"}, {ID: "b2"}}
+		assert.Equal(t, []articleQuery{{boardIDs: []string{"b1", "b2"}, limit: 10}}, newArticleQueries(boards, 10))
+	})
+
+	t.Run("노출 한도가 있는 게시판은 개별 조회하고 프로바이더 한도를 넘지 않음", func(t *testing.T) {
+		boards := []*config.BoardConfig{{ID: "busy", MaxItemCount: 3}, {ID: "notice"}, {ID: "big", MaxItemCount: 50}}
+		assert.Equal(t, []articleQuery{
+			{boardIDs: []string{"notice"}, limit: 10},
+			{boardIDs: []string{"busy"}, limit: 3},
+			{boardIDs: []string{"big"}, limit: 10},
+		}, newArticleQueries(boards, 10))
+	})
+
+	t.Run("게시판이 없으면 조회하지 않음", func(t *testing.T) {
+		assert.Empty(t, newArticleQueries(nil, 10))
+	})
+}
+
 func TestHandler_ViewSummary(t *testing.T) {
 	e := echo.New()
 	e.Renderer = &dummyTemplateRenderer{}
@@ -249,6 +298,175 @@ func TestHandler_GetFeed(t *testing.T) {
 		mockRepo.AssertExpectations(t)
 	})
 
+	t.Run("Per-Board And Per-Provider Item Limits", func(t *testing.T) {
+		limitedCfg := &config.RSSFeedConfig{
+			MaxItemCount: 10,
+			Providers: []*config.ProviderConfig{
+				{
+					ID: "provider1",
+					Config: &config.ProviderDetailConfig{
+						Name:         "Test Provider",
+						URL:          "http://test.com",
+						MaxItemCount: 3,
+						Boards: []*config.BoardConfig{
+							{ID: "free", Name: "자유발언", MaxItemCount: 2},
+							{ID: "notice", Name: "공지사항"},
+						},
+					},
+				},
+			},
+		}
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues("provider1")
+
+		now := time.Now()
+		newArticle := func(boardID, id string, age time.Duration) *feed.Article {
+			return &feed.Article{ArticleID: id, BoardID: boardID, Title: "Title " + id, Link: "http://test.com/" + id, CreatedAt: now.Add(-age)}
+		}
+
+		mockRepo := new(MockFeedRepo)
+		mockRepo.On("GetArticles", mock.Anything, "provider1", []string{"notice"}, uint(3)).Return([]*feed.Article{
+			newArticle("notice", "n1", 3*time.Hour),
+			newArticle("notice", "n2", 48*time.Hour),
+		}, nil)
+		mockRepo.On("GetArticles", mock.Anything, "provider1", []string{"free"}, uint(2)).Return([]*feed.Article{
+			newArticle("free", "f1", time.Minute),
+			newArticle("free", "f2", 2*time.Minute),
+		}, nil)
+
+		h := New(limitedCfg, mockRepo, nil)
+		err := h.GetFeed(c)
+
+		assert.NoError(t, err)
+		body := rec.Body.String()
+		assert.Contains(t, body, "Title f1")
+		assert.Contains(t, body, "Title f2")
+		assert.Contains(t, body, "Title n1", "게시글이 많은 게시판에 밀리지 않고 공지사항이 노출되어야 합니다")
+		assert.NotContains(t, body, "Title n2", "프로바이더 한도(3건)를 넘는 게시글은 제외되어야 합니다")
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Edited Article Uses UpdatedAt and Title Marker", func(t *testing.T) {
+		editedCfg := *cfg
+		editedCfg.Revalidation = config.RevalidationConfig{Days: 7, TimeSpec: "0 0 * * * *", MarkEditedTitle: true}
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues("provider1")
+
+		createdAt := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+		updatedAt := time.Date(2025, 3, 3, 18, 30, 0, 0, time.UTC)
+
+		mockRepo := new(MockFeedRepo)
+		mockRepo.On("GetArticles", mock.Anything, "provider1", []string{"b1"}, uint(10)).Return([]*feed.Article{
+			{ArticleID: "1", BoardID: "b1", Title: "Edited", Content: "본문", Link: "http://test.com/1", CreatedAt: createdAt, UpdatedAt: updatedAt},
+			{ArticleID: "2", BoardID: "b1", Title: "Untouched", Content: "본문", Link: "http://test.com/2", CreatedAt: createdAt},
+		}, nil)
+
+		h := New(&editedCfg, mockRepo, nil)
+		assert.NoError(t, h.GetFeed(c))
+
+		body := rec.Body.String()
+		assert.Contains(t, body, "[수정] [Board 1] Edited")
+		assert.Contains(t, body, "<title>[Board 1] Untouched</title>")
+		// 수정 일시가 채널의 갱신 기준일(LastBuildDate)로 반영되어야 합니다.
+		assert.Contains(t, body, updatedAt.Format(time.RFC1123Z))
+
+		// 수정된 게시글 항목에만 수정 일시가 atom:updated로 포함되고, pubDate는 작성 일시를 유지해야 합니다.
+		editedItem := body[strings.Index(body, "<item>"):strings.Index(body, "</item>")]
+		assert.Contains(t, editedItem, "<atom:updated>"+updatedAt.Format(time.RFC3339)+"</atom:updated>")
+		assert.Contains(t, editedItem, "<pubDate>"+createdAt.Format(time.RFC1123Z)+"</pubDate>")
+		untouchedItem := body[strings.LastIndex(body, "<item>"):]
+		assert.Contains(t, untouchedItem, "Untouched")
+		assert.NotContains(t, untouchedItem, "atom:updated")
+	})
+
+	t.Run("Deleted Article Policy", func(t *testing.T) {
+		deletedAt := time.Date(2025, 3, 4, 9, 0, 0, 0, time.UTC)
+
+		tests := []struct {
+			policy       config.DeletedArticlePolicy
+			wantContains []string
+			wantMissing  []string
+		}{
+			{policy: config.DeletedArticlePolicyHide, wantContains: []string{"<title>[Board 1] Alive</title>"}, wantMissing: []string{"Removed", "Restricted"}},
+			{policy: config.DeletedArticlePolicyMark, wantContains: []string{"<title>[Board 1] Alive</title>", "<title>[삭제됨] [Board 1] Removed</title>", "<title>[접근 제한] [Board 1] Restricted</title>"}},
+			{policy: config.DeletedArticlePolicyKeep, wantContains: []string{"<title>[Board 1] Alive</title>", "<title>[Board 1] Removed</title>", "<title>[Board 1] Restricted</title>"}, wantMissing: []string{"[삭제됨]", "[접근 제한]"}},
+		}
+
+		for _, tt := range tests {
+			t.Run(string(tt.policy), func(t *testing.T) {
+				detail := *cfg.Providers[0].Config
+				detail.DeletedArticlePolicy = tt.policy
+				providerCfg := *cfg.Providers[0]
+				providerCfg.Config = &detail
+				policyCfg := *cfg
+				policyCfg.Providers = []*config.ProviderConfig{&providerCfg}
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodGet, "/", nil)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+				c.SetParamNames("id")
+				c.SetParamValues("provider1")
+
+				mockRepo := new(MockFeedRepo)
+				mockRepo.On("GetArticles", mock.Anything, "provider1", []string{"b1"}, uint(10)).Return([]*feed.Article{
+					{ArticleID: "1", BoardID: "b1", Title: "Alive", Content: "본문", Link: "http://test.com/1", CreatedAt: deletedAt},
+					{ArticleID: "2", BoardID: "b1", Title: "Removed", Content: "본문", Link: "http://test.com/2", CreatedAt: deletedAt, DeletedAt: deletedAt, DeletedReason: feed.DeletedReasonRemoved},
+					{ArticleID: "3", BoardID: "b1", Title: "Restricted", Content: "본문", Link: "http://test.com/3", CreatedAt: deletedAt, DeletedAt: deletedAt, DeletedReason: feed.DeletedReasonAccessDenied},
+				}, nil)
+
+				h := New(&policyCfg, mockRepo, nil)
+				assert.NoError(t, h.GetFeed(c))
+
+				body := rec.Body.String()
+				for _, want := range tt.wantContains {
+					assert.Contains(t, body, want)
+				}
+				for _, missing := range tt.wantMissing {
+					assert.NotContains(t, body, missing)
+				}
+			})
+		}
+
+		t.Run("hide 정책은 삭제된 게시글을 조회 단계에서 제외한다", func(t *testing.T) {
+			detail := *cfg.Providers[0].Config
+			detail.DeletedArticlePolicy = config.DeletedArticlePolicyHide
+			providerCfg := *cfg.Providers[0]
+			providerCfg.Config = &detail
+			policyCfg := *cfg
+			policyCfg.Providers = []*config.ProviderConfig{&providerCfg}
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues("provider1")
+
+			// GetArticles로 조회하면 LIMIT 이후에 걸러져 게시글 수가 줄어드므로, GetVisibleArticles만 호출되어야 합니다.
+			mockRepo := new(MockVisibleFeedRepo)
+			mockRepo.On("GetVisibleArticles", mock.Anything, "provider1", []string{"b1"}, uint(10)).Return([]*feed.Article{
+				{ArticleID: "1", BoardID: "b1", Title: "Alive", Content: "본문", Link: "http://test.com/1", CreatedAt: deletedAt},
+			}, nil)
+
+			h := New(&policyCfg, mockRepo, nil)
+			assert.NoError(t, h.GetFeed(c))
+
+			assert.Contains(t, rec.Body.String(), "<title>[Board 1] Alive</title>")
+			mockRepo.AssertNotCalled(t, "GetArticles", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	})
+
 	t.Run("DB Context Cancelled (Client Timeout)", func(t *testing.T) {
 		e := echo.New()
 		req := httptest.NewRequest(http.MethodGet, "/", nil)
@@ -304,3 +522,100 @@ func TestHandler_GetFeed(t *testing.T) {
 		assert.Contains(t, rec.Body.String(), h.startedAt.Format(time.RFC1123Z))
 	})
 }
+
+func TestHandler_GetFeed_CollapseDuplicates(t *testing.T) {
+	newCfg := func(threshold uint) *config.RSSFeedConfig {
+		return &config.RSSFeedConfig{
+			MaxItemCount: 10,
+			Providers: []*config.ProviderConfig{
+				{
+					ID: "provider1",
+					Config: &config.ProviderDetailConfig{
+						Name:               "Test Provider",
+						URL:                "http://test.com",
+						DuplicateThreshold: threshold,
+						Boards: []*config.BoardConfig{
+							{ID: "b1", Name: "Board 1"},
+							{ID: "b2", Name: "Board 2"},
+						},
+					},
+				},
+				{
+					ID: "provider2",
+					Config: &config.ProviderDetailConfig{
+						Name:   "Other Provider",
+						URL:    "http://other.com",
+						Boards: []*config.BoardConfig{{ID: "x", Name: "Notice"}},
+					},
+				},
+			},
+		}
+	}
+
+	now := time.Now()
+	articles := func() []*feed.Article {
+		return []*feed.Article{
+			{ArticleID: "1", BoardID: "b1", Title: "Cross Posted", Link: "http://test.com/1", CreatedAt: now, Fingerprint: 0b1111},
+			{ArticleID: "2", BoardID: "b2", Title: "Cross Posted Again", Link: "http://test.com/2", CreatedAt: now.Add(-time.Minute), Fingerprint: 0b1110},
+			{ArticleID: "3", BoardID: "b1", Title: "Unrelated", Link: "http://test.com/3", CreatedAt: now.Add(-time.Hour), Fingerprint: 0b1111 << 32},
+		}
+	}
+	others := []*feed.SourcedArticle{
+		{ProviderID: "provider2", Article: &feed.Article{ArticleID: "9", BoardID: "x", Title: "Original", Link: "http://other.com/9", Fingerprint: 0b0111}},
+	}
+
+	serve := func(t *testing.T, h *Handler) string {
+		t.Helper()
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues("provider1")
+
+		assert.NoError(t, h.GetFeed(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		return rec.Body.String()
+	}
+
+	t.Run("같은 공급자와 다른 공급자의 중복 게시글을 하나의 항목으로 묶는다", func(t *testing.T) {
+		mockRepo := new(MockDuplicateFeedRepo)
+		mockRepo.On("GetArticles", mock.Anything, "provider1", []string{"b1", "b2"}, uint(10)).Return(articles(), nil)
+		mockRepo.On("GetFingerprintedArticles", mock.Anything, now.Add(-time.Hour).Add(-duplicateLookback), "provider1", uint(duplicateCandidateLimit)).Return(others, nil)
+
+		body := serve(t, New(newCfg(3), mockRepo, nil))
+
+		assert.Equal(t, 2, strings.Count(body, "<item>"))
+		assert.Contains(t, body, "[Board 1] Cross Posted</title>")
+		assert.NotContains(t, body, "[Board 2] Cross Posted Again</title>")
+		assert.Contains(t, body, "같은 내용의 게시글")
+		assert.Contains(t, body, "http://test.com/2")
+		assert.Contains(t, body, "Other Provider &amp;gt; Notice")
+		assert.Contains(t, body, "http://other.com/9")
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("다른 공급자 조회에 실패해도 같은 공급자 안에서 묶어 피드를 제공한다", func(t *testing.T) {
+		mockRepo := new(MockDuplicateFeedRepo)
+		mockRepo.On("GetArticles", mock.Anything, "provider1", []string{"b1", "b2"}, uint(10)).Return(articles(), nil)
+		mockRepo.On("GetFingerprintedArticles", mock.Anything, mock.Anything, "provider1", mock.Anything).Return(nil, errors.New("db error"))
+
+		body := serve(t, New(newCfg(3), mockRepo, nil))
+
+		assert.Equal(t, 2, strings.Count(body, "<item>"))
+		assert.Contains(t, body, "http://test.com/2")
+		assert.NotContains(t, body, "http://other.com/9")
+	})
+
+	t.Run("duplicate_threshold가 0이면 묶지 않는다", func(t *testing.T) {
+		mockRepo := new(MockDuplicateFeedRepo)
+		mockRepo.On("GetArticles", mock.Anything, "provider1", []string{"b1", "b2"}, uint(10)).Return(articles(), nil)
+
+		body := serve(t, New(newCfg(0), mockRepo, nil))
+
+		assert.Equal(t, 3, strings.Count(body, "<item>"))
+		assert.NotContains(t, body, "같은 내용의 게시글")
+		mockRepo.AssertNotCalled(t, "GetFingerprintedArticles", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,376 @@
+package rss
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	applog "github.com/darkkaiser/notify-server/pkg/log"
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+	"github.com/darkkaiser/rss-feed-server/internal/service/api/httputil"
+	"github.com/darkkaiser/rss-feed-server/internal/service/api/model/response"
+	"github.com/gorilla/feeds"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// defaultHistoryLimit 게시글 이력 조회 시 limit 파라미터가 없을 때 반환하는 최대 개수입니다.
+	defaultHistoryLimit = 50
+
+	// maxHistoryLimit 게시글 이력 조회 시 한 번에 반환할 수 있는 최대 개수입니다.
+	maxHistoryLimit = 200
+
+	// archiveItemLimit 아카이브 피드 하나(하루치)에 담는 최대 게시글 수입니다.
+	// 하루 게시글이 이보다 많으면 최신 게시글부터 이 개수만큼만 담고, 전체 게시글은 이력 API로 조회해야 합니다.
+	archiveItemLimit = 1000
+)
+
+// ──────────────────────────────────────────────────────────────────────────────
+// RFC 5005 (Feed Paging and Archiving) 직렬화
+// ──────────────────────────────────────────────────────────────────────────────
+//
+// gorilla/feeds는 채널에 임의의 atom:link 요소를 추가할 수 없으므로, 라이브러리가 만든 채널(RssFeed)을
+// 감싸 RFC 5005가 정의한 링크와 fh:archive 표식을 덧붙여 직렬화합니다.
+
+const (
+	// atomNamespace atom:link 요소의 XML 네임스페이스입니다.
+	atomNamespace = "http://www.w3.org/2005/Atom"
+
+	// feedHistoryNamespace RFC 5005 fh:archive 요소의 XML 네임스페이스입니다.
+	feedHistoryNamespace = "http://purl.org/syndication/history/1.0"
+
+	// contentNamespace content:encoded 요소의 XML 네임스페이스입니다.
+	contentNamespace = "http://purl.org/rss/1.0/modules/content/"
+)
+
+// atomLink RSS 채널에 포함하는 atom:link 요소입니다. (self, current, prev-archive, next-archive)
+type atomLink struct {
+	XMLName xml.Name `xml:"atom:link"`
+	Rel     string   `xml:"rel,attr"`
+	Href    string   `xml:"href,attr"`
+	Type    string   `xml:"type,attr"`
+}
+
+// rssChannel gorilla/feeds의 채널에 atom:link 목록과 아카이브 표식을 더한 채널 요소입니다.
+// 링크가 게시글보다 앞에 오도록 Items 필드를 다시 선언하여 라이브러리 채널의 Items를 가립니다.
+type rssChannel struct {
+	*feeds.RssFeed
+	Archive *struct{}   `xml:"fh:archive"`
+	Links   []*atomLink `xml:"atom:link"`
+	Items   []*rssItem  `xml:"item"`
+}
+
+// rssItem gorilla/feeds의 항목에 atom:updated 요소를 더한 항목 요소입니다.
+//
+// RSS 2.0에는 항목의 수정 일시를 나타내는 요소가 없고, gorilla/feeds는 pubDate에 작성 일시(Created)를 우선 사용하므로
+// feeds.Item.Updated는 직렬화 결과에 나타나지 않습니다. 원문 수정이 감지된 게시글의 수정 일시를 구독자에게 전달하기 위해
+// Atom 규격의 atom:updated 요소(RFC 3339)를 항목에 덧붙입니다.
+type rssItem struct {
+	*feeds.RssItem
+	AtomUpdated string `xml:"atom:updated,omitempty"`
+}
+
+// rssDocument 최상위 <rss> 요소입니다.
+type rssDocument struct {
+	XMLName          xml.Name `xml:"rss"`
+	Version          string   `xml:"version,attr"`
+	ContentNamespace string   `xml:"xmlns:content,attr"`
+	AtomNamespace    string   `xml:"xmlns:atom,attr"`
+	HistoryNamespace string   `xml:"xmlns:fh,attr,omitempty"`
+	Channel          *rssChannel
+}
+
+// FeedXml feeds.XmlFeed 인터페이스를 구현합니다.
+func (d *rssDocument) FeedXml() interface{} {
+	return d
+}
+
+// renderRSS 피드를 atom:link 목록과 함께 RSS 2.0 문서로 직렬화합니다.
+// archive가 true이면 내용이 더 이상 바뀌지 않는 아카이브 문서임을 나타내는 fh:archive 요소를 포함합니다.
+func renderRSS(f *feeds.Feed, links []*atomLink, archive bool) (string, error) {
+	channel := (&feeds.Rss{Feed: f}).RssFeed()
+
+	doc := &rssDocument{
+		Version:          "2.0",
+		ContentNamespace: contentNamespace,
+		AtomNamespace:    atomNamespace,
+		Channel:          &rssChannel{RssFeed: channel, Links: links},
+	}
+
+	// RssFeed()는 f.Items와 같은 순서로 항목을 만들므로, 같은 위치의 원본 항목에서 수정 일시를 가져옵니다.
+	for i, item := range channel.Items {
+		wrapped := &rssItem{RssItem: item}
+		if src := f.Items[i]; src.Updated.After(src.Created) {
+			wrapped.AtomUpdated = src.Updated.Format(time.RFC3339)
+		}
+		doc.Channel.Items = append(doc.Channel.Items, wrapped)
+	}
+	channel.Items = nil
+
+	for _, link := range links {
+		link.Type = "application/rss+xml"
+	}
+	if archive {
+		doc.HistoryNamespace = feedHistoryNamespace
+		doc.Channel.Archive = &struct{}{}
+	}
+
+	return feeds.ToXML(doc)
+}
+
+// ──────────────────────────────────────────────────────────────────────────────
+// 주소 및 날짜 헬퍼
+// ──────────────────────────────────────────────────────────────────────────────
+
+// baseURL 요청이 들어온 스킴과 호스트로 서버의 기본 주소를 만듭니다.
+func baseURL(c echo.Context) string {
+	return fmt.Sprintf("%s://%s%s", c.Scheme(), c.Request().Host, httputil.AccessTokenPath(c))
+}
+
+// feedURL 프로바이더 개별 피드의 주소를 반환합니다.
+func feedURL(c echo.Context, provider providerCache) string {
+	return fmt.Sprintf("%s/%s", baseURL(c), provider.cfg.ID)
+}
+
+// archiveURL 프로바이더의 지정한 날짜 아카이브 피드 주소를 반환합니다.
+func archiveURL(c echo.Context, provider providerCache, day time.Time) string {
+	return fmt.Sprintf("%s/%s/archive/%s", baseURL(c), provider.cfg.ID, day.Format(httputil.QueryDateLayout))
+}
+
+// permalinkURL 이 서버가 제공하는 게시글 페이지의 주소를 반환합니다.
+func permalinkURL(c echo.Context, provider providerCache, article *feed.Article) string {
+	return fmt.Sprintf("%s/%s/articles/%s/%s", baseURL(c), provider.cfg.ID, url.PathEscape(article.BoardID), url.PathEscape(article.ArticleID))
+}
+
+// startOfDay t가 속한 날(서버 로컬 시간대 기준)의 자정을 반환합니다.
+func startOfDay(t time.Time) time.Time {
+	t = t.In(time.Local)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local)
+}
+
+// ──────────────────────────────────────────────────────────────────────────────
+// 아카이브 피드
+// ──────────────────────────────────────────────────────────────────────────────
+
+// latestArchiveDate before가 속한 날보다 이전에 게시글이 있는 가장 최근 날(아카이브 피드가 존재하는 날)을 반환합니다.
+// 그러한 날이 없거나 저장소가 이력 조회를 지원하지 않으면 zero value를 반환합니다.
+func (h *Handler) latestArchiveDate(ctx context.Context, provider providerCache, before time.Time) (time.Time, error) {
+	if h.historyRepo == nil || len(provider.boardIDs) == 0 {
+		return time.Time{}, nil
+	}
+
+	articles, err := h.historyRepo.ListArticles(ctx, provider.cfg.ID, feed.ArticlePageQuery{
+		BoardIDs:       provider.boardIDs,
+		Until:          startOfDay(before),
+		ExcludeDeleted: provider.hidesDeleted(),
+		Limit:          1,
+	})
+	if err != nil || len(articles) == 0 {
+		return time.Time{}, err
+	}
+
+	return startOfDay(articles[0].CreatedAt), nil
+}
+
+// earliestArchiveDate from 이후(포함), until 이전(미포함)에 게시글이 있는 가장 이른 날을 반환합니다. 그러한 날이 없으면 zero value를 반환합니다.
+func (h *Handler) earliestArchiveDate(ctx context.Context, provider providerCache, from, until time.Time) (time.Time, error) {
+	articles, err := h.historyRepo.ListArticles(ctx, provider.cfg.ID, feed.ArticlePageQuery{
+		BoardIDs:       provider.boardIDs,
+		Since:          from,
+		Until:          until,
+		Ascending:      true,
+		ExcludeDeleted: provider.hidesDeleted(),
+		Limit:          1,
+	})
+	if err != nil || len(articles) == 0 {
+		return time.Time{}, err
+	}
+
+	return startOfDay(articles[0].CreatedAt), nil
+}
+
+// GetArchiveFeed godoc
+// @Summary 날짜별 아카이브 RSS 피드 조회
+// @Description 지정한 날짜(서버 시간대 기준)에 작성된 게시글을 RFC 5005 아카이브 피드(RSS 2.0)로 반환합니다.
+// @Description 개별 피드와 각 아카이브 피드는 prev-archive/next-archive 링크로 게시글이 있는 이웃 날짜의 아카이브를 가리키므로,
+// @Description RSS 리더는 링크를 따라가며 피드 노출 한도(max_item_count)를 넘어 보관 중인 과거 게시글을 가져갈 수 있습니다.
+// @Description 아직 끝나지 않은 오늘 이후의 날짜는 조회할 수 없습니다.
+// @Description 삭제된 게시글 처리 정책(deleted_article_policy)이 hide인 피드는 삭제가 감지된 게시글을 아카이브와 이웃 날짜 계산에서 제외하고, mark이면 제목에 표시합니다.
+// @Tags RSS
+// @Produce application/xml
+// @Param id path string true "RSS 피드 고유 식별자" example(naver-cafe)
+// @Param date path string true "아카이브 날짜 (YYYY-MM-DD)" example(2024-03-15)
+// @Success 200 {string} string "RSS 2.0 규격 XML 문서 (<fh:archive/> 포함)"
+// @Failure 400 {object} response.ErrorResponse "잘못된 날짜 형식"
+// @Failure 404 {object} response.ErrorResponse "등록되지 않은 피드 또는 게시글이 없는 날짜"
+// @Failure 500 {object} response.ErrorResponse "서버 내부 오류 (DB 조회 실패 또는 XML 직렬화 오류)"
+// @Failure 503 {object} response.ErrorResponse "저장소가 게시글 이력 조회를 지원하지 않음"
+// @Router /{id}/archive/{date} [get]
+func (h *Handler) GetArchiveFeed(c echo.Context) error {
+	provider, logger, err := h.findProvider(c, "/{id}/archive/{date}")
+	if err != nil {
+		return err
+	}
+	if h.historyRepo == nil {
+		return httputil.NewServiceUnavailableError("현재 저장소는 게시글 이력 조회를 지원하지 않습니다")
+	}
+
+	rawDate := strings.TrimSuffix(c.Param("date"), ".xml")
+	day, err := time.ParseInLocation(httputil.QueryDateLayout, rawDate, time.Local)
+	if err != nil {
+		return httputil.NewBadRequestError(fmt.Sprintf("아카이브 날짜('%s')는 YYYY-MM-DD 형식이어야 합니다", rawDate))
+	}
+
+	// 아카이브 문서는 내용이 확정된 과거만 다루므로, 게시글이 계속 추가될 수 있는 오늘 이후는 제공하지 않습니다.
+	today := startOfDay(time.Now())
+	if !day.Before(today) || len(provider.boardIDs) == 0 {
+		return httputil.NewNotFoundError(fmt.Sprintf("%s 피드의 %s 아카이브가 존재하지 않습니다", provider.cfg.ID, rawDate))
+	}
+	nextDay := day.AddDate(0, 0, 1)
+
+	ctx := c.Request().Context()
+	articles, err := h.historyRepo.ListArticles(ctx, provider.cfg.ID, feed.ArticlePageQuery{
+		BoardIDs:       provider.boardIDs,
+		Since:          day,
+		Until:          nextDay,
+		ExcludeDeleted: provider.hidesDeleted(),
+		Limit:          archiveItemLimit,
+	})
+	if err != nil {
+		return h.notifyError(logger, fmt.Sprintf("아카이브 게시글을 조회하는 과정에서 시스템 내부 오류가 발생했습니다. (제공자 식별자: %s)", provider.cfg.ID), err)
+	}
+	if len(articles) == 0 {
+		return httputil.NewNotFoundError(fmt.Sprintf("%s 피드의 %s 아카이브가 존재하지 않습니다", provider.cfg.ID, rawDate))
+	}
+	if len(articles) == archiveItemLimit {
+		logger.Warnf("하루 게시글 수가 아카이브 피드 한도(%d건)를 넘어 일부만 포함합니다 (p_id:%s, date:%s)", archiveItemLimit, provider.cfg.ID, rawDate)
+	}
+
+	prev, err := h.latestArchiveDate(ctx, provider, day)
+	if err != nil {
+		return h.notifyError(logger, fmt.Sprintf("이전 아카이브를 조회하는 과정에서 시스템 내부 오류가 발생했습니다. (제공자 식별자: %s)", provider.cfg.ID), err)
+	}
+	next, err := h.earliestArchiveDate(ctx, provider, nextDay, today)
+	if err != nil {
+		return h.notifyError(logger, fmt.Sprintf("다음 아카이브를 조회하는 과정에서 시스템 내부 오류가 발생했습니다. (제공자 식별자: %s)", provider.cfg.ID), err)
+	}
+
+	links := []*atomLink{
+		{Rel: "self", Href: archiveURL(c, provider, day)},
+		{Rel: "current", Href: feedURL(c, provider)},
+	}
+	if !prev.IsZero() {
+		links = append(links, &atomLink{Rel: "prev-archive", Href: archiveURL(c, provider, prev)})
+	}
+	if !next.IsZero() {
+		links = append(links, &atomLink{Rel: "next-archive", Href: archiveURL(c, provider, next)})
+	}
+
+	f := h.buildFeed(c, logger, provider, articles)
+	f.Title = fmt.Sprintf("%s (%s)", f.Title, rawDate)
+
+	rssXML, err := renderRSS(f, links, true)
+	if err != nil {
+		return h.notifyError(logger, fmt.Sprintf("시스템 내부 오류로 인해 아카이브 피드 문서를 정상적으로 생성할 수 없습니다. (제공자 식별자: %s)", provider.cfg.ID), err)
+	}
+
+	// 지난 날짜의 게시글은 수정이나 삭제가 감지될 때만 바뀌므로 개별 피드보다 길게 캐싱합니다.
+	c.Response().Header().Set("Cache-Control", httputil.CacheControl(c, "3600"))
+
+	return c.Blob(http.StatusOK, "application/rss+xml; charset=UTF-8", []byte(rssXML))
+}
+
+// ──────────────────────────────────────────────────────────────────────────────
+// 게시글 이력 API
+// ──────────────────────────────────────────────────────────────────────────────
+
+// GetHistory godoc
+// @Summary 게시글 이력 조회
+// @Description 피드 노출 한도(max_item_count)와 관계없이 보관 기간(archive_days) 동안 저장된 게시글을 최신순으로 한 페이지씩 반환합니다.
+// @Description 응답의 next_cursor 값을 cursor 파라미터로 전달하면 다음 페이지를 조회합니다. 조회 도중 새 게시글이 수집되어도 이미 받은 게시글이 다시 나오지 않습니다.
+// @Description 삭제된 게시글 처리 정책(deleted_article_policy)이 hide인 피드는 삭제가 감지된 게시글을 제외하며, 그 외에는 deleted_at 필드로 삭제 시각을 표시합니다.
+// @Tags RSS
+// @Produce json
+// @Param id path string true "RSS 피드 고유 식별자" example(naver-cafe)
+// @Param board query string false "게시판 식별자 목록 (쉼표로 구분, 생략 시 전체)"
+// @Param from query string false "작성일시 시작 (포함, YYYY-MM-DD 또는 RFC3339)"
+// @Param to query string false "작성일시 끝 (미포함, YYYY-MM-DD 또는 RFC3339)"
+// @Param cursor query string false "이전 응답의 next_cursor 값"
+// @Param limit query int false "최대 반환 개수 (기본 50, 최대 200)"
+// @Success 200 {object} response.ArticleListResponse
+// @Failure 400 {object} response.ErrorResponse "잘못된 파라미터 (등록되지 않은 게시판, 날짜 형식, 커서 등)"
+// @Failure 404 {object} response.ErrorResponse "등록되지 않은 피드"
+// @Failure 500 {object} response.ErrorResponse "서버 내부 오류 (DB 조회 실패)"
+// @Failure 503 {object} response.ErrorResponse "저장소가 게시글 이력 조회를 지원하지 않음"
+// @Router /{id}/history [get]
+func (h *Handler) GetHistory(c echo.Context) error {
+	provider, logger, err := h.findProvider(c, "/{id}/history")
+	if err != nil {
+		return err
+	}
+	if h.historyRepo == nil {
+		return httputil.NewServiceUnavailableError("현재 저장소는 게시글 이력 조회를 지원하지 않습니다")
+	}
+
+	query, err := httputil.ParseArticlePageQuery(c, provider.cfg.ID, provider.boardIDs)
+	if err != nil {
+		return err
+	}
+	limit, err := httputil.ParseLimitQuery(c, defaultHistoryLimit, maxHistoryLimit)
+	if err != nil {
+		return err
+	}
+
+	resp := response.ArticleListResponse{
+		ResultCode: 0,
+		ProviderID: provider.cfg.ID,
+		Articles:   []response.ArticleResponse{},
+	}
+	if len(query.BoardIDs) == 0 {
+		return c.JSON(http.StatusOK, resp)
+	}
+
+	// 다음 페이지가 있는지 확인하기 위해 요청한 개수보다 한 건 더 조회합니다.
+	// 삭제된 게시글을 숨기는 공급자는 조회 단계에서 제외하여 페이지 크기와 커서가 숨긴 게시글의 영향을 받지 않도록 합니다.
+	query.ExcludeDeleted = provider.hidesDeleted()
+	query.Limit = uint(limit) + 1
+	articles, err := h.historyRepo.ListArticles(c.Request().Context(), provider.cfg.ID, query)
+	if err != nil {
+		return h.notifyError(logger, fmt.Sprintf("게시글 이력을 조회하는 과정에서 시스템 내부 오류가 발생했습니다. (제공자 식별자: %s)", provider.cfg.ID), err)
+	}
+	if len(articles) > limit {
+		articles = articles[:limit]
+		resp.NextCursor = feed.CursorOf(articles[len(articles)-1]).Encode()
+	}
+
+	for _, a := range articles {
+		resp.Articles = append(resp.Articles, response.NewArticleResponse(a, provider.boardNameByID[a.BoardID]))
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// findProvider 경로의 피드 식별자로 프로바이더를 찾고, 요청 정보를 바인딩한 로거를 함께 반환합니다.
+func (h *Handler) findProvider(c echo.Context, endpoint string) (providerCache, *applog.Entry, error) {
+	id := strings.ToLower(c.Param("id"))
+
+	logger := applog.WithComponentAndFields(component, applog.Fields{
+		"request_id": c.Response().Header().Get(echo.HeaderXRequestID),
+		"endpoint":   endpoint,
+		"feed_id":    id,
+		"method":     c.Request().Method,
+		"remote_ip":  c.RealIP(),
+		"user_agent": c.Request().UserAgent(),
+	})
+
+	provider, ok := h.providers[id]
+	if !ok {
+		return providerCache{}, logger, httputil.NewNotFoundError(fmt.Sprintf("요청하신 식별자(%s)에 해당하는 RSS 피드 제공자 정보를 찾을 수 없습니다. 올바른 주소인지 확인해 주시기 바랍니다.", id))
+	}
+
+	return h.visibleProvider(c, provider), logger, nil
+}
@@ -0,0 +1,345 @@
+package rss
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/darkkaiser/rss-feed-server/internal/config"
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+	"github.com/darkkaiser/rss-feed-server/internal/service/api/httputil"
+	"github.com/darkkaiser/rss-feed-server/internal/service/api/model/response"
+	"github.com/gorilla/feeds"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockHistoryFeedRepo feed.HistoryRepository를 함께 구현하는 저장소 Mock입니다.
+type MockHistoryFeedRepo struct {
+	MockFeedRepo
+}
+
+func (m *MockHistoryFeedRepo) ListArticles(ctx context.Context, providerID string, query feed.ArticlePageQuery) ([]*feed.Article, error) {
+	args := m.Called(ctx, providerID, query)
+	var res []*feed.Article
+	if v := args.Get(0); v != nil {
+		res = v.([]*feed.Article)
+	}
+	return res, args.Error(1)
+}
+
+func (m *MockHistoryFeedRepo) GetArticle(ctx context.Context, providerID, boardID, articleID string) (*feed.Article, error) {
+	args := m.Called(ctx, providerID, boardID, articleID)
+	var res *feed.Article
+	if v := args.Get(0); v != nil {
+		res = v.(*feed.Article)
+	}
+	return res, args.Error(1)
+}
+
+func newHistoryTestConfig() *config.RSSFeedConfig {
+	return &config.RSSFeedConfig{
+		MaxItemCount: 10,
+		Providers: []*config.ProviderConfig{
+			{
+				ID: "provider1",
+				Config: &config.ProviderDetailConfig{
+					Name: "Test Provider",
+					URL:  "http://test.com",
+					Boards: []*config.BoardConfig{
+						{ID: "b1", Name: "Board 1"},
+						{ID: "b2", Name: "Board 2"},
+					},
+				},
+			},
+		},
+	}
+}
+
+// newHistoryTestContext 지정한 경로 파라미터로 요청 컨텍스트를 만듭니다.
+func newHistoryTestContext(target string, names []string, values []string) (echo.Context, *httptest.ResponseRecorder) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, target, nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames(names...)
+	c.SetParamValues(values...)
+
+	return c, rec
+}
+
+func TestRenderRSS(t *testing.T) {
+	f := &feeds.Feed{Title: "T", Link: &feeds.Link{Href: "http://test.com"}, Created: time.Now()}
+	f.Items = append(f.Items, &feeds.Item{Title: "Item", Link: &feeds.Link{Href: "http://test.com/1"}, Created: time.Now()})
+
+	t.Run("링크는 게시글보다 앞에 직렬화된다", func(t *testing.T) {
+		out, err := renderRSS(f, []*atomLink{{Rel: "prev-archive", Href: "http://x/archive/2024-03-14"}}, false)
+		require.NoError(t, err)
+
+		assert.Contains(t, out, `xmlns:atom="http://www.w3.org/2005/Atom"`)
+		assert.Contains(t, out, `<atom:link rel="prev-archive" href="http://x/archive/2024-03-14" type="application/rss+xml"></atom:link>`)
+		assert.Less(t, strings.Index(out, "<atom:link"), strings.Index(out, "<item>"))
+		assert.NotContains(t, out, "fh:archive", "아카이브가 아닌 문서에는 아카이브 표식이 없어야 합니다")
+	})
+
+	t.Run("아카이브 문서에는 fh:archive 표식을 포함한다", func(t *testing.T) {
+		out, err := renderRSS(f, nil, true)
+		require.NoError(t, err)
+
+		assert.Contains(t, out, `xmlns:fh="http://purl.org/syndication/history/1.0"`)
+		assert.Contains(t, out, "<fh:archive></fh:archive>")
+		assert.Equal(t, 1, strings.Count(out, "<item>"))
+	})
+}
+
+func TestHandler_GetHistory(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	articles := func(n int) []*feed.Article {
+		var list []*feed.Article
+		for i := 0; i < n; i++ {
+			list = append(list, &feed.Article{BoardID: "b1", BoardName: "old name", ArticleID: string(rune('a' + i)), Title: "Title", CreatedAt: now.Add(-time.Duration(i) * time.Minute)})
+		}
+		return list
+	}
+
+	t.Run("저장소가 이력 조회를 지원하지 않으면 503", func(t *testing.T) {
+		h := New(newHistoryTestConfig(), new(MockFeedRepo), nil)
+		c, _ := newHistoryTestContext("/provider1/history", []string{"id"}, []string{"provider1"})
+
+		err := h.GetHistory(c)
+		var httpErr *echo.HTTPError
+		require.True(t, errors.As(err, &httpErr))
+		assert.Equal(t, http.StatusServiceUnavailable, httpErr.Code)
+	})
+
+	t.Run("등록되지 않은 피드는 404", func(t *testing.T) {
+		h := New(newHistoryTestConfig(), new(MockHistoryFeedRepo), nil)
+		c, _ := newHistoryTestContext("/unknown/history", []string{"id"}, []string{"unknown"})
+
+		err := h.GetHistory(c)
+		var httpErr *echo.HTTPError
+		require.True(t, errors.As(err, &httpErr))
+		assert.Equal(t, http.StatusNotFound, httpErr.Code)
+	})
+
+	for _, target := range []string{
+		"/provider1/history?board=unknown",
+		"/provider1/history?from=2024/03/15",
+		"/provider1/history?from=2024-03-15&to=2024-03-15",
+		"/provider1/history?cursor=invalid!",
+		"/provider1/history?limit=0",
+	} {
+		t.Run("잘못된 파라미터는 400: "+target, func(t *testing.T) {
+			h := New(newHistoryTestConfig(), new(MockHistoryFeedRepo), nil)
+			c, _ := newHistoryTestContext(target, []string{"id"}, []string{"provider1"})
+
+			err := h.GetHistory(c)
+			var httpErr *echo.HTTPError
+			require.True(t, errors.As(err, &httpErr))
+			assert.Equal(t, http.StatusBadRequest, httpErr.Code)
+		})
+	}
+
+	t.Run("다음 페이지가 있으면 마지막 게시글의 커서를 next_cursor로 반환한다", func(t *testing.T) {
+		mockRepo := new(MockHistoryFeedRepo)
+		mockRepo.On("ListArticles", mock.Anything, "provider1", mock.MatchedBy(func(q feed.ArticlePageQuery) bool {
+			return assert.ObjectsAreEqual([]string{"b1"}, q.BoardIDs) && q.Limit == 3 && q.After == nil && !q.Since.IsZero()
+		})).Return(articles(3), nil)
+
+		h := New(newHistoryTestConfig(), mockRepo, nil)
+		c, rec := newHistoryTestContext("/provider1/history?board=b1&from=2024-03-15&limit=2", []string{"id"}, []string{"provider1"})
+
+		require.NoError(t, h.GetHistory(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var resp response.ArticleListResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, "provider1", resp.ProviderID)
+		require.Len(t, resp.Articles, 2)
+		assert.Equal(t, "Board 1", resp.Articles[0].BoardName, "게시판 이름은 현재 설정을 우선해야 합니다")
+		assert.Nil(t, resp.Articles[0].DeletedAt)
+
+		cursor, err := feed.ParseArticleCursor(resp.NextCursor)
+		require.NoError(t, err)
+		assert.Equal(t, "b", cursor.ArticleID)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("전달한 커서 다음부터 조회하고, 마지막 페이지에는 next_cursor가 없다", func(t *testing.T) {
+		after := feed.ArticleCursor{CreatedAt: now, BoardID: "b1", ArticleID: "b"}
+
+		mockRepo := new(MockHistoryFeedRepo)
+		mockRepo.On("ListArticles", mock.Anything, "provider1", mock.MatchedBy(func(q feed.ArticlePageQuery) bool {
+			return assert.ObjectsAreEqual([]string{"b1", "b2"}, q.BoardIDs) && q.After != nil && q.After.ArticleID == "b" && q.Limit == defaultHistoryLimit+1
+		})).Return(articles(1), nil)
+
+		h := New(newHistoryTestConfig(), mockRepo, nil)
+		c, rec := newHistoryTestContext("/provider1/history?cursor="+after.Encode(), []string{"id"}, []string{"provider1"})
+
+		require.NoError(t, h.GetHistory(c))
+
+		var resp response.ArticleListResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Len(t, resp.Articles, 1)
+		assert.Empty(t, resp.NextCursor)
+		mockRepo.AssertExpectations(t)
+	})
+
+	for _, tc := range []struct {
+		policy         config.DeletedArticlePolicy
+		excludeDeleted bool
+	}{
+		{config.DeletedArticlePolicyHide, true},
+		{config.DeletedArticlePolicyMark, false},
+		{config.DeletedArticlePolicyKeep, false},
+	} {
+		t.Run("삭제된 게시글 처리 정책이 hide인 경우에만 삭제된 게시글을 제외하고 조회한다: "+string(tc.policy), func(t *testing.T) {
+			cfg := newHistoryTestConfig()
+			cfg.Providers[0].Config.DeletedArticlePolicy = tc.policy
+
+			mockRepo := new(MockHistoryFeedRepo)
+			mockRepo.On("ListArticles", mock.Anything, "provider1", mock.MatchedBy(func(q feed.ArticlePageQuery) bool {
+				return q.ExcludeDeleted == tc.excludeDeleted
+			})).Return(articles(1), nil)
+
+			h := New(cfg, mockRepo, nil)
+			c, _ := newHistoryTestContext("/provider1/history", []string{"id"}, []string{"provider1"})
+
+			require.NoError(t, h.GetHistory(c))
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandler_GetArchiveFeed(t *testing.T) {
+	today := startOfDay(time.Now())
+	day := today.AddDate(0, 0, -3)
+	date := day.Format(httputil.QueryDateLayout)
+
+	serve := func(h *Handler, date string) (*httptest.ResponseRecorder, error) {
+		c, rec := newHistoryTestContext("/provider1/archive/"+date, []string{"id", "date"}, []string{"provider1", date})
+		return rec, h.GetArchiveFeed(c)
+	}
+	assertStatus := func(t *testing.T, err error, code int) {
+		t.Helper()
+		var httpErr *echo.HTTPError
+		require.True(t, errors.As(err, &httpErr))
+		assert.Equal(t, code, httpErr.Code)
+	}
+
+	t.Run("날짜 형식이 잘못되면 400", func(t *testing.T) {
+		_, err := serve(New(newHistoryTestConfig(), new(MockHistoryFeedRepo), nil), "20240315")
+		assertStatus(t, err, http.StatusBadRequest)
+	})
+
+	t.Run("아직 끝나지 않은 오늘의 아카이브는 404", func(t *testing.T) {
+		_, err := serve(New(newHistoryTestConfig(), new(MockHistoryFeedRepo), nil), today.Format(httputil.QueryDateLayout))
+		assertStatus(t, err, http.StatusNotFound)
+	})
+
+	t.Run("게시글이 없는 날짜는 404", func(t *testing.T) {
+		mockRepo := new(MockHistoryFeedRepo)
+		mockRepo.On("ListArticles", mock.Anything, "provider1", mock.Anything).Return([]*feed.Article{}, nil)
+
+		_, err := serve(New(newHistoryTestConfig(), mockRepo, nil), date)
+		assertStatus(t, err, http.StatusNotFound)
+	})
+
+	t.Run("하루치 게시글과 이웃 아카이브 링크를 담은 아카이브 피드를 반환한다", func(t *testing.T) {
+		prevDay := day.AddDate(0, 0, -5)
+		nextDay := day.AddDate(0, 0, 2)
+
+		mockRepo := new(MockHistoryFeedRepo)
+		mockRepo.On("ListArticles", mock.Anything, "provider1", mock.MatchedBy(func(q feed.ArticlePageQuery) bool {
+			return q.Since.Equal(day) && q.Until.Equal(day.AddDate(0, 0, 1)) && q.Limit == archiveItemLimit
+		})).Return([]*feed.Article{
+			{BoardID: "b1", ArticleID: "1", Title: "Archived", Link: "http://test.com/1", CreatedAt: day.Add(9 * time.Hour)},
+		}, nil)
+		mockRepo.On("ListArticles", mock.Anything, "provider1", mock.MatchedBy(func(q feed.ArticlePageQuery) bool {
+			return q.Since.IsZero() && q.Until.Equal(day) && !q.Ascending && q.Limit == 1
+		})).Return([]*feed.Article{{BoardID: "b2", ArticleID: "0", CreatedAt: prevDay.Add(23 * time.Hour)}}, nil)
+		mockRepo.On("ListArticles", mock.Anything, "provider1", mock.MatchedBy(func(q feed.ArticlePageQuery) bool {
+			return q.Since.Equal(day.AddDate(0, 0, 1)) && q.Until.Equal(today) && q.Ascending && q.Limit == 1
+		})).Return([]*feed.Article{{BoardID: "b1", ArticleID: "2", CreatedAt: nextDay.Add(time.Hour)}}, nil)
+
+		rec, err := serve(New(newHistoryTestConfig(), mockRepo, nil), date)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		body := rec.Body.String()
+		assert.Contains(t, body, "<fh:archive></fh:archive>")
+		assert.Contains(t, body, "[Board 1] Archived</title>")
+		assert.Contains(t, body, `rel="current" href="http://example.com/provider1"`)
+		assert.Contains(t, body, `rel="prev-archive" href="http://example.com/provider1/archive/`+prevDay.Format(httputil.QueryDateLayout)+`"`)
+		assert.Contains(t, body, `rel="next-archive" href="http://example.com/provider1/archive/`+nextDay.Format(httputil.QueryDateLayout)+`"`)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("삭제된 게시글을 숨기는 피드는 아카이브와 이웃 날짜 조회 모두에서 삭제된 게시글을 제외한다", func(t *testing.T) {
+		cfg := newHistoryTestConfig()
+		cfg.Providers[0].Config.DeletedArticlePolicy = config.DeletedArticlePolicyHide
+
+		mockRepo := new(MockHistoryFeedRepo)
+		mockRepo.On("ListArticles", mock.Anything, "provider1", mock.MatchedBy(func(q feed.ArticlePageQuery) bool {
+			return q.ExcludeDeleted && q.Limit == archiveItemLimit
+		})).Return([]*feed.Article{
+			{BoardID: "b1", ArticleID: "1", Title: "Archived", Link: "http://test.com/1", CreatedAt: day.Add(9 * time.Hour)},
+		}, nil)
+		mockRepo.On("ListArticles", mock.Anything, "provider1", mock.MatchedBy(func(q feed.ArticlePageQuery) bool {
+			return q.ExcludeDeleted && q.Limit == 1
+		})).Return([]*feed.Article{}, nil).Twice()
+
+		rec, err := serve(New(cfg, mockRepo, nil), date)
+		require.NoError(t, err)
+		assert.NotContains(t, rec.Body.String(), "prev-archive")
+		assert.NotContains(t, rec.Body.String(), "next-archive")
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("삭제된 게시글을 표시하는 피드는 삭제된 게시글을 제목에 표시하여 포함한다", func(t *testing.T) {
+		cfg := newHistoryTestConfig()
+		cfg.Providers[0].Config.DeletedArticlePolicy = config.DeletedArticlePolicyMark
+		deletedAt := day.Add(10 * time.Hour)
+
+		mockRepo := new(MockHistoryFeedRepo)
+		mockRepo.On("ListArticles", mock.Anything, "provider1", mock.MatchedBy(func(q feed.ArticlePageQuery) bool {
+			return !q.ExcludeDeleted && q.Limit == archiveItemLimit
+		})).Return([]*feed.Article{
+			{BoardID: "b1", ArticleID: "1", Title: "Archived", Link: "http://test.com/1", CreatedAt: day.Add(9 * time.Hour), DeletedAt: deletedAt},
+		}, nil)
+		mockRepo.On("ListArticles", mock.Anything, "provider1", mock.MatchedBy(func(q feed.ArticlePageQuery) bool {
+			return !q.ExcludeDeleted && q.Limit == 1
+		})).Return([]*feed.Article{}, nil).Twice()
+
+		rec, err := serve(New(cfg, mockRepo, nil), date)
+		require.NoError(t, err)
+		assert.Contains(t, rec.Body.String(), "[삭제됨]")
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestHandler_GetFeed_PrevArchiveLink(t *testing.T) {
+	yesterday := startOfDay(time.Now()).AddDate(0, 0, -1)
+
+	mockRepo := new(MockHistoryFeedRepo)
+	mockRepo.On("GetArticles", mock.Anything, "provider1", []string{"b1", "b2"}, uint(10)).Return([]*feed.Article{}, nil)
+	mockRepo.On("ListArticles", mock.Anything, "provider1", mock.MatchedBy(func(q feed.ArticlePageQuery) bool {
+		return q.Until.Equal(startOfDay(time.Now())) && q.Limit == 1
+	})).Return([]*feed.Article{{BoardID: "b1", ArticleID: "1", CreatedAt: yesterday.Add(time.Hour)}}, nil)
+
+	c, rec := newHistoryTestContext("/provider1", []string{"id"}, []string{"provider1"})
+	require.NoError(t, New(newHistoryTestConfig(), mockRepo, nil).GetFeed(c))
+
+	body := rec.Body.String()
+	assert.Contains(t, body, `rel="self" href="http://example.com/provider1"`)
+	assert.Contains(t, body, `rel="prev-archive" href="http://example.com/provider1/archive/`+yesterday.Format(httputil.QueryDateLayout)+`"`)
+	assert.NotContains(t, body, "fh:archive", "구독용 피드는 아카이브 문서가 아닙니다")
+	mockRepo.AssertExpectations(t)
+}
@@ -0,0 +1,189 @@
+package rss
+
+import (
+	"fmt"
+	"html/template"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/darkkaiser/rss-feed-server/internal/config"
+	"github.com/darkkaiser/rss-feed-server/internal/service/api/httputil"
+	"github.com/labstack/echo/v4"
+)
+
+// articleTimeLayout 게시글 페이지에 표시하는 일시의 형식입니다.
+const articleTimeLayout = "2006-01-02 15:04"
+
+// articlePageCSP 게시글 페이지의 Content-Security-Policy 헤더 값입니다.
+//
+// 게시글 본문은 외부 사이트에서 수집한 HTML을 그대로 출력하므로, 본문에 스크립트가 섞여 있더라도 이 서버의 출처(Origin)에서
+// 실행되지 않도록 스크립트와 폼 전송을 모두 막고, 이미지와 미디어만 외부 주소에서 불러올 수 있게 합니다.
+const articlePageCSP = "default-src 'none'; img-src * data:; media-src *; style-src 'unsafe-inline'; base-uri 'none'; form-action 'none'; frame-ancestors 'none'; sandbox allow-popups allow-popups-to-escape-sandbox"
+
+// attachmentExtensions 본문의 링크 중 첨부 파일로 간주하는 파일 확장자 목록입니다.
+var attachmentExtensions = map[string]bool{
+	".pdf": true, ".hwp": true, ".hwpx": true, ".doc": true, ".docx": true, ".xls": true, ".xlsx": true,
+	".ppt": true, ".pptx": true, ".txt": true, ".zip": true, ".7z": true, ".rar": true, ".alz": true, ".egg": true,
+	".jpg": true, ".jpeg": true, ".png": true, ".gif": true, ".mp3": true, ".mp4": true,
+}
+
+// articleAttachment 게시글 본문에서 찾은 첨부 파일(이미지 포함) 하나입니다.
+type articleAttachment struct {
+	// Name 표시용 이름입니다. 링크 문구가 없으면 파일 이름을 사용합니다.
+	Name string
+
+	// URL 첨부 파일의 절대 주소입니다.
+	URL string
+
+	// Image 본문에 삽입된 이미지인지 여부입니다.
+	Image bool
+}
+
+// ViewArticle godoc
+// @Summary 게시글 페이지
+// @Description 서버에 보관된 게시글 하나를 본문, 작성 정보, 첨부 파일, 원문 링크와 함께 HTML 페이지로 제공합니다.
+// @Description 로그인이 필요하거나(네이버 카페) 원문이 삭제된 게시글도 보관 기간 동안은 이 페이지에서 볼 수 있습니다.
+// @Description 공급자의 use_permalink 설정을 켜면 피드 항목의 링크와 GUID가 이 페이지 주소로 바뀝니다.
+// @Tags RSS
+// @Produce text/html
+// @Param id path string true "RSS 피드 고유 식별자" example(naver-cafe)
+// @Param boardID path string true "게시판 식별자"
+// @Param articleID path string true "게시글 식별자"
+// @Success 200 {string} string "게시글 HTML 페이지"
+// @Failure 404 {object} response.ErrorResponse "등록되지 않은 피드나 게시판, 또는 존재하지 않는(숨김 처리된) 게시글"
+// @Failure 500 {object} response.ErrorResponse "서버 내부 오류 (DB 조회 실패 또는 템플릿 렌더링 실패)"
+// @Failure 503 {object} response.ErrorResponse "저장소가 게시글 조회를 지원하지 않음"
+// @Router /{id}/articles/{boardID}/{articleID} [get]
+func (h *Handler) ViewArticle(c echo.Context) error {
+	provider, logger, err := h.findProvider(c, "/{id}/articles/{boardID}/{articleID}")
+	if err != nil {
+		return err
+	}
+	if h.historyRepo == nil {
+		return httputil.NewServiceUnavailableError("현재 저장소는 게시글 조회를 지원하지 않습니다")
+	}
+
+	boardID, articleID := c.Param("boardID"), c.Param("articleID")
+	boardName, ok := provider.boardNameByID[boardID]
+	if !ok {
+		return httputil.NewNotFoundError(fmt.Sprintf("%s 피드에 등록되지 않은 게시판(%s)입니다", provider.cfg.ID, boardID))
+	}
+
+	article, err := h.historyRepo.GetArticle(c.Request().Context(), provider.cfg.ID, boardID, articleID)
+	if err != nil {
+		return h.notifyError(logger, fmt.Sprintf("게시글을 조회하는 과정에서 시스템 내부 오류가 발생했습니다. (제공자 식별자: %s)", provider.cfg.ID), err)
+	}
+
+	// 삭제된 게시글을 숨기도록 설정된 공급자는 피드와 마찬가지로 게시글 페이지에서도 숨깁니다.
+	if article == nil || (article.IsDeleted() && provider.cfg.Config.DeletedPolicy() == config.DeletedArticlePolicyHide) {
+		return httputil.NewNotFoundError(fmt.Sprintf("게시글(게시판: %s, 게시글: %s)을 찾을 수 없습니다. 보관 기한이 지나 정리되었을 수 있습니다.", boardID, articleID))
+	}
+
+	data := map[string]any{
+		"providerName": provider.cfg.Config.Name,
+		"providerURL":  provider.cfg.Config.URL,
+		"feedURL":      feedURL(c, provider),
+		"boardName":    boardName,
+		"title":        article.Title,
+		"author":       article.Author,
+		"link":         article.Link,
+		"createdAt":    formatArticleTime(article.CreatedAt),
+		"updatedAt":    formatArticleTime(article.UpdatedAt),
+		"deletedAt":    formatArticleTime(article.DeletedAt),
+		"accessDenied": article.IsAccessDenied(),
+		// 본문은 크롤러가 수집한 HTML이므로 이스케이프하지 않고 출력합니다. 스크립트 실행은 articlePageCSP로 막습니다.
+		"content":     template.HTML(formatContent(article.Content)),
+		"attachments": extractAttachments(article.Content, article.Link),
+	}
+
+	header := c.Response().Header()
+	header.Set("Content-Security-Policy", articlePageCSP)
+	// 원문 사이트가 외부 참조(Referer)를 검사하여 이미지를 차단하는 경우가 많으므로 참조 정보를 보내지 않습니다.
+	header.Set("Referrer-Policy", "no-referrer")
+	header.Set("Cache-Control", httputil.CacheControl(c, "300"))
+
+	return c.Render(http.StatusOK, "rss_article.tmpl", data)
+}
+
+// formatArticleTime 게시글 페이지에 표시할 일시 문자열을 반환합니다. zero value이면 빈 문자열을 반환합니다.
+func formatArticleTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Local().Format(articleTimeLayout)
+}
+
+// extractAttachments 게시글 본문에서 첨부 파일 링크와 삽입된 이미지를 찾아 본문에 나타난 순서대로 반환합니다.
+//
+// 수집한 게시글은 첨부 파일을 별도로 저장하지 않고 본문 HTML 안의 링크로만 가지고 있으므로, 파일 확장자나
+// 다운로드 경로로 보이는 링크를 첨부 파일로 간주합니다. 상대 주소는 원문 주소(base)를 기준으로 절대 주소로 바꿉니다.
+func extractAttachments(content, base string) []articleAttachment {
+	if !strings.Contains(content, "<") {
+		return nil
+	}
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(content))
+	if err != nil {
+		return nil
+	}
+	baseURL, _ := url.Parse(base)
+
+	var (
+		attachments []articleAttachment
+		seen        = make(map[string]bool)
+	)
+	doc.Find("a[href], img[src]").Each(func(_ int, s *goquery.Selection) {
+		image := goquery.NodeName(s) == "img"
+
+		raw := s.AttrOr("href", "")
+		if image {
+			raw = s.AttrOr("src", "")
+		}
+		u, err := url.Parse(strings.TrimSpace(raw))
+		if err != nil {
+			return
+		}
+		if baseURL != nil {
+			u = baseURL.ResolveReference(u)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return
+		}
+		if !image && !isAttachmentURL(u) {
+			return
+		}
+
+		href := u.String()
+		if seen[href] {
+			return
+		}
+		seen[href] = true
+
+		name := strings.TrimSpace(s.Text())
+		if image {
+			name = strings.TrimSpace(s.AttrOr("alt", ""))
+		}
+		if name == "" {
+			name = path.Base(u.Path)
+		}
+
+		attachments = append(attachments, articleAttachment{Name: name, URL: href, Image: image})
+	})
+
+	return attachments
+}
+
+// isAttachmentURL 링크가 첨부 파일을 가리키는 것으로 보이는지 여부를 반환합니다.
+func isAttachmentURL(u *url.URL) bool {
+	if attachmentExtensions[strings.ToLower(path.Ext(u.Path))] {
+		return true
+	}
+
+	// 관공서 게시판은 파일을 'download.do?fileSn=1', 'fileDown.jsp' 같은 다운로드 전용 경로로 내려보내는 경우가 많습니다.
+	p := strings.ToLower(u.Path)
+	return strings.Contains(p, "download") || strings.Contains(p, "filedown")
+}
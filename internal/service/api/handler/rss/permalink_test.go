@@ -0,0 +1,148 @@
+package rss
+
+import (
+	"errors"
+	"html/template"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/darkkaiser/rss-feed-server/internal/config"
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// capturingTemplateRenderer 렌더링을 요청받은 템플릿 이름과 데이터를 기록하는 렌더러입니다.
+type capturingTemplateRenderer struct {
+	name string
+	data map[string]any
+}
+
+func (r *capturingTemplateRenderer) Render(w io.Writer, name string, data interface{}, c echo.Context) error {
+	r.name = name
+	r.data = data.(map[string]any)
+	_, err := w.Write([]byte("rendered"))
+	return err
+}
+
+func TestHandler_ViewArticle(t *testing.T) {
+	params := []string{"id", "boardID", "articleID"}
+	target := "/provider1/articles/b1/42"
+
+	t.Run("저장된 게시글을 본문, 첨부 파일과 함께 렌더링한다", func(t *testing.T) {
+		mockRepo := new(MockHistoryFeedRepo)
+		mockRepo.On("GetArticle", mock.Anything, "provider1", "b1", "42").Return(&feed.Article{
+			BoardID:   "b1",
+			ArticleID: "42",
+			Title:     "제목",
+			Content:   "첫 줄\n<a href=\"/files/안내문.hwp\">안내문</a>",
+			Link:      "http://test.com/board/42",
+			Author:    "작성자",
+			CreatedAt: time.Date(2024, 3, 15, 9, 30, 0, 0, time.Local),
+		}, nil)
+
+		c, rec := newHistoryTestContext(target, params, []string{"provider1", "b1", "42"})
+		renderer := &capturingTemplateRenderer{}
+		c.Echo().Renderer = renderer
+
+		require.NoError(t, New(newHistoryTestConfig(), mockRepo, nil).ViewArticle(c))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, articlePageCSP, rec.Header().Get("Content-Security-Policy"))
+		assert.Equal(t, "no-referrer", rec.Header().Get("Referrer-Policy"))
+
+		assert.Equal(t, "rss_article.tmpl", renderer.name)
+		assert.Equal(t, "Board 1", renderer.data["boardName"])
+		assert.Equal(t, "2024-03-15 09:30", renderer.data["createdAt"])
+		assert.Equal(t, "", renderer.data["deletedAt"])
+		assert.Equal(t, false, renderer.data["accessDenied"])
+		assert.Equal(t, template.HTML("첫 줄<br/><a href=\"/files/안내문.hwp\">안내문</a>"), renderer.data["content"])
+		assert.Equal(t, []articleAttachment{{Name: "안내문", URL: "http://test.com/files/%EC%95%88%EB%82%B4%EB%AC%B8.hwp"}}, renderer.data["attachments"])
+	})
+
+	t.Run("저장소가 게시글 조회를 지원하지 않으면 503", func(t *testing.T) {
+		c, _ := newHistoryTestContext(target, params, []string{"provider1", "b1", "42"})
+
+		err := New(newHistoryTestConfig(), new(MockFeedRepo), nil).ViewArticle(c)
+		var httpErr *echo.HTTPError
+		require.True(t, errors.As(err, &httpErr))
+		assert.Equal(t, http.StatusServiceUnavailable, httpErr.Code)
+	})
+
+	t.Run("등록되지 않은 게시판은 404", func(t *testing.T) {
+		mockRepo := new(MockHistoryFeedRepo)
+		c, _ := newHistoryTestContext("/provider1/articles/b9/42", params, []string{"provider1", "b9", "42"})
+
+		err := New(newHistoryTestConfig(), mockRepo, nil).ViewArticle(c)
+		var httpErr *echo.HTTPError
+		require.True(t, errors.As(err, &httpErr))
+		assert.Equal(t, http.StatusNotFound, httpErr.Code)
+		mockRepo.AssertNotCalled(t, "GetArticle", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("존재하지 않는 게시글은 404", func(t *testing.T) {
+		mockRepo := new(MockHistoryFeedRepo)
+		mockRepo.On("GetArticle", mock.Anything, "provider1", "b1", "42").Return(nil, nil)
+		c, _ := newHistoryTestContext(target, params, []string{"provider1", "b1", "42"})
+
+		err := New(newHistoryTestConfig(), mockRepo, nil).ViewArticle(c)
+		var httpErr *echo.HTTPError
+		require.True(t, errors.As(err, &httpErr))
+		assert.Equal(t, http.StatusNotFound, httpErr.Code)
+	})
+
+	t.Run("삭제된 게시글을 숨기는 공급자는 404", func(t *testing.T) {
+		cfg := newHistoryTestConfig()
+		cfg.Providers[0].Config.DeletedArticlePolicy = config.DeletedArticlePolicyHide
+
+		mockRepo := new(MockHistoryFeedRepo)
+		mockRepo.On("GetArticle", mock.Anything, "provider1", "b1", "42").
+			Return(&feed.Article{BoardID: "b1", ArticleID: "42", DeletedAt: time.Now()}, nil)
+		c, _ := newHistoryTestContext(target, params, []string{"provider1", "b1", "42"})
+
+		err := New(cfg, mockRepo, nil).ViewArticle(c)
+		var httpErr *echo.HTTPError
+		require.True(t, errors.As(err, &httpErr))
+		assert.Equal(t, http.StatusNotFound, httpErr.Code)
+	})
+}
+
+func TestExtractAttachments(t *testing.T) {
+	t.Run("파일 링크와 이미지를 순서대로 찾고 상대 주소를 절대 주소로 바꾼다", func(t *testing.T) {
+		content := `<p><img src="/img/a.png" alt="사진"><a href="https://other.com/page">일반 링크</a>
+			<a href="download.do?fileSn=1">모집 공고</a><a href="mailto:a@b.com">메일</a>
+			<a href="/img/a.png">중복</a><a href="javascript:alert(1)">스크립트.pdf</a><a href="/f/report.PDF"></a></p>`
+
+		assert.Equal(t, []articleAttachment{
+			{Name: "사진", URL: "http://site.com/img/a.png", Image: true},
+			{Name: "모집 공고", URL: "http://site.com/board/download.do?fileSn=1"},
+			{Name: "report.PDF", URL: "http://site.com/f/report.PDF"},
+		}, extractAttachments(content, "http://site.com/board/view.do?id=1"))
+	})
+
+	t.Run("HTML이 아닌 본문은 첨부 파일이 없다", func(t *testing.T) {
+		assert.Nil(t, extractAttachments("첨부 파일 없음\nhttp://site.com/a.pdf", "http://site.com"))
+	})
+}
+
+func TestHandler_GetFeed_UsePermalink(t *testing.T) {
+	cfg := newHistoryTestConfig()
+	cfg.Providers[0].Config.UsePermalink = true
+
+	mockRepo := new(MockFeedRepo)
+	mockRepo.On("GetArticles", mock.Anything, "provider1", []string{"b1", "b2"}, uint(10)).Return([]*feed.Article{
+		{BoardID: "b1", ArticleID: "a/1", Title: "T", Link: "http://test.com/a/1", CreatedAt: time.Now()},
+	}, nil)
+
+	c, rec := newHistoryTestContext("/provider1", []string{"id"}, []string{"provider1"})
+	require.NoError(t, New(cfg, mockRepo, nil).GetFeed(c))
+
+	body := rec.Body.String()
+	assert.Contains(t, body, "<link>http://example.com/provider1/articles/b1/a%2F1</link>")
+	assert.Contains(t, body, "<guid>http://example.com/provider1/articles/b1/a%2F1</guid>")
+	assert.NotContains(t, body, "<link>http://test.com/a/1</link>")
+}
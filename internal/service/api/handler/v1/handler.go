@@ -0,0 +1,407 @@
+// Package v1 공급자, 게시판, 게시글 정보를 JSON으로 제공하는 REST API(/api/v1) 핸들러를 구현합니다.
+//
+// RSS 피드로는 표현하기 어려운 조회(조건별 게시글 목록, 단건 조회, 통계 등)를 대시보드나 외부 스크립트에서
+// 사용할 수 있도록 제공하며, 응답 형식은 하위 호환성을 유지하는 범위에서만 변경합니다.
+package v1
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	applog "github.com/darkkaiser/notify-server/pkg/log"
+	"github.com/darkkaiser/rss-feed-server/internal/config"
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+	"github.com/darkkaiser/rss-feed-server/internal/service/api/httputil"
+	"github.com/darkkaiser/rss-feed-server/internal/service/api/model/response"
+	"github.com/labstack/echo/v4"
+)
+
+// component REST API 핸들러의 로깅용 컴포넌트 이름
+const component = "api.handler.v1"
+
+const (
+	// defaultArticleListLimit 게시글 목록 조회 시 limit 파라미터가 없을 때 반환하는 최대 개수입니다.
+	defaultArticleListLimit = 50
+
+	// maxArticleListLimit 게시글 목록 조회 시 한 번에 반환할 수 있는 최대 개수입니다.
+	maxArticleListLimit = 200
+
+	// defaultStatsDays 통계 조회 시 기간을 지정하지 않았을 때 집계하는 일수입니다. (오늘 포함)
+	defaultStatsDays = 30
+
+	// maxStatsDays 통계 조회 시 한 번에 집계할 수 있는 최대 일수입니다.
+	maxStatsDays = 366
+)
+
+// Handler /api/v1 경로의 REST API 요청을 처리하는 핸들러입니다.
+type Handler struct {
+	cfg *config.RSSFeedConfig
+
+	// providers 피드 ID(소문자)로 공급자 설정을 찾기 위한 맵입니다.
+	providers map[string]*config.ProviderConfig
+
+	// historyRepo 게시글 목록 및 단건 조회 저장소입니다. 저장소가 지원하지 않으면 nil입니다.
+	historyRepo feed.HistoryRepository
+
+	// statsRepo 게시글 수 통계 저장소입니다. 저장소가 지원하지 않으면 nil입니다.
+	statsRepo feed.StatsRepository
+}
+
+// New Handler 인스턴스를 생성하고 반환합니다.
+func New(cfg *config.RSSFeedConfig, feedRepo feed.Repository) *Handler {
+	if cfg == nil {
+		panic("config.RSSFeedConfig는 필수입니다")
+	}
+	if feedRepo == nil {
+		panic("feed.Repository는 필수입니다")
+	}
+
+	// 피드 ID 비교 시 대소문자를 구분하지 않도록 소문자로 정규화하여 저장합니다. (RSS 피드 주소와 동일한 규칙)
+	providers := make(map[string]*config.ProviderConfig, len(cfg.Providers))
+	for _, p := range cfg.Providers {
+		providers[strings.ToLower(p.ID)] = p
+	}
+
+	historyRepo, _ := feedRepo.(feed.HistoryRepository)
+	statsRepo, _ := feedRepo.(feed.StatsRepository)
+
+	return &Handler{
+		cfg:         cfg,
+		providers:   providers,
+		historyRepo: historyRepo,
+		statsRepo:   statsRepo,
+	}
+}
+
+// ListProviders godoc
+// @Summary 공급자 목록 조회
+// @Description 설정 파일에 정의된 모든 RSS 피드 공급자와 게시판, 수집 스케줄을 반환합니다.
+// @Tags API v1
+// @Produce json
+// @Success 200 {object} response.ProviderListResponse
+// @Router /api/v1/providers [get]
+func (h *Handler) ListProviders(c echo.Context) error {
+	// 비공개 공급자와 게시판은 열람할 수 있는 접근 토큰으로 요청한 경우에만 목록에 포함합니다.
+	providers := httputil.VisibleProviders(c, h.cfg.Providers)
+
+	resp := response.ProviderListResponse{
+		ResultCode: 0,
+		Providers:  make([]response.ProviderResponse, 0, len(providers)),
+	}
+	for _, p := range providers {
+		resp.Providers = append(resp.Providers, h.newProviderResponse(c, p))
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// GetProvider godoc
+// @Summary 공급자 조회
+// @Description 지정한 RSS 피드 공급자의 게시판과 수집 스케줄을 반환합니다.
+// @Tags API v1
+// @Produce json
+// @Param id path string true "RSS 피드 공급자 식별자"
+// @Success 200 {object} response.ProviderDetailResponse
+// @Failure 404 {object} response.ErrorResponse "등록되지 않은 공급자"
+// @Router /api/v1/providers/{id} [get]
+func (h *Handler) GetProvider(c echo.Context) error {
+	p, err := h.findProvider(c)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response.ProviderDetailResponse{
+		ResultCode: 0,
+		Provider:   h.newProviderResponse(c, p),
+	})
+}
+
+// ListArticles godoc
+// @Summary 게시글 목록 조회
+// @Description 공급자의 게시글을 최근 작성 순으로 반환합니다. 응답의 next_cursor 값을 cursor 파라미터로 넘기면 다음 페이지를 조회할 수 있습니다.
+// @Description 설정 파일에서 제외된 게시판의 게시글은 조회되지 않으며, 원문에서 삭제된 게시글은 deleted_at 필드로 구분합니다.
+// @Description 삭제된 게시글 처리 정책(deleted_article_policy)이 hide인 공급자는 피드와 마찬가지로 삭제된 게시글을 목록에서 제외합니다.
+// @Tags API v1
+// @Produce json
+// @Param id path string true "RSS 피드 공급자 식별자"
+// @Param board query string false "게시판 식별자 목록 (쉼표로 구분, 생략 시 전체)"
+// @Param from query string false "작성일시 시작 (포함, YYYY-MM-DD 또는 RFC3339)"
+// @Param to query string false "작성일시 끝 (미포함, YYYY-MM-DD 또는 RFC3339)"
+// @Param cursor query string false "이전 응답의 next_cursor 값"
+// @Param limit query int false "최대 반환 개수 (기본 50, 최대 200)"
+// @Success 200 {object} response.ArticleListResponse
+// @Failure 400 {object} response.ErrorResponse "잘못된 파라미터 (등록되지 않은 게시판, 날짜 형식, 커서 등)"
+// @Failure 404 {object} response.ErrorResponse "등록되지 않은 공급자"
+// @Failure 500 {object} response.ErrorResponse "서버 내부 오류 (DB 조회 실패)"
+// @Failure 503 {object} response.ErrorResponse "저장소가 게시글 조회를 지원하지 않음"
+// @Router /api/v1/providers/{id}/articles [get]
+func (h *Handler) ListArticles(c echo.Context) error {
+	p, err := h.findProvider(c)
+	if err != nil {
+		return err
+	}
+	if h.historyRepo == nil {
+		return httputil.NewServiceUnavailableError("현재 저장소는 게시글 조회를 지원하지 않습니다")
+	}
+
+	boardIDs := make([]string, 0, len(p.Config.Boards))
+	for _, b := range p.Config.Boards {
+		boardIDs = append(boardIDs, b.ID)
+	}
+
+	query, err := httputil.ParseArticlePageQuery(c, p.ID, boardIDs)
+	if err != nil {
+		return err
+	}
+	limit, err := httputil.ParseLimitQuery(c, defaultArticleListLimit, maxArticleListLimit)
+	if err != nil {
+		return err
+	}
+
+	resp := response.ArticleListResponse{
+		ResultCode: 0,
+		ProviderID: p.ID,
+		Articles:   []response.ArticleResponse{},
+	}
+	if len(query.BoardIDs) == 0 {
+		return c.JSON(http.StatusOK, resp)
+	}
+
+	// 다음 페이지가 있는지 확인하기 위해 요청한 개수보다 한 건 더 조회합니다.
+	// 삭제된 게시글을 숨기도록 설정된 공급자는 피드, 게시글 페이지와 마찬가지로 목록에서도 숨깁니다.
+	query.ExcludeDeleted = p.Config.DeletedPolicy() == config.DeletedArticlePolicyHide
+	query.Limit = uint(limit) + 1
+	articles, err := h.historyRepo.ListArticles(c.Request().Context(), p.ID, query)
+	if err != nil {
+		h.logger(c).Errorf("게시글 목록 조회 실패 (공급자: %s): %v", p.ID, err)
+		return httputil.NewInternalServerError("게시글 목록을 조회하는 과정에서 오류가 발생했습니다")
+	}
+	if len(articles) > limit {
+		articles = articles[:limit]
+		resp.NextCursor = feed.CursorOf(articles[len(articles)-1]).Encode()
+	}
+
+	for _, a := range articles {
+		resp.Articles = append(resp.Articles, response.NewArticleResponse(a, boardName(p, a.BoardID)))
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// GetArticle godoc
+// @Summary 게시글 조회
+// @Description 공급자, 게시판, 게시글 식별자로 게시글 하나를 반환합니다.
+// @Description 삭제된 게시글 처리 정책(deleted_article_policy)이 hide인 공급자의 삭제된 게시글은 존재하지 않는 게시글과 같이 404를 반환합니다.
+// @Tags API v1
+// @Produce json
+// @Param id path string true "RSS 피드 공급자 식별자"
+// @Param boardID path string true "게시판 식별자"
+// @Param articleID path string true "게시글 식별자"
+// @Success 200 {object} response.ArticleDetailResponse
+// @Failure 404 {object} response.ErrorResponse "등록되지 않은 공급자나 게시판, 또는 존재하지 않는 게시글"
+// @Failure 500 {object} response.ErrorResponse "서버 내부 오류 (DB 조회 실패)"
+// @Failure 503 {object} response.ErrorResponse "저장소가 게시글 조회를 지원하지 않음"
+// @Router /api/v1/providers/{id}/boards/{boardID}/articles/{articleID} [get]
+func (h *Handler) GetArticle(c echo.Context) error {
+	p, err := h.findProvider(c)
+	if err != nil {
+		return err
+	}
+	if h.historyRepo == nil {
+		return httputil.NewServiceUnavailableError("현재 저장소는 게시글 조회를 지원하지 않습니다")
+	}
+
+	boardID, articleID := c.Param("boardID"), c.Param("articleID")
+	if !p.Config.HasBoard(boardID) {
+		return httputil.NewNotFoundError(fmt.Sprintf("%s 피드에 등록되지 않은 게시판(%s)입니다", p.ID, boardID))
+	}
+
+	article, err := h.historyRepo.GetArticle(c.Request().Context(), p.ID, boardID, articleID)
+	if err != nil {
+		h.logger(c).Errorf("게시글 조회 실패 (공급자: %s, 게시판: %s, 게시글: %s): %v", p.ID, boardID, articleID, err)
+		return httputil.NewInternalServerError("게시글을 조회하는 과정에서 오류가 발생했습니다")
+	}
+	// 삭제된 게시글을 숨기도록 설정된 공급자는 피드, 게시글 페이지와 마찬가지로 API에서도 숨깁니다.
+	if article == nil || (article.IsDeleted() && p.Config.DeletedPolicy() == config.DeletedArticlePolicyHide) {
+		return httputil.NewNotFoundError(fmt.Sprintf("게시글(게시판: %s, 게시글: %s)을 찾을 수 없습니다. 보관 기한이 지나 정리되었을 수 있습니다.", boardID, articleID))
+	}
+
+	return c.JSON(http.StatusOK, response.ArticleDetailResponse{
+		ResultCode: 0,
+		ProviderID: p.ID,
+		Article:    response.NewArticleResponse(article, boardName(p, boardID)),
+	})
+}
+
+// GetStats godoc
+// @Summary 게시글 수 통계 조회
+// @Description 공급자의 게시판별, 날짜별(서버 시간대 기준) 게시글 수를 반환합니다. 기간을 지정하지 않으면 오늘을 포함한 최근 30일을 집계합니다.
+// @Description 설정 파일에 정의된 모든 게시판이 포함되며, 게시글이 없는 날짜는 daily 목록에서 생략됩니다.
+// @Tags API v1
+// @Produce json
+// @Param id path string true "RSS 피드 공급자 식별자"
+// @Param from query string false "집계 시작 날짜 (포함, YYYY-MM-DD)"
+// @Param to query string false "집계 끝 날짜 (포함, YYYY-MM-DD, 기본값: 오늘)"
+// @Success 200 {object} response.ArticleStatsResponse
+// @Failure 400 {object} response.ErrorResponse "잘못된 날짜 형식 또는 최대 366일을 초과하는 기간"
+// @Failure 404 {object} response.ErrorResponse "등록되지 않은 공급자"
+// @Failure 500 {object} response.ErrorResponse "서버 내부 오류 (DB 조회 실패)"
+// @Failure 503 {object} response.ErrorResponse "저장소가 통계 조회를 지원하지 않음"
+// @Router /api/v1/providers/{id}/stats [get]
+func (h *Handler) GetStats(c echo.Context) error {
+	p, err := h.findProvider(c)
+	if err != nil {
+		return err
+	}
+	if h.statsRepo == nil {
+		return httputil.NewServiceUnavailableError("현재 저장소는 게시글 통계 조회를 지원하지 않습니다")
+	}
+
+	from, to, err := parseStatsRange(c, time.Now())
+	if err != nil {
+		return err
+	}
+
+	counts, err := h.statsRepo.CountArticlesByDay(c.Request().Context(), p.ID, from, to.AddDate(0, 0, 1), time.Local)
+	if err != nil {
+		h.logger(c).Errorf("게시글 통계 조회 실패 (공급자: %s): %v", p.ID, err)
+		return httputil.NewInternalServerError("게시글 통계를 조회하는 과정에서 오류가 발생했습니다")
+	}
+
+	// 게시글이 없는 게시판도 응답에 포함되도록 설정 파일의 게시판 순서대로 항목을 미리 만들어 둡니다.
+	// 설정에서 제외된 게시판의 집계 결과는 버립니다.
+	resp := response.ArticleStatsResponse{
+		ResultCode: 0,
+		ProviderID: p.ID,
+		From:       from.Format(httputil.QueryDateLayout),
+		To:         to.Format(httputil.QueryDateLayout),
+		Boards:     make([]response.BoardStatsResponse, 0, len(p.Config.Boards)),
+	}
+	indexByBoardID := make(map[string]int, len(p.Config.Boards))
+	for i, b := range p.Config.Boards {
+		indexByBoardID[b.ID] = i
+		resp.Boards = append(resp.Boards, response.BoardStatsResponse{
+			BoardID:   b.ID,
+			BoardName: b.Name,
+			Daily:     []response.DailyCountResponse{},
+		})
+	}
+	for _, count := range counts {
+		i, ok := indexByBoardID[count.BoardID]
+		if !ok {
+			continue
+		}
+		resp.Boards[i].Total += count.Count
+		resp.Boards[i].Daily = append(resp.Boards[i].Daily, response.DailyCountResponse{
+			Date:  count.Date.Format(httputil.QueryDateLayout),
+			Count: count.Count,
+		})
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// parseStatsRange 통계 조회 기간(from, to 쿼리 파라미터)을 서버 로컬 시간대의 날짜로 해석합니다.
+// 두 값 모두 해당 날짜의 자정이며 to도 집계에 포함됩니다. 지정하지 않은 값은 now를 기준으로 기본 기간(최근 30일)에 맞춰 채웁니다.
+func parseStatsRange(c echo.Context, now time.Time) (from, to time.Time, err error) {
+	parseDate := func(name string) (time.Time, error) {
+		value := c.QueryParam(name)
+		if value == "" {
+			return time.Time{}, nil
+		}
+		t, err := time.ParseInLocation(httputil.QueryDateLayout, value, time.Local)
+		if err != nil {
+			return time.Time{}, httputil.NewBadRequestError(fmt.Sprintf("%s 파라미터('%s')는 YYYY-MM-DD 형식이어야 합니다", name, value))
+		}
+		return t, nil
+	}
+
+	if from, err = parseDate("from"); err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	if to, err = parseDate("to"); err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	if to.IsZero() {
+		if from.IsZero() {
+			now = now.In(time.Local)
+			to = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+		} else {
+			to = from.AddDate(0, 0, defaultStatsDays-1)
+		}
+	}
+	if from.IsZero() {
+		from = to.AddDate(0, 0, -(defaultStatsDays - 1))
+	}
+
+	if to.Before(from) {
+		return time.Time{}, time.Time{}, httputil.NewBadRequestError("from은 to와 같거나 이전 날짜여야 합니다")
+	}
+	if from.AddDate(0, 0, maxStatsDays).Compare(to) <= 0 {
+		return time.Time{}, time.Time{}, httputil.NewBadRequestError(fmt.Sprintf("통계 조회 기간은 최대 %d일입니다", maxStatsDays))
+	}
+
+	return from, to, nil
+}
+
+// newProviderResponse 공급자 설정을 응답 모델로 변환합니다. 보관 일수와 노출 한도는 상위 설정을 반영한 실제 적용값입니다.
+func (h *Handler) newProviderResponse(c echo.Context, p *config.ProviderConfig) response.ProviderResponse {
+	boards := make([]response.BoardResponse, 0, len(p.Config.Boards))
+	for _, b := range p.Config.Boards {
+		boards = append(boards, response.BoardResponse{
+			ID:           b.ID,
+			Name:         b.Name,
+			Category:     b.Category,
+			ArchiveDays:  b.RetentionDays(p.Config.ArchiveDays),
+			MaxItemCount: b.MaxItemCount,
+		})
+	}
+
+	return response.ProviderResponse{
+		ID:           p.ID,
+		Site:         p.Site,
+		Name:         p.Config.Name,
+		Description:  p.Config.Description,
+		URL:          p.Config.URL,
+		FeedURL:      fmt.Sprintf("%s://%s%s/%s", c.Scheme(), c.Request().Host, httputil.AccessTokenPath(c), p.ID),
+		Schedule:     p.Scheduler.TimeSpec,
+		ArchiveDays:  p.Config.ArchiveDays,
+		MaxItemCount: p.Config.ItemLimit(h.cfg.MaxItemCount),
+		Boards:       boards,
+	}
+}
+
+// findProvider 경로의 공급자 식별자(id)에 해당하는 공급자 설정을 반환합니다.
+// 반환된 설정의 게시판 목록에는 요청이 열람할 수 있는 게시판만 남아 있으며, 열람할 수 없는 비공개 공급자는 등록되지 않은 공급자와 같이 취급합니다.
+func (h *Handler) findProvider(c echo.Context) (*config.ProviderConfig, error) {
+	id := strings.ToLower(c.Param("id"))
+
+	p, ok := h.providers[id]
+	if ok {
+		if visible := httputil.VisibleProviders(c, []*config.ProviderConfig{p}); len(visible) > 0 {
+			return visible[0], nil
+		}
+	}
+
+	return nil, httputil.NewNotFoundError(fmt.Sprintf("요청하신 식별자(%s)에 해당하는 RSS 피드 공급자를 찾을 수 없습니다", id))
+}
+
+// boardName 게시판의 표시용 이름을 반환합니다. 설정에서 제외된 게시판이면 빈 문자열을 반환합니다.
+func boardName(p *config.ProviderConfig, boardID string) string {
+	if b := p.Config.Board(boardID); b != nil {
+		return b.Name
+	}
+	return ""
+}
+
+// logger 요청 정보를 바인딩한 로거를 반환합니다.
+func (h *Handler) logger(c echo.Context) *applog.Entry {
+	return applog.WithComponentAndFields(component, applog.Fields{
+		"request_id": c.Response().Header().Get(echo.HeaderXRequestID),
+		"path":       c.Request().URL.Path,
+		"method":     c.Request().Method,
+	})
+}
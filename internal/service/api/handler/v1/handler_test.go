@@ -0,0 +1,387 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/darkkaiser/rss-feed-server/internal/config"
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+	"github.com/darkkaiser/rss-feed-server/internal/service/api/model/response"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// =============================================================================
+// Mocks
+// =============================================================================
+
+// MockFeedRepo 필수 인터페이스(feed.Repository)만 구현하는 저장소 Mock입니다.
+type MockFeedRepo struct {
+	mock.Mock
+}
+
+func (m *MockFeedRepo) SaveArticles(ctx context.Context, providerID string, articles []*feed.Article) (int, error) {
+	args := m.Called(ctx, providerID, articles)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockFeedRepo) GetArticles(ctx context.Context, providerID string, boardIDs []string, limit uint) ([]*feed.Article, error) {
+	args := m.Called(ctx, providerID, boardIDs, limit)
+	return args.Get(0).([]*feed.Article), args.Error(1)
+}
+
+func (m *MockFeedRepo) GetCrawlingCursor(ctx context.Context, providerID, boardID string) (string, time.Time, error) {
+	args := m.Called(ctx, providerID, boardID)
+	return args.String(0), args.Get(1).(time.Time), args.Error(2)
+}
+
+func (m *MockFeedRepo) UpsertLatestCrawledArticleID(ctx context.Context, providerID, boardID, articleID string) error {
+	args := m.Called(ctx, providerID, boardID, articleID)
+	return args.Error(0)
+}
+
+// MockFullFeedRepo 이력 조회와 통계 조회를 함께 지원하는 저장소 Mock입니다.
+type MockFullFeedRepo struct {
+	MockFeedRepo
+}
+
+func (m *MockFullFeedRepo) ListArticles(ctx context.Context, providerID string, query feed.ArticlePageQuery) ([]*feed.Article, error) {
+	args := m.Called(ctx, providerID, query)
+	var res []*feed.Article
+	if v := args.Get(0); v != nil {
+		res = v.([]*feed.Article)
+	}
+	return res, args.Error(1)
+}
+
+func (m *MockFullFeedRepo) GetArticle(ctx context.Context, providerID, boardID, articleID string) (*feed.Article, error) {
+	args := m.Called(ctx, providerID, boardID, articleID)
+	var res *feed.Article
+	if v := args.Get(0); v != nil {
+		res = v.(*feed.Article)
+	}
+	return res, args.Error(1)
+}
+
+func (m *MockFullFeedRepo) CountArticlesByDay(ctx context.Context, providerID string, since, until time.Time, loc *time.Location) ([]*feed.DailyArticleCount, error) {
+	args := m.Called(ctx, providerID, since, until, loc)
+	var res []*feed.DailyArticleCount
+	if v := args.Get(0); v != nil {
+		res = v.([]*feed.DailyArticleCount)
+	}
+	return res, args.Error(1)
+}
+
+// =============================================================================
+// Test Helpers
+// =============================================================================
+
+func newTestConfig() *config.RSSFeedConfig {
+	return &config.RSSFeedConfig{
+		MaxItemCount: 10,
+		Providers: []*config.ProviderConfig{
+			{
+				ID:   "provider1",
+				Site: "YeosuCityHall",
+				Config: &config.ProviderDetailConfig{
+					Name:        "Test Provider",
+					Description: "테스트 공급자",
+					URL:         "http://test.com",
+					ArchiveDays: 30,
+					Boards: []*config.BoardConfig{
+						{ID: "b1", Name: "Board 1", Category: "공지"},
+						{ID: "b2", Name: "Board 2", ArchiveDays: 7, MaxItemCount: 3},
+					},
+				},
+				Scheduler: config.SchedulerConfig{TimeSpec: "0 */10 * * * *"},
+			},
+		},
+	}
+}
+
+// newTestContext 지정한 경로 파라미터로 요청 컨텍스트를 만듭니다.
+func newTestContext(target string, names []string, values []string) (echo.Context, *httptest.ResponseRecorder) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, target, nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames(names...)
+	c.SetParamValues(values...)
+
+	return c, rec
+}
+
+func assertHTTPError(t *testing.T, err error, code int) {
+	t.Helper()
+
+	var httpErr *echo.HTTPError
+	require.True(t, errors.As(err, &httpErr), "echo.HTTPError가 반환되어야 합니다: %v", err)
+	assert.Equal(t, code, httpErr.Code)
+}
+
+// =============================================================================
+// 테스트
+// =============================================================================
+
+func TestNew(t *testing.T) {
+	assert.Panics(t, func() { New(nil, new(MockFeedRepo)) })
+	assert.Panics(t, func() { New(newTestConfig(), nil) })
+}
+
+func TestHandler_ListProviders(t *testing.T) {
+	h := New(newTestConfig(), new(MockFeedRepo))
+	c, rec := newTestContext("/api/v1/providers", nil, nil)
+
+	require.NoError(t, h.ListProviders(c))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var resp response.ProviderListResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	require.Len(t, resp.Providers, 1)
+
+	p := resp.Providers[0]
+	assert.Equal(t, "provider1", p.ID)
+	assert.Equal(t, "YeosuCityHall", p.Site)
+	assert.Equal(t, "http://example.com/provider1", p.FeedURL)
+	assert.Equal(t, "0 */10 * * * *", p.Schedule)
+	assert.Equal(t, uint(10), p.MaxItemCount, "공급자 설정이 없으면 전역 노출 한도가 적용되어야 합니다")
+	assert.Equal(t, []response.BoardResponse{
+		{ID: "b1", Name: "Board 1", Category: "공지", ArchiveDays: 30},
+		{ID: "b2", Name: "Board 2", ArchiveDays: 7, MaxItemCount: 3},
+	}, p.Boards)
+}
+
+func TestHandler_GetProvider(t *testing.T) {
+	h := New(newTestConfig(), new(MockFeedRepo))
+
+	t.Run("식별자는 대소문자를 구분하지 않는다", func(t *testing.T) {
+		c, rec := newTestContext("/api/v1/providers/PROVIDER1", []string{"id"}, []string{"PROVIDER1"})
+
+		require.NoError(t, h.GetProvider(c))
+
+		var resp response.ProviderDetailResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, "provider1", resp.Provider.ID)
+	})
+
+	t.Run("등록되지 않은 공급자는 404", func(t *testing.T) {
+		c, _ := newTestContext("/api/v1/providers/unknown", []string{"id"}, []string{"unknown"})
+		assertHTTPError(t, h.GetProvider(c), http.StatusNotFound)
+	})
+}
+
+func TestHandler_ListArticles(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	articles := []*feed.Article{
+		{BoardID: "b1", ArticleID: "3", Title: "T3", CreatedAt: now},
+		{BoardID: "b2", ArticleID: "2", Title: "T2", CreatedAt: now.Add(-time.Minute)},
+		{BoardID: "b1", ArticleID: "1", Title: "T1", CreatedAt: now.Add(-2 * time.Minute)},
+	}
+
+	t.Run("저장소가 게시글 조회를 지원하지 않으면 503", func(t *testing.T) {
+		h := New(newTestConfig(), new(MockFeedRepo))
+		c, _ := newTestContext("/api/v1/providers/provider1/articles", []string{"id"}, []string{"provider1"})
+		assertHTTPError(t, h.ListArticles(c), http.StatusServiceUnavailable)
+	})
+
+	t.Run("잘못된 파라미터는 400", func(t *testing.T) {
+		h := New(newTestConfig(), new(MockFullFeedRepo))
+		c, _ := newTestContext("/api/v1/providers/provider1/articles?board=unknown", []string{"id"}, []string{"provider1"})
+		assertHTTPError(t, h.ListArticles(c), http.StatusBadRequest)
+	})
+
+	t.Run("limit보다 많으면 다음 페이지 커서를 반환한다", func(t *testing.T) {
+		repo := new(MockFullFeedRepo)
+		repo.On("ListArticles", mock.Anything, "provider1", mock.MatchedBy(func(q feed.ArticlePageQuery) bool {
+			return q.Limit == 3 && len(q.BoardIDs) == 2 && q.After == nil
+		})).Return(articles, nil)
+
+		h := New(newTestConfig(), repo)
+		c, rec := newTestContext("/api/v1/providers/provider1/articles?limit=2", []string{"id"}, []string{"provider1"})
+
+		require.NoError(t, h.ListArticles(c))
+
+		var resp response.ArticleListResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		require.Len(t, resp.Articles, 2)
+		assert.Equal(t, "Board 2", resp.Articles[1].BoardName)
+		assert.Equal(t, feed.CursorOf(articles[1]).Encode(), resp.NextCursor)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("삭제된 게시글 처리 정책이 hide인 경우에만 삭제된 게시글을 제외하고 조회한다", func(t *testing.T) {
+		for policy, excludeDeleted := range map[config.DeletedArticlePolicy]bool{
+			config.DeletedArticlePolicyHide: true,
+			config.DeletedArticlePolicyMark: false,
+			config.DeletedArticlePolicyKeep: false,
+		} {
+			cfg := newTestConfig()
+			cfg.Providers[0].Config.DeletedArticlePolicy = policy
+
+			repo := new(MockFullFeedRepo)
+			repo.On("ListArticles", mock.Anything, "provider1", mock.MatchedBy(func(q feed.ArticlePageQuery) bool {
+				return q.ExcludeDeleted == excludeDeleted
+			})).Return(articles, nil)
+
+			h := New(cfg, repo)
+			c, _ := newTestContext("/api/v1/providers/provider1/articles", []string{"id"}, []string{"provider1"})
+
+			require.NoError(t, h.ListArticles(c), "policy: %s", policy)
+			repo.AssertExpectations(t)
+		}
+	})
+
+	t.Run("저장소 오류는 500", func(t *testing.T) {
+		repo := new(MockFullFeedRepo)
+		repo.On("ListArticles", mock.Anything, "provider1", mock.Anything).Return(nil, errors.New("db error"))
+
+		h := New(newTestConfig(), repo)
+		c, _ := newTestContext("/api/v1/providers/provider1/articles", []string{"id"}, []string{"provider1"})
+		assertHTTPError(t, h.ListArticles(c), http.StatusInternalServerError)
+	})
+}
+
+func TestHandler_GetArticle(t *testing.T) {
+	params := []string{"id", "boardID", "articleID"}
+
+	t.Run("게시글을 반환한다", func(t *testing.T) {
+		repo := new(MockFullFeedRepo)
+		repo.On("GetArticle", mock.Anything, "provider1", "b1", "42").
+			Return(&feed.Article{BoardID: "b1", ArticleID: "42", Title: "제목", Link: "http://test.com/42"}, nil)
+
+		h := New(newTestConfig(), repo)
+		c, rec := newTestContext("/api/v1/providers/provider1/boards/b1/articles/42", params, []string{"provider1", "b1", "42"})
+
+		require.NoError(t, h.GetArticle(c))
+
+		var resp response.ArticleDetailResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, "provider1", resp.ProviderID)
+		assert.Equal(t, "42", resp.Article.ArticleID)
+		assert.Equal(t, "Board 1", resp.Article.BoardName)
+	})
+
+	t.Run("존재하지 않는 게시글은 404", func(t *testing.T) {
+		repo := new(MockFullFeedRepo)
+		repo.On("GetArticle", mock.Anything, "provider1", "b1", "404").Return(nil, nil)
+
+		h := New(newTestConfig(), repo)
+		c, _ := newTestContext("/api/v1/providers/provider1/boards/b1/articles/404", params, []string{"provider1", "b1", "404"})
+		assertHTTPError(t, h.GetArticle(c), http.StatusNotFound)
+	})
+
+	t.Run("삭제된 게시글은 삭제된 게시글 처리 정책이 hide이면 404, 그 외에는 deleted_at과 함께 반환한다", func(t *testing.T) {
+		deleted := &feed.Article{BoardID: "b1", ArticleID: "7", Title: "제목", DeletedAt: time.Now().Truncate(time.Second)}
+
+		for policy, code := range map[config.DeletedArticlePolicy]int{
+			config.DeletedArticlePolicyHide: http.StatusNotFound,
+			config.DeletedArticlePolicyMark: http.StatusOK,
+			config.DeletedArticlePolicyKeep: http.StatusOK,
+		} {
+			cfg := newTestConfig()
+			cfg.Providers[0].Config.DeletedArticlePolicy = policy
+
+			repo := new(MockFullFeedRepo)
+			repo.On("GetArticle", mock.Anything, "provider1", "b1", "7").Return(deleted, nil)
+
+			h := New(cfg, repo)
+			c, rec := newTestContext("/api/v1/providers/provider1/boards/b1/articles/7", params, []string{"provider1", "b1", "7"})
+
+			err := h.GetArticle(c)
+			if code == http.StatusNotFound {
+				assertHTTPError(t, err, code)
+				continue
+			}
+			require.NoError(t, err, "policy: %s", policy)
+
+			var resp response.ArticleDetailResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			assert.NotNil(t, resp.Article.DeletedAt, "policy: %s", policy)
+		}
+	})
+
+	t.Run("등록되지 않은 게시판은 저장소를 조회하지 않고 404", func(t *testing.T) {
+		repo := new(MockFullFeedRepo)
+
+		h := New(newTestConfig(), repo)
+		c, _ := newTestContext("/api/v1/providers/provider1/boards/b9/articles/1", params, []string{"provider1", "b9", "1"})
+		assertHTTPError(t, h.GetArticle(c), http.StatusNotFound)
+		repo.AssertNotCalled(t, "GetArticle", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestHandler_GetStats(t *testing.T) {
+	t.Run("설정된 게시판별로 합산하고 설정에 없는 게시판은 제외한다", func(t *testing.T) {
+		day := func(d int) time.Time { return time.Date(2024, 3, d, 0, 0, 0, 0, time.Local) }
+
+		repo := new(MockFullFeedRepo)
+		repo.On("CountArticlesByDay", mock.Anything, "provider1", day(1), day(11), time.Local).Return([]*feed.DailyArticleCount{
+			{BoardID: "b1", Date: day(2), Count: 3},
+			{BoardID: "b1", Date: day(5), Count: 2},
+			{BoardID: "old", Date: day(5), Count: 9},
+		}, nil)
+
+		h := New(newTestConfig(), repo)
+		c, rec := newTestContext("/api/v1/providers/provider1/stats?from=2024-03-01&to=2024-03-10", []string{"id"}, []string{"provider1"})
+
+		require.NoError(t, h.GetStats(c))
+
+		var resp response.ArticleStatsResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, "2024-03-01", resp.From)
+		assert.Equal(t, "2024-03-10", resp.To)
+		assert.Equal(t, []response.BoardStatsResponse{
+			{BoardID: "b1", BoardName: "Board 1", Total: 5, Daily: []response.DailyCountResponse{{Date: "2024-03-02", Count: 3}, {Date: "2024-03-05", Count: 2}}},
+			{BoardID: "b2", BoardName: "Board 2", Total: 0, Daily: []response.DailyCountResponse{}},
+		}, resp.Boards)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("저장소가 통계 조회를 지원하지 않으면 503", func(t *testing.T) {
+		h := New(newTestConfig(), new(MockFeedRepo))
+		c, _ := newTestContext("/api/v1/providers/provider1/stats", []string{"id"}, []string{"provider1"})
+		assertHTTPError(t, h.GetStats(c), http.StatusServiceUnavailable)
+	})
+}
+
+func TestParseStatsRange(t *testing.T) {
+	now := time.Date(2024, 3, 15, 13, 0, 0, 0, time.Local)
+	day := func(m time.Month, d int) time.Time { return time.Date(2024, m, d, 0, 0, 0, 0, time.Local) }
+
+	tests := []struct {
+		name     string
+		query    string
+		from, to time.Time
+		wantErr  bool
+	}{
+		{name: "기본값은 오늘을 포함한 최근 30일", query: "", from: day(2, 15), to: day(3, 15)},
+		{name: "from만 지정하면 그날부터 30일", query: "from=2024-01-01", from: day(1, 1), to: day(1, 30)},
+		{name: "to만 지정하면 그날까지 30일", query: "to=2024-01-30", from: day(1, 1), to: day(1, 30)},
+		{name: "같은 날짜는 하루를 집계", query: "from=2024-03-01&to=2024-03-01", from: day(3, 1), to: day(3, 1)},
+		{name: "최대 366일", query: "from=2024-01-01&to=2024-12-31", from: day(1, 1), to: day(12, 31)},
+		{name: "366일을 넘으면 400", query: "from=2024-01-01&to=2025-01-01", wantErr: true},
+		{name: "to가 from보다 이전이면 400", query: "from=2024-03-02&to=2024-03-01", wantErr: true},
+		{name: "날짜 형식이 아니면 400", query: "from=2024-03-01T00:00:00Z", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _ := newTestContext("/?"+tt.query, nil, nil)
+
+			from, to, err := parseStatsRange(c, now)
+			if tt.wantErr {
+				assertHTTPError(t, err, http.StatusBadRequest)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, tt.from.Equal(from), "from: %s", from)
+			assert.True(t, tt.to.Equal(to), "to: %s", to)
+		})
+	}
+}
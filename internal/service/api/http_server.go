@@ -64,12 +64,21 @@ type ServerConfig struct {
 	// - 운영 환경: ["https://example.com"]과 같이 신뢰할 수 있는 특정 도메인만 명시해야 합니다.
 	// 무분별한 허용은 악의적인 웹사이트가 사용자의 브라우저를 통해 API를 호출하는 보안 위협을 초래할 수 있습니다.
 	AllowOrigins []string
+
+	// FeedAccess 비공개 피드 접근 제어 설정입니다.
+	// 비공개(private)로 설정된 공급자나 게시판이 없으면 토큰 없이도 모든 피드를 열람할 수 있으므로 비워 두어도 됩니다.
+	FeedAccess appmiddleware.FeedAccessConfig
 }
 
 // NewEchoServer 설정된 미들웨어를 포함한 Echo 인스턴스를 생성합니다.
 //
 // 미들웨어는 다음 순서로 적용됩니다 (순서가 중요합니다):
 //
+//  0. Tracing - 분산 추적 (OpenTelemetry)
+//     - 요청마다 서버 Span을 생성하고, 요청 컨텍스트를 통해 핸들러와 저장소 호출의 Span을 하위로 연결
+//     - 요청 헤더의 traceparent가 있으면 상위 추적을 이어받음
+//     - HTTPLogger의 로그에 trace_id가 포함되도록 가장 바깥쪽에 적용
+//
 //  1. HTTPLogger - HTTP 요청/응답 로깅
 //     - 모든 HTTP 요청과 응답 정보를 구조화된 로그로 기록
 //     - 민감 정보(app_key, password 등)는 자동으로 마스킹
@@ -109,6 +118,14 @@ type ServerConfig struct {
 //  8. BodyLimit - 요청 본문 크기 제한 (초과 시 413 응답)
 //     - 대용량 요청으로 인한 메모리 고갈 및 DoS 공격 방지
 //
+//  9. FeedAccess - 비공개 피드 접근 제어
+//     - 요청에 포함된 접근 토큰을 검증하고 사용 기록을 남김
+//     - 비공개 공급자를 토큰 없이 요청하면 401, 토큰의 범위 밖이면 403 응답
+//     - 토큰 대입 시도가 RateLimit에 먼저 걸리도록 마지막에 적용
+//
+// 위 미들웨어와 별도로, 라우팅 전에 실행되는 Pre 미들웨어로 AccessTokenExtractor를 등록합니다.
+// 요청 주소의 접근 토큰(/t/{token}/... 또는 ?token=)을 분리하여 라우팅과 로그에 토큰이 드러나지 않게 합니다.
+//
 // 라우트 설정은 포함되지 않으며, 반환된 Echo 인스턴스에 별도로 설정해야 합니다.
 func NewEchoServer(cfg ServerConfig, views embed.FS) *echo.Echo {
 	e := echo.New()
@@ -129,9 +146,14 @@ func NewEchoServer(cfg ServerConfig, views embed.FS) *echo.Echo {
 	// 전역 HTTP 에러 핸들러 설정
 	e.HTTPErrorHandler = httputil.ErrorHandler
 
+	// 라우팅 전에 요청 주소에서 접근 토큰을 분리합니다.
+	e.Pre(appmiddleware.AccessTokenExtractor())
+
 	// 미들웨어 적용 (권장 순서)
 
-	// 1. HTTP 로깅 (가장 바깥쪽에서 모든 요청/응답 기록, Panic 포함)
+	// 0. 분산 추적 (HTTP 로그에도 trace_id가 기록되도록 로깅보다 바깥쪽에 위치)
+	e.Use(appmiddleware.Tracing())
+	// 1. HTTP 로깅 (모든 요청/응답 기록, Panic 포함)
 	e.Use(appmiddleware.HTTPLogger())
 	// 2. Panic 복구
 	e.Use(appmiddleware.PanicRecovery())
@@ -170,10 +192,12 @@ func NewEchoServer(cfg ServerConfig, views embed.FS) *echo.Echo {
 	e.Use(appmiddleware.RateLimit(defaultRateLimitPerSecond, defaultRateLimitBurst))
 	// 8. Body Limit
 	e.Use(middleware.BodyLimit(defaultMaxBodySize))
+	// 9. 비공개 피드 접근 제어
+	e.Use(appmiddleware.FeedAccess(cfg.FeedAccess))
 
 	// HTML 템플릿 렌더러를 주입합니다. 없으면 c.Render() 호출 시 런타임 오류가 발생합니다.
 	e.Renderer = &templateRenderer{
-		templates: template.Must(template.ParseFS(views, "views/templates/rss_summary.tmpl")),
+		templates: template.Must(template.ParseFS(views, "views/templates/rss_summary.tmpl", "views/templates/rss_article.tmpl")),
 	}
 
 	return e
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"crypto/tls"
 	"encoding/json"
+	"html/template"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -399,6 +400,22 @@ func TestTemplateRenderer_Render(t *testing.T) {
 			"렌더링 결과에 페이지 제목이 포함되어야 한다")
 	})
 
+	t.Run("게시글 페이지 템플릿은 본문을 이스케이프하지 않고 나머지 값은 이스케이프한다", func(t *testing.T) {
+		var buf bytes.Buffer
+		data := map[string]any{
+			"providerName": "여수시청",
+			"boardName":    "공지사항",
+			"title":        "<b>제목</b>",
+			"createdAt":    "2024-03-15 09:30",
+			"content":      template.HTML("<p>본문</p>"),
+			"attachments":  []any{},
+		}
+		err := renderer.Render(&buf, "rss_article.tmpl", data, nil)
+		require.NoError(t, err)
+		assert.Contains(t, buf.String(), "<p>본문</p>")
+		assert.Contains(t, buf.String(), "&lt;b&gt;제목&lt;/b&gt;")
+	})
+
 	t.Run("존재하지 않는 템플릿 이름으로 렌더링 시 에러가 반환된다", func(t *testing.T) {
 		var buf bytes.Buffer
 		err := renderer.Render(&buf, "non_existent_template.tmpl", nil, nil)
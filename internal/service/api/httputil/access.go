@@ -0,0 +1,130 @@
+package httputil
+
+import (
+	"github.com/darkkaiser/rss-feed-server/internal/config"
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// AccessTokenQueryParam 비공개 피드의 접근 토큰을 전달하는 쿼리 파라미터 이름입니다. (예: /ludypang.xml?token=rft_...)
+	AccessTokenQueryParam = "token"
+
+	// AccessTokenPathPrefix 비공개 피드의 접근 토큰을 경로로 전달할 때 사용하는 접두사입니다. (예: /t/rft_.../ludypang.xml)
+	// 쿼리 파라미터를 보존하지 않는 RSS 리더도 있으므로, 서버가 생성하는 링크는 모두 이 형식으로 토큰을 이어 붙입니다.
+	AccessTokenPathPrefix = "/t/"
+
+	// contextKeyAccessTokenSecret 요청 주소에서 분리한 접근 토큰 원문을 보관하는 컨텍스트 키입니다.
+	contextKeyAccessTokenSecret = "access_token_secret"
+
+	// contextKeyAccessToken 검증을 마친 접근 토큰(*feed.AccessToken)을 보관하는 컨텍스트 키입니다.
+	contextKeyAccessToken = "access_token"
+)
+
+// SetAccessTokenSecret 요청 주소에서 분리한 접근 토큰 원문을 컨텍스트에 저장합니다.
+func SetAccessTokenSecret(c echo.Context, secret string) {
+	c.Set(contextKeyAccessTokenSecret, secret)
+}
+
+// AccessTokenSecret 요청 주소에서 분리한 접근 토큰 원문을 반환합니다. 토큰 없이 요청되었으면 빈 문자열을 반환합니다.
+func AccessTokenSecret(c echo.Context) string {
+	secret, _ := c.Get(contextKeyAccessTokenSecret).(string)
+	return secret
+}
+
+// SetAccessToken 검증을 마친 접근 토큰을 컨텍스트에 저장합니다.
+func SetAccessToken(c echo.Context, token *feed.AccessToken) {
+	c.Set(contextKeyAccessToken, token)
+}
+
+// AccessToken 요청에 사용된 유효한 접근 토큰을 반환합니다. 토큰 없이 요청되었으면 nil을 반환합니다.
+func AccessToken(c echo.Context) *feed.AccessToken {
+	token, _ := c.Get(contextKeyAccessToken).(*feed.AccessToken)
+	return token
+}
+
+// AccessTokenPath 서버가 생성하는 링크에 덧붙일 접근 토큰 경로(/t/{token})를 반환합니다.
+// 유효한 접근 토큰으로 요청된 경우에만 값을 반환하므로, 토큰으로 연 피드의 링크를 따라가도 같은 토큰이 계속 전달됩니다.
+func AccessTokenPath(c echo.Context) string {
+	if AccessToken(c) == nil {
+		return ""
+	}
+	return AccessTokenPathPrefix + AccessTokenSecret(c)
+}
+
+// CacheControl 응답의 Cache-Control 헤더 값을 반환합니다.
+// 접근 토큰으로 요청된 응답은 구독자 한 명을 위한 것이므로 공유 캐시(프록시, CDN)에 저장되지 않도록 private으로 지정합니다.
+func CacheControl(c echo.Context, maxAge string) string {
+	if AccessToken(c) != nil {
+		return "private, max-age=" + maxAge
+	}
+	return "public, max-age=" + maxAge
+}
+
+// CanViewProvider 요청이 공급자의 피드를 열람할 수 있는지 여부를 반환합니다.
+// 비공개 공급자는 범위에 이 공급자의 게시판이 하나라도 포함된 토큰이 있어야 열람할 수 있습니다.
+func CanViewProvider(c echo.Context, p *config.ProviderConfig) bool {
+	if !p.Config.Private {
+		return true
+	}
+
+	token := AccessToken(c)
+	return token != nil && token.AllowsProvider(p.ID)
+}
+
+// CanViewBoard 요청이 공급자의 게시판을 열람할 수 있는지 여부를 반환합니다.
+// 공급자와 게시판이 모두 공개이거나, 토큰의 범위에 게시판이 포함된 경우에만 true를 반환합니다.
+func CanViewBoard(c echo.Context, p *config.ProviderConfig, b *config.BoardConfig) bool {
+	if !p.Config.Private && !b.Private {
+		return true
+	}
+
+	token := AccessToken(c)
+	return token != nil && token.Allows(p.ID, b.ID)
+}
+
+// VisibleBoards 공급자의 게시판 중 요청이 열람할 수 있는 게시판만 반환합니다.
+// 비공개로 설정된 대상이 없으면 설정의 게시판 목록을 복사하지 않고 그대로 반환합니다.
+func VisibleBoards(c echo.Context, p *config.ProviderConfig) []*config.BoardConfig {
+	boards := p.Config.Boards
+	for i, b := range boards {
+		if CanViewBoard(c, p, b) {
+			continue
+		}
+
+		visible := append(make([]*config.BoardConfig, 0, len(boards)-1), boards[:i]...)
+		for _, b := range boards[i+1:] {
+			if CanViewBoard(c, p, b) {
+				visible = append(visible, b)
+			}
+		}
+		return visible
+	}
+
+	return boards
+}
+
+// VisibleProviders 공급자 목록에서 요청이 열람할 수 없는 공급자를 제외하고, 남은 공급자의 게시판 목록도 열람할 수 있는 게시판으로 줄여 반환합니다.
+// 게시판 목록을 줄여야 하는 공급자는 설정 원본을 수정하지 않도록 복사본을 반환합니다.
+func VisibleProviders(c echo.Context, providers []*config.ProviderConfig) []*config.ProviderConfig {
+	visible := make([]*config.ProviderConfig, 0, len(providers))
+	for _, p := range providers {
+		if !CanViewProvider(c, p) {
+			continue
+		}
+
+		boards := VisibleBoards(c, p)
+		if len(boards) != len(p.Config.Boards) {
+			detail := *p.Config
+			detail.Boards = boards
+
+			copied := *p
+			copied.Config = &detail
+			p = &copied
+		}
+
+		visible = append(visible, p)
+	}
+
+	return visible
+}
@@ -0,0 +1,79 @@
+package httputil
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/darkkaiser/rss-feed-server/internal/config"
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newAccessContext(token *feed.AccessToken) echo.Context {
+	c := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), httptest.NewRecorder())
+	if token != nil {
+		SetAccessTokenSecret(c, "rft_secret")
+		SetAccessToken(c, token)
+	}
+	return c
+}
+
+func newAccessProviders() []*config.ProviderConfig {
+	return []*config.ProviderConfig{
+		{ID: "open", Config: &config.ProviderDetailConfig{Boards: []*config.BoardConfig{{ID: "1"}, {ID: "2", Private: true}, {ID: "3"}}}},
+		{ID: "closed", Config: &config.ProviderDetailConfig{Private: true, Boards: []*config.BoardConfig{{ID: "1"}, {ID: "2"}}}},
+	}
+}
+
+func boardIDs(boards []*config.BoardConfig) []string {
+	ids := make([]string, 0, len(boards))
+	for _, b := range boards {
+		ids = append(ids, b.ID)
+	}
+	return ids
+}
+
+func TestVisibleProviders(t *testing.T) {
+	t.Run("토큰이 없으면 비공개 공급자와 게시판을 제외한다", func(t *testing.T) {
+		providers := newAccessProviders()
+
+		visible := VisibleProviders(newAccessContext(nil), providers)
+		require.Len(t, visible, 1)
+		assert.Equal(t, "open", visible[0].ID)
+		assert.Equal(t, []string{"1", "3"}, boardIDs(visible[0].Config.Boards))
+		assert.Len(t, providers[0].Config.Boards, 3, "설정 원본은 수정하지 않아야 합니다")
+	})
+
+	t.Run("토큰의 범위에 포함된 공급자와 게시판만 추가로 노출한다", func(t *testing.T) {
+		providers := newAccessProviders()
+
+		visible := VisibleProviders(newAccessContext(&feed.AccessToken{Scopes: []string{"open/2", "closed/1"}}), providers)
+		require.Len(t, visible, 2)
+		assert.Same(t, providers[0], visible[0], "제외할 게시판이 없으면 원본을 그대로 반환해야 합니다")
+		assert.Equal(t, []string{"1"}, boardIDs(visible[1].Config.Boards))
+	})
+
+	t.Run("전체 범위 토큰은 모든 공급자와 게시판을 노출한다", func(t *testing.T) {
+		providers := newAccessProviders()
+
+		visible := VisibleProviders(newAccessContext(&feed.AccessToken{Scopes: []string{"*"}}), providers)
+		assert.Equal(t, providers, visible)
+	})
+}
+
+func TestAccessTokenPathAndCacheControl(t *testing.T) {
+	c := newAccessContext(nil)
+	assert.Empty(t, AccessTokenPath(c))
+	assert.Equal(t, "public, max-age=60", CacheControl(c, "60"))
+
+	// 검증되지 않은 토큰 원문은 링크에 이어 붙이지 않습니다.
+	SetAccessTokenSecret(c, "rft_unverified")
+	assert.Empty(t, AccessTokenPath(c))
+
+	c = newAccessContext(&feed.AccessToken{Scopes: []string{"*"}})
+	assert.Equal(t, "/t/rft_secret", AccessTokenPath(c))
+	assert.Equal(t, "private, max-age=60", CacheControl(c, "60"))
+}
@@ -0,0 +1,42 @@
+package httputil
+
+import (
+	"github.com/darkkaiser/rss-feed-server/internal/config"
+	"github.com/labstack/echo/v4"
+)
+
+// AdminAuthMethod 관리 엔드포인트 요청이 인증된 방식을 나타내는 타입입니다.
+type AdminAuthMethod string
+
+// 지원하는 관리 인증 방식 목록입니다.
+const (
+	AdminAuthAPIKey   AdminAuthMethod = "api_key"  // X-API-Key 또는 Authorization: Bearer 헤더
+	AdminAuthBasic    AdminAuthMethod = "basic"    // HTTP 기본 인증 (관리자 계정)
+	AdminAuthLoopback AdminAuthMethod = "loopback" // 인증 수단이 설정되지 않은 서버의 로컬 요청
+)
+
+// contextKeyAdminPrincipal 인증을 마친 관리 요청의 주체(*AdminPrincipal)를 보관하는 컨텍스트 키입니다.
+const contextKeyAdminPrincipal = "admin_principal"
+
+// AdminPrincipal 관리 엔드포인트에 인증된 주체입니다. 권한 검사와 감사 로그에 사용됩니다.
+type AdminPrincipal struct {
+	// Name API 키 이름 또는 관리자 계정 아이디입니다. 로컬 요청이면 "loopback"입니다.
+	Name string
+
+	// Role 주체에 부여된 권한 등급입니다.
+	Role config.AdminRole
+
+	// Method 인증 방식입니다.
+	Method AdminAuthMethod
+}
+
+// SetAdminPrincipal 인증을 마친 관리 요청의 주체를 컨텍스트에 저장합니다.
+func SetAdminPrincipal(c echo.Context, p *AdminPrincipal) {
+	c.Set(contextKeyAdminPrincipal, p)
+}
+
+// GetAdminPrincipal 관리 요청의 주체를 반환합니다. 인증을 거치지 않은 요청이면 nil을 반환합니다.
+func GetAdminPrincipal(c echo.Context) *AdminPrincipal {
+	p, _ := c.Get(contextKeyAdminPrincipal).(*AdminPrincipal)
+	return p
+}
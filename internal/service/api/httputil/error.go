@@ -50,10 +50,10 @@ func ErrorHandler(err error, c echo.Context) {
 
 	if code >= http.StatusInternalServerError {
 		// 5xx: 서버 내부 오류 - 즉시 조치 필요
-		applog.WithComponentAndFields(component, fields).Error("HTTP 5xx: 서버 내부 오류")
+		applog.WithComponentAndFields(component, fields).WithContext(c.Request().Context()).Error("HTTP 5xx: 서버 내부 오류")
 	} else if code >= http.StatusBadRequest {
 		// 4xx: 클라이언트 요청 오류 - 정상적인 거부 응답
-		applog.WithComponentAndFields(component, fields).Warn("HTTP 4xx: 클라이언트 요청 오류")
+		applog.WithComponentAndFields(component, fields).WithContext(c.Request().Context()).Warn("HTTP 4xx: 클라이언트 요청 오류")
 	}
 
 	// 이중 응답 방지: 이미 응답이 전송된 경우 추가 응답 시도하지 않음
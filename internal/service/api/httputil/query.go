@@ -0,0 +1,99 @@
+package httputil
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+	"github.com/labstack/echo/v4"
+)
+
+// QueryDateLayout 날짜만 지정하는 쿼리 파라미터의 형식입니다.
+const QueryDateLayout = "2006-01-02"
+
+// ParseTimeQuery 날짜(YYYY-MM-DD, 서버 로컬 시간대의 자정) 또는 RFC3339 형식의 쿼리 파라미터를 해석합니다.
+// 파라미터가 없으면 zero value를, 형식이 잘못되었으면 400 Bad Request 에러를 반환합니다.
+func ParseTimeQuery(c echo.Context, name string) (time.Time, error) {
+	value := c.QueryParam(name)
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	if t, err := time.ParseInLocation(QueryDateLayout, value, time.Local); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+
+	return time.Time{}, NewBadRequestError(fmt.Sprintf("%s 파라미터('%s')는 YYYY-MM-DD 또는 RFC3339 형식이어야 합니다", name, value))
+}
+
+// ParseLimitQuery limit 쿼리 파라미터를 해석합니다. 파라미터가 없으면 defaultLimit을, maxLimit보다 크면 maxLimit을 반환하며,
+// 1 이상의 정수가 아니면 400 Bad Request 에러를 반환합니다.
+func ParseLimitQuery(c echo.Context, defaultLimit, maxLimit int) (int, error) {
+	raw := c.QueryParam("limit")
+	if raw == "" {
+		return defaultLimit, nil
+	}
+
+	v, err := strconv.Atoi(raw)
+	if err != nil || v <= 0 {
+		return 0, NewBadRequestError(fmt.Sprintf("limit 파라미터('%s')는 1 이상의 정수여야 합니다", raw))
+	}
+
+	return min(v, maxLimit), nil
+}
+
+// SplitListQuery 쉼표로 구분된 쿼리 파라미터를 공백을 제거하여 분리합니다. 파라미터가 없으면 nil을 반환합니다.
+func SplitListQuery(c echo.Context, name string) []string {
+	var items []string
+	for _, item := range strings.Split(c.QueryParam(name), ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
+
+// ParseArticlePageQuery 게시글 목록 조회의 공통 쿼리 파라미터(board, from, to, cursor)를 해석합니다.
+// board 파라미터가 없으면 공급자의 전체 게시판(boardIDs)을 대상으로 하며, 설정에 없는 게시판을 요청하면 400 Bad Request 에러를 반환합니다.
+// 반환된 조회 조건의 Limit은 설정하지 않으므로 호출자가 채워야 합니다.
+func ParseArticlePageQuery(c echo.Context, providerID string, boardIDs []string) (feed.ArticlePageQuery, error) {
+	query := feed.ArticlePageQuery{BoardIDs: boardIDs}
+
+	// 요청한 게시판이 모두 현재 설정에 있는지 확인합니다. 설정에서 제외된 게시판의 게시글은 조회하지 않습니다.
+	if requested := SplitListQuery(c, "board"); len(requested) > 0 {
+		for _, id := range requested {
+			if !slices.Contains(boardIDs, id) {
+				return feed.ArticlePageQuery{}, NewBadRequestError(fmt.Sprintf("%s 피드에 등록되지 않은 게시판(%s)입니다", providerID, id))
+			}
+		}
+		query.BoardIDs = requested
+	}
+
+	var err error
+	if query.Since, err = ParseTimeQuery(c, "from"); err != nil {
+		return feed.ArticlePageQuery{}, err
+	}
+	if query.Until, err = ParseTimeQuery(c, "to"); err != nil {
+		return feed.ArticlePageQuery{}, err
+	}
+	if !query.Since.IsZero() && !query.Until.IsZero() && !query.Since.Before(query.Until) {
+		return feed.ArticlePageQuery{}, NewBadRequestError("from은 to보다 이전 시각이어야 합니다")
+	}
+
+	if raw := c.QueryParam("cursor"); raw != "" {
+		cursor, err := feed.ParseArticleCursor(raw)
+		if err != nil {
+			return feed.ArticlePageQuery{}, NewBadRequestError(fmt.Sprintf("cursor 파라미터가 올바르지 않습니다: %s", err))
+		}
+		query.After = &cursor
+	}
+
+	return query, nil
+}
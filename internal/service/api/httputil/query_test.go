@@ -0,0 +1,110 @@
+package httputil
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newQueryContext(query string) echo.Context {
+	req := httptest.NewRequest(http.MethodGet, "/?"+query, nil)
+	return echo.New().NewContext(req, httptest.NewRecorder())
+}
+
+func TestParseTimeQuery(t *testing.T) {
+	t.Run("파라미터가 없으면 zero value", func(t *testing.T) {
+		v, err := ParseTimeQuery(newQueryContext(""), "from")
+		require.NoError(t, err)
+		assert.True(t, v.IsZero())
+	})
+
+	t.Run("날짜는 로컬 시간대의 자정으로 해석한다", func(t *testing.T) {
+		v, err := ParseTimeQuery(newQueryContext("from=2024-03-15"), "from")
+		require.NoError(t, err)
+		assert.True(t, time.Date(2024, 3, 15, 0, 0, 0, 0, time.Local).Equal(v))
+	})
+
+	t.Run("RFC3339 형식을 해석한다", func(t *testing.T) {
+		v, err := ParseTimeQuery(newQueryContext("to=2024-03-15T09:30:00%2B09:00"), "to")
+		require.NoError(t, err)
+		assert.True(t, time.Date(2024, 3, 15, 0, 30, 0, 0, time.UTC).Equal(v))
+	})
+
+	t.Run("형식이 잘못되면 400", func(t *testing.T) {
+		_, err := ParseTimeQuery(newQueryContext("from=2024/03/15"), "from")
+		checkHTTPError(t, err, http.StatusBadRequest, "from 파라미터('2024/03/15')는 YYYY-MM-DD 또는 RFC3339 형식이어야 합니다")
+	})
+}
+
+func TestParseLimitQuery(t *testing.T) {
+	tests := []struct {
+		query string
+		want  int
+	}{
+		{"", 50},
+		{"limit=10", 10},
+		{"limit=1000", 200},
+	}
+	for _, tt := range tests {
+		v, err := ParseLimitQuery(newQueryContext(tt.query), 50, 200)
+		require.NoError(t, err)
+		assert.Equal(t, tt.want, v, tt.query)
+	}
+
+	for _, raw := range []string{"0", "-1", "abc"} {
+		_, err := ParseLimitQuery(newQueryContext("limit="+raw), 50, 200)
+		checkHTTPError(t, err, http.StatusBadRequest, "limit 파라미터('"+raw+"')는 1 이상의 정수여야 합니다")
+	}
+}
+
+func TestSplitListQuery(t *testing.T) {
+	assert.Nil(t, SplitListQuery(newQueryContext(""), "board"))
+	assert.Equal(t, []string{"72", "222"}, SplitListQuery(newQueryContext("board=72,+222,,"), "board"))
+}
+
+func TestParseArticlePageQuery(t *testing.T) {
+	boards := []string{"notice", "free"}
+
+	t.Run("파라미터가 없으면 전체 게시판을 대상으로 한다", func(t *testing.T) {
+		q, err := ParseArticlePageQuery(newQueryContext(""), "p1", boards)
+		require.NoError(t, err)
+		assert.Equal(t, boards, q.BoardIDs)
+		assert.True(t, q.Since.IsZero())
+		assert.True(t, q.Until.IsZero())
+		assert.Nil(t, q.After)
+	})
+
+	t.Run("게시판, 기간, 커서를 해석한다", func(t *testing.T) {
+		// base64url("2025-01-15T00:00:00Z\nnotice\n1")
+		cursor := "MjAyNS0wMS0xNVQwMDowMDowMFoKbm90aWNlCjE"
+		q, err := ParseArticlePageQuery(newQueryContext("board=free&from=2025-01-01&to=2025-02-01&cursor="+cursor), "p1", boards)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"free"}, q.BoardIDs)
+		assert.True(t, time.Date(2025, 1, 1, 0, 0, 0, 0, time.Local).Equal(q.Since))
+		assert.True(t, time.Date(2025, 2, 1, 0, 0, 0, 0, time.Local).Equal(q.Until))
+		require.NotNil(t, q.After)
+		assert.Equal(t, "notice", q.After.BoardID)
+		assert.Equal(t, "1", q.After.ArticleID)
+	})
+
+	t.Run("등록되지 않은 게시판이면 400", func(t *testing.T) {
+		_, err := ParseArticlePageQuery(newQueryContext("board=qna"), "p1", boards)
+		checkHTTPError(t, err, http.StatusBadRequest, "p1 피드에 등록되지 않은 게시판(qna)입니다")
+	})
+
+	t.Run("from이 to보다 이전이 아니면 400", func(t *testing.T) {
+		_, err := ParseArticlePageQuery(newQueryContext("from=2025-02-01&to=2025-02-01"), "p1", boards)
+		checkHTTPError(t, err, http.StatusBadRequest, "from은 to보다 이전 시각이어야 합니다")
+	})
+
+	t.Run("커서가 잘못되면 400", func(t *testing.T) {
+		_, err := ParseArticlePageQuery(newQueryContext("cursor=!!"), "p1", boards)
+		require.Error(t, err)
+		assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+	})
+}
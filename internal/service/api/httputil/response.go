@@ -23,6 +23,14 @@ func NewUnauthorizedError(message string) error {
 	})
 }
 
+// NewForbiddenError 403 Forbidden 에러를 생성합니다
+func NewForbiddenError(message string) error {
+	return echo.NewHTTPError(http.StatusForbidden, response.ErrorResponse{
+		ResultCode: http.StatusForbidden,
+		Message:    message,
+	})
+}
+
 // NewNotFoundError 404 Not Found 에러를 생성합니다
 func NewNotFoundError(message string) error {
 	return echo.NewHTTPError(http.StatusNotFound, response.ErrorResponse{
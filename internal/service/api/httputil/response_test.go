@@ -74,6 +74,12 @@ func TestErrorResponses(t *testing.T) {
 			message:        "인증이 필요합니다",
 			expectedStatus: http.StatusUnauthorized,
 		},
+		{
+			name:           "Forbidden_접근 거부",
+			createError:    NewForbiddenError,
+			message:        "접근 권한이 없습니다",
+			expectedStatus: http.StatusForbidden,
+		},
 		{
 			name:           "NotFound_리소스 없음",
 			createError:    NewNotFoundError,
@@ -0,0 +1,90 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	applog "github.com/darkkaiser/notify-server/pkg/log"
+	"github.com/darkkaiser/rss-feed-server/internal/service/api/httputil"
+	"github.com/labstack/echo/v4"
+)
+
+// componentAdminAudit 관리 작업 감사 로그의 로깅용 컴포넌트 이름
+const componentAdminAudit = "api.middleware.admin_audit"
+
+// AdminAudit 관리 엔드포인트에서 상태를 바꾸는 요청(POST, PUT, PATCH, DELETE)을 감사 로그로 기록하는 미들웨어를 반환합니다.
+//
+// AdminAuth 다음에 등록하여 누가(actor, role, auth_method) 어떤 작업을 요청했고 결과가 어땠는지(status)를 남깁니다.
+// 요청·응답 정보의 필드 이름과 민감한 쿼리 파라미터 마스킹은 HTTPLogger와 같으므로, 두 로그를 request_id로 이어서 볼 수 있습니다.
+// 권한 부족(403)이나 핸들러 오류로 실패한 작업도 기록되며, 조회 요청(GET, HEAD)은 기록하지 않습니다.
+func AdminAudit() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			if !isMutatingMethod(req.Method) {
+				return next(c)
+			}
+
+			start := time.Now()
+			err := next(c)
+			latency := time.Since(start)
+
+			fields := applog.Fields{
+				"time_rfc3339": start.Format(time.RFC3339),
+
+				"method": req.Method,
+				"path":   req.URL.Path,
+				"uri":    maskSensitiveQueryParams(req.RequestURI),
+				"route":  c.Path(),
+
+				"remote_ip":  c.RealIP(),
+				"user_agent": req.UserAgent(),
+
+				"status":        strconv.Itoa(auditStatus(c, err)),
+				"latency":       strconv.FormatInt(latency.Nanoseconds()/1000, 10),
+				"latency_human": latency.String(),
+
+				"request_id": c.Response().Header().Get(echo.HeaderXRequestID),
+			}
+			if p := httputil.GetAdminPrincipal(c); p != nil {
+				fields["actor"] = p.Name
+				fields["role"] = p.Role
+				fields["auth_method"] = p.Method
+			}
+			if err != nil {
+				fields["error"] = err
+			}
+
+			applog.WithComponentAndFields(componentAdminAudit, fields).Info("관리 작업 감사")
+
+			return err
+		}
+	}
+}
+
+// isMutatingMethod 서버의 상태를 바꿀 수 있는 HTTP 메서드인지 여부를 반환합니다.
+func isMutatingMethod(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// auditStatus 감사 로그에 기록할 응답 상태 코드를 반환합니다.
+//
+// 핸들러가 에러를 반환하면 응답은 아직 전송되지 않았으므로(전역 에러 핸들러가 이후에 전송), 에러에서 상태 코드를 결정합니다.
+func auditStatus(c echo.Context, err error) int {
+	if err == nil {
+		return c.Response().Status
+	}
+
+	var he *echo.HTTPError
+	if errors.As(err, &he) {
+		return he.Code
+	}
+	return http.StatusInternalServerError
+}
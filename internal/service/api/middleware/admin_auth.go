@@ -0,0 +1,165 @@
+package middleware
+
+import (
+	"crypto/sha256"
+	"net/http"
+	"strings"
+
+	applog "github.com/darkkaiser/notify-server/pkg/log"
+	"github.com/darkkaiser/rss-feed-server/internal/config"
+	"github.com/darkkaiser/rss-feed-server/internal/service/api/httputil"
+	"github.com/labstack/echo/v4"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// componentAdminAuth 관리 엔드포인트 인증 미들웨어의 로깅용 컴포넌트 이름
+const componentAdminAuth = "api.middleware.admin_auth"
+
+const (
+	// headerAPIKey 관리 API 키를 전달하는 요청 헤더 이름입니다.
+	headerAPIKey = "X-API-Key"
+
+	// adminBasicRealm HTTP 기본 인증 요청 시 브라우저에 표시되는 영역(realm) 이름입니다.
+	adminBasicRealm = `Basic realm="rss-feed-server admin", charset="UTF-8"`
+)
+
+// AdminAuth 관리 엔드포인트(/admin) 요청의 주체를 인증하는 미들웨어를 반환합니다.
+//
+// 인증 수단은 다음 순서로 확인하며, 인증된 주체는 httputil.SetAdminPrincipal로 컨텍스트에 저장됩니다.
+//  1. API 키: X-API-Key 헤더 또는 Authorization: Bearer 헤더
+//  2. HTTP 기본 인증: Authorization: Basic 헤더 (설정 파일의 관리자 계정, bcrypt 해시와 비교)
+//
+// cfg에 인증 수단이 하나도 없으면 인증 대신 LoopbackOnly와 같이 서버 로컬 요청만 허용하고 admin 등급을 부여합니다.
+// 인증 수단이 설정된 경우에는 로컬 요청도 인증을 거쳐야 하며, 자격 증명이 없거나 틀리면 401 Unauthorized로 거부합니다.
+//
+// 권한 등급 검사는 라우트별로 RequireAdminRole이 수행합니다.
+func AdminAuth(cfg config.AdminConfig) echo.MiddlewareFunc {
+	if !cfg.Enabled() {
+		return loopbackAdminAuth
+	}
+
+	// API 키는 SHA-256 해시를 키로 조회합니다. 요청마다 원문 대신 고정 길이 해시를 비교하므로
+	// 문자열 비교에 걸리는 시간으로 키의 앞부분을 추측하는 공격이 통하지 않습니다.
+	apiKeys := make(map[[sha256.Size]byte]*config.AdminAPIKeyConfig, len(cfg.APIKeys))
+	for _, k := range cfg.APIKeys {
+		apiKeys[sha256.Sum256([]byte(k.Key))] = k
+	}
+
+	users := make(map[string]*config.AdminUserConfig, len(cfg.Users))
+	for _, u := range cfg.Users {
+		users[u.Username] = u
+	}
+
+	// 존재하지 않는 아이디로 요청해도 bcrypt 비교를 한 번 수행하여, 응답 시간으로 계정 존재 여부를 알 수 없게 합니다.
+	var dummyHash []byte
+	if len(cfg.Users) > 0 {
+		cost, _ := bcrypt.Cost([]byte(cfg.Users[0].PasswordHash))
+		dummyHash, _ = bcrypt.GenerateFromPassword([]byte("rss-feed-server"), cost)
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+
+			if key := apiKeyFromRequest(req); key != "" {
+				k, ok := apiKeys[sha256.Sum256([]byte(key))]
+				if !ok {
+					return denyAdminAuth(c, httputil.AdminAuthAPIKey, "", len(users) > 0)
+				}
+
+				httputil.SetAdminPrincipal(c, &httputil.AdminPrincipal{Name: k.Name, Role: k.Role, Method: httputil.AdminAuthAPIKey})
+				return next(c)
+			}
+
+			if username, password, ok := req.BasicAuth(); ok && len(users) > 0 {
+				u := users[username]
+
+				hash := dummyHash
+				if u != nil {
+					hash = []byte(u.PasswordHash)
+				}
+				if err := bcrypt.CompareHashAndPassword(hash, []byte(password)); err != nil || u == nil {
+					return denyAdminAuth(c, httputil.AdminAuthBasic, username, true)
+				}
+
+				httputil.SetAdminPrincipal(c, &httputil.AdminPrincipal{Name: u.Username, Role: u.Role, Method: httputil.AdminAuthBasic})
+				return next(c)
+			}
+
+			if len(users) > 0 {
+				c.Response().Header().Set(echo.HeaderWWWAuthenticate, adminBasicRealm)
+			}
+			return httputil.NewUnauthorizedError("관리 엔드포인트에 접근하려면 API 키 또는 관리자 계정으로 인증해야 합니다")
+		}
+	}
+}
+
+// loopbackAdminAuth 인증 수단이 설정되지 않은 서버에서 로컬 요청에만 admin 등급을 부여하는 미들웨어입니다.
+func loopbackAdminAuth(next echo.HandlerFunc) echo.HandlerFunc {
+	return LoopbackOnly()(func(c echo.Context) error {
+		httputil.SetAdminPrincipal(c, &httputil.AdminPrincipal{Name: "loopback", Role: config.AdminRoleAdmin, Method: httputil.AdminAuthLoopback})
+		return next(c)
+	})
+}
+
+// apiKeyFromRequest 요청 헤더에서 API 키를 꺼냅니다. X-API-Key 헤더를 우선하며, 없으면 Authorization: Bearer 헤더를 확인합니다.
+func apiKeyFromRequest(r *http.Request) string {
+	if key := r.Header.Get(headerAPIKey); key != "" {
+		return key
+	}
+
+	scheme, credentials, ok := strings.Cut(r.Header.Get(echo.HeaderAuthorization), " ")
+	if ok && strings.EqualFold(scheme, "Bearer") {
+		return strings.TrimSpace(credentials)
+	}
+
+	return ""
+}
+
+// denyAdminAuth 인증 실패를 경고 로그로 남기고 401 Unauthorized 에러를 반환합니다. 제출된 자격 증명은 기록하지 않습니다.
+func denyAdminAuth(c echo.Context, method httputil.AdminAuthMethod, username string, challenge bool) error {
+	applog.WithComponentAndFields(componentAdminAuth, applog.Fields{
+		"path":        c.Request().URL.Path,
+		"method":      c.Request().Method,
+		"remote_ip":   c.RealIP(),
+		"auth_method": method,
+		"username":    username,
+	}).Warn("관리 엔드포인트 인증 실패: 등록되지 않은 API 키이거나 아이디 또는 비밀번호가 일치하지 않습니다")
+
+	if challenge {
+		c.Response().Header().Set(echo.HeaderWWWAuthenticate, adminBasicRealm)
+	}
+	return httputil.NewUnauthorizedError("인증 정보가 올바르지 않습니다")
+}
+
+// RequireAdminRole 인증된 주체가 required 이상의 권한 등급을 가진 경우에만 통과시키는 미들웨어를 반환합니다.
+//
+// AdminAuth 이후에 라우트별로 적용합니다. 인증되지 않은 요청은 401 Unauthorized, 등급이 부족하면 403 Forbidden으로 거부합니다.
+//
+// 사용 예시:
+//
+//	g.POST("/snapshots/:id/replay", h.ReplayParseSnapshot, middleware.RequireAdminRole(config.AdminRoleOperator))
+func RequireAdminRole(required config.AdminRole) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			p := httputil.GetAdminPrincipal(c)
+			if p == nil {
+				return httputil.NewUnauthorizedError("관리 엔드포인트에 접근하려면 인증이 필요합니다")
+			}
+
+			if !p.Role.Allows(required) {
+				applog.WithComponentAndFields(componentAdminAuth, applog.Fields{
+					"path":          c.Request().URL.Path,
+					"method":        c.Request().Method,
+					"actor":         p.Name,
+					"role":          p.Role,
+					"required_role": required,
+				}).Warn("관리 엔드포인트 접근 거부: 권한 등급이 부족합니다")
+
+				return httputil.NewForbiddenError("이 작업을 수행할 권한이 없습니다 (필요 등급: " + string(required) + ")")
+			}
+
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,176 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/darkkaiser/rss-feed-server/internal/config"
+	"github.com/darkkaiser/rss-feed-server/internal/service/api/httputil"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// =============================================================================
+// 관리 엔드포인트 인증·권한·감사 미들웨어 테스트
+// =============================================================================
+
+const testAdminAPIKey = "0123456789abcdef0123456789abcdef"
+
+// newAdminTestServer 관리 라우트와 같은 구성(AdminAuth → AdminAudit → RequireAdminRole)의 테스트 서버를 생성합니다.
+// 핸들러는 인증된 주체를 JSON으로 응답합니다.
+func newAdminTestServer(t *testing.T, cfg config.AdminConfig) *echo.Echo {
+	t.Helper()
+
+	e := echo.New()
+	g := e.Group("/admin", AdminAuth(cfg), AdminAudit())
+
+	handler := func(c echo.Context) error {
+		p := httputil.GetAdminPrincipal(c)
+		return c.JSON(http.StatusOK, map[string]any{"name": p.Name, "role": p.Role, "method": p.Method})
+	}
+	g.GET("/items", handler, RequireAdminRole(config.AdminRoleViewer))
+	g.POST("/items", handler, RequireAdminRole(config.AdminRoleOperator))
+	g.DELETE("/items", handler, RequireAdminRole(config.AdminRoleAdmin))
+
+	return e
+}
+
+func newAdminTestConfig(t *testing.T) config.AdminConfig {
+	t.Helper()
+
+	hash, err := bcrypt.GenerateFromPassword([]byte("secret-password"), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	return config.AdminConfig{
+		APIKeys: []*config.AdminAPIKeyConfig{{Name: "script", Key: testAdminAPIKey, Role: config.AdminRoleOperator}},
+		Users:   []*config.AdminUserConfig{{Username: "darkkaiser", PasswordHash: string(hash), Role: config.AdminRoleViewer}},
+	}
+}
+
+func TestAdminAuth(t *testing.T) {
+	e := newAdminTestServer(t, newAdminTestConfig(t))
+
+	tests := []struct {
+		name      string
+		method    string
+		setup     func(req *http.Request)
+		status    int
+		actor     string
+		challenge bool
+	}{
+		{"자격 증명이 없으면 401과 기본 인증 요청", http.MethodGet, func(req *http.Request) {}, http.StatusUnauthorized, "", true},
+		{"로컬 요청도 인증이 필요하다", http.MethodGet, func(req *http.Request) { req.RemoteAddr = "127.0.0.1:1234" }, http.StatusUnauthorized, "", true},
+		{"X-API-Key 헤더", http.MethodGet, func(req *http.Request) { req.Header.Set("X-API-Key", testAdminAPIKey) }, http.StatusOK, "script", false},
+		{"Bearer 헤더", http.MethodPost, func(req *http.Request) { req.Header.Set(echo.HeaderAuthorization, "Bearer "+testAdminAPIKey) }, http.StatusOK, "script", false},
+		{"등록되지 않은 API 키", http.MethodGet, func(req *http.Request) { req.Header.Set("X-API-Key", "unknown") }, http.StatusUnauthorized, "", true},
+		{"기본 인증", http.MethodGet, func(req *http.Request) { req.SetBasicAuth("darkkaiser", "secret-password") }, http.StatusOK, "darkkaiser", false},
+		{"틀린 비밀번호", http.MethodGet, func(req *http.Request) { req.SetBasicAuth("darkkaiser", "wrong") }, http.StatusUnauthorized, "", true},
+		{"존재하지 않는 아이디", http.MethodGet, func(req *http.Request) { req.SetBasicAuth("nobody", "secret-password") }, http.StatusUnauthorized, "", true},
+		{"operator 키로 admin 작업은 403", http.MethodDelete, func(req *http.Request) { req.Header.Set("X-API-Key", testAdminAPIKey) }, http.StatusForbidden, "", false},
+		{"viewer 계정으로 operator 작업은 403", http.MethodPost, func(req *http.Request) { req.SetBasicAuth("darkkaiser", "secret-password") }, http.StatusForbidden, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "/admin/items", nil)
+			req.RemoteAddr = "203.0.113.10:1234"
+			tt.setup(req)
+			rec := httptest.NewRecorder()
+
+			e.ServeHTTP(rec, req)
+
+			require.Equal(t, tt.status, rec.Code, rec.Body.String())
+			assert.Equal(t, tt.challenge, rec.Header().Get(echo.HeaderWWWAuthenticate) != "")
+			if tt.actor != "" {
+				var body map[string]string
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+				assert.Equal(t, tt.actor, body["name"])
+			}
+		})
+	}
+}
+
+func TestAdminAuth_LoopbackFallback(t *testing.T) {
+	e := newAdminTestServer(t, config.AdminConfig{})
+
+	t.Run("인증 수단이 없으면 로컬 요청에 admin 등급을 부여한다", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodDelete, "/admin/items", nil)
+		req.RemoteAddr = "127.0.0.1:1234"
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"name":"loopback","role":"admin","method":"loopback"}`, rec.Body.String())
+	})
+
+	t.Run("인증 수단이 없으면 외부 요청은 403", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/admin/items", nil)
+		req.RemoteAddr = "203.0.113.10:1234"
+		req.Header.Set("X-API-Key", testAdminAPIKey)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+}
+
+func TestRequireAdminRole_Unauthenticated(t *testing.T) {
+	c := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), httptest.NewRecorder())
+
+	err := RequireAdminRole(config.AdminRoleViewer)(func(c echo.Context) error { return nil })(c)
+
+	he, ok := err.(*echo.HTTPError)
+	require.True(t, ok)
+	assert.Equal(t, http.StatusUnauthorized, he.Code)
+}
+
+func TestAdminAudit(t *testing.T) {
+	e := newAdminTestServer(t, newAdminTestConfig(t))
+
+	t.Run("상태를 바꾸는 요청은 주체와 결과를 기록한다", func(t *testing.T) {
+		buf := captureLogs(t)
+
+		req := httptest.NewRequest(http.MethodPost, "/admin/items?token=rft_secret_value", nil)
+		req.Header.Set("X-API-Key", testAdminAPIKey)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		entry := parseLastLogEntry(t, buf)
+		assert.Equal(t, "관리 작업 감사", entry["msg"])
+		assert.Equal(t, "script", entry["actor"])
+		assert.Equal(t, "operator", entry["role"])
+		assert.Equal(t, "api_key", entry["auth_method"])
+		assert.Equal(t, "POST", entry["method"])
+		assert.Equal(t, "/admin/items", entry["route"])
+		assert.Equal(t, "200", entry["status"])
+		assert.NotContains(t, entry["uri"], "rft_secret_value", "민감한 쿼리 파라미터는 HTTPLogger와 같이 마스킹해야 합니다")
+		assert.NotContains(t, buf.String(), testAdminAPIKey, "API 키 원문은 로그에 남지 않아야 합니다")
+	})
+
+	t.Run("권한 부족으로 거부된 작업도 기록한다", func(t *testing.T) {
+		buf := captureLogs(t)
+
+		req := httptest.NewRequest(http.MethodDelete, "/admin/items", nil)
+		req.SetBasicAuth("darkkaiser", "secret-password")
+		e.ServeHTTP(httptest.NewRecorder(), req)
+
+		entry := parseLastLogEntry(t, buf)
+		assert.Equal(t, "관리 작업 감사", entry["msg"])
+		assert.Equal(t, "darkkaiser", entry["actor"])
+		assert.Equal(t, "403", entry["status"])
+	})
+
+	t.Run("조회 요청은 기록하지 않는다", func(t *testing.T) {
+		buf := captureLogs(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/admin/items", nil)
+		req.Header.Set("X-API-Key", testAdminAPIKey)
+		e.ServeHTTP(httptest.NewRecorder(), req)
+
+		assert.NotContains(t, buf.String(), "관리 작업 감사")
+	})
+}
@@ -0,0 +1,177 @@
+package middleware
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	applog "github.com/darkkaiser/notify-server/pkg/log"
+	"github.com/darkkaiser/rss-feed-server/internal/config"
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+	"github.com/darkkaiser/rss-feed-server/internal/service/api/httputil"
+	"github.com/labstack/echo/v4"
+)
+
+// componentFeedAccess 비공개 피드 접근 제어 미들웨어의 로깅용 컴포넌트 이름
+const componentFeedAccess = "api.middleware.feed_access"
+
+const (
+	// accessTokenUsageKeep 토큰별로 보관할 최대 사용 기록 수입니다. 초과분은 오래된 기록부터 삭제됩니다.
+	accessTokenUsageKeep = 1000
+
+	// accessTokenUsageTimeout 사용 기록 저장에 허용하는 최대 시간입니다.
+	// 기록 저장이 지연되더라도 피드 응답이 함께 늦어지지 않도록 짧게 제한합니다.
+	accessTokenUsageTimeout = 3 * time.Second
+)
+
+// FeedAccessConfig 비공개 피드 접근 제어 미들웨어의 설정입니다.
+type FeedAccessConfig struct {
+	// Providers 접근 제어 대상 공급자 목록입니다. 공급자나 게시판의 Private 설정으로 비공개 여부를 판단합니다.
+	Providers []*config.ProviderConfig
+
+	// Repository 접근 토큰을 조회하고 사용 기록을 남기는 저장소입니다.
+	// nil이면 토큰을 검증할 수 없으므로 토큰이 포함된 요청은 503 Service Unavailable로 거부되고, 비공개 피드는 열람할 수 없습니다.
+	Repository feed.AccessTokenRepository
+}
+
+// AccessTokenExtractor 요청 주소에 포함된 접근 토큰을 분리하여 컨텍스트에 저장하는 미들웨어를 반환합니다.
+//
+// 라우팅보다 먼저 실행되어야 하므로 e.Pre()로 등록합니다. 토큰은 다음 두 가지 형식으로 전달할 수 있습니다.
+//   - 경로 접두사: /t/{token}/ludypang.xml
+//   - 쿼리 파라미터: /ludypang.xml?token={token}
+//
+// 분리한 토큰은 요청 주소(URL, RequestURI)에서 제거되므로, 이후의 라우팅은 토큰이 없는 주소로 수행되고
+// HTTPLogger 등 요청 주소를 기록하는 미들웨어의 로그에도 토큰 원문이 남지 않습니다.
+func AccessTokenExtractor() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if secret := extractAccessToken(c.Request().URL); secret != "" {
+				httputil.SetAccessTokenSecret(c, secret)
+				c.Request().RequestURI = c.Request().URL.RequestURI()
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// extractAccessToken u에서 접근 토큰을 분리하여 반환합니다. 두 형식이 함께 사용되면 경로의 토큰을 우선합니다.
+func extractAccessToken(u *url.URL) string {
+	var secret string
+
+	if rest, ok := strings.CutPrefix(u.EscapedPath(), httputil.AccessTokenPathPrefix); ok {
+		token, remainder, _ := strings.Cut(rest, "/")
+		if path, err := url.PathUnescape("/" + remainder); err == nil && token != "" {
+			secret = token
+			u.Path, u.RawPath = path, ""
+			if escaped := "/" + remainder; escaped != u.EscapedPath() {
+				u.RawPath = escaped
+			}
+		}
+	}
+
+	if q := u.Query(); q.Has(httputil.AccessTokenQueryParam) {
+		if secret == "" {
+			secret = q.Get(httputil.AccessTokenQueryParam)
+		}
+		q.Del(httputil.AccessTokenQueryParam)
+		u.RawQuery = q.Encode()
+	}
+
+	return secret
+}
+
+// FeedAccess 비공개로 설정된 공급자와 게시판에 대한 접근을 제어하는 미들웨어를 반환합니다.
+//
+// 라우팅 이후 경로 파라미터(:id)로 요청 대상 공급자를 알아야 하므로 e.Use()로 등록하며,
+// AccessTokenExtractor가 먼저 등록되어 있어야 합니다. 처리 순서는 다음과 같습니다.
+//
+//  1. 관리(/admin), API 문서(/swagger) 경로는 검사하지 않습니다.
+//  2. 요청에 토큰이 있으면 저장소에서 조회하여 폐기·만료 여부를 확인하고, 유효하지 않으면 401 Unauthorized로 거부합니다.
+//     유효한 토큰은 핸들러가 열람 범위를 판단할 수 있도록 컨텍스트에 저장합니다.
+//  3. 요청 대상이 비공개 공급자인데 토큰이 없으면 401 Unauthorized, 토큰의 범위에 공급자가 없으면 403 Forbidden으로 거부합니다.
+//  4. 통과한 요청에 토큰이 있으면 사용 기록을 남깁니다. (기록 실패는 경고 로그만 남기고 요청은 계속 처리합니다)
+//
+// 공개 공급자의 비공개 게시판은 이 미들웨어가 거부하지 않으며, 각 핸들러가 httputil.CanViewBoard로 토큰의 범위에 없는 게시판을 제외합니다.
+func FeedAccess(cfg FeedAccessConfig) echo.MiddlewareFunc {
+	providers := make(map[string]*config.ProviderConfig, len(cfg.Providers))
+	for _, p := range cfg.Providers {
+		providers[strings.ToLower(p.ID)] = p
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if strings.HasPrefix(c.Path(), "/admin") || strings.HasPrefix(c.Path(), "/swagger") {
+				return next(c)
+			}
+
+			secret := httputil.AccessTokenSecret(c)
+			if secret != "" {
+				if cfg.Repository == nil {
+					return httputil.NewServiceUnavailableError("현재 저장소는 접근 토큰을 지원하지 않습니다")
+				}
+
+				token, err := cfg.Repository.GetAccessTokenByHash(c.Request().Context(), feed.HashAccessToken(secret))
+				if err != nil {
+					applog.WithComponentAndFields(componentFeedAccess, applog.Fields{
+						"path":  c.Request().URL.Path,
+						"error": err,
+					}).Error("접근 토큰 조회 실패")
+
+					return httputil.NewInternalServerError("접근 토큰을 확인하는 과정에서 시스템 내부 오류가 발생했습니다")
+				}
+				if token == nil || !token.IsActive(time.Now()) {
+					applog.WithComponentAndFields(componentFeedAccess, applog.Fields{
+						"path":      c.Request().URL.Path,
+						"remote_ip": c.RealIP(),
+					}).Warn("접근 거부: 존재하지 않거나 폐기 또는 만료된 접근 토큰입니다")
+
+					return httputil.NewUnauthorizedError("유효하지 않거나 만료된 접근 토큰입니다")
+				}
+
+				httputil.SetAccessToken(c, token)
+			}
+
+			id := strings.TrimSuffix(strings.ToLower(c.Param("id")), ".xml")
+			if p, ok := providers[id]; ok && !httputil.CanViewProvider(c, p) {
+				if secret == "" {
+					return httputil.NewUnauthorizedError("비공개 피드입니다. 발급받은 접근 토큰을 포함한 주소로 요청해 주시기 바랍니다.")
+				}
+
+				applog.WithComponentAndFields(componentFeedAccess, applog.Fields{
+					"feed_id":  p.ID,
+					"token_id": httputil.AccessToken(c).ID,
+				}).Warn("접근 거부: 토큰의 범위에 포함되지 않은 비공개 피드입니다")
+
+				return httputil.NewForbiddenError("이 접근 토큰으로는 요청하신 피드를 열람할 수 없습니다")
+			}
+
+			if token := httputil.AccessToken(c); token != nil {
+				recordAccessTokenUsage(c, cfg.Repository, token)
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// recordAccessTokenUsage 토큰 사용 기록을 저장합니다. 저장에 실패하더라도 요청 처리는 계속되도록 경고 로그만 남깁니다.
+func recordAccessTokenUsage(c echo.Context, repo feed.AccessTokenRepository, token *feed.AccessToken) {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), accessTokenUsageTimeout)
+	defer cancel()
+
+	usage := &feed.AccessTokenUsage{
+		TokenID:   token.ID,
+		Path:      c.Request().URL.Path,
+		RemoteIP:  c.RealIP(),
+		UserAgent: c.Request().UserAgent(),
+		UsedAt:    time.Now(),
+	}
+	if err := repo.RecordAccessTokenUsage(ctx, usage, accessTokenUsageKeep); err != nil {
+		applog.WithComponentAndFields(componentFeedAccess, applog.Fields{
+			"token_id": token.ID,
+			"error":    err,
+		}).Warn("접근 토큰 사용 기록 저장 실패")
+	}
+}
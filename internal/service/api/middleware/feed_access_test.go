@@ -0,0 +1,194 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/darkkaiser/rss-feed-server/internal/config"
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+	"github.com/darkkaiser/rss-feed-server/internal/service/api/httputil"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// =============================================================================
+// 비공개 피드 접근 제어 미들웨어 테스트
+// =============================================================================
+
+// mockAccessTokenRepository 해시로 토큰을 찾고 사용 기록을 모아 두는 테스트용 저장소입니다.
+type mockAccessTokenRepository struct {
+	feed.AccessTokenRepository
+
+	tokens map[string]*feed.AccessToken
+	err    error
+
+	usages []*feed.AccessTokenUsage
+}
+
+func (m *mockAccessTokenRepository) GetAccessTokenByHash(_ context.Context, hash string) (*feed.AccessToken, error) {
+	return m.tokens[hash], m.err
+}
+
+func (m *mockAccessTokenRepository) RecordAccessTokenUsage(_ context.Context, usage *feed.AccessTokenUsage, _ int) error {
+	m.usages = append(m.usages, usage)
+	return nil
+}
+
+func newFeedAccessTestServer(repo feed.AccessTokenRepository) *echo.Echo {
+	providers := []*config.ProviderConfig{
+		{ID: "public", Config: &config.ProviderDetailConfig{Boards: []*config.BoardConfig{{ID: "1"}, {ID: "2", Private: true}}}},
+		{ID: "private", Config: &config.ProviderDetailConfig{Private: true, Boards: []*config.BoardConfig{{ID: "1"}}}},
+	}
+
+	e := echo.New()
+	e.Pre(AccessTokenExtractor())
+	e.Use(FeedAccess(FeedAccessConfig{Providers: providers, Repository: repo}))
+
+	handler := func(c echo.Context) error {
+		tokenID := int64(0)
+		if token := httputil.AccessToken(c); token != nil {
+			tokenID = token.ID
+		}
+		return c.JSON(http.StatusOK, map[string]any{"path": c.Request().URL.Path, "uri": c.Request().RequestURI, "token_id": tokenID, "link": httputil.AccessTokenPath(c)})
+	}
+	e.GET("/", handler)
+	e.GET("/:id", handler)
+	e.GET("/:id/articles/:boardID/:articleID", handler)
+	e.GET("/admin/tokens/:id", handler)
+
+	return e
+}
+
+func TestExtractAccessToken(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		target   string
+		secret   string
+		expected string
+	}{
+		{"토큰 없음", "/ludypang.xml?board=1", "", "/ludypang.xml?board=1"},
+		{"경로 접두사", "/t/rft_abc/ludypang.xml", "rft_abc", "/ludypang.xml"},
+		{"경로 접두사만 있으면 요약 페이지", "/t/rft_abc", "rft_abc", "/"},
+		{"쿼리 파라미터", "/ludypang.xml?token=rft_abc&board=1", "rft_abc", "/ludypang.xml?board=1"},
+		{"경로의 토큰을 우선하고 쿼리 파라미터도 제거한다", "/t/rft_abc/ludypang.xml?token=rft_xyz", "rft_abc", "/ludypang.xml"},
+		{"인코딩된 경로를 보존한다", "/t/rft_abc/p1/articles/%EA%B3%B5%EC%A7%80/1", "rft_abc", "/p1/articles/%EA%B3%B5%EC%A7%80/1"},
+		{"빈 토큰은 무시한다", "/t//ludypang.xml", "", "/t//ludypang.xml"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			u, err := url.Parse(tt.target)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.secret, extractAccessToken(u))
+			assert.Equal(t, tt.expected, u.RequestURI())
+		})
+	}
+}
+
+func TestFeedAccess(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	newRepo := func() *mockAccessTokenRepository {
+		return &mockAccessTokenRepository{tokens: map[string]*feed.AccessToken{
+			feed.HashAccessToken("all"):     {ID: 1, Scopes: []string{"*"}},
+			feed.HashAccessToken("public"):  {ID: 2, Scopes: []string{"public/2"}},
+			feed.HashAccessToken("expired"): {ID: 3, Scopes: []string{"*"}, ExpiresAt: now.Add(-time.Hour)},
+			feed.HashAccessToken("revoked"): {ID: 4, Scopes: []string{"*"}, RevokedAt: now.Add(-time.Hour)},
+		}}
+	}
+
+	tests := []struct {
+		name    string
+		target  string
+		status  int
+		tokenID int64
+	}{
+		{"공개 공급자는 토큰 없이 열람할 수 있다", "/public.xml", http.StatusOK, 0},
+		{"비공개 공급자는 토큰이 없으면 401", "/private.xml", http.StatusUnauthorized, 0},
+		{"비공개 공급자의 하위 경로도 401", "/private/articles/1/10", http.StatusUnauthorized, 0},
+		{"범위에 포함된 토큰은 열람할 수 있다 (경로)", "/t/all/private.xml", http.StatusOK, 1},
+		{"범위에 포함된 토큰은 열람할 수 있다 (쿼리)", "/private.xml?token=all", http.StatusOK, 1},
+		{"범위 밖의 공급자는 403", "/t/public/private.xml", http.StatusForbidden, 0},
+		{"공개 공급자는 비공개 게시판 범위의 토큰으로도 열람할 수 있다", "/t/public/public.xml", http.StatusOK, 2},
+		{"만료된 토큰은 401", "/t/expired/public.xml", http.StatusUnauthorized, 0},
+		{"폐기된 토큰은 401", "/t/revoked/public.xml", http.StatusUnauthorized, 0},
+		{"존재하지 않는 토큰은 401", "/public.xml?token=unknown", http.StatusUnauthorized, 0},
+		{"관리 경로는 검사하지 않는다", "/admin/tokens/private?token=unknown", http.StatusOK, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := newRepo()
+			rec := httptest.NewRecorder()
+			newFeedAccessTestServer(repo).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.target, nil))
+
+			require.Equal(t, tt.status, rec.Code, rec.Body.String())
+			if tt.status != http.StatusOK {
+				assert.Empty(t, repo.usages, "거부된 요청은 사용 기록을 남기지 않아야 합니다")
+				return
+			}
+
+			var body struct {
+				TokenID int64 `json:"token_id"`
+			}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+			assert.Equal(t, tt.tokenID, body.TokenID)
+			if tt.tokenID != 0 {
+				assert.Len(t, repo.usages, 1)
+			}
+		})
+	}
+}
+
+func TestFeedAccess_TokenRedaction(t *testing.T) {
+	repo := &mockAccessTokenRepository{tokens: map[string]*feed.AccessToken{
+		feed.HashAccessToken("rft_secret"): {ID: 7, Scopes: []string{"private"}},
+	}}
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/private.xml?token=rft_secret&board=1", nil)
+	req.Header.Set("User-Agent", "reader")
+	newFeedAccessTestServer(repo).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"path":"/private.xml","uri":"/private.xml?board=1","token_id":7,"link":"/t/rft_secret"}`, rec.Body.String(),
+		"토큰은 요청 주소에서 제거되고, 생성하는 링크에는 경로 형식으로 이어 붙여야 합니다")
+
+	require.Len(t, repo.usages, 1)
+	assert.Equal(t, int64(7), repo.usages[0].TokenID)
+	assert.Equal(t, "/private.xml", repo.usages[0].Path)
+	assert.Equal(t, "reader", repo.usages[0].UserAgent)
+}
+
+func TestFeedAccess_Unavailable(t *testing.T) {
+	t.Parallel()
+
+	t.Run("토큰 저장소가 없으면 토큰이 포함된 요청은 503", func(t *testing.T) {
+		t.Parallel()
+
+		rec := httptest.NewRecorder()
+		newFeedAccessTestServer(nil).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/t/all/public.xml", nil))
+		assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	})
+
+	t.Run("토큰 조회에 실패하면 500", func(t *testing.T) {
+		t.Parallel()
+
+		rec := httptest.NewRecorder()
+		newFeedAccessTestServer(&mockAccessTokenRepository{err: errors.New("db error")}).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/t/all/public.xml", nil))
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
@@ -31,6 +31,7 @@ var sensitiveQueryParams = []string{
 // 기록되는 정보:
 //   - 요청: IP, 메서드, URI, User-Agent, Content-Length
 //   - 응답: 상태 코드, 응답 크기, Request ID
+//   - 추적: Trace ID, Span ID (Tracing 미들웨어가 바깥쪽에 등록된 경우)
 //   - 성능: 처리 시간 (마이크로초 및 사람이 읽기 쉬운 형식)
 //   - 보안: 민감한 쿼리 파라미터 자동 마스킹 (app_key, password 등)
 //
@@ -82,7 +83,8 @@ func httpLoggerHandler(c echo.Context, next echo.HandlerFunc) error {
 		uri := maskSensitiveQueryParams(req.RequestURI)
 
 		// 구조화된 로그 기록
-		applog.WithFields(applog.Fields{
+		// 요청 컨텍스트를 연결하여, 추적이 활성화된 경우 trace_id와 span_id가 함께 기록되도록 합니다.
+		applog.WithContext(req.Context()).WithFields(applog.Fields{
 			// 시간 정보
 			"time_rfc3339": stop.Format(time.RFC3339),
 
@@ -0,0 +1,50 @@
+package middleware
+
+import (
+	"net"
+	"net/http"
+
+	applog "github.com/darkkaiser/notify-server/pkg/log"
+	"github.com/darkkaiser/rss-feed-server/internal/service/api/httputil"
+	"github.com/labstack/echo/v4"
+)
+
+// componentLoopbackOnly 로컬 접근 제한 미들웨어의 로깅용 컴포넌트 이름
+const componentLoopbackOnly = "api.middleware.loopback_only"
+
+// LoopbackOnly 서버와 같은 호스트(루프백 주소)에서 들어온 요청만 통과시키는 미들웨어를 반환합니다.
+//
+// 관리자 전용 엔드포인트처럼 외부에 노출되어서는 안 되는 라우트 그룹에 적용합니다.
+// 판정에는 TCP 연결의 실제 원격 주소(RemoteAddr)만 사용하며, 클라이언트가 임의로 조작할 수 있는
+// X-Forwarded-For / X-Real-IP 헤더는 신뢰하지 않습니다.
+//
+// 사용 예시:
+//
+//	g := e.Group("/admin", middleware.LoopbackOnly())
+func LoopbackOnly() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if !isLoopbackRequest(c.Request()) {
+				applog.WithComponentAndFields(componentLoopbackOnly, applog.Fields{
+					"path":        c.Request().URL.Path,
+					"remote_addr": c.Request().RemoteAddr,
+				}).Warn("로컬 전용 엔드포인트 접근 거부: 루프백 주소가 아닌 요청입니다")
+
+				return httputil.NewForbiddenError("이 엔드포인트는 서버 로컬에서만 접근할 수 있습니다")
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// isLoopbackRequest TCP 연결의 원격 주소(RemoteAddr)가 루프백 주소인지 여부를 반환합니다.
+func isLoopbackRequest(r *http.Request) bool {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+// =============================================================================
+// 로컬 접근 제한 미들웨어 테스트
+// =============================================================================
+
+// TestLoopbackOnly는 루프백 주소에서 들어온 요청만 통과되는지 검증합니다.
+func TestLoopbackOnly(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		remoteAddr    string
+		forwardedFor  string
+		expectedAllow bool
+	}{
+		{name: "IPv4 루프백", remoteAddr: "127.0.0.1:54321", expectedAllow: true},
+		{name: "IPv6 루프백", remoteAddr: "[::1]:54321", expectedAllow: true},
+		{name: "외부 주소", remoteAddr: "203.0.113.10:54321", expectedAllow: false},
+		{name: "X-Forwarded-For 헤더 위조는 무시", remoteAddr: "203.0.113.10:54321", forwardedFor: "127.0.0.1", expectedAllow: false},
+		{name: "해석할 수 없는 주소", remoteAddr: "unknown", expectedAllow: false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/admin/snapshots", nil)
+			req.RemoteAddr = tt.remoteAddr
+			if tt.forwardedFor != "" {
+				req.Header.Set(echo.HeaderXForwardedFor, tt.forwardedFor)
+			}
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			called := false
+			err := LoopbackOnly()(func(c echo.Context) error {
+				called = true
+				return c.NoContent(http.StatusOK)
+			})(c)
+
+			assert.Equal(t, tt.expectedAllow, called)
+			if tt.expectedAllow {
+				assert.NoError(t, err)
+				return
+			}
+
+			he, ok := err.(*echo.HTTPError)
+			if assert.True(t, ok) {
+				assert.Equal(t, http.StatusForbidden, he.Code)
+			}
+		})
+	}
+}
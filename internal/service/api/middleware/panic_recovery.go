@@ -51,7 +51,7 @@ func PanicRecovery() echo.MiddlewareFunc {
 					}
 
 					// 4. 패닉 로그 기록
-					applog.WithComponentAndFields(componentPanicRecovery, fields).Error("패닉 복구: 예기치 못한 오류가 발생하여 안전하게 복구했습니다")
+					applog.WithComponentAndFields(componentPanicRecovery, fields).WithContext(c.Request().Context()).Error("패닉 복구: 예기치 못한 오류가 발생하여 안전하게 복구했습니다")
 
 					// 5. Echo 에러 핸들러로 전달 (HTTP 500 응답)
 					c.Error(err)
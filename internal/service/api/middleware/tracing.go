@@ -0,0 +1,79 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/darkkaiser/rss-feed-server/internal/tracing"
+	"github.com/labstack/echo/v4"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.39.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Tracing 요청마다 OpenTelemetry 서버 Span을 생성하는 미들웨어를 반환합니다.
+//
+// 기록되는 정보:
+//   - Span 이름: "{메서드} {라우트 패턴}" (예: "GET /:id") — 요청 주소 대신 라우트 패턴을 사용하여 이름의 종류가 무한히 늘어나지 않게 합니다.
+//   - 요청: 메서드, 경로, 클라이언트 IP, User-Agent
+//   - 응답: 상태 코드, Request ID (기존 로그의 request_id와 추적을 이어서 볼 수 있도록 기록)
+//   - 5xx 응답은 Span 상태를 Error로 설정합니다.
+//
+// 요청 헤더에 W3C Trace Context(traceparent)가 있으면 상위 추적을 이어받습니다.
+// Span은 요청 컨텍스트에 담겨 핸들러와 저장소 호출까지 전달되므로, 하위 Span과 c.Request().Context()를 연결한 로그에 같은 trace_id가 기록됩니다.
+//
+// HTTPLogger의 요청 로그에도 trace_id가 기록되도록 HTTPLogger보다 먼저(바깥쪽에) 등록해야 합니다.
+//
+// 사용 예시:
+//
+//	e := echo.New()
+//	e.Use(middleware.Tracing())
+//	e.Use(middleware.HTTPLogger())
+func Tracing() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+
+			// 상위 서비스(리버스 프록시 등)가 전달한 추적 컨텍스트를 이어받습니다.
+			ctx := otel.GetTextMapPropagator().Extract(req.Context(), propagation.HeaderCarrier(req.Header))
+
+			spanName := req.Method
+			if route := c.Path(); route != "" {
+				spanName += " " + route
+			}
+
+			ctx, span := tracing.Tracer().Start(ctx, spanName,
+				trace.WithSpanKind(trace.SpanKindServer),
+				trace.WithAttributes(
+					semconv.HTTPRequestMethodKey.String(req.Method),
+					semconv.HTTPRoute(c.Path()),
+					semconv.URLPath(req.URL.Path),
+					semconv.ClientAddress(c.RealIP()),
+					semconv.UserAgentOriginal(req.UserAgent()),
+				),
+			)
+			defer span.End()
+
+			c.SetRequest(req.WithContext(ctx))
+
+			// 에러 응답의 상태 코드를 기록하기 위해 HTTPLogger와 같은 방식으로 여기서 에러 핸들러를 호출합니다.
+			if err := next(c); err != nil {
+				span.RecordError(err)
+				c.Error(err)
+			}
+
+			res := c.Response()
+			span.SetAttributes(
+				semconv.HTTPResponseStatusCode(res.Status),
+				attribute.String("request_id", res.Header().Get(echo.HeaderXRequestID)),
+			)
+			if res.Status >= http.StatusInternalServerError {
+				span.SetStatus(codes.Error, http.StatusText(res.Status))
+			}
+
+			return nil
+		}
+	}
+}
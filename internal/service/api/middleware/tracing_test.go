@@ -0,0 +1,152 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	applog "github.com/darkkaiser/notify-server/pkg/log"
+	"github.com/darkkaiser/rss-feed-server/internal/tracing"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// =============================================================================
+// 분산 추적 미들웨어 테스트
+// =============================================================================
+
+// setupTracing 전역 TracerProvider와 전파기를 기록용으로 교체하고, 테스트 종료 시 원래대로 되돌립니다.
+// 전역 상태를 변경하므로 이 헬퍼를 사용하는 테스트는 t.Parallel()을 사용하지 않습니다.
+func setupTracing(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+
+	recorder := tracetest.NewSpanRecorder()
+	originalProvider := otel.GetTracerProvider()
+	originalPropagator := otel.GetTextMapPropagator()
+
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	t.Cleanup(func() {
+		otel.SetTracerProvider(originalProvider)
+		otel.SetTextMapPropagator(originalPropagator)
+	})
+
+	return recorder
+}
+
+func findAttr(attrs []attribute.KeyValue, key attribute.Key) attribute.Value {
+	for _, kv := range attrs {
+		if kv.Key == key {
+			return kv.Value
+		}
+	}
+	return attribute.Value{}
+}
+
+// TestTracing_ServerSpan 라우트 패턴 기반의 서버 Span이 생성되고 응답 정보가 기록되는지 검증합니다.
+func TestTracing_ServerSpan(t *testing.T) {
+	recorder := setupTracing(t)
+
+	e := echo.New()
+	e.Use(Tracing())
+	e.Use(middleware.RequestID())
+
+	var handlerSpan trace.SpanContext
+	e.GET("/api/v1/providers/:id", func(c echo.Context) error {
+		handlerSpan = trace.SpanContextFromContext(c.Request().Context())
+		return c.NoContent(http.StatusNoContent)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/providers/naver-cafe", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	span := spans[0]
+
+	assert.Equal(t, "GET /api/v1/providers/:id", span.Name())
+	assert.Equal(t, trace.SpanKindServer, span.SpanKind())
+	assert.Equal(t, "/api/v1/providers/:id", findAttr(span.Attributes(), "http.route").AsString())
+	assert.Equal(t, int64(http.StatusNoContent), findAttr(span.Attributes(), "http.response.status_code").AsInt64())
+	assert.Equal(t, rec.Header().Get(echo.HeaderXRequestID), findAttr(span.Attributes(), "request_id").AsString())
+	assert.Equal(t, codes.Unset, span.Status().Code)
+
+	// 핸들러는 Span이 담긴 요청 컨텍스트를 전달받아야 합니다.
+	assert.Equal(t, span.SpanContext().SpanID(), handlerSpan.SpanID())
+}
+
+// TestTracing_PropagatesParent 요청 헤더의 traceparent로 전달된 상위 추적을 이어받는지 검증합니다.
+func TestTracing_PropagatesParent(t *testing.T) {
+	recorder := setupTracing(t)
+
+	e := echo.New()
+	e.Use(Tracing())
+	e.GET("/", func(c echo.Context) error { return c.NoContent(http.StatusOK) })
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	e.ServeHTTP(httptest.NewRecorder(), req)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", spans[0].SpanContext().TraceID().String())
+	assert.Equal(t, "00f067aa0ba902b7", spans[0].Parent().SpanID().String())
+}
+
+// TestTracing_ServerError 5xx 응답과 핸들러 에러가 Span에 에러로 기록되는지 검증합니다.
+func TestTracing_ServerError(t *testing.T) {
+	recorder := setupTracing(t)
+
+	e := echo.New()
+	e.Use(Tracing())
+	e.GET("/boom", func(c echo.Context) error {
+		return errors.New("boom")
+	})
+
+	e.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/boom", nil))
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	assert.Equal(t, codes.Error, spans[0].Status().Code)
+	require.NotEmpty(t, spans[0].Events())
+	assert.Equal(t, "exception", spans[0].Events()[0].Name)
+}
+
+// TestTracing_HTTPLoggerTraceID Tracing 미들웨어 안쪽의 HTTP 로그에 trace_id가 기록되는지 검증합니다.
+func TestTracing_HTTPLoggerTraceID(t *testing.T) {
+	recorder := setupTracing(t)
+	buf := captureLogs(t)
+
+	hooks := make(map[applog.Level][]applog.Hook)
+	for level, hs := range applog.StandardLogger().Hooks {
+		hooks[level] = append([]applog.Hook(nil), hs...)
+	}
+	t.Cleanup(func() { applog.StandardLogger().ReplaceHooks(hooks) })
+	tracing.InstallLogHook(applog.StandardLogger())
+
+	e := echo.New()
+	e.Use(Tracing())
+	e.Use(HTTPLogger())
+	e.GET("/", func(c echo.Context) error { return c.NoContent(http.StatusOK) })
+
+	e.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+
+	entry := parseLastLogEntry(t, buf)
+	assert.Equal(t, spans[0].SpanContext().TraceID().String(), entry[tracing.LogFieldTraceID])
+	assert.Equal(t, spans[0].SpanContext().SpanID().String(), entry[tracing.LogFieldSpanID])
+}
@@ -0,0 +1,15 @@
+package request
+
+import "time"
+
+// CreateAccessTokenRequest 비공개 피드 접근 토큰 발급 요청
+type CreateAccessTokenRequest struct {
+	// Name 토큰을 발급받을 구독자를 구분하기 위한 이름
+	Name string `json:"name" example:"홍길동 (Inoreader)"`
+
+	// Scopes 토큰으로 접근할 수 있는 범위 목록 ('*', '<공급자 ID>', '<공급자 ID>/<게시판 ID>')
+	Scopes []string `json:"scopes" example:"ludypang,yeosu-cityhall/notice"`
+
+	// ExpiresAt 토큰 만료 일시 (생략 시 만료되지 않음)
+	ExpiresAt *time.Time `json:"expires_at,omitempty" example:"2025-03-15T00:00:00+09:00"`
+}
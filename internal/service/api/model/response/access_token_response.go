@@ -0,0 +1,107 @@
+package response
+
+import (
+	"time"
+
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+)
+
+// AccessTokenResponse 비공개 피드 접근 토큰 정보 (토큰 원문과 해시는 포함하지 않습니다)
+type AccessTokenResponse struct {
+	// ID 토큰 고유 식별자
+	ID int64 `json:"id" example:"3"`
+
+	// Name 토큰을 발급받은 구독자 이름
+	Name string `json:"name" example:"홍길동 (Inoreader)"`
+
+	// Scopes 토큰으로 접근할 수 있는 범위 목록
+	Scopes []string `json:"scopes" example:"ludypang,yeosu-cityhall/notice"`
+
+	// Active 폐기되지 않았고 만료되지 않아 현재 사용할 수 있는지 여부
+	Active bool `json:"active" example:"true"`
+
+	// ExpiresAt 토큰 만료 일시 (만료되지 않는 토큰은 생략)
+	ExpiresAt *time.Time `json:"expires_at,omitempty" example:"2025-03-15T00:00:00+09:00"`
+
+	// CreatedAt 토큰 발급 일시
+	CreatedAt time.Time `json:"created_at" example:"2024-03-15T09:30:00+09:00"`
+
+	// LastUsedAt 토큰이 마지막으로 사용된 일시 (사용된 적이 없으면 생략)
+	LastUsedAt *time.Time `json:"last_used_at,omitempty" example:"2024-03-16T07:00:00+09:00"`
+
+	// RevokedAt 토큰 폐기 일시 (폐기되지 않았으면 생략)
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+}
+
+// AccessTokenListResponse 접근 토큰 목록 응답
+type AccessTokenListResponse struct {
+	// ResultCode 처리 결과 코드 (0: 성공)
+	ResultCode int `json:"result_code" example:"0"`
+
+	// Tokens 발급 순으로 정렬된 토큰 목록
+	Tokens []AccessTokenResponse `json:"tokens"`
+}
+
+// AccessTokenCreatedResponse 접근 토큰 발급 응답
+type AccessTokenCreatedResponse struct {
+	// ResultCode 처리 결과 코드 (0: 성공)
+	ResultCode int `json:"result_code" example:"0"`
+
+	// Token 발급된 토큰 정보
+	Token AccessTokenResponse `json:"token"`
+
+	// Secret 토큰 원문. 서버에는 해시만 저장되므로 이 응답에서만 확인할 수 있습니다.
+	Secret string `json:"secret" example:"rft_3q2-7wEvx3tJd9kY0aQ1bZc8LmN4pR6s"`
+}
+
+// AccessTokenUsageResponse 접근 토큰 사용 기록
+type AccessTokenUsageResponse struct {
+	// Path 요청 경로 (토큰 제외)
+	Path string `json:"path" example:"/ludypang.xml"`
+
+	// RemoteIP 요청한 클라이언트의 IP 주소
+	RemoteIP string `json:"remote_ip" example:"203.0.113.10"`
+
+	// UserAgent 요청한 클라이언트의 User-Agent
+	UserAgent string `json:"user_agent" example:"Inoreader/1.0"`
+
+	// UsedAt 요청 일시
+	UsedAt time.Time `json:"used_at" example:"2024-03-16T07:00:00+09:00"`
+}
+
+// AccessTokenUsageListResponse 접근 토큰 사용 기록 목록 응답
+type AccessTokenUsageListResponse struct {
+	// ResultCode 처리 결과 코드 (0: 성공)
+	ResultCode int `json:"result_code" example:"0"`
+
+	// TokenID 토큰 식별자
+	TokenID int64 `json:"token_id" example:"3"`
+
+	// Usages 최근 순으로 정렬된 사용 기록 목록
+	Usages []AccessTokenUsageResponse `json:"usages"`
+}
+
+// NewAccessTokenResponse 접근 토큰을 응답 모델로 변환합니다. now는 사용 가능 여부(Active)를 판단하는 기준 시각입니다.
+func NewAccessTokenResponse(t *feed.AccessToken, now time.Time) AccessTokenResponse {
+	r := AccessTokenResponse{
+		ID:        t.ID,
+		Name:      t.Name,
+		Scopes:    t.Scopes,
+		Active:    t.IsActive(now),
+		CreatedAt: t.CreatedAt,
+	}
+	if r.Scopes == nil {
+		r.Scopes = []string{}
+	}
+	if !t.ExpiresAt.IsZero() {
+		r.ExpiresAt = &t.ExpiresAt
+	}
+	if !t.LastUsedAt.IsZero() {
+		r.LastUsedAt = &t.LastUsedAt
+	}
+	if !t.RevokedAt.IsZero() {
+		r.RevokedAt = &t.RevokedAt
+	}
+
+	return r
+}
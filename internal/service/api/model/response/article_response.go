@@ -0,0 +1,97 @@
+package response
+
+import (
+	"time"
+
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+)
+
+// ArticleResponse 수집된 게시글
+type ArticleResponse struct {
+	// BoardID 게시판 식별자
+	BoardID string `json:"board_id" example:"notice"`
+
+	// BoardName 게시판 이름
+	BoardName string `json:"board_name" example:"공지사항"`
+
+	// ArticleID 게시글 식별자
+	ArticleID string `json:"article_id" example:"12345"`
+
+	// Title 게시글 제목
+	Title string `json:"title" example:"2024년 상반기 공지사항"`
+
+	// Content 게시글 본문
+	Content string `json:"content" example:"공지사항 본문입니다."`
+
+	// Link 게시글 원문 주소
+	Link string `json:"link" example:"https://www.yeosu.go.kr/www/govt/news/notice?mode=view&idx=12345"`
+
+	// Author 작성자
+	Author string `json:"author" example:"총무과"`
+
+	// CreatedAt 작성일시
+	CreatedAt time.Time `json:"created_at" example:"2024-03-15T09:30:00+09:00"`
+
+	// UpdatedAt 원문 수정이 감지된 가장 최근 일시 (수정이 감지되지 않았으면 생략)
+	UpdatedAt *time.Time `json:"updated_at,omitempty" example:"2024-03-16T10:00:00+09:00"`
+
+	// DeletedAt 원문 삭제가 감지된 일시 (삭제가 감지되지 않았으면 생략)
+	DeletedAt *time.Time `json:"deleted_at,omitempty" example:"2024-03-20T08:00:00+09:00"`
+
+	// DeletedReason 삭제 판정 근거 (removed: 원문 삭제, access_denied: 원문 접근 제한, 삭제가 감지되지 않았으면 생략)
+	DeletedReason string `json:"deleted_reason,omitempty" example:"removed"`
+}
+
+// NewArticleResponse 게시글을 응답 모델로 변환합니다. boardName은 현재 설정의 게시판 표시 이름이며, 비어 있으면 저장된 이름을 사용합니다.
+func NewArticleResponse(a *feed.Article, boardName string) ArticleResponse {
+	if boardName == "" {
+		boardName = a.BoardName
+	}
+
+	r := ArticleResponse{
+		BoardID:   a.BoardID,
+		BoardName: boardName,
+		ArticleID: a.ArticleID,
+		Title:     a.Title,
+		Content:   a.Content,
+		Link:      a.Link,
+		Author:    a.Author,
+		CreatedAt: a.CreatedAt,
+	}
+	if a.IsEdited() {
+		r.UpdatedAt = &a.UpdatedAt
+	}
+	if a.IsDeleted() {
+		r.DeletedAt = &a.DeletedAt
+		r.DeletedReason = string(a.DeletedReason)
+	}
+
+	return r
+}
+
+// ArticleListResponse 게시글 목록 조회 응답
+type ArticleListResponse struct {
+	// ResultCode 처리 결과 코드 (0: 성공)
+	ResultCode int `json:"result_code" example:"0"`
+
+	// ProviderID RSS 피드 공급자 식별자
+	ProviderID string `json:"provider_id" example:"yeosu-cityhall"`
+
+	// Articles 최신 작성일시 순으로 정렬된 게시글 목록
+	Articles []ArticleResponse `json:"articles"`
+
+	// NextCursor 다음 페이지를 조회할 때 cursor 파라미터로 전달할 값 (마지막 페이지이면 생략)
+	NextCursor string `json:"next_cursor,omitempty" example:"MjAyNC0wMy0xNVQwMDozMDowMFoKbm90aWNlCjEyMzQ1"`
+}
+
+// ArticleDetailResponse 게시글 단건 조회 응답
+type ArticleDetailResponse struct {
+	// ResultCode 처리 결과 코드 (0: 성공)
+	ResultCode int `json:"result_code" example:"0"`
+
+	// ProviderID RSS 피드 공급자 식별자
+	ProviderID string `json:"provider_id" example:"yeosu-cityhall"`
+
+	// Article 게시글
+	Article ArticleResponse `json:"article"`
+}
@@ -0,0 +1,81 @@
+package response
+
+import "time"
+
+// ParseSnapshotResponse 파싱 실패 스냅샷의 메타데이터
+type ParseSnapshotResponse struct {
+	// ID 스냅샷 고유 식별자
+	ID int64 `json:"id" example:"17"`
+
+	// ProviderID 파싱에 실패한 RSS 피드 공급자 식별자
+	ProviderID string `json:"provider_id" example:"yeosu-cityhall"`
+
+	// BoardID 파싱에 실패한 게시판 식별자 (게시판 구분 없이 수집하는 공급자는 빈 문자열)
+	BoardID string `json:"board_id" example:"notice"`
+
+	// Page 파싱에 실패한 목록 페이지 번호
+	Page int `json:"page" example:"1"`
+
+	// URL 응답을 요청한 주소
+	URL string `json:"url" example:"https://www.yeosu.go.kr/www/govt/news/notice?page=1"`
+
+	// Header 응답을 얻기 위해 전송한 요청 헤더
+	Header map[string][]string `json:"header,omitempty"`
+
+	// ContentType 스냅샷 본문의 미디어 타입
+	ContentType string `json:"content_type" example:"text/html; charset=utf-8"`
+
+	// Truncated 본문이 저장 용량 제한으로 잘렸는지 여부
+	Truncated bool `json:"truncated" example:"false"`
+
+	// Error 파싱 실패 사유
+	Error string `json:"error" example:"3번째 행: [ParsingFailed] 지원되지 않는 작성일 데이터 포맷('홍길동')이 감지되어 시간 변환에 실패하였습니다."`
+
+	// CreatedAt 스냅샷 기록 일시
+	CreatedAt time.Time `json:"created_at" example:"2024-03-15T09:30:00+09:00"`
+}
+
+// ParseSnapshotListResponse 파싱 실패 스냅샷 목록 응답
+type ParseSnapshotListResponse struct {
+	// ResultCode 처리 결과 코드 (0: 성공)
+	ResultCode int `json:"result_code" example:"0"`
+
+	// Snapshots 최근 기록 순으로 정렬된 스냅샷 목록
+	Snapshots []ParseSnapshotResponse `json:"snapshots"`
+}
+
+// ReplayedArticleResponse 스냅샷 재생으로 추출된 게시글
+type ReplayedArticleResponse struct {
+	// BoardID 게시판 식별자
+	BoardID string `json:"board_id" example:"notice"`
+
+	// ArticleID 게시글 식별자
+	ArticleID string `json:"article_id" example:"12345"`
+
+	// Title 게시글 제목
+	Title string `json:"title" example:"2024년 상반기 공지사항"`
+
+	// Author 작성자
+	Author string `json:"author" example:"총무과"`
+
+	// Link 게시글 상세 페이지 주소
+	Link string `json:"link" example:"https://www.yeosu.go.kr/www/govt/news/notice?mode=view&idx=12345"`
+
+	// CreatedAt 작성일
+	CreatedAt time.Time `json:"created_at" example:"2024-03-15T00:00:00+09:00"`
+}
+
+// ParseReplayResponse 파싱 실패 스냅샷 재생 결과 응답
+type ParseReplayResponse struct {
+	// ResultCode 처리 결과 코드 (0: 성공)
+	ResultCode int `json:"result_code" example:"0"`
+
+	// SnapshotID 재생한 스냅샷 식별자
+	SnapshotID int64 `json:"snapshot_id" example:"17"`
+
+	// Articles 현재 파서가 추출에 성공한 게시글 목록
+	Articles []ReplayedArticleResponse `json:"articles"`
+
+	// Errors 현재 파서가 추출에 실패한 행의 에러 메시지 목록
+	Errors []string `json:"errors"`
+}
@@ -0,0 +1,70 @@
+package response
+
+// BoardResponse 공급자가 수집하는 게시판
+type BoardResponse struct {
+	// ID 게시판 식별자
+	ID string `json:"id" example:"notice"`
+
+	// Name 게시판 이름
+	Name string `json:"name" example:"공지사항"`
+
+	// Category 게시판 분류 (설정되지 않았으면 생략)
+	Category string `json:"category,omitempty" example:"시정소식"`
+
+	// ArchiveDays 게시글 보관 일수 (0: 기간 제한 없음)
+	ArchiveDays uint `json:"archive_days" example:"90"`
+
+	// MaxItemCount 공급자 피드에 노출하는 이 게시판 게시글의 최대 수 (0: 제한 없음)
+	MaxItemCount uint `json:"max_item_count" example:"0"`
+}
+
+// ProviderResponse RSS 피드 공급자
+type ProviderResponse struct {
+	// ID RSS 피드 공급자 식별자
+	ID string `json:"id" example:"yeosu-cityhall"`
+
+	// Site 수집 대상 사이트 종류
+	Site string `json:"site" example:"YeosuCityHall"`
+
+	// Name 피드 이름
+	Name string `json:"name" example:"여수시청"`
+
+	// Description 피드 설명
+	Description string `json:"description" example:"여수시청 공지사항"`
+
+	// URL 수집 대상 사이트 주소
+	URL string `json:"url" example:"https://www.yeosu.go.kr"`
+
+	// FeedURL RSS 피드 구독 주소
+	FeedURL string `json:"feed_url" example:"https://rss.darkkaiser.com:3443/yeosu-cityhall"`
+
+	// Schedule 게시글 수집 스케줄 (초 단위를 포함한 6필드 Cron 표현식)
+	Schedule string `json:"schedule" example:"0 */10 * * * *"`
+
+	// ArchiveDays 게시글 보관 일수 (0: 기간 제한 없음)
+	ArchiveDays uint `json:"archive_days" example:"90"`
+
+	// MaxItemCount 피드에 노출하는 최대 게시글 수
+	MaxItemCount uint `json:"max_item_count" example:"100"`
+
+	// Boards 수집하는 게시판 목록
+	Boards []BoardResponse `json:"boards"`
+}
+
+// ProviderListResponse 공급자 목록 조회 응답
+type ProviderListResponse struct {
+	// ResultCode 처리 결과 코드 (0: 성공)
+	ResultCode int `json:"result_code" example:"0"`
+
+	// Providers 설정 파일에 정의된 순서의 공급자 목록
+	Providers []ProviderResponse `json:"providers"`
+}
+
+// ProviderDetailResponse 공급자 단건 조회 응답
+type ProviderDetailResponse struct {
+	// ResultCode 처리 결과 코드 (0: 성공)
+	ResultCode int `json:"result_code" example:"0"`
+
+	// Provider 공급자
+	Provider ProviderResponse `json:"provider"`
+}
@@ -0,0 +1,43 @@
+package response
+
+// DailyCountResponse 하루 동안 작성된 게시글 수
+type DailyCountResponse struct {
+	// Date 날짜 (서버 시간대 기준, YYYY-MM-DD)
+	Date string `json:"date" example:"2024-03-15"`
+
+	// Count 작성된 게시글 수
+	Count int64 `json:"count" example:"12"`
+}
+
+// BoardStatsResponse 게시판 하나의 게시글 수 통계
+type BoardStatsResponse struct {
+	// BoardID 게시판 식별자
+	BoardID string `json:"board_id" example:"notice"`
+
+	// BoardName 게시판 이름
+	BoardName string `json:"board_name" example:"공지사항"`
+
+	// Total 조회 기간 동안 작성된 게시글 수
+	Total int64 `json:"total" example:"34"`
+
+	// Daily 게시글이 있는 날짜별 게시글 수 (날짜 순)
+	Daily []DailyCountResponse `json:"daily"`
+}
+
+// ArticleStatsResponse 게시글 수 통계 조회 응답
+type ArticleStatsResponse struct {
+	// ResultCode 처리 결과 코드 (0: 성공)
+	ResultCode int `json:"result_code" example:"0"`
+
+	// ProviderID RSS 피드 공급자 식별자
+	ProviderID string `json:"provider_id" example:"yeosu-cityhall"`
+
+	// From 집계 시작 날짜 (포함)
+	From string `json:"from" example:"2024-03-01"`
+
+	// To 집계 끝 날짜 (포함)
+	To string `json:"to" example:"2024-03-30"`
+
+	// Boards 설정 파일에 정의된 순서의 게시판별 통계
+	Boards []BoardStatsResponse `json:"boards"`
+}
@@ -1,7 +1,11 @@
 package api
 
 import (
+	"github.com/darkkaiser/rss-feed-server/internal/config"
+	"github.com/darkkaiser/rss-feed-server/internal/service/api/handler/admin"
 	"github.com/darkkaiser/rss-feed-server/internal/service/api/handler/rss"
+	v1 "github.com/darkkaiser/rss-feed-server/internal/service/api/handler/v1"
+	"github.com/darkkaiser/rss-feed-server/internal/service/api/middleware"
 	"github.com/labstack/echo/v4"
 	echoSwagger "github.com/swaggo/echo-swagger"
 )
@@ -10,6 +14,8 @@ import (
 //
 // 이 함수는 다음과 같은 엔드포인트들을 설정합니다:
 //   - RSS 피드 서비스: RSS 요약 정보(/) 및 개별 RSS 피드(/:id) 제공
+//   - 과거 게시글: 게시글 이력 API(/:id/history) 및 날짜별 아카이브 피드(/:id/archive/:date) 제공
+//   - 게시글 페이지: 서버에 보관된 게시글 하나를 HTML로 제공 (/:id/articles/:boardID/:articleID)
 //   - API 문서: Swagger UI (/swagger/*) 제공
 func RegisterRoutes(e *echo.Echo, h *rss.Handler) {
 	registerRSSRoutes(e, h)
@@ -19,6 +25,55 @@ func RegisterRoutes(e *echo.Echo, h *rss.Handler) {
 func registerRSSRoutes(e *echo.Echo, h *rss.Handler) {
 	e.GET("/", h.ViewSummary)
 	e.GET("/:id", h.GetFeed)
+	e.GET("/:id/history", h.GetHistory)
+	e.GET("/:id/archive/:date", h.GetArchiveFeed)
+	e.GET("/:id/articles/:boardID/:articleID", h.ViewArticle)
+}
+
+// RegisterAPIRoutes JSON REST API 라우트를 /api/v1 그룹 아래에 등록합니다.
+//
+// 응답 형식을 바꿔야 하는 변경은 기존 클라이언트가 깨지지 않도록 새 버전 그룹(/api/v2)으로 추가합니다.
+//   - GET /api/v1/providers: 공급자 목록
+//   - GET /api/v1/providers/:id: 공급자 상세 (게시판, 수집 스케줄)
+//   - GET /api/v1/providers/:id/articles: 게시글 목록 (게시판, 기간 필터 및 커서 기반 페이지네이션)
+//   - GET /api/v1/providers/:id/boards/:boardID/articles/:articleID: 게시글 단건
+//   - GET /api/v1/providers/:id/stats: 게시판별, 날짜별 게시글 수
+func RegisterAPIRoutes(e *echo.Echo, h *v1.Handler) {
+	g := e.Group("/api/v1")
+
+	g.GET("/providers", h.ListProviders)
+	g.GET("/providers/:id", h.GetProvider)
+	g.GET("/providers/:id/articles", h.ListArticles)
+	g.GET("/providers/:id/boards/:boardID/articles/:articleID", h.GetArticle)
+	g.GET("/providers/:id/stats", h.GetStats)
+}
+
+// RegisterAdminRoutes 운영자 전용 관리 라우트를 /admin 그룹 아래에 등록합니다.
+//
+// 그룹의 모든 요청은 AdminAuth로 인증하고(인증 수단이 설정되지 않았으면 서버 로컬 요청만 허용),
+// 상태를 바꾸는 요청은 AdminAudit로 감사 로그를 남깁니다. 라우트별로 필요한 권한 등급은 다음과 같습니다.
+//   - GET    /admin/snapshots: 파싱 실패 스냅샷 목록 (viewer)
+//   - GET    /admin/snapshots/:id: 파싱 실패 스냅샷 원본 내려받기 (viewer)
+//   - POST   /admin/snapshots/:id/replay: 파싱 실패 스냅샷 재생 (operator)
+//   - GET    /admin/tokens: 비공개 피드 접근 토큰 목록 (viewer)
+//   - POST   /admin/tokens: 비공개 피드 접근 토큰 발급 (admin)
+//   - DELETE /admin/tokens/:id: 비공개 피드 접근 토큰 폐기 (admin)
+//   - GET    /admin/tokens/:id/usage: 비공개 피드 접근 토큰 사용 기록 (viewer)
+func RegisterAdminRoutes(e *echo.Echo, h *admin.Handler, authConfig config.AdminConfig) {
+	g := e.Group("/admin", middleware.AdminAuth(authConfig), middleware.AdminAudit())
+
+	viewer := middleware.RequireAdminRole(config.AdminRoleViewer)
+	operator := middleware.RequireAdminRole(config.AdminRoleOperator)
+	adminOnly := middleware.RequireAdminRole(config.AdminRoleAdmin)
+
+	g.GET("/snapshots", h.ListParseSnapshots, viewer)
+	g.GET("/snapshots/:id", h.DownloadParseSnapshot, viewer)
+	g.POST("/snapshots/:id/replay", h.ReplayParseSnapshot, operator)
+
+	g.GET("/tokens", h.ListAccessTokens, viewer)
+	g.POST("/tokens", h.CreateAccessToken, adminOnly)
+	g.DELETE("/tokens/:id", h.RevokeAccessToken, adminOnly)
+	g.GET("/tokens/:id/usage", h.ListAccessTokenUsage, viewer)
 }
 
 func registerSwaggerRoutes(e *echo.Echo) {
@@ -5,7 +5,10 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/darkkaiser/rss-feed-server/internal/config"
+	"github.com/darkkaiser/rss-feed-server/internal/service/api/handler/admin"
 	"github.com/darkkaiser/rss-feed-server/internal/service/api/handler/rss"
+	v1 "github.com/darkkaiser/rss-feed-server/internal/service/api/handler/v1"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -74,13 +77,22 @@ func TestRegisterRSSRoutes(t *testing.T) {
 		assert.True(t, routeExists(e, http.MethodGet, "/:id"))
 	})
 
+	t.Run("과거 게시글 라우트가 등록된다 (GET /:id/history, GET /:id/archive/:date)", func(t *testing.T) {
+		assert.True(t, routeExists(e, http.MethodGet, "/:id/history"))
+		assert.True(t, routeExists(e, http.MethodGet, "/:id/archive/:date"))
+	})
+
+	t.Run("게시글 페이지 라우트가 등록된다 (GET /:id/articles/:boardID/:articleID)", func(t *testing.T) {
+		assert.True(t, routeExists(e, http.MethodGet, "/:id/articles/:boardID/:articleID"))
+	})
+
 	t.Run("Swagger 라우트는 등록되지 않는다", func(t *testing.T) {
 		assert.False(t, routeExists(e, http.MethodGet, "/swagger/*"),
 			"registerRSSRoutes는 Swagger 라우트를 등록하면 안 된다")
 	})
 
-	t.Run("정확히 RSS 라우트 2개만 등록된다", func(t *testing.T) {
-		assert.Len(t, e.Routes(), 2, "RSS 라우트는 정확히 2개여야 한다")
+	t.Run("정확히 RSS 라우트 5개만 등록된다", func(t *testing.T) {
+		assert.Len(t, e.Routes(), 5, "RSS 라우트는 정확히 5개여야 한다")
 	})
 }
 
@@ -143,6 +155,27 @@ func TestRoutes_RoutingBehavior(t *testing.T) {
 				"id": "some-feed.xml",
 			},
 		},
+		{
+			name:         "GET /some-feed-id/archive/2024-03-15 요청은 아카이브 피드 라우트로 매핑된다",
+			method:       http.MethodGet,
+			requestPath:  "/some-feed-id/archive/2024-03-15",
+			expectedPath: "/:id/archive/:date",
+			expectedParams: map[string]string{
+				"id":   "some-feed-id",
+				"date": "2024-03-15",
+			},
+		},
+		{
+			name:         "GET /some-feed-id/articles/72/1234 요청은 게시글 페이지 라우트로 매핑된다",
+			method:       http.MethodGet,
+			requestPath:  "/some-feed-id/articles/72/1234",
+			expectedPath: "/:id/articles/:boardID/:articleID",
+			expectedParams: map[string]string{
+				"id":        "some-feed-id",
+				"boardID":   "72",
+				"articleID": "1234",
+			},
+		},
 		{
 			name:         "GET /swagger/index.html 요청은 Swagger 라우트로 매핑된다",
 			method:       http.MethodGet,
@@ -179,3 +212,95 @@ func TestRoutes_RoutingBehavior(t *testing.T) {
 		assert.Equal(t, echo.ErrMethodNotAllowed, err, "등록되지 않은 메서드는 405 에러 핸들러로 매핑되어야 합니다")
 	})
 }
+
+// =============================================================================
+// RegisterAPIRoutes 테스트
+// =============================================================================
+
+func TestRegisterAPIRoutes(t *testing.T) {
+	appConf := newTestAppConfig()
+
+	e := echo.New()
+	RegisterRoutes(e, newTestRSSHandler())
+	RegisterAPIRoutes(e, v1.New(&appConf.RSSFeed, &mockFeedRepository{}))
+
+	t.Run("REST API 라우트가 등록된다", func(t *testing.T) {
+		assert.True(t, routeExists(e, http.MethodGet, "/api/v1/providers"))
+		assert.True(t, routeExists(e, http.MethodGet, "/api/v1/providers/:id"))
+		assert.True(t, routeExists(e, http.MethodGet, "/api/v1/providers/:id/articles"))
+		assert.True(t, routeExists(e, http.MethodGet, "/api/v1/providers/:id/boards/:boardID/articles/:articleID"))
+		assert.True(t, routeExists(e, http.MethodGet, "/api/v1/providers/:id/stats"))
+	})
+
+	t.Run("RSS 피드 라우트(/:id/archive/:date)보다 REST API 라우트가 우선한다", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/providers", nil)
+		rec := httptest.NewRecorder()
+
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"result_code":0,"providers":[]}`, rec.Body.String())
+	})
+}
+
+// =============================================================================
+// RegisterAdminRoutes 테스트
+// =============================================================================
+
+func TestRegisterAdminRoutes(t *testing.T) {
+	e := echo.New()
+	RegisterAdminRoutes(e, admin.New(&mockFeedRepository{}, nil), config.AdminConfig{})
+
+	t.Run("파싱 실패 스냅샷 관리 라우트가 등록된다", func(t *testing.T) {
+		assert.True(t, routeExists(e, http.MethodGet, "/admin/snapshots"))
+		assert.True(t, routeExists(e, http.MethodGet, "/admin/snapshots/:id"))
+		assert.True(t, routeExists(e, http.MethodPost, "/admin/snapshots/:id/replay"))
+	})
+
+	t.Run("접근 토큰 관리 라우트가 등록된다", func(t *testing.T) {
+		assert.True(t, routeExists(e, http.MethodGet, "/admin/tokens"))
+		assert.True(t, routeExists(e, http.MethodPost, "/admin/tokens"))
+		assert.True(t, routeExists(e, http.MethodDelete, "/admin/tokens/:id"))
+		assert.True(t, routeExists(e, http.MethodGet, "/admin/tokens/:id/usage"))
+	})
+
+	t.Run("로컬이 아닌 주소에서의 요청은 403으로 거부된다", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/admin/snapshots", nil)
+		req.RemoteAddr = "203.0.113.10:51234"
+		rec := httptest.NewRecorder()
+
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	t.Run("로컬 주소에서의 요청은 핸들러까지 전달된다", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/admin/snapshots", nil)
+		req.RemoteAddr = "127.0.0.1:51234"
+		rec := httptest.NewRecorder()
+
+		e.ServeHTTP(rec, req)
+
+		// 스냅샷 기록을 지원하지 않는 저장소이므로 핸들러가 503을 반환합니다.
+		assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	})
+	t.Run("인증 수단이 설정되면 라우트별 권한 등급을 검사한다", func(t *testing.T) {
+		e := echo.New()
+		RegisterAdminRoutes(e, admin.New(&mockFeedRepository{}, nil), config.AdminConfig{
+			APIKeys: []*config.AdminAPIKeyConfig{{Name: "viewer", Key: "0123456789abcdef0123456789abcdef", Role: config.AdminRoleViewer}},
+		})
+
+		serve := func(method, target string) int {
+			req := httptest.NewRequest(method, target, nil)
+			req.RemoteAddr = "203.0.113.10:51234"
+			req.Header.Set("X-API-Key", "0123456789abcdef0123456789abcdef")
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+			return rec.Code
+		}
+
+		assert.Equal(t, http.StatusServiceUnavailable, serve(http.MethodGet, "/admin/tokens"), "viewer 등급은 조회할 수 있어야 합니다")
+		assert.Equal(t, http.StatusForbidden, serve(http.MethodPost, "/admin/tokens"))
+		assert.Equal(t, http.StatusForbidden, serve(http.MethodPost, "/admin/snapshots/1/replay"))
+	})
+}
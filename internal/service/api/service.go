@@ -15,7 +15,10 @@ import (
 	apperrors "github.com/darkkaiser/rss-feed-server/internal/errors"
 	"github.com/darkkaiser/rss-feed-server/internal/feed"
 	"github.com/darkkaiser/rss-feed-server/internal/service"
+	"github.com/darkkaiser/rss-feed-server/internal/service/api/handler/admin"
 	"github.com/darkkaiser/rss-feed-server/internal/service/api/handler/rss"
+	v1 "github.com/darkkaiser/rss-feed-server/internal/service/api/handler/v1"
+	appmiddleware "github.com/darkkaiser/rss-feed-server/internal/service/api/middleware"
 	"github.com/labstack/echo/v4"
 )
 
@@ -36,6 +39,8 @@ var (
 //   - Echo 기반 HTTP/HTTPS 서버 시작 및 종료
 //   - 미들웨어 체인 설정 (PanicRecovery, RequestID, RateLimit, HTTPLogger, CORS, Secure)
 //   - API 엔드포인트 라우팅 설정 (RSS 요약 정보, 개별 RSS 피드 제공)
+//   - REST API 라우팅 설정 (/api/v1: 공급자, 게시글, 통계 조회)
+//   - 관리 엔드포인트 라우팅 설정 (파싱 실패 스냅샷 조회 및 재생, 로컬 접근 전용)
 //   - Swagger UI 제공
 //   - 커스텀 HTTP 에러 핸들러 설정
 //   - 서비스 상태 관리 (시작/중지)
@@ -51,6 +56,9 @@ type Service struct {
 
 	notifyClient *notify.Client
 
+	// snapshotReplayer 파싱 실패 스냅샷 재생을 담당하는 컴포넌트입니다. (선택 사항)
+	snapshotReplayer admin.ParseSnapshotReplayer
+
 	running   bool
 	runningMu sync.Mutex
 }
@@ -79,6 +87,14 @@ func NewService(appConfig *config.AppConfig, feedRepo feed.Repository, notifyCli
 	}
 }
 
+// SetParseSnapshotReplayer 관리 API의 파싱 실패 스냅샷 재생 요청을 처리할 컴포넌트를 설정합니다.
+//
+// 설정하지 않으면 스냅샷 목록 조회와 내려받기만 제공되고, 재생 요청은 503 Service Unavailable로 거부됩니다.
+// 서버가 시작되기 전에 호출해야 합니다.
+func (s *Service) SetParseSnapshotReplayer(replayer admin.ParseSnapshotReplayer) {
+	s.snapshotReplayer = replayer
+}
+
 // Start API 서비스를 시작합니다.
 //
 // 서비스는 별도의 고루틴에서 실행되며, 다음 작업을 수행합니다:
@@ -143,22 +159,33 @@ func (s *Service) runEventLoop(serviceStopCtx context.Context, serviceStopWG *sy
 // setupServer Echo 서버 인스턴스를 생성하고 모든 설정을 완료합니다.
 //
 // 다음 순서로 서버를 구성합니다:
-//  1. Handler 생성 (RSS 핸들러)
-//  2. Echo 서버 생성 (미들웨어 체인, CORS 설정 포함)
-//  3. 라우트 등록 (전역 라우트)
+//  1. Handler 생성 (RSS 핸들러, REST API 핸들러, 관리 핸들러)
+//  2. Echo 서버 생성 (미들웨어 체인, CORS 및 비공개 피드 접근 제어 설정 포함)
+//  3. 라우트 등록 (전역 라우트, REST API 라우트, 관리 라우트)
 func (s *Service) setupServer() *echo.Echo {
 	// 1. Handler 생성
 	rssHandler := rss.New(&s.appConfig.RSSFeed, s.feedRepo, s.notifyClient)
+	apiHandler := v1.New(&s.appConfig.RSSFeed, s.feedRepo)
+	adminHandler := admin.New(s.feedRepo, s.snapshotReplayer)
+
+	// 저장소가 접근 토큰을 지원하지 않으면 nil이 되며, 이 경우 비공개 피드는 열람할 수 없습니다.
+	accessTokenRepo, _ := s.feedRepo.(feed.AccessTokenRepository)
 
 	// 2. Echo 서버 생성 (미들웨어 체인 포함)
 	e := NewEchoServer(ServerConfig{
 		Debug:        s.appConfig.Debug,
 		EnableHSTS:   s.appConfig.WS.TLSServer,
 		AllowOrigins: []string{"*"},
+		FeedAccess: appmiddleware.FeedAccessConfig{
+			Providers:  s.appConfig.RSSFeed.Providers,
+			Repository: accessTokenRepo,
+		},
 	}, views)
 
 	// 3. 라우트 등록
 	RegisterRoutes(e, rssHandler)
+	RegisterAPIRoutes(e, apiHandler)
+	RegisterAdminRoutes(e, adminHandler, s.appConfig.Admin)
 
 	return e
 }
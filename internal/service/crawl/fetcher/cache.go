@@ -0,0 +1,226 @@
+package fetcher
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	applog "github.com/darkkaiser/notify-server/pkg/log"
+)
+
+// CachedResponse 조건부 요청(If-None-Match, If-Modified-Since)에 재사용하기 위해 캐시에 저장하는 HTTP 응답입니다.
+type CachedResponse struct {
+	// URL 응답을 받은 요청의 URL입니다. 민감한 정보가 저장되지 않도록 redactURL 규칙에 따라 마스킹된 값입니다.
+	URL string `json:"url"`
+
+	// StatusCode, Header, Body 원래 응답의 상태 코드, 헤더, 본문입니다. (Set-Cookie 헤더는 저장하지 않음)
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header"`
+	Body       []byte      `json:"body"`
+
+	// ETag, LastModified 조건부 요청에 사용할 검증자(Validator)입니다.
+	ETag         string `json:"etag,omitempty"`
+	LastModified string `json:"last_modified,omitempty"`
+
+	// StoredAt 응답을 캐시에 저장(또는 304 응답으로 갱신)한 시각입니다.
+	StoredAt time.Time `json:"stored_at"`
+}
+
+// ResponseCache CachingFetcher가 응답을 저장하고 조회하는 저장소 인터페이스입니다.
+//
+// 키는 CachingFetcher가 전체 URL로부터 계산한 해시 문자열이며, 구현체는 동시 호출에 안전해야 합니다.
+type ResponseCache interface {
+	// Get 키에 해당하는 응답을 반환합니다. 없으면 false를 반환합니다.
+	Get(key string) (*CachedResponse, bool)
+
+	// Set 키에 응답을 저장합니다. 저장 공간이 부족하면 구현체의 정책에 따라 오래된 항목을 제거합니다.
+	Set(key string, resp *CachedResponse) error
+
+	// Delete 키에 해당하는 응답을 제거합니다.
+	Delete(key string)
+}
+
+// CachingFetcher ETag/Last-Modified를 이용한 조건부 요청으로 변경되지 않은 페이지의 재전송을 피하는 미들웨어입니다.
+//
+// 주요 기능:
+//   - 검증자(ETag 또는 Last-Modified)가 있는 200 응답을 ResponseCache에 저장합니다.
+//   - 같은 URL을 다시 요청하면 If-None-Match/If-Modified-Since 헤더를 붙여 보냅니다.
+//   - 서버가 304 Not Modified로 응답하면 캐시된 응답을 200 응답으로 복원하여 반환하므로,
+//     호출자(Scraper.FetchHTMLDocument 등)는 캐시 여부와 관계없이 평소처럼 본문을 읽을 수 있습니다.
+//
+// 캐시 키는 전체 URL의 SHA-256 해시이며, 캐시에 함께 저장되는 URL은 redactURL 규칙에 따라 민감한 쿼리 값이 마스킹됩니다.
+// 상태 코드 검증보다 안쪽에 배치하여 304 응답이 검증 단계에 도달하기 전에 200 응답으로 복원되도록 합니다.
+type CachingFetcher struct {
+	delegate Fetcher
+
+	cache ResponseCache
+}
+
+// 컴파일 타임에 인터페이스 구현 여부를 검증합니다.
+var _ Fetcher = (*CachingFetcher)(nil)
+
+// NewCachingFetcher 새로운 CachingFetcher 인스턴스를 생성합니다. cache가 nil이면 delegate를 그대로 반환합니다.
+func NewCachingFetcher(delegate Fetcher, cache ResponseCache) Fetcher {
+	if cache == nil {
+		return delegate
+	}
+
+	return &CachingFetcher{
+		delegate: delegate,
+		cache:    cache,
+	}
+}
+
+// Do 캐시된 응답이 있으면 조건부 요청을 수행하고, 304 응답을 캐시된 응답으로 복원하여 반환합니다.
+//
+// 매개변수:
+//   - req: 처리할 HTTP 요청
+//
+// 반환값:
+//   - HTTP 응답 객체 (304 응답을 받은 경우 캐시에서 복원한 200 응답)
+//   - 에러 (요청 처리 또는 응답 본문을 읽는 중 발생한 에러)
+func (f *CachingFetcher) Do(req *http.Request) (*http.Response, error) {
+	// GET 요청만 캐시합니다. 호출자가 직접 조건부 요청이나 부분 요청을 보내는 경우에도 응답을 그대로 전달합니다.
+	if req.Method != http.MethodGet || req.Header.Get("Range") != "" ||
+		req.Header.Get("If-None-Match") != "" || req.Header.Get("If-Modified-Since") != "" {
+		return f.delegate.Do(req)
+	}
+
+	key := responseCacheKey(req.URL)
+	cached, ok := f.cache.Get(key)
+
+	// ========================================
+	// 1단계: 캐시된 검증자로 조건부 요청 구성
+	// ========================================
+	outReq := req
+	if ok {
+		outReq = req.Clone(req.Context())
+		if cached.ETag != "" {
+			outReq.Header.Set("If-None-Match", cached.ETag)
+		}
+		if cached.LastModified != "" {
+			outReq.Header.Set("If-Modified-Since", cached.LastModified)
+		}
+	}
+
+	resp, err := f.delegate.Do(outReq)
+	if err != nil {
+		return resp, err
+	}
+
+	// ========================================
+	// 2단계: 304 Not Modified → 캐시된 응답으로 복원
+	// ========================================
+	if resp.StatusCode == http.StatusNotModified && ok {
+		drainAndCloseBody(resp.Body)
+
+		// 304 응답에 포함된 헤더(Date, Cache-Control, 새 ETag 등)로 캐시된 헤더를 갱신합니다. (RFC 9111 4.3.4)
+		for name, values := range resp.Header {
+			if name == "Set-Cookie" || name == "Content-Length" {
+				continue
+			}
+			cached.Header[name] = values
+		}
+		if etag := resp.Header.Get("ETag"); etag != "" {
+			cached.ETag = etag
+		}
+		if lastModified := resp.Header.Get("Last-Modified"); lastModified != "" {
+			cached.LastModified = lastModified
+		}
+		cached.StoredAt = time.Now()
+		_ = f.cache.Set(key, cached)
+
+		applog.WithComponent(component).WithContext(req.Context()).WithFields(applog.Fields{
+			"url": cached.URL,
+		}).Debug("HTTP 캐시 적중: 변경되지 않은 응답(304)을 캐시된 응답으로 대체합니다")
+
+		return cached.toResponse(req), nil
+	}
+
+	// ========================================
+	// 3단계: 검증자가 있는 200 응답 저장
+	// ========================================
+	if resp.StatusCode != http.StatusOK {
+		return resp, nil
+	}
+
+	etag, lastModified := resp.Header.Get("ETag"), resp.Header.Get("Last-Modified")
+	if (etag == "" && lastModified == "") || strings.Contains(strings.ToLower(resp.Header.Get("Cache-Control")), "no-store") {
+		// 더 이상 조건부 요청을 할 수 없는 응답이므로 이전에 저장된 응답은 제거합니다.
+		if ok {
+			f.cache.Delete(key)
+		}
+		return resp, nil
+	}
+
+	// 본문을 모두 읽어 저장하고, 호출자에게는 읽은 본문으로 새 Body를 만들어 반환합니다.
+	// (크기 제한 초과 등으로 읽기에 실패하면 호출자가 본문을 읽을 때와 같은 에러를 반환합니다)
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	header := resp.Header.Clone()
+	header.Del("Set-Cookie")
+
+	if err := f.cache.Set(key, &CachedResponse{
+		URL:          redactURL(req.URL),
+		StatusCode:   resp.StatusCode,
+		Header:       header,
+		Body:         body,
+		ETag:         etag,
+		LastModified: lastModified,
+		StoredAt:     time.Now(),
+	}); err != nil {
+		applog.WithComponent(component).WithContext(req.Context()).WithFields(applog.Fields{
+			"url":   redactURL(req.URL),
+			"error": err,
+		}).Warn("HTTP 캐시 저장 실패: 응답은 정상적으로 반환하지만 다음 요청에서 조건부 요청을 사용할 수 없습니다")
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	resp.ContentLength = int64(len(body))
+
+	return resp, nil
+}
+
+func (f *CachingFetcher) Close() error {
+	return f.delegate.Close()
+}
+
+// toResponse 캐시된 응답으로 req에 대한 새 HTTP 응답 객체를 만듭니다.
+func (c *CachedResponse) toResponse(req *http.Request) *http.Response {
+	header := c.Header.Clone()
+	header.Set("Content-Length", strconv.Itoa(len(c.Body)))
+
+	return &http.Response{
+		Status:        strconv.Itoa(c.StatusCode) + " " + http.StatusText(c.StatusCode),
+		StatusCode:    c.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(c.Body)),
+		ContentLength: int64(len(c.Body)),
+		Request:       req,
+	}
+}
+
+// responseCacheKey 요청 URL로부터 캐시 키를 계산합니다.
+//
+// 민감한 쿼리 값(토큰 등)이 다른 URL끼리 응답이 섞이지 않도록 전체 URL을 사용하되,
+// 원문이 캐시 저장소에 남지 않도록 SHA-256 해시로 변환합니다. (Fragment는 서버로 전송되지 않으므로 제외)
+func responseCacheKey(u *url.URL) string {
+	ku := *u
+	ku.Fragment, ku.RawFragment = "", ""
+
+	sum := sha256.Sum256([]byte(ku.String()))
+	return hex.EncodeToString(sum[:])
+}
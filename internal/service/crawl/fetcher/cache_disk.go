@@ -0,0 +1,237 @@
+package fetcher
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	applog "github.com/darkkaiser/notify-server/pkg/log"
+)
+
+const (
+	// DefaultResponseCacheMaxBytes 디스크 캐시의 최대 크기를 지정하지 않았을 때 사용하는 기본값(100MB)입니다.
+	DefaultResponseCacheMaxBytes int64 = 100 * 1024 * 1024
+
+	// responseCacheFileExt 캐시 항목 파일의 확장자입니다.
+	responseCacheFileExt = ".json"
+)
+
+// DiskResponseCache 응답을 디렉터리 안의 파일로 저장하는 크기 제한 ResponseCache 구현체입니다.
+//
+// 항목 하나를 "<캐시 키>.json" 파일 하나로 저장하며, 전체 파일 크기의 합이 maxBytes를 넘으면
+// 가장 오랫동안 사용되지 않은 항목부터 제거합니다. 서버를 재시작해도 기존 파일을 다시 읽어 캐시를 이어서 사용합니다.
+type DiskResponseCache struct {
+	dir      string
+	maxBytes int64
+
+	mu        sync.Mutex
+	entries   map[string]*diskCacheEntry
+	totalSize int64
+}
+
+// diskCacheEntry 디스크 캐시 항목의 크기와 마지막 사용 시각입니다. (LRU 제거 순서 결정용)
+type diskCacheEntry struct {
+	size       int64
+	lastAccess time.Time
+}
+
+// 컴파일 타임에 인터페이스 구현 여부를 검증합니다.
+var _ ResponseCache = (*DiskResponseCache)(nil)
+
+// NewDiskResponseCache dir에 응답을 저장하는 디스크 캐시를 생성합니다.
+//
+// 디렉터리가 없으면 생성하고, 이미 저장된 캐시 파일이 있으면 색인에 불러옵니다.
+// maxBytes가 0 이하이면 DefaultResponseCacheMaxBytes를 사용합니다.
+func NewDiskResponseCache(dir string, maxBytes int64) (*DiskResponseCache, error) {
+	if maxBytes <= 0 {
+		maxBytes = DefaultResponseCacheMaxBytes
+	}
+
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("HTTP 캐시 디렉터리 생성 실패 (%s): %w", dir, err)
+	}
+
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("HTTP 캐시 디렉터리 조회 실패 (%s): %w", dir, err)
+	}
+
+	c := &DiskResponseCache{
+		dir:      dir,
+		maxBytes: maxBytes,
+		entries:  make(map[string]*diskCacheEntry),
+	}
+
+	for _, file := range files {
+		name := file.Name()
+		if file.IsDir() {
+			continue
+		}
+
+		// 저장 도중 종료되어 남은 임시 파일은 정리합니다.
+		if strings.HasSuffix(name, ".tmp") {
+			_ = os.Remove(filepath.Join(dir, name))
+			continue
+		}
+		if !strings.HasSuffix(name, responseCacheFileExt) {
+			continue
+		}
+
+		info, err := file.Info()
+		if err != nil {
+			continue
+		}
+
+		c.entries[strings.TrimSuffix(name, responseCacheFileExt)] = &diskCacheEntry{size: info.Size(), lastAccess: info.ModTime()}
+		c.totalSize += info.Size()
+	}
+
+	// 설정한 최대 크기가 줄어든 경우를 위해 불러온 직후에도 크기 제한을 적용합니다.
+	c.mu.Lock()
+	c.evictLocked()
+	c.mu.Unlock()
+
+	return c, nil
+}
+
+// Get 키에 해당하는 응답을 파일에서 읽어 반환합니다. 파일이 손상되었으면 제거하고 false를 반환합니다.
+func (c *DiskResponseCache) Get(key string) (*CachedResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	data, err := os.ReadFile(c.path(key))
+	if err != nil {
+		c.removeLocked(key)
+		return nil, false
+	}
+
+	var resp CachedResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		applog.WithComponentAndFields(component, applog.Fields{
+			"key":   key,
+			"error": err,
+		}).Warn("HTTP 캐시 항목 손상: 해당 항목을 제거합니다")
+
+		c.removeLocked(key)
+		return nil, false
+	}
+	if resp.Header == nil {
+		resp.Header = make(http.Header)
+	}
+
+	entry.lastAccess = time.Now()
+
+	return &resp, true
+}
+
+// Set 응답을 파일로 저장하고, 전체 크기가 최대 크기를 넘으면 오래된 항목을 제거합니다.
+//
+// 파일은 임시 파일에 먼저 기록한 뒤 이름을 바꾸므로, 저장 도중 프로세스가 종료되어도 손상된 항목이 남지 않습니다.
+func (c *DiskResponseCache) Set(key string, resp *CachedResponse) error {
+	data, err := json.Marshal(resp)
+	if err != nil {
+		return fmt.Errorf("HTTP 캐시 항목 직렬화 실패: %w", err)
+	}
+
+	// 최대 크기보다 큰 항목은 저장하면 다른 항목을 모두 밀어내므로 저장하지 않습니다.
+	if int64(len(data)) > c.maxBytes {
+		c.Delete(key)
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	tmp, err := os.CreateTemp(c.dir, key+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("HTTP 캐시 임시 파일 생성 실패: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("HTTP 캐시 항목 기록 실패: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("HTTP 캐시 항목 기록 실패: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), c.path(key)); err != nil {
+		return fmt.Errorf("HTTP 캐시 항목 저장 실패: %w", err)
+	}
+
+	if old, ok := c.entries[key]; ok {
+		c.totalSize -= old.size
+	}
+	c.entries[key] = &diskCacheEntry{size: int64(len(data)), lastAccess: time.Now()}
+	c.totalSize += int64(len(data))
+
+	c.evictLocked()
+
+	return nil
+}
+
+// Delete 키에 해당하는 응답 파일을 제거합니다.
+func (c *DiskResponseCache) Delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.removeLocked(key)
+}
+
+// Size 현재 캐시에 저장된 항목 수와 전체 크기(바이트)를 반환합니다.
+func (c *DiskResponseCache) Size() (count int, bytes int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.entries), c.totalSize
+}
+
+// evictLocked 전체 크기가 최대 크기 이하가 될 때까지 가장 오랫동안 사용되지 않은 항목부터 제거합니다. (c.mu를 보유한 상태로 호출)
+func (c *DiskResponseCache) evictLocked() {
+	if c.totalSize <= c.maxBytes {
+		return
+	}
+
+	keys := make([]string, 0, len(c.entries))
+	for key := range c.entries {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return c.entries[keys[i]].lastAccess.Before(c.entries[keys[j]].lastAccess)
+	})
+
+	for _, key := range keys {
+		if c.totalSize <= c.maxBytes {
+			break
+		}
+		c.removeLocked(key)
+	}
+}
+
+// removeLocked 항목을 색인과 디스크에서 제거합니다. (c.mu를 보유한 상태로 호출)
+func (c *DiskResponseCache) removeLocked(key string) {
+	entry, ok := c.entries[key]
+	if !ok {
+		return
+	}
+
+	_ = os.Remove(c.path(key))
+	c.totalSize -= entry.size
+	delete(c.entries, key)
+}
+
+// path 캐시 키에 해당하는 파일 경로를 반환합니다.
+func (c *DiskResponseCache) path(key string) string {
+	return filepath.Join(c.dir, key+responseCacheFileExt)
+}
@@ -0,0 +1,100 @@
+package fetcher_test
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/darkkaiser/rss-feed-server/internal/service/crawl/fetcher"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newCachedResponse(body string) *fetcher.CachedResponse {
+	return &fetcher.CachedResponse{
+		URL:        "https://example.com/",
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": {"text/html"}},
+		Body:       []byte(body),
+		ETag:       `"v1"`,
+		StoredAt:   time.Now(),
+	}
+}
+
+// TestDiskResponseCache_Persistence 저장한 항목을 새 인스턴스에서도 읽을 수 있는지 검증합니다.
+func TestDiskResponseCache_Persistence(t *testing.T) {
+	dir := t.TempDir()
+
+	cache, err := fetcher.NewDiskResponseCache(dir, 0)
+	require.NoError(t, err)
+	require.NoError(t, cache.Set("a", newCachedResponse("hello")))
+
+	reopened, err := fetcher.NewDiskResponseCache(dir, 0)
+	require.NoError(t, err)
+
+	cached, ok := reopened.Get("a")
+	require.True(t, ok)
+	assert.Equal(t, "hello", string(cached.Body))
+	assert.Equal(t, `"v1"`, cached.ETag)
+
+	reopened.Delete("a")
+	_, ok = reopened.Get("a")
+	assert.False(t, ok)
+}
+
+// TestDiskResponseCache_Eviction 최대 크기를 넘으면 가장 오랫동안 사용되지 않은 항목부터 제거하는지 검증합니다.
+func TestDiskResponseCache_Eviction(t *testing.T) {
+	body := strings.Repeat("x", 1000)
+
+	// 항목 2개만 들어가는 크기로 제한합니다.
+	cache, err := fetcher.NewDiskResponseCache(t.TempDir(), 3000)
+	require.NoError(t, err)
+
+	require.NoError(t, cache.Set("a", newCachedResponse(body)))
+	time.Sleep(5 * time.Millisecond)
+	require.NoError(t, cache.Set("b", newCachedResponse(body)))
+	time.Sleep(5 * time.Millisecond)
+
+	// "a"를 사용하여 "b"가 가장 오래된 항목이 되도록 합니다.
+	_, ok := cache.Get("a")
+	require.True(t, ok)
+	time.Sleep(5 * time.Millisecond)
+
+	require.NoError(t, cache.Set("c", newCachedResponse(body)))
+
+	_, ok = cache.Get("b")
+	assert.False(t, ok, "가장 오랫동안 사용되지 않은 항목이 제거되어야 합니다")
+	_, ok = cache.Get("a")
+	assert.True(t, ok)
+	_, ok = cache.Get("c")
+	assert.True(t, ok)
+
+	count, size := cache.Size()
+	assert.Equal(t, 2, count)
+	assert.LessOrEqual(t, size, int64(3000))
+
+	// 최대 크기보다 큰 항목은 저장하지 않습니다.
+	require.NoError(t, cache.Set("huge", newCachedResponse(strings.Repeat("x", 5000))))
+	_, ok = cache.Get("huge")
+	assert.False(t, ok)
+}
+
+// TestDiskResponseCache_CorruptedEntry 손상된 항목 파일은 조회 시 제거하고, 남은 임시 파일은 시작 시 정리하는지 검증합니다.
+func TestDiskResponseCache_CorruptedEntry(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "broken.json"), []byte("{not json"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.123.tmp"), []byte("partial"), 0o600))
+
+	cache, err := fetcher.NewDiskResponseCache(dir, 0)
+	require.NoError(t, err)
+
+	_, ok := cache.Get("broken")
+	assert.False(t, ok)
+
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, files)
+}
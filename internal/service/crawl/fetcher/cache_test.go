@@ -0,0 +1,181 @@
+package fetcher_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/darkkaiser/rss-feed-server/internal/service/crawl/fetcher"
+	"github.com/darkkaiser/rss-feed-server/internal/service/crawl/fetcher/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newETagTestServer 요청에 If-None-Match가 현재 ETag와 같으면 304, 아니면 200과 본문으로 응답하는 테스트 서버를 생성합니다.
+func newETagTestServer(t *testing.T, etag *atomic.Value, header http.Header) (*httptest.Server, *atomic.Int32, *atomic.Int32) {
+	t.Helper()
+
+	var fullHits, notModifiedHits atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := etag.Load().(string)
+		if r.Header.Get("If-None-Match") == current {
+			notModifiedHits.Add(1)
+			w.Header().Set("ETag", current)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		fullHits.Add(1)
+		for name, values := range header {
+			w.Header()[name] = values
+		}
+		w.Header().Set("ETag", current)
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("<html>" + current + "</html>"))
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &fullHits, &notModifiedHits
+}
+
+func doCachingRequest(t *testing.T, f fetcher.Fetcher, rawURL string) (*http.Response, string) {
+	t.Helper()
+
+	req, err := http.NewRequest(http.MethodGet, rawURL, nil)
+	require.NoError(t, err)
+
+	resp, err := f.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	return resp, string(body)
+}
+
+func newTestDiskCache(t *testing.T, maxBytes int64) *fetcher.DiskResponseCache {
+	t.Helper()
+
+	cache, err := fetcher.NewDiskResponseCache(t.TempDir(), maxBytes)
+	require.NoError(t, err)
+	return cache
+}
+
+func TestNewCachingFetcher_NilCache(t *testing.T) {
+	mockF := mocks.NewMockFetcher()
+	assert.Same(t, mockF, fetcher.NewCachingFetcher(mockF, nil))
+}
+
+// TestCachingFetcher_NotModified 두 번째 요청에 조건부 헤더를 보내고, 304 응답을 캐시된 200 응답으로 복원하는지 검증합니다.
+func TestCachingFetcher_NotModified(t *testing.T) {
+	var etag atomic.Value
+	etag.Store(`"v1"`)
+	server, fullHits, notModifiedHits := newETagTestServer(t, &etag, nil)
+
+	f := fetcher.NewFromConfig(fetcher.Config{DisableLogging: true, ResponseCache: newTestDiskCache(t, 0)})
+
+	resp, body := doCachingRequest(t, f, server.URL+"/list")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, `<html>"v1"</html>`, body)
+
+	// 변경되지 않았으면 304 응답을 받지만, 호출자에게는 캐시된 200 응답이 반환되어야 합니다. (StatusCodeFetcher 통과)
+	resp, body = doCachingRequest(t, f, server.URL+"/list")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, `<html>"v1"</html>`, body)
+	assert.Equal(t, "text/html; charset=utf-8", resp.Header.Get("Content-Type"))
+	assert.NotNil(t, resp.Request)
+	assert.Equal(t, int32(1), fullHits.Load())
+	assert.Equal(t, int32(1), notModifiedHits.Load())
+
+	// 변경되었으면 새 본문을 받아 캐시를 갱신합니다.
+	etag.Store(`"v2"`)
+	_, body = doCachingRequest(t, f, server.URL+"/list")
+	assert.Equal(t, `<html>"v2"</html>`, body)
+
+	_, body = doCachingRequest(t, f, server.URL+"/list")
+	assert.Equal(t, `<html>"v2"</html>`, body)
+	assert.Equal(t, int32(2), fullHits.Load())
+	assert.Equal(t, int32(2), notModifiedHits.Load())
+}
+
+// TestCachingFetcher_NoStore Cache-Control: no-store 응답은 저장하지 않는지 검증합니다.
+func TestCachingFetcher_NoStore(t *testing.T) {
+	var etag atomic.Value
+	etag.Store(`"v1"`)
+	server, fullHits, notModifiedHits := newETagTestServer(t, &etag, http.Header{"Cache-Control": {"private, no-store"}})
+
+	cache := newTestDiskCache(t, 0)
+	f := fetcher.NewCachingFetcher(fetcher.NewHTTPFetcher(), cache)
+
+	doCachingRequest(t, f, server.URL+"/list")
+	doCachingRequest(t, f, server.URL+"/list")
+
+	count, _ := cache.Size()
+	assert.Equal(t, 0, count)
+	assert.Equal(t, int32(2), fullHits.Load())
+	assert.Equal(t, int32(0), notModifiedHits.Load())
+}
+
+// TestCachingFetcher_PassThrough GET이 아니거나 호출자가 직접 조건부 요청을 보낸 경우 캐시를 사용하지 않는지 검증합니다.
+func TestCachingFetcher_PassThrough(t *testing.T) {
+	var etag atomic.Value
+	etag.Store(`"v1"`)
+	server, _, _ := newETagTestServer(t, &etag, nil)
+
+	cache := newTestDiskCache(t, 0)
+	f := fetcher.NewCachingFetcher(fetcher.NewHTTPFetcher(), cache)
+
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/list", nil)
+	require.NoError(t, err)
+	req.Header.Set("If-None-Match", `"v1"`)
+
+	resp, err := f.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusNotModified, resp.StatusCode, "호출자가 보낸 조건부 요청의 304 응답은 그대로 전달해야 합니다")
+
+	count, _ := cache.Size()
+	assert.Equal(t, 0, count)
+}
+
+// TestCachingFetcher_RedactedURL 캐시에 저장되는 URL에서 민감한 쿼리 값이 마스킹되는지 검증합니다.
+func TestCachingFetcher_RedactedURL(t *testing.T) {
+	var etag atomic.Value
+	etag.Store(`"v1"`)
+	server, _, _ := newETagTestServer(t, &etag, http.Header{"Set-Cookie": {"session=abc"}})
+
+	dir := t.TempDir()
+	cache, err := fetcher.NewDiskResponseCache(dir, 0)
+	require.NoError(t, err)
+	f := fetcher.NewCachingFetcher(fetcher.NewHTTPFetcher(), cache)
+
+	rawURL := server.URL + "/list?page=1&access_token=secret-value"
+	doCachingRequest(t, f, rawURL)
+
+	u, err := url.Parse(rawURL)
+	require.NoError(t, err)
+
+	cached, ok := cache.Get(fetcher.ResponseCacheKey(u))
+	require.True(t, ok)
+	assert.Contains(t, cached.URL, "access_token=xxxxx")
+	assert.Empty(t, cached.Header.Get("Set-Cookie"))
+
+	// 캐시 디렉터리의 어떤 파일에도 민감한 값이 남지 않아야 합니다.
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+
+	data, err := os.ReadFile(filepath.Join(dir, files[0].Name()))
+	require.NoError(t, err)
+	assert.False(t, strings.Contains(string(data), "secret-value"))
+	assert.False(t, strings.Contains(string(data), "session=abc"))
+}
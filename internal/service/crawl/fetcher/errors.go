@@ -89,3 +89,35 @@ func newErrSharedTransportCreateFailed(err error) error {
 	}
 	return apperrors.Wrap(err, errType, "공유 Transport 리소스 초기화 또는 조회 중 오류가 발생했습니다")
 }
+
+// RateLimitFetcher 관련 에러
+
+// ErrHostBlocked 서버가 Retry-After로 요구한 요청 보류 시간이 많이 남아 요청을 보내지 않은 경우 반환하는 에러입니다.
+// 보류 시간이 끝나기 전에는 다시 시도해도 같은 결과가 나오므로 RetryFetcher의 재시도 대상에서 제외됩니다.
+var ErrHostBlocked = apperrors.New(apperrors.Unavailable, "서버가 Retry-After로 요구한 대기 시간이 남아 있어 요청을 보내지 않았습니다")
+
+// newErrHostBlocked 호스트와 남은 보류 시간을 담아 ErrHostBlocked를 래핑한 에러를 생성합니다.
+func newErrHostBlocked(host, remaining string) error {
+	return apperrors.Wrap(ErrHostBlocked, apperrors.Unavailable, fmt.Sprintf("요청 보류 중인 호스트입니다 (호스트: %s, 남은 시간: %s)", host, remaining))
+}
+
+// SessionFetcher 관련 에러
+
+// ErrSessionExpired 로그인 세션이 만료되었고, 요청 본문을 다시 만들 수 없어 비로그인 상태로 다시 요청하지 못한 경우 반환하는 에러입니다.
+var ErrSessionExpired = apperrors.New(apperrors.Unauthorized, "로그인 세션이 만료되었습니다")
+
+// newErrSessionExpired 세션 만료를 판단한 사유를 담아 ErrSessionExpired를 래핑한 에러를 생성합니다.
+func newErrSessionExpired(reason string) error {
+	return apperrors.Wrap(ErrSessionExpired, apperrors.Unauthorized, fmt.Sprintf("로그인 세션이 만료되어 요청을 완료하지 못했습니다 (%s)", reason))
+}
+
+// ReplayFetcher 관련 에러
+
+// ErrFixtureNotFound 재생할 요청과 일치하는 fixture가 없는 경우 반환하는 에러입니다.
+// 원문 서버의 404 응답(apperrors.NotFound)과 구별되는 종류를 사용하므로, 파서가 fixture 누락을 원문 게시글 삭제로 오인하지 않습니다.
+var ErrFixtureNotFound = apperrors.New(apperrors.Internal, "요청과 일치하는 fixture가 없습니다")
+
+// newErrFixtureNotFound 요청의 메서드와 마스킹된 URL을 담아 ErrFixtureNotFound를 래핑한 에러를 생성합니다.
+func newErrFixtureNotFound(method, redactedURL string) error {
+	return apperrors.Wrap(ErrFixtureNotFound, apperrors.Internal, fmt.Sprintf("요청과 일치하는 fixture가 없습니다 (메서드: %s, URL: %s)", method, redactedURL))
+}
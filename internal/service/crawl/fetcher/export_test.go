@@ -3,6 +3,7 @@ package fetcher
 import (
 	"container/list"
 	"net/http"
+	"net/url"
 	"time"
 )
 
@@ -105,6 +106,74 @@ func InspectMaxBytesFetcher(f Fetcher) (delegate Fetcher, maxBytes int64) {
 	return nil, 0
 }
 
+// InspectRateLimitFetcher returns the delegate and shared limiter of a RateLimitFetcher.
+func InspectRateLimitFetcher(f Fetcher) (delegate Fetcher, limiter *HostRateLimiter) {
+	if rlf, ok := f.(*RateLimitFetcher); ok {
+		return rlf.delegate, rlf.limiter
+	}
+	return nil, nil
+}
+
+// SetHostRateLimiterClock replaces the clock used by a HostRateLimiter for deterministic testing.
+func SetHostRateLimiterClock(l *HostRateLimiter, now func() time.Time) {
+	l.now = now
+}
+
+// HostRateLimiterPenalty returns the current adaptive slow-down factor applied to the host.
+func HostRateLimiterPenalty(l *HostRateLimiter, host string) float64 {
+	s := l.state(host)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.penalty
+}
+
+// HostRateLimiterLimit returns the current requests-per-second limit applied to the host.
+func HostRateLimiterLimit(l *HostRateLimiter, host string) float64 {
+	return float64(l.state(host).limiter.Limit())
+}
+
+// InspectRobotsFetcher returns the delegate, shared policy, robots.txt fetcher and default User-Agent of a RobotsFetcher.
+func InspectRobotsFetcher(f Fetcher) (delegate Fetcher, policy *RobotsPolicy, robotsFetcher Fetcher, userAgent string) {
+	if rf, ok := f.(*RobotsFetcher); ok {
+		return rf.delegate, rf.policy, rf.robotsFetcher, rf.userAgent
+	}
+	return nil, nil, nil, ""
+}
+
+// SetRobotsPolicyClock replaces the clock used by a RobotsPolicy for deterministic cache expiry testing.
+func SetRobotsPolicyClock(p *RobotsPolicy, now func() time.Time) {
+	p.now = now
+}
+
+// InspectCachingFetcher returns the delegate and cache of a CachingFetcher.
+func InspectCachingFetcher(f Fetcher) (delegate Fetcher, cache ResponseCache) {
+	if cf, ok := f.(*CachingFetcher); ok {
+		return cf.delegate, cf.cache
+	}
+	return nil, nil
+}
+
+// InspectRecordingFetcher returns the delegate and fixture directory of a RecordingFetcher.
+func InspectRecordingFetcher(f Fetcher) (delegate Fetcher, dir string) {
+	if rf, ok := f.(*RecordingFetcher); ok {
+		return rf.delegate, rf.dir
+	}
+	return nil, ""
+}
+
+// InspectSessionFetcher returns the delegate and session of a SessionFetcher.
+func InspectSessionFetcher(f Fetcher) (delegate Fetcher, session *Session) {
+	if sf, ok := f.(*SessionFetcher); ok {
+		return sf.delegate, sf.session
+	}
+	return nil, nil
+}
+
+// ResponseCacheKey exposes the cache key derivation for testing.
+func ResponseCacheKey(u *url.URL) string {
+	return responseCacheKey(u)
+}
+
 // HTTPFetcherOptions exposes internal configuration of an HTTPFetcher for testing.
 type HTTPFetcherOptions struct {
 	ProxyURL              *string
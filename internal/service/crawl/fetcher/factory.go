@@ -2,6 +2,8 @@ package fetcher
 
 import (
 	"time"
+
+	applog "github.com/darkkaiser/notify-server/pkg/log"
 )
 
 // Config Fetcher 체인을 구성하기 위한 모든 설정 옵션을 정의하는 구조체입니다.
@@ -189,6 +191,61 @@ type Config struct {
 	//   - 값 지정: "text/html" 같이 파라미터를 제외한 순수 MIME 타입만 허용 (대소문자 구분 안 함)
 	AllowedMimeTypes []string
 
+	// ========================================
+	// 요청 속도 제한
+	// ========================================
+
+	// RateLimiter 호스트별 요청 속도를 제한할 때 사용할 공유 HostRateLimiter입니다.
+	//
+	// 설정 값:
+	//   - nil (기본값): 요청 속도 제한 없음
+	//   - 값 지정: 요청마다 대상 호스트의 토큰 버킷과 Retry-After 보류 상태에 따라 대기
+	//     (같은 호스트에 대한 제한이 모든 크롤러에 함께 적용되도록 프로세스 전체에서 하나의 인스턴스를 공유해야 함)
+	RateLimiter *HostRateLimiter
+
+	// RobotsPolicy robots.txt 준수 여부를 판단할 때 사용할 공유 RobotsPolicy입니다.
+	//
+	// 설정 값:
+	//   - nil (기본값): robots.txt를 확인하지 않음
+	//   - 값 지정: robots.txt에서 허용하지 않은 경로의 요청을 보내지 않고 *RobotsDisallowedError를 반환
+	//     (robots.txt는 이 체인과 같은 프록시와 타임아웃으로 읽어오며, 규칙 그룹은 요청에 실제로 담겨 나가는 User-Agent로 선택)
+	RobotsPolicy *RobotsPolicy
+
+	// ========================================
+	// 응답 캐시
+	// ========================================
+
+	// ResponseCache 조건부 요청(ETag/Last-Modified)에 사용할 응답 캐시입니다.
+	//
+	// 설정 값:
+	//   - nil (기본값): 응답을 캐시하지 않음
+	//   - 값 지정: 검증자가 있는 응답을 저장해 두고, 다시 요청할 때 서버가 304로 응답하면 저장된 응답을 반환
+	ResponseCache ResponseCache
+
+	// ========================================
+	// 응답 녹화
+	// ========================================
+
+	// RecordingDir 요청/응답 쌍을 fixture 파일로 기록할 디렉터리 경로입니다.
+	//
+	// 설정 값:
+	//   - 빈 문자열 (기본값): 녹화하지 않음
+	//   - 값 지정: RecordingFetcher로 실제 응답을 기록하여 ReplayFetcher 기반의 파서 회귀 테스트에 사용할 수 있도록 함
+	//     (녹화는 부가 기능이므로 디렉터리를 만들 수 없으면 경고만 남기고 녹화 없이 체인을 구성)
+	RecordingDir string
+
+	// ========================================
+	// 로그인 세션
+	// ========================================
+
+	// Session 요청에 로그인 쿠키를 포함할 때 사용할 세션입니다.
+	//
+	// 설정 값:
+	//   - nil (기본값): 로그인 쿠키 없이 요청
+	//   - 값 지정: WithCookieJar로 HTTP 클라이언트에 세션을 연결하고, 응답에서 세션 만료를 감지하면 비로그인 상태로 전환
+	//     (로그인한 사용자만 볼 수 있는 응답이 다른 공급자와 공유되지 않도록 ResponseCache와 함께 사용하지 않는 것을 권장)
+	Session *Session
+
 	// ========================================
 	// 미들웨어 체인 구성
 	// ========================================
@@ -200,6 +257,14 @@ type Config struct {
 	//   - true: 로깅 비활성화 (성능 향상 또는 민감한 정보 보호가 필요한 경우)
 	DisableLogging bool
 
+	// EnableTracing 요청마다 OpenTelemetry 클라이언트 Span을 생성할지 여부를 제어합니다.
+	//
+	// 설정 값:
+	//   - false (기본값): Span을 생성하지 않음
+	//   - true: 체인의 가장 바깥에 TracingFetcher를 배치하여 재시도를 포함한 요청 하나를 하나의 Span으로 기록
+	//     (실제 기록 여부는 전역 TracerProvider 설정을 따르므로, 추적이 비활성화된 환경에서는 비용이 거의 없음)
+	EnableTracing bool
+
 	// DisableTransportCaching Transport 캐싱 사용 여부를 제어합니다.
 	//
 	// 설정 값:
@@ -260,6 +325,28 @@ func (cfg *Config) applyDefaults() {
 	normalizePtr(&cfg.MaxBytes, defaultMaxBytes, normalizeByteLimit)
 }
 
+// robotsTxtConfig robots.txt를 읽어올 Fetcher 체인의 설정을 반환합니다.
+//
+// 프록시, 타임아웃, 연결 설정과 요청 속도 제한은 크롤링 요청과 같게 두되, robots.txt는 응답 상태 코드에 따라 처리가 달라지므로
+// 상태 코드 및 MIME 타입 검증과 재시도는 적용하지 않습니다. 로그인 세션, 응답 캐시, 녹화도 robots.txt 조회에는 사용하지 않습니다.
+func (cfg Config) robotsTxtConfig() Config {
+	cfg.MaxRetries = nil
+	cfg.DisableStatusCodeValidation = true
+	cfg.AllowedMimeTypes = nil
+
+	cfg.EnableUserAgentRandomization = false
+
+	cfg.RobotsPolicy = nil
+	cfg.ResponseCache = nil
+	cfg.RecordingDir = ""
+	cfg.Session = nil
+
+	cfg.DisableLogging = true
+	cfg.EnableTracing = false
+
+	return cfg
+}
+
 // New 주요 설정값(재시도 횟수, 지연 시간, 본문 크기 제한)만으로 빠르고 간편하게 Fetcher를 생성합니다.
 //
 // 이 함수는 내부적으로 Config를 생성하고 applyDefaults()를 통해 안전한 기본값을 적용한 후,
@@ -291,18 +378,30 @@ func New(maxRetries int, minRetryDelay time.Duration, maxBytes int64, opts ...Op
 //
 // Fetcher 체인은 책임 연쇄 패턴(Chain of Responsibility)을 따르며, 다음과 같은 순서로 미들웨어가 구성됩니다 (바깥쪽 -> 안쪽):
 //
-//  1. [관찰] LoggingFetcher    (최외곽): 모든 시도와 지연을 포함한 전체 요청 생애주기를 기록합니다.
+//  0. [관찰] TracingFetcher    (최외곽): 재시도를 포함한 요청 하나를 하나의 클라이언트 Span으로 기록합니다. (EnableTracing 설정 시)
+//  1. [관찰] LoggingFetcher    (외곽): 모든 시도와 지연을 포함한 전체 요청 생애주기를 기록합니다.
 //  2. [보조] UserAgentFetcher  (보조): 각 요청에 매번 새로운 User-Agent를 부여합니다.
-//  3. [제어] RetryFetcher      (핵심): 실패 시 지수 백오프 전략에 따라 재시도를 총괄 제어합니다.
-//  4. [검증] MimeTypeFetcher   (검증): 서버가 반환한 Content-Type의 유효성을 검사합니다.
-//  5. [검증] StatusCodeFetcher (검증): HTTP 응답 상태 코드의 유효성을 검사합니다.
-//  6. [제한] MaxBytesFetcher   (보호): 응답 본문의 크기를 실시간으로 감시하여 메모리 고갈을 방지합니다.
-//  7. [전송] HTTPFetcher       (최내곽): 최하단에서 실제 네트워크 I/O 및 패킷 전송을 담당합니다.
+//  3. [제한] RobotsFetcher     (예절): robots.txt에서 허용하지 않은 경로의 요청을 차단합니다. (RobotsPolicy 설정 시)
+//  4. [제어] RetryFetcher      (핵심): 실패 시 지수 백오프 전략에 따라 재시도를 총괄 제어합니다.
+//  5. [검증] MimeTypeFetcher   (검증): 서버가 반환한 Content-Type의 유효성을 검사합니다.
+//  6. [검증] StatusCodeFetcher (검증): HTTP 응답 상태 코드의 유효성을 검사합니다.
+//  7. [인증] SessionFetcher    (보조): 로그인 세션 만료를 감지하면 비로그인 상태로 전환합니다. (Session 설정 시)
+//  8. [기록] RecordingFetcher  (보조): 파서가 실제로 받는 응답을 fixture 파일로 기록합니다. (RecordingDir 설정 시)
+//  9. [최적] CachingFetcher    (절약): 조건부 요청을 보내고 304 응답을 캐시된 응답으로 복원합니다. (ResponseCache 설정 시)
+//  10. [제한] MaxBytesFetcher  (보호): 응답 본문의 크기를 실시간으로 감시하여 메모리 고갈을 방지합니다.
+//  11. [제한] RateLimitFetcher (예절): 호스트별 요청 속도와 Retry-After 요구를 지킵니다. (RateLimiter 설정 시)
+//  12. [전송] HTTPFetcher      (최내곽): 최하단에서 실제 네트워크 I/O 및 패킷 전송을 담당합니다.
 //
 // 설계 의도:
-//   - LoggingFetcher는 재시도를 포함한 전체 흐름을 기록하기 위해 가장 바깥에 위치합니다.
+//   - LoggingFetcher는 재시도를 포함한 전체 흐름을 기록하기 위해 바깥에 위치하며, TracingFetcher는 그 로그에 trace_id가 남도록 더 바깥에 위치합니다.
 //   - RetryFetcher는 하위 검증 로직(상태 코드, MimeType) 실패 시에도 재시도를 수행해야 하므로 검증 미들웨어보다 바깥에 위치합니다.
 //   - 검증 로직(StatusCode, MimeType)은 각 시도(Attempt)마다 수행되어야 하므로 RetryFetcher 안쪽에 위치합니다.
+//   - RobotsFetcher는 차단된 요청을 재시도해도 결과가 같으므로 RetryFetcher 바깥에 위치합니다.
+//   - CachingFetcher는 304 응답이 상태 코드 검증에서 실패로 처리되지 않도록 StatusCodeFetcher 안쪽에,
+//     캐시에 저장할 본문도 크기 제한을 받도록 MaxBytesFetcher 바깥에 위치합니다.
+//   - RecordingFetcher는 파서가 실제로 받는 응답(304가 복원된 응답, 검증 전의 4xx 응답 포함)을 기록하도록 CachingFetcher 바깥, 검증 미들웨어 안쪽에 위치하며,
+//     본문을 메모리로 읽어들이므로 MaxBytesFetcher보다 바깥에 위치합니다.
+//   - RateLimitFetcher는 재시도를 포함해 실제로 네트워크에 나가는 모든 요청의 간격을 조절해야 하므로 HTTPFetcher 바로 바깥에 위치합니다.
 //
 // 매개변수:
 //   - cfg: Fetcher 체인 구성을 위한 상세 설정값
@@ -363,6 +462,11 @@ func NewFromConfig(cfg Config, opts ...Option) Fetcher {
 		mergedOpts = append(mergedOpts, WithIdleConnTimeout(*cfg.IdleConnTimeout))
 	}
 
+	// 로그인 세션 쿠키 설정
+	if cfg.Session != nil {
+		mergedOpts = append(mergedOpts, WithCookieJar(cfg.Session))
+	}
+
 	// Transport 캐싱 사용 여부 설정
 	mergedOpts = append(mergedOpts, WithDisableTransportCaching(cfg.DisableTransportCaching))
 
@@ -372,15 +476,56 @@ func NewFromConfig(cfg Config, opts ...Option) Fetcher {
 	// ========================================
 	// 1단계: 기본 HTTPFetcher 생성 (체인의 가장 안쪽)
 	// ========================================
-	var f Fetcher = NewHTTPFetcher(mergedOpts...)
+	httpFetcher := NewHTTPFetcher(mergedOpts...)
+
+	var f Fetcher = httpFetcher
 
 	// ========================================
-	// 2단계: HTTP 응답 본문의 크기 제한 미들웨어
+	// 2단계: 호스트별 요청 속도 제한 미들웨어
+	// ========================================
+	// RetryFetcher 안쪽에 위치하여 재시도 요청도 같은 호스트의 요청 속도 제한을 따릅니다.
+	if cfg.RateLimiter != nil {
+		f = NewRateLimitFetcher(f, cfg.RateLimiter)
+	}
+
+	// ========================================
+	// 3단계: HTTP 응답 본문의 크기 제한 미들웨어
 	// ========================================
 	f = NewMaxBytesFetcher(f, *cfg.MaxBytes)
 
 	// ========================================
-	// 3단계: HTTP 응답 상태 코드 검증 미들웨어
+	// 4단계: 조건부 요청 응답 캐시 미들웨어
+	// ========================================
+	// StatusCodeFetcher 안쪽에 위치하여 304 응답이 상태 코드 검증 전에 캐시된 200 응답으로 복원됩니다.
+	if cfg.ResponseCache != nil {
+		f = NewCachingFetcher(f, cfg.ResponseCache)
+	}
+
+	// ========================================
+	// 5단계: 응답 녹화 미들웨어
+	// ========================================
+	if cfg.RecordingDir != "" {
+		recorder, err := NewRecordingFetcher(f, cfg.RecordingDir)
+		if err != nil {
+			applog.WithComponentAndFields(component, applog.Fields{
+				"dir":   cfg.RecordingDir,
+				"error": err.Error(),
+			}).Warn("응답 녹화 초기화 실패: 녹화 없이 Fetcher 체인을 구성합니다")
+		} else {
+			f = recorder
+		}
+	}
+
+	// ========================================
+	// 6단계: 로그인 세션 만료 감지 미들웨어
+	// ========================================
+	// 로그인 페이지로 이동된 응답(200)이 상태 코드 검증을 통과해 정상 응답으로 처리되지 않도록 StatusCodeFetcher 안쪽에 위치합니다.
+	if cfg.Session != nil {
+		f = NewSessionFetcher(f, cfg.Session)
+	}
+
+	// ========================================
+	// 7단계: HTTP 응답 상태 코드 검증 미들웨어
 	// ========================================
 	if !cfg.DisableStatusCodeValidation {
 		if len(cfg.AllowedStatusCodes) > 0 {
@@ -393,19 +538,28 @@ func NewFromConfig(cfg Config, opts ...Option) Fetcher {
 	}
 
 	// ========================================
-	// 4단계: HTTP 응답 MIME 타입 검증 미들웨어
+	// 8단계: HTTP 응답 MIME 타입 검증 미들웨어
 	// ========================================
 	if len(cfg.AllowedMimeTypes) > 0 {
 		f = NewMimeTypeFetcher(f, cfg.AllowedMimeTypes, true)
 	}
 
 	// ========================================
-	// 5단계: HTTP 요청 재시도 수행 미들웨어
+	// 9단계: HTTP 요청 재시도 수행 미들웨어
 	// ========================================
 	f = NewRetryFetcher(f, *cfg.MaxRetries, *cfg.MinRetryDelay, *cfg.MaxRetryDelay)
 
 	// ========================================
-	// 6단계: User-Agent 주입 미들웨어
+	// 10단계: robots.txt 준수 미들웨어
+	// ========================================
+	// RetryFetcher 바깥에 위치하여 robots.txt에 의해 차단된 요청은 재시도 없이 즉시 실패합니다.
+	// robots.txt는 이 체인과 같은 프록시와 타임아웃으로 읽어오고, 요청에 User-Agent가 없으면 HTTPFetcher가 담아 보낼 기본 User-Agent로 규칙을 고릅니다.
+	if cfg.RobotsPolicy != nil {
+		f = NewRobotsFetcher(f, cfg.RobotsPolicy, NewFromConfig(cfg.robotsTxtConfig(), opts...), httpFetcher.defaultUA)
+	}
+
+	// ========================================
+	// 11단계: User-Agent 주입 미들웨어
 	// ========================================
 	// RetryFetcher 바깥에 위치하여 재시도 시에도 동일한 User-Agent를 유지합니다.
 	if cfg.EnableUserAgentRandomization {
@@ -413,13 +567,21 @@ func NewFromConfig(cfg Config, opts ...Option) Fetcher {
 	}
 
 	// ========================================
-	// 7단계: 로깅 미들웨어 (체인의 가장 바깥쪽)
+	// 12단계: 로깅 미들웨어 (체인의 가장 바깥쪽)
 	// ========================================
 	// 가장 바깥쪽에 위치하여 모든 미들웨어의 동작을 포함한 전체 과정을 로깅
 	if !cfg.DisableLogging {
 		f = NewLoggingFetcher(f)
 	}
 
+	// ========================================
+	// 13단계: 분산 추적 미들웨어
+	// ========================================
+	// LoggingFetcher보다 바깥에 위치하여 요청 로그와 재시도 로그에도 Span의 trace_id가 기록됩니다.
+	if cfg.EnableTracing {
+		f = NewTracingFetcher(f)
+	}
+
 	return f
 }
 
@@ -1,6 +1,8 @@
 ﻿package fetcher
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -538,6 +540,142 @@ func TestNewFromConfig_MiddlewareToggling_Disabled(t *testing.T) {
 	require.NotNil(t, httpOpts)
 }
 
+// TestNewFromConfig_RateLimiter RateLimiter 설정 시 HTTPFetcher 바로 바깥에 RateLimitFetcher가 배치되는지 검증
+func TestNewFromConfig_RateLimiter(t *testing.T) {
+	limiter := NewHostRateLimiter(HostRateLimit{}, nil)
+
+	f := NewFromConfig(Config{DisableLogging: true, DisableStatusCodeValidation: true, RateLimiter: limiter})
+
+	// Expected Chain: Retry -> MaxBytes -> RateLimit -> HTTP
+	retryDelegate, _, _, _ := InspectRetryFetcher(f)
+	require.NotNil(t, retryDelegate)
+
+	bytesDelegate, _ := InspectMaxBytesFetcher(retryDelegate)
+	require.NotNil(t, bytesDelegate)
+
+	rateDelegate, sharedLimiter := InspectRateLimitFetcher(bytesDelegate)
+	require.NotNil(t, rateDelegate, "RateLimitFetcher should wrap HTTPFetcher directly")
+	assert.Same(t, limiter, sharedLimiter)
+
+	require.NotNil(t, InspectHTTPFetcher(rateDelegate))
+}
+
+// TestNewFromConfig_RobotsPolicy RobotsPolicy 설정 시 RetryFetcher 바깥에 RobotsFetcher가 배치되고,
+// robots.txt는 같은 프록시와 타임아웃을 사용하되 상태 코드 검증이 없는 별도 체인으로 읽어오는지 검증
+func TestNewFromConfig_RobotsPolicy(t *testing.T) {
+	policy := NewRobotsPolicy("", 0, nil)
+	proxyURL := "http://proxy.example.com:8080"
+	timeout := 7 * time.Second
+
+	f := NewFromConfig(Config{DisableLogging: true, RobotsPolicy: policy, ProxyURL: &proxyURL, Timeout: &timeout}, WithUserAgent("TestBot/1.0"))
+
+	// Expected Chain: Robots -> Retry -> ...
+	robotsDelegate, sharedPolicy, robotsFetcher, userAgent := InspectRobotsFetcher(f)
+	require.NotNil(t, robotsDelegate, "RobotsFetcher should wrap RetryFetcher")
+	assert.Same(t, policy, sharedPolicy)
+	assert.Equal(t, "TestBot/1.0", userAgent, "요청에 User-Agent가 없을 때 HTTPFetcher가 보낼 User-Agent로 규칙을 골라야 합니다")
+
+	retryDelegate, _, _, _ := InspectRetryFetcher(robotsDelegate)
+	require.NotNil(t, retryDelegate)
+
+	// Expected robots.txt Chain: Retry(0) -> MaxBytes -> HTTP
+	robotsRetryDelegate, maxRetries, _, _ := InspectRetryFetcher(robotsFetcher)
+	require.NotNil(t, robotsRetryDelegate)
+	assert.Equal(t, 0, maxRetries)
+
+	statusDelegate, _ := InspectStatusCodeFetcher(robotsRetryDelegate)
+	assert.Nil(t, statusDelegate, "robots.txt 조회에는 상태 코드 검증을 적용하지 않아야 합니다")
+
+	bytesDelegate, _ := InspectMaxBytesFetcher(robotsRetryDelegate)
+	require.NotNil(t, bytesDelegate)
+
+	opts := InspectHTTPFetcher(bytesDelegate)
+	require.NotNil(t, opts)
+	require.NotNil(t, opts.ProxyURL)
+	assert.Equal(t, proxyURL, *opts.ProxyURL)
+	assert.Equal(t, timeout, opts.Timeout)
+}
+
+// TestNewFromConfig_ResponseCache ResponseCache 설정 시 StatusCodeFetcher와 MaxBytesFetcher 사이에 CachingFetcher가 배치되는지 검증
+func TestNewFromConfig_ResponseCache(t *testing.T) {
+	cache, err := NewDiskResponseCache(t.TempDir(), 0)
+	require.NoError(t, err)
+
+	f := NewFromConfig(Config{DisableLogging: true, ResponseCache: cache})
+
+	// Expected Chain: Retry -> StatusCode -> Caching -> MaxBytes -> HTTP
+	retryDelegate, _, _, _ := InspectRetryFetcher(f)
+	require.NotNil(t, retryDelegate)
+
+	statusDelegate, _ := InspectStatusCodeFetcher(retryDelegate)
+	require.NotNil(t, statusDelegate)
+
+	cachingDelegate, sharedCache := InspectCachingFetcher(statusDelegate)
+	require.NotNil(t, cachingDelegate, "CachingFetcher should wrap MaxBytesFetcher")
+	assert.Same(t, cache, sharedCache)
+
+	bytesDelegate, _ := InspectMaxBytesFetcher(cachingDelegate)
+	require.NotNil(t, bytesDelegate)
+}
+
+// TestNewFromConfig_RecordingDir RecordingDir 설정 시 StatusCodeFetcher 안쪽, CachingFetcher 바깥에 RecordingFetcher가 배치되는지 검증
+func TestNewFromConfig_RecordingDir(t *testing.T) {
+	cache, err := NewDiskResponseCache(t.TempDir(), 0)
+	require.NoError(t, err)
+	dir := filepath.Join(t.TempDir(), "fixtures")
+
+	f := NewFromConfig(Config{DisableLogging: true, ResponseCache: cache, RecordingDir: dir})
+
+	// Expected Chain: Retry -> StatusCode -> Recording -> Caching -> MaxBytes -> HTTP
+	retryDelegate, _, _, _ := InspectRetryFetcher(f)
+	statusDelegate, _ := InspectStatusCodeFetcher(retryDelegate)
+	require.NotNil(t, statusDelegate)
+
+	recordingDelegate, recordingDir := InspectRecordingFetcher(statusDelegate)
+	require.NotNil(t, recordingDelegate, "RecordingFetcher should wrap CachingFetcher")
+	assert.Equal(t, dir, recordingDir)
+	assert.DirExists(t, dir)
+
+	cachingDelegate, _ := InspectCachingFetcher(recordingDelegate)
+	require.NotNil(t, cachingDelegate)
+
+	t.Run("디렉터리를 만들 수 없으면 녹화 없이 구성", func(t *testing.T) {
+		file := filepath.Join(t.TempDir(), "file")
+		require.NoError(t, os.WriteFile(file, nil, 0o644))
+
+		f := NewFromConfig(Config{DisableLogging: true, RecordingDir: filepath.Join(file, "fixtures")})
+
+		retryDelegate, _, _, _ := InspectRetryFetcher(f)
+		statusDelegate, _ := InspectStatusCodeFetcher(retryDelegate)
+		bytesDelegate, _ := InspectMaxBytesFetcher(statusDelegate)
+		assert.NotNil(t, bytesDelegate)
+	})
+}
+
+// TestNewFromConfig_Session Session 설정 시 StatusCodeFetcher 안쪽에 SessionFetcher가 배치되는지 검증
+func TestNewFromConfig_Session(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cookies.json")
+	require.NoError(t, os.WriteFile(path, []byte(`[{"name":"NID_AUT","value":"v","domain":".naver.com"}]`), 0o600))
+
+	session, err := NewSession(path, SessionMarkers{}, nil)
+	require.NoError(t, err)
+
+	f := NewFromConfig(Config{DisableLogging: true, Session: session})
+
+	// Expected Chain: Retry -> StatusCode -> Session -> MaxBytes -> HTTP
+	retryDelegate, _, _, _ := InspectRetryFetcher(f)
+	statusDelegate, _ := InspectStatusCodeFetcher(retryDelegate)
+	require.NotNil(t, statusDelegate)
+
+	sessionDelegate, sharedSession := InspectSessionFetcher(statusDelegate)
+	require.NotNil(t, sessionDelegate, "SessionFetcher should wrap MaxBytesFetcher")
+	assert.Same(t, session, sharedSession)
+
+	bytesDelegate, _ := InspectMaxBytesFetcher(sessionDelegate)
+	require.NotNil(t, bytesDelegate)
+	assert.Same(t, session, bytesDelegate.(*HTTPFetcher).client.Jar, "세션은 HTTP 클라이언트의 CookieJar로 연결되어야 합니다")
+}
+
 // TestNewFromConfig_ValidationOptions_StatusCodesAndMimeTypes 검증 옵션 설정에 따른 분기 검증
 func TestNewFromConfig_ValidationOptions_StatusCodesAndMimeTypes(t *testing.T) {
 	// Case A: AllowedStatusCodes is nil/empty -> Default 200 OK only
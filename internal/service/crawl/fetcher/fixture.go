@@ -0,0 +1,123 @@
+package fetcher
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+)
+
+// fixtureFileExt RecordingFetcher가 기록하고 ReplayFetcher가 읽어들이는 fixture 파일의 확장자입니다.
+const fixtureFileExt = ".json"
+
+// fixtureBodyEncodingBase64 UTF-8로 표현할 수 없는 본문(예: EUC-KR 페이지, 이미지)을 Base64로 인코딩하여 저장했음을 나타내는 값입니다.
+const fixtureBodyEncodingBase64 = "base64"
+
+// fixture 한 번의 HTTP 요청/응답 쌍을 파일로 저장하기 위한 직렬화 구조체입니다.
+//
+// 요청 URL, 헤더, 요청 본문은 저장 전에 redactURL/redactHeaders/redactBody로 마스킹되므로,
+// 운영 환경에서 수집한 fixture를 저장소에 커밋하더라도 인증 정보가 노출되지 않습니다.
+type fixture struct {
+	Request  fixtureRequest  `json:"request"`
+	Response fixtureResponse `json:"response"`
+}
+
+// fixtureRequest fixture에 기록되는 요청 정보입니다.
+type fixtureRequest struct {
+	Method       string      `json:"method"`
+	URL          string      `json:"url"`
+	Header       http.Header `json:"header,omitempty"`
+	Body         string      `json:"body,omitempty"`
+	BodyEncoding string      `json:"body_encoding,omitempty"`
+}
+
+// fixtureResponse fixture에 기록되는 응답 정보입니다.
+type fixtureResponse struct {
+	StatusCode   int         `json:"status_code"`
+	Status       string      `json:"status"`
+	Header       http.Header `json:"header,omitempty"`
+	Body         string      `json:"body,omitempty"`
+	BodyEncoding string      `json:"body_encoding,omitempty"`
+}
+
+// fixtureKey 요청을 식별하는 매칭 키를 계산합니다.
+//
+// 키는 메서드, 마스킹된 URL, 요청 본문의 해시로 구성됩니다. body에는 redactBody로 마스킹한 본문을 전달해야 합니다.
+// 녹화 시점과 재생 시점 모두 동일하게 마스킹된 URL과 본문을 사용하므로, 토큰 등 민감한 값이 달라도 같은 fixture에 매칭됩니다.
+func fixtureKey(method string, u *url.URL, body []byte) string {
+	bodySum := sha256.Sum256(body)
+	return strings.ToUpper(method) + " " + redactURL(u) + " " + hex.EncodeToString(bodySum[:])
+}
+
+// fixtureFileName 매칭 키로부터 fixture 파일 이름을 생성합니다.
+// 사람이 디렉터리를 훑어보며 구분할 수 있도록 메서드와 호스트를 접두어로 붙이고, 나머지는 키의 해시로 고유성을 보장합니다.
+func fixtureFileName(method string, u *url.URL, key string) string {
+	sum := sha256.Sum256([]byte(key))
+
+	host := strings.NewReplacer(":", "_", "/", "_").Replace(u.Host)
+	if host == "" {
+		host = "local"
+	}
+
+	return fmt.Sprintf("%s_%s_%s%s", strings.ToUpper(method), host, hex.EncodeToString(sum[:8]), fixtureFileExt)
+}
+
+// encodeFixtureBody 본문을 fixture 파일에 저장할 문자열과 인코딩 방식으로 변환합니다.
+// 유효한 UTF-8 본문은 사람이 읽을 수 있도록 그대로 저장하고, 그렇지 않으면 Base64로 인코딩합니다.
+func encodeFixtureBody(body []byte) (string, string) {
+	if utf8.Valid(body) {
+		return string(body), ""
+	}
+	return base64.StdEncoding.EncodeToString(body), fixtureBodyEncodingBase64
+}
+
+// decodeFixtureBody encodeFixtureBody로 저장된 본문을 원래의 바이트 배열로 복원합니다.
+func decodeFixtureBody(body, encoding string) ([]byte, error) {
+	switch encoding {
+	case "":
+		return []byte(body), nil
+
+	case fixtureBodyEncodingBase64:
+		return base64.StdEncoding.DecodeString(body)
+
+	default:
+		return nil, fmt.Errorf("지원하지 않는 fixture 본문 인코딩입니다: %s", encoding)
+	}
+}
+
+// readRequestBody 요청 본문을 모두 읽어 반환하고, 이후 실제 요청 전송에 사용할 수 있도록 요청 본문을 복원합니다.
+// GetBody가 설정된 요청은 GetBody로 본문 사본을 얻으므로 원본 스트림을 소비하지 않습니다.
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+
+	if req.GetBody != nil {
+		rc, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		defer rc.Close()
+
+		return io.ReadAll(rc)
+	}
+
+	body, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+
+	return body, nil
+}
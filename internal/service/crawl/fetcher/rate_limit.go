@@ -0,0 +1,316 @@
+package fetcher
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	applog "github.com/darkkaiser/notify-server/pkg/log"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// DefaultHostRequestsPerSecond 호스트별 초당 요청 수를 지정하지 않았을 때 사용하는 기본값입니다.
+	DefaultHostRequestsPerSecond = 2.0
+
+	// DefaultHostBurst 호스트별 버스트 허용량을 지정하지 않았을 때 사용하는 기본값입니다.
+	DefaultHostBurst = 4
+
+	// adaptiveWindowSize 호스트별 스로틀링 비율(429/503 응답 비율)을 계산할 때 사용하는 최근 응답 개수입니다.
+	adaptiveWindowSize = 20
+
+	// maxAdaptivePenalty 스로틀링 응답이 많아질 때 요청 속도를 줄이는 최대 배수입니다.
+	// 최근 응답이 모두 429/503이면 설정된 속도의 1/16로 요청합니다.
+	maxAdaptivePenalty = 16
+
+	// maxHostBlockWait 서버가 Retry-After로 요구한 대기 시간 중 요청을 보류하며 기다려 줄 최대 시간입니다.
+	// 남은 대기 시간이 이보다 길면 크롤링 작업이 오래 멈춰 있지 않도록 기다리지 않고 즉시 에러를 반환합니다.
+	maxHostBlockWait = time.Minute
+
+	// maxCrawlDelay robots.txt의 Crawl-delay로 적용할 요청 간격의 최대값입니다.
+	// 지나치게 큰 값으로 크롤링 작업이 실행 시간 상한 안에 끝나지 못하는 것을 막기 위해 이 값으로 제한합니다.
+	maxCrawlDelay = 30 * time.Second
+)
+
+// HostRateLimit 호스트 하나에 적용할 요청 속도 제한 설정입니다.
+type HostRateLimit struct {
+	// RequestsPerSecond 초당 허용 요청 수입니다. (0 이하: 기본값 사용)
+	RequestsPerSecond float64
+
+	// Burst 순간적으로 허용할 최대 요청 수입니다. (0 이하: 기본값 사용)
+	Burst int
+}
+
+// HostRateLimiter 호스트별 토큰 버킷으로 요청 간격을 조절하는 공유 상태입니다.
+//
+// 여러 공급자가 같은 호스트를 크롤링하더라도 해당 호스트에 대한 전체 요청 속도가 제한되도록,
+// 프로세스에 하나만 생성하여 모든 Fetcher 체인(RateLimitFetcher)이 함께 사용해야 합니다.
+//
+// 호스트별로 다음 세 가지를 관리합니다.
+//   - 토큰 버킷: 설정된 초당 요청 수와 버스트 허용량으로 요청 간격을 조절합니다.
+//   - Retry-After: 응답에 Retry-After 헤더가 있으면 재시도 여부와 관계없이 해당 시각까지 같은 호스트로의 요청을 보류합니다.
+//   - 적응형 감속: 최근 응답 중 429/503 비율이 높아질수록 요청 속도를 최대 1/16까지 줄이고, 비율이 낮아지면 원래 속도로 되돌립니다.
+type HostRateLimiter struct {
+	// defaultLimit 호스트별 설정이 없는 호스트에 적용할 기본 설정입니다.
+	defaultLimit HostRateLimit
+
+	// hostLimits 호스트별 설정입니다. (키: 소문자 호스트 이름, 포트 포함 가능)
+	hostLimits map[string]HostRateLimit
+
+	mu    sync.Mutex
+	hosts map[string]*hostRateState
+
+	// now 현재 시각을 반환하는 함수입니다. (테스트에서 교체)
+	now func() time.Time
+}
+
+// hostRateState 호스트 하나의 요청 속도 제한 상태입니다.
+type hostRateState struct {
+	mu sync.Mutex
+
+	limiter *rate.Limiter
+
+	// configuredLimit, configuredBurst 설정 파일에 지정된(또는 기본값으로 보정된) 요청 속도와 버스트 허용량입니다.
+	configuredLimit rate.Limit
+	configuredBurst int
+
+	// baseLimit 감속 배수를 적용하기 전의 요청 속도입니다. (Crawl-delay가 있으면 설정값보다 느려질 수 있음)
+	baseLimit rate.Limit
+
+	// outcomes 최근 응답의 스로틀링 여부를 담은 원형 버퍼입니다.
+	outcomes  [adaptiveWindowSize]bool
+	count     int
+	next      int
+	throttled int
+
+	// penalty 현재 적용 중인 감속 배수입니다. (1: 감속 없음)
+	penalty float64
+
+	// blockedUntil Retry-After로 요청을 보류해야 하는 시각입니다.
+	blockedUntil time.Time
+}
+
+// NewHostRateLimiter 새로운 HostRateLimiter를 생성합니다.
+//
+// 매개변수:
+//   - defaultLimit: hostLimits에 없는 호스트에 적용할 기본 설정 (0 이하의 값은 DefaultHostRequestsPerSecond, DefaultHostBurst로 보정)
+//   - hostLimits: 호스트별 설정 (키는 대소문자를 구분하지 않으며, "example.com:8080"처럼 포트를 포함할 수 있음)
+func NewHostRateLimiter(defaultLimit HostRateLimit, hostLimits map[string]HostRateLimit) *HostRateLimiter {
+	normalized := make(map[string]HostRateLimit, len(hostLimits))
+	for host, limit := range hostLimits {
+		normalized[strings.ToLower(host)] = limit
+	}
+
+	return &HostRateLimiter{
+		defaultLimit: defaultLimit,
+		hostLimits:   normalized,
+		hosts:        make(map[string]*hostRateState),
+		now:          time.Now,
+	}
+}
+
+// state 호스트의 상태를 반환합니다. 처음 요청하는 호스트이면 설정에 따라 상태를 생성합니다.
+func (l *HostRateLimiter) state(host string) *hostRateState {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if s, ok := l.hosts[host]; ok {
+		return s
+	}
+
+	limit, ok := l.hostLimits[host]
+	if !ok {
+		limit = l.defaultLimit
+	}
+	if limit.RequestsPerSecond <= 0 {
+		limit.RequestsPerSecond = DefaultHostRequestsPerSecond
+	}
+	if limit.Burst <= 0 {
+		limit.Burst = DefaultHostBurst
+	}
+
+	s := &hostRateState{
+		limiter:         rate.NewLimiter(rate.Limit(limit.RequestsPerSecond), limit.Burst),
+		configuredLimit: rate.Limit(limit.RequestsPerSecond),
+		configuredBurst: limit.Burst,
+		baseLimit:       rate.Limit(limit.RequestsPerSecond),
+		penalty:         1,
+	}
+	l.hosts[host] = s
+
+	return s
+}
+
+// Wait host로 요청을 보내도 될 때까지 대기합니다.
+//
+// Retry-After로 보류 중인 호스트이면 보류가 풀릴 때까지 먼저 기다린 뒤 토큰 버킷의 토큰을 기다립니다.
+// 남은 보류 시간이 maxHostBlockWait보다 길면 기다리지 않고 에러를 반환하며, ctx가 취소되면 즉시 ctx의 에러를 반환합니다.
+func (l *HostRateLimiter) Wait(ctx context.Context, host string) error {
+	s := l.state(host)
+
+	s.mu.Lock()
+	blockedFor := s.blockedUntil.Sub(l.now())
+	s.mu.Unlock()
+
+	if blockedFor > 0 {
+		if blockedFor > maxHostBlockWait {
+			return newErrHostBlocked(host, blockedFor.Round(time.Second).String())
+		}
+
+		timer := time.NewTimer(blockedFor)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	return s.limiter.Wait(ctx)
+}
+
+// Observe host로부터 받은 응답을 기록하여 Retry-After 보류와 적응형 감속 상태를 갱신합니다.
+func (l *HostRateLimiter) Observe(host string, statusCode int, header http.Header) {
+	s := l.state(host)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// 1단계: Retry-After 보류 (재시도 대상이 아닌 요청이어도 서버의 요구를 따름)
+	if delay, ok := parseRetryAfter(header.Get("Retry-After")); ok && delay > 0 {
+		if until := l.now().Add(delay); until.After(s.blockedUntil) {
+			s.blockedUntil = until
+
+			applog.WithComponentAndFields(component, applog.Fields{
+				"host":        host,
+				"status_code": statusCode,
+				"retry_after": delay.String(),
+			}).Warn("요청 보류: 서버가 Retry-After로 요청 중단을 요구하여 해당 호스트로의 요청을 보류합니다")
+		}
+	}
+
+	// 2단계: 최근 응답의 스로틀링 비율 갱신
+	throttled := statusCode == http.StatusTooManyRequests || statusCode == http.StatusServiceUnavailable
+	if s.count == adaptiveWindowSize {
+		if s.outcomes[s.next] {
+			s.throttled--
+		}
+	} else {
+		s.count++
+	}
+	s.outcomes[s.next] = throttled
+	s.next = (s.next + 1) % adaptiveWindowSize
+	if throttled {
+		s.throttled++
+	}
+
+	// 3단계: 스로틀링 비율에 비례하여 감속 배수를 조정 (비율 0 → 1배, 비율 1 → 16배)
+	ratio := float64(s.throttled) / float64(s.count)
+	penalty := 1 + ratio*(maxAdaptivePenalty-1)
+	if penalty == s.penalty {
+		return
+	}
+
+	if (penalty >= 2) != (s.penalty >= 2) {
+		applog.WithComponentAndFields(component, applog.Fields{
+			"host":            host,
+			"throttled_ratio": ratio,
+			"penalty":         penalty,
+		}).Info("요청 속도 조정: 최근 429/503 응답 비율에 따라 해당 호스트의 요청 속도를 조정합니다")
+	}
+
+	s.penalty = penalty
+	s.limiter.SetLimit(s.baseLimit / rate.Limit(penalty))
+}
+
+// SetCrawlDelay robots.txt의 Crawl-delay를 host의 요청 간격에 반영합니다.
+//
+// Crawl-delay가 설정된 요청 속도보다 느린 경우에만 적용하며, 이때는 요청이 몰리지 않도록 버스트 허용량도 1로 줄입니다.
+// delay가 0이면 설정 파일의 요청 속도로 되돌리고, maxCrawlDelay보다 크면 maxCrawlDelay로 제한합니다.
+func (l *HostRateLimiter) SetCrawlDelay(host string, delay time.Duration) {
+	s := l.state(host)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if delay > maxCrawlDelay {
+		delay = maxCrawlDelay
+	}
+
+	baseLimit, burst := s.configuredLimit, s.configuredBurst
+	if delay > 0 {
+		if every := rate.Every(delay); every < baseLimit {
+			baseLimit, burst = every, 1
+		}
+	}
+
+	if baseLimit == s.baseLimit && burst == s.limiter.Burst() {
+		return
+	}
+
+	applog.WithComponentAndFields(component, applog.Fields{
+		"host":        host,
+		"crawl_delay": delay.String(),
+	}).Info("요청 속도 조정: robots.txt의 Crawl-delay를 해당 호스트의 요청 간격에 반영합니다")
+
+	s.baseLimit = baseLimit
+	s.limiter.SetBurst(burst)
+	s.limiter.SetLimit(s.baseLimit / rate.Limit(s.penalty))
+}
+
+// RateLimitFetcher 공유 HostRateLimiter로 호스트별 요청 속도를 제한하는 미들웨어입니다.
+//
+// 주요 기능:
+//   - 요청을 보내기 전에 대상 호스트의 토큰 버킷과 Retry-After 보류 상태에 따라 대기합니다.
+//   - 응답의 상태 코드와 Retry-After 헤더를 HostRateLimiter에 기록하여 같은 호스트로의 이후 요청에 반영합니다.
+//
+// 재시도를 포함한 모든 실제 요청이 제한되도록 HTTPFetcher 바로 바깥(RetryFetcher 안쪽)에 배치합니다.
+type RateLimitFetcher struct {
+	delegate Fetcher
+
+	limiter *HostRateLimiter
+}
+
+// 컴파일 타임에 인터페이스 구현 여부를 검증합니다.
+var _ Fetcher = (*RateLimitFetcher)(nil)
+
+// NewRateLimitFetcher 새로운 RateLimitFetcher 인스턴스를 생성합니다. limiter가 nil이면 delegate를 그대로 반환합니다.
+func NewRateLimitFetcher(delegate Fetcher, limiter *HostRateLimiter) Fetcher {
+	if limiter == nil {
+		return delegate
+	}
+
+	return &RateLimitFetcher{
+		delegate: delegate,
+		limiter:  limiter,
+	}
+}
+
+// Do 대상 호스트의 요청 속도 제한에 따라 대기한 뒤 HTTP 요청을 수행합니다.
+//
+// 매개변수:
+//   - req: 처리할 HTTP 요청
+//
+// 반환값:
+//   - HTTP 응답 객체 (성공 시)
+//   - 에러 (대기 중 요청 취소, Retry-After 보류 시간 초과, 요청 처리 중 발생한 에러)
+func (f *RateLimitFetcher) Do(req *http.Request) (*http.Response, error) {
+	host := strings.ToLower(req.URL.Host)
+
+	if err := f.limiter.Wait(req.Context(), host); err != nil {
+		return nil, err
+	}
+
+	resp, err := f.delegate.Do(req)
+	if resp != nil {
+		f.limiter.Observe(host, resp.StatusCode, resp.Header)
+	}
+
+	return resp, err
+}
+
+func (f *RateLimitFetcher) Close() error {
+	return f.delegate.Close()
+}
@@ -0,0 +1,156 @@
+package fetcher_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	apperrors "github.com/darkkaiser/rss-feed-server/internal/errors"
+	"github.com/darkkaiser/rss-feed-server/internal/service/crawl/fetcher"
+	"github.com/darkkaiser/rss-feed-server/internal/service/crawl/fetcher/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newRateLimitRequest(t *testing.T, ctx context.Context, rawURL string) *http.Request {
+	t.Helper()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	require.NoError(t, err)
+
+	return req
+}
+
+// TestRateLimitFetcher_SpacesRequestsPerHost 같은 호스트로의 요청은 설정된 속도로 간격이 조절되고,
+// 다른 호스트로의 요청은 서로 영향을 주지 않는지 검증합니다.
+func TestRateLimitFetcher_SpacesRequestsPerHost(t *testing.T) {
+	limiter := fetcher.NewHostRateLimiter(fetcher.HostRateLimit{RequestsPerSecond: 20, Burst: 1}, nil)
+
+	mockFetcher := mocks.NewMockFetcher()
+	mockFetcher.On("Do", mock.Anything).Return(&http.Response{StatusCode: http.StatusOK, Header: http.Header{}}, nil)
+
+	// 같은 HostRateLimiter를 공유하는 서로 다른 Fetcher 체인에서도 제한이 함께 적용되어야 합니다.
+	f1 := fetcher.NewRateLimitFetcher(mockFetcher, limiter)
+	f2 := fetcher.NewRateLimitFetcher(mockFetcher, limiter)
+
+	start := time.Now()
+	for _, f := range []fetcher.Fetcher{f1, f2, f1} {
+		_, err := f.Do(newRateLimitRequest(t, context.Background(), "http://Example.com/page"))
+		require.NoError(t, err)
+	}
+	assert.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond, "20 RPS, 버스트 1이면 세 요청 사이에 약 50ms 간격이 있어야 합니다")
+
+	// 다른 호스트는 별도의 토큰 버킷을 사용하므로 대기 없이 바로 처리되어야 합니다.
+	start = time.Now()
+	_, err := f1.Do(newRateLimitRequest(t, context.Background(), "http://other.example.com/page"))
+	require.NoError(t, err)
+	assert.Less(t, time.Since(start), 40*time.Millisecond)
+}
+
+// TestHostRateLimiter_HostOverride 호스트별 설정이 기본 설정보다 우선 적용되는지 검증합니다.
+func TestHostRateLimiter_HostOverride(t *testing.T) {
+	limiter := fetcher.NewHostRateLimiter(fetcher.HostRateLimit{}, map[string]fetcher.HostRateLimit{
+		"Slow.Example.com": {RequestsPerSecond: 0.5, Burst: 1},
+	})
+
+	assert.Equal(t, fetcher.DefaultHostRequestsPerSecond, fetcher.HostRateLimiterLimit(limiter, "example.com"))
+	assert.Equal(t, 0.5, fetcher.HostRateLimiterLimit(limiter, "slow.example.com"))
+}
+
+// TestRateLimitFetcher_RetryAfter 재시도 대상이 아닌 응답이라도 Retry-After 헤더가 있으면
+// 같은 호스트로의 이후 요청을 보류하는지 검증합니다.
+func TestRateLimitFetcher_RetryAfter(t *testing.T) {
+	t.Run("짧은 보류 시간은 대기 후 요청", func(t *testing.T) {
+		limiter := fetcher.NewHostRateLimiter(fetcher.HostRateLimit{RequestsPerSecond: 100, Burst: 10}, nil)
+
+		base := time.Now()
+		fetcher.SetHostRateLimiterClock(limiter, func() time.Time { return base })
+
+		mockFetcher := mocks.NewMockFetcher()
+		mockFetcher.On("Do", mock.Anything).Return(&http.Response{StatusCode: http.StatusOK, Header: http.Header{"Retry-After": []string{"2"}}}, nil).Once()
+		mockFetcher.On("Do", mock.Anything).Return(&http.Response{StatusCode: http.StatusOK, Header: http.Header{}}, nil)
+
+		f := fetcher.NewRateLimitFetcher(mockFetcher, limiter)
+
+		_, err := f.Do(newRateLimitRequest(t, context.Background(), "http://example.com/a"))
+		require.NoError(t, err)
+
+		// 보류 시각까지 60ms가 남은 시점으로 시계를 옮깁니다.
+		fetcher.SetHostRateLimiterClock(limiter, func() time.Time { return base.Add(2*time.Second - 60*time.Millisecond) })
+
+		start := time.Now()
+		_, err = f.Do(newRateLimitRequest(t, context.Background(), "http://example.com/b"))
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+		mockFetcher.AssertNumberOfCalls(t, "Do", 2)
+	})
+
+	t.Run("긴 보류 시간은 요청하지 않고 에러 반환", func(t *testing.T) {
+		limiter := fetcher.NewHostRateLimiter(fetcher.HostRateLimit{}, nil)
+		limiter.Observe("example.com", http.StatusTooManyRequests, http.Header{"Retry-After": []string{"3600"}})
+
+		mockFetcher := mocks.NewMockFetcher()
+		f := fetcher.NewRateLimitFetcher(mockFetcher, limiter)
+
+		resp, err := f.Do(newRateLimitRequest(t, context.Background(), "http://example.com/a"))
+		assert.Nil(t, resp)
+		require.Error(t, err)
+		assert.True(t, apperrors.Is(err, apperrors.Unavailable))
+		assert.ErrorIs(t, err, fetcher.ErrHostBlocked)
+		mockFetcher.AssertNotCalled(t, "Do", mock.Anything)
+
+		// 다른 호스트는 영향을 받지 않아야 합니다.
+		mockFetcher.On("Do", mock.Anything).Return(&http.Response{StatusCode: http.StatusOK, Header: http.Header{}}, nil)
+		_, err = f.Do(newRateLimitRequest(t, context.Background(), "http://other.example.com/a"))
+		assert.NoError(t, err)
+	})
+
+	t.Run("보류 대기 중 컨텍스트 취소", func(t *testing.T) {
+		limiter := fetcher.NewHostRateLimiter(fetcher.HostRateLimit{}, nil)
+		limiter.Observe("example.com", http.StatusServiceUnavailable, http.Header{"Retry-After": []string{"30"}})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		err := limiter.Wait(ctx, "example.com")
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
+// TestHostRateLimiter_AdaptiveSlowDown 429/503 응답 비율에 따라 요청 속도를 줄이고,
+// 정상 응답이 이어지면 원래 속도로 되돌리는지 검증합니다.
+func TestHostRateLimiter_AdaptiveSlowDown(t *testing.T) {
+	limiter := fetcher.NewHostRateLimiter(fetcher.HostRateLimit{RequestsPerSecond: 16, Burst: 1}, nil)
+	host := "example.com"
+
+	assert.Equal(t, 1.0, fetcher.HostRateLimiterPenalty(limiter, host))
+
+	// 절반이 429이면 감속 배수는 1 + 0.5*15 = 8.5배
+	for i := 0; i < 10; i++ {
+		limiter.Observe(host, http.StatusOK, http.Header{})
+		limiter.Observe(host, http.StatusTooManyRequests, http.Header{})
+	}
+	assert.InDelta(t, 8.5, fetcher.HostRateLimiterPenalty(limiter, host), 0.001)
+
+	// 최근 응답이 모두 503이면 최대 감속(16배)
+	for i := 0; i < 20; i++ {
+		limiter.Observe(host, http.StatusServiceUnavailable, http.Header{})
+	}
+	assert.InDelta(t, 16.0, fetcher.HostRateLimiterPenalty(limiter, host), 0.001)
+	assert.InDelta(t, 1.0, fetcher.HostRateLimiterLimit(limiter, host), 0.001)
+
+	// 정상 응답이 윈도우를 모두 채우면 원래 속도로 복구
+	for i := 0; i < 20; i++ {
+		limiter.Observe(host, http.StatusOK, http.Header{})
+	}
+	assert.Equal(t, 1.0, fetcher.HostRateLimiterPenalty(limiter, host))
+	assert.InDelta(t, 16.0, fetcher.HostRateLimiterLimit(limiter, host), 0.001)
+}
+
+// TestNewRateLimitFetcher_NilLimiter limiter가 nil이면 delegate를 그대로 반환하는지 검증합니다.
+func TestNewRateLimitFetcher_NilLimiter(t *testing.T) {
+	mockFetcher := mocks.NewMockFetcher()
+	assert.Same(t, mockFetcher, fetcher.NewRateLimitFetcher(mockFetcher, nil))
+}
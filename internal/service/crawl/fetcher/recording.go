@@ -0,0 +1,157 @@
+package fetcher
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+
+	applog "github.com/darkkaiser/notify-server/pkg/log"
+	apperrors "github.com/darkkaiser/rss-feed-server/internal/errors"
+)
+
+// RecordingFetcher 실제 HTTP 요청/응답 쌍을 fixture 파일로 기록하는 미들웨어입니다.
+//
+// 운영 환경에서 실제 사이트의 응답을 수집해 두면, ReplayFetcher로 네트워크 없이 동일한 응답을 재생하여
+// 각 Provider 파서의 회귀 테스트(Regression Test)를 작성할 수 있습니다.
+//
+// 주요 특징:
+//   - 요청 URL, 요청/응답 헤더, 요청 본문은 redactURL/redactHeaders/redactBody로 마스킹한 뒤 저장합니다.
+//   - 같은 요청(메서드, 마스킹된 URL, 마스킹된 요청 본문)이 다시 기록되면 최신 응답으로 덮어씁니다.
+//   - fixture 기록에 실패하더라도 원래 요청의 결과는 그대로 호출자에게 전달합니다. (녹화는 부가 기능입니다)
+//
+// 체인 구성 시 주의사항:
+// 응답 본문을 기록하기 위해 본문 전체를 메모리로 읽어들이므로, MaxBytesFetcher보다 바깥쪽에 위치시켜
+// 크기 제한이 먼저 적용되도록 구성해야 합니다.
+type RecordingFetcher struct {
+	delegate Fetcher
+
+	// dir fixture 파일이 저장되는 디렉터리 경로입니다.
+	dir string
+
+	// mu 동시에 같은 fixture 파일에 쓰는 것을 방지합니다.
+	mu sync.Mutex
+}
+
+// 컴파일 타임에 인터페이스 구현 여부를 검증합니다.
+var _ Fetcher = (*RecordingFetcher)(nil)
+
+// NewRecordingFetcher 새로운 RecordingFetcher 인스턴스를 생성합니다.
+// fixture 디렉터리가 존재하지 않으면 생성하며, 생성에 실패하면 에러를 반환합니다.
+func NewRecordingFetcher(delegate Fetcher, dir string) (*RecordingFetcher, error) {
+	if delegate == nil {
+		panic("NewRecordingFetcher: delegate Fetcher는 필수입니다")
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, apperrors.Wrapf(err, apperrors.System, "fixture 디렉터리(%s)를 생성할 수 없습니다", dir)
+	}
+
+	return &RecordingFetcher{
+		delegate: delegate,
+		dir:      dir,
+	}, nil
+}
+
+// Do HTTP 요청을 수행하고, 응답이 존재하면 요청/응답 쌍을 fixture 파일로 기록합니다.
+//
+// 매개변수:
+//   - req: 처리할 HTTP 요청
+//
+// 반환값:
+//   - HTTP 응답 객체 (본문은 기록을 위해 메모리로 읽어들인 사본으로 교체됩니다)
+//   - 에러 (요청 처리 또는 응답 본문 읽기 중 발생한 에러)
+func (f *RecordingFetcher) Do(req *http.Request) (*http.Response, error) {
+	reqBody, err := readRequestBody(req)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.InvalidInput, "녹화를 위한 요청 본문을 읽는 과정에서 오류가 발생하였습니다")
+	}
+
+	resp, err := f.delegate.Do(req)
+	if resp == nil {
+		return resp, err
+	}
+
+	// 응답 본문을 모두 읽어 기록하고, 호출자가 그대로 읽을 수 있도록 메모리 버퍼로 교체합니다.
+	var respBody []byte
+	if resp.Body != nil {
+		var readErr error
+		respBody, readErr = io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+		if readErr != nil {
+			if err == nil {
+				err = readErr
+			}
+			return resp, err
+		}
+	}
+
+	if recErr := f.record(req, reqBody, resp, respBody); recErr != nil {
+		applog.WithComponentAndFields(component, applog.Fields{
+			"method": req.Method,
+			"url":    redactURL(req.URL),
+			"dir":    f.dir,
+			"error":  recErr.Error(),
+		}).Warn("fixture 기록 실패: 요청 결과는 정상적으로 반환합니다")
+	}
+
+	return resp, err
+}
+
+// record 요청/응답 쌍을 마스킹하여 fixture 파일로 저장합니다.
+// 파일은 임시 파일에 먼저 기록한 뒤 이름을 변경(rename)하여, 재생 중인 프로세스가 불완전한 파일을 읽지 않도록 합니다.
+func (f *RecordingFetcher) record(req *http.Request, reqBody []byte, resp *http.Response, respBody []byte) error {
+	// 로그인 폼의 비밀번호 등이 fixture에 남지 않도록 본문을 마스킹한 뒤, 매칭 키도 마스킹된 본문으로 계산합니다.
+	reqBody = redactBody(req.Header, reqBody)
+	key := fixtureKey(req.Method, req.URL, reqBody)
+
+	fx := fixture{
+		Request: fixtureRequest{
+			Method: req.Method,
+			URL:    redactURL(req.URL),
+			Header: redactHeaders(req.Header),
+		},
+		Response: fixtureResponse{
+			StatusCode: resp.StatusCode,
+			Status:     resp.Status,
+			Header:     redactHeaders(resp.Header),
+		},
+	}
+	fx.Request.Body, fx.Request.BodyEncoding = encodeFixtureBody(reqBody)
+	fx.Response.Body, fx.Response.BodyEncoding = encodeFixtureBody(respBody)
+
+	data, err := json.MarshalIndent(fx, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	path := filepath.Join(f.dir, fixtureFileName(req.Method, req.URL, key))
+
+	tmp, err := os.CreateTemp(f.dir, ".fixture-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+func (f *RecordingFetcher) Close() error {
+	return f.delegate.Close()
+}
@@ -0,0 +1,246 @@
+package fetcher_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	apperrors "github.com/darkkaiser/rss-feed-server/internal/errors"
+	"github.com/darkkaiser/rss-feed-server/internal/service/crawl/fetcher"
+	"github.com/darkkaiser/rss-feed-server/internal/service/crawl/fetcher/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newRecordedResponse는 녹화 테스트에서 delegate가 반환할 응답을 생성합니다.
+func newRecordedResponse(statusCode int, body []byte, header http.Header) *http.Response {
+	if header == nil {
+		header = make(http.Header)
+	}
+	return &http.Response{
+		StatusCode: statusCode,
+		Status:     http.StatusText(statusCode),
+		Header:     header,
+		Body:       io.NopCloser(strings.NewReader(string(body))),
+	}
+}
+
+// mustNewReplayFetcher는 녹화가 끝난 fixture 디렉터리로 ReplayFetcher를 생성하여 반환합니다.
+func mustNewReplayFetcher(t *testing.T, dir string) *fetcher.ReplayFetcher {
+	t.Helper()
+
+	replay, err := fetcher.NewReplayFetcher(dir)
+	require.NoError(t, err)
+	return replay
+}
+
+func TestRecordingFetcher_RecordThenReplay(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	mockF := mocks.NewMockFetcher()
+	mockF.On("Do", mock.MatchedBy(func(req *http.Request) bool { return req.Method == http.MethodGet })).
+		Return(newRecordedResponse(http.StatusOK, []byte("<html>목록</html>"), http.Header{"Content-Type": {"text/html"}, "Set-Cookie": {"JSESSIONID=abc"}}), nil).Once()
+	mockF.On("Do", mock.MatchedBy(func(req *http.Request) bool { return req.Method == http.MethodPost })).
+		Return(newRecordedResponse(http.StatusOK, []byte("<html>상세</html>"), nil), nil).Once()
+
+	recorder, err := fetcher.NewRecordingFetcher(mockF, dir)
+	require.NoError(t, err)
+
+	// 1. GET 요청 녹화: 호출자는 녹화 이후에도 응답 본문을 그대로 읽을 수 있어야 합니다.
+	getReq, _ := http.NewRequest(http.MethodGet, "https://example.com/board?page=1&token=secret", nil)
+	getReq.Header.Set("Cookie", "NID_AUT=private")
+	resp, err := recorder.Do(getReq)
+	require.NoError(t, err)
+	body, _ := io.ReadAll(resp.Body)
+	assert.Equal(t, "<html>목록</html>", string(body))
+
+	// 2. POST 요청 녹화: 요청 본문이 실제 전송에도 그대로 전달되어야 합니다.
+	postReq, _ := http.NewRequest(http.MethodPost, "https://example.com/view", strings.NewReader("seq=10"))
+	resp, err = recorder.Do(postReq)
+	require.NoError(t, err)
+	body, _ = io.ReadAll(resp.Body)
+	assert.Equal(t, "<html>상세</html>", string(body))
+
+	mockF.AssertExpectations(t)
+
+	// 3. 녹화된 fixture에는 민감 정보가 남지 않아야 합니다.
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		require.NoError(t, err)
+		assert.NotContains(t, string(data), "secret")
+		assert.NotContains(t, string(data), "NID_AUT=private")
+		assert.NotContains(t, string(data), "JSESSIONID=abc")
+	}
+
+	// 4. 재생: 메서드, URL, 요청 본문이 일치하는 응답을 네트워크 없이 돌려주어야 합니다.
+	replay := mustNewReplayFetcher(t, dir)
+	assert.Equal(t, 2, replay.Len())
+
+	// 토큰 값이 달라도 마스킹된 URL이 같으므로 동일한 fixture에 매칭됩니다.
+	getReq, _ = http.NewRequestWithContext(context.Background(), http.MethodGet, "https://example.com/board?page=1&token=other", nil)
+	resp, err = replay.Do(getReq)
+	require.NoError(t, err)
+	body, _ = io.ReadAll(resp.Body)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/html", resp.Header.Get("Content-Type"))
+	assert.Equal(t, "<html>목록</html>", string(body))
+
+	postReq, _ = http.NewRequest(http.MethodPost, "https://example.com/view", strings.NewReader("seq=10"))
+	resp, err = replay.Do(postReq)
+	require.NoError(t, err)
+	body, _ = io.ReadAll(resp.Body)
+	assert.Equal(t, "<html>상세</html>", string(body))
+
+	// 요청 본문이 다르면 매칭되지 않아야 합니다.
+	postReq, _ = http.NewRequest(http.MethodPost, "https://example.com/view", strings.NewReader("seq=11"))
+	_, err = replay.Do(postReq)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, fetcher.ErrFixtureNotFound)
+}
+
+func TestRecordingFetcher_RedactsRequestBody(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	mockF := mocks.NewMockFetcher()
+	mockF.On("Do", mock.Anything).Return(newRecordedResponse(http.StatusOK, []byte("<html>로그인 완료</html>"), nil), nil).Once()
+
+	recorder, err := fetcher.NewRecordingFetcher(mockF, dir)
+	require.NoError(t, err)
+
+	// 실제 요청에는 원본 본문이 그대로 전달되어야 합니다.
+	var sentBody string
+	req, _ := http.NewRequest(http.MethodPost, "https://example.com/login", strings.NewReader("id=admin&password=secret"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	_, err = recorder.Do(req)
+	require.NoError(t, err)
+	if sent, ok := mockF.Calls[0].Arguments.Get(0).(*http.Request); ok {
+		data, _ := io.ReadAll(sent.Body)
+		sentBody = string(data)
+	}
+	assert.Equal(t, "id=admin&password=secret", sentBody)
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	data, err := os.ReadFile(files[0])
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "secret", "요청 본문의 비밀번호가 fixture에 남지 않아야 합니다")
+	assert.Contains(t, string(data), "password=xxxxx")
+
+	// 비밀번호가 달라도 마스킹된 본문이 같으므로 동일한 fixture에 매칭됩니다.
+	replay := mustNewReplayFetcher(t, dir)
+	req, _ = http.NewRequest(http.MethodPost, "https://example.com/login", strings.NewReader("id=admin&password=other"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := replay.Do(req)
+	require.NoError(t, err)
+	body, _ := io.ReadAll(resp.Body)
+	assert.Equal(t, "<html>로그인 완료</html>", string(body))
+}
+
+func TestRecordingFetcher_BinaryBody(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	// EUC-KR로 인코딩된 "공지" (유효한 UTF-8이 아님)
+	eucKR := []byte{0xB0, 0xF8, 0xC1, 0xF6}
+
+	mockF := mocks.NewMockFetcher()
+	mockF.On("Do", mock.Anything).Return(newRecordedResponse(http.StatusOK, eucKR, nil), nil).Once()
+
+	recorder, err := fetcher.NewRecordingFetcher(mockF, dir)
+	require.NoError(t, err)
+
+	req, _ := http.NewRequest(http.MethodGet, "https://example.com/euckr", nil)
+	_, err = recorder.Do(req)
+	require.NoError(t, err)
+
+	replay := mustNewReplayFetcher(t, dir)
+	req, _ = http.NewRequest(http.MethodGet, "https://example.com/euckr", nil)
+	resp, err := replay.Do(req)
+	require.NoError(t, err)
+
+	body, _ := io.ReadAll(resp.Body)
+	assert.Equal(t, eucKR, body, "UTF-8이 아닌 본문도 바이트 단위로 동일하게 재생되어야 합니다")
+}
+
+func TestRecordingFetcher_ErrorWithoutResponse(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	wantErr := apperrors.New(apperrors.Unavailable, "connection refused")
+
+	mockF := mocks.NewMockFetcher()
+	mockF.On("Do", mock.Anything).Return(nil, wantErr).Once()
+
+	recorder, err := fetcher.NewRecordingFetcher(mockF, dir)
+	require.NoError(t, err)
+
+	req, _ := http.NewRequest(http.MethodGet, "https://example.com/down", nil)
+	resp, err := recorder.Do(req)
+	assert.Nil(t, resp)
+	assert.Equal(t, wantErr, err)
+
+	files, _ := filepath.Glob(filepath.Join(dir, "*.json"))
+	assert.Empty(t, files, "응답이 없는 요청은 기록하지 않아야 합니다")
+}
+
+func TestNewReplayFetcher_Errors(t *testing.T) {
+	t.Parallel()
+
+	t.Run("존재하지 않는 디렉터리", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := fetcher.NewReplayFetcher(filepath.Join(t.TempDir(), "missing"))
+		assert.Error(t, err)
+	})
+
+	t.Run("손상된 fixture 파일", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "broken.json"), []byte("{not json"), 0o644))
+
+		_, err := fetcher.NewReplayFetcher(dir)
+		require.Error(t, err)
+		assert.True(t, apperrors.Is(err, apperrors.ParsingFailed))
+	})
+
+	t.Run("일치하는 fixture 없음", func(t *testing.T) {
+		t.Parallel()
+
+		replay, err := fetcher.NewReplayFetcher(t.TempDir())
+		require.NoError(t, err)
+
+		req, _ := http.NewRequest(http.MethodGet, "https://example.com/unrecorded", nil)
+		_, err = replay.Do(req)
+		assert.ErrorIs(t, err, fetcher.ErrFixtureNotFound)
+		assert.False(t, apperrors.Is(err, apperrors.NotFound), "fixture 누락은 원문 404와 구별되어야 합니다: %v", err)
+	})
+
+	t.Run("취소된 Context", func(t *testing.T) {
+		t.Parallel()
+
+		replay, err := fetcher.NewReplayFetcher(t.TempDir())
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "https://example.com", nil)
+		_, err = replay.Do(req)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
@@ -1,6 +1,9 @@
 package fetcher
 
 import (
+	"bytes"
+	"encoding/json"
+	"mime"
 	"net/http"
 	"net/url"
 	"slices"
@@ -59,16 +62,47 @@ func redactHeaders(h http.Header) http.Header {
 
 	masked := h.Clone()
 
+	// 헤더 맵에 직접 값을 넣은 경우 키가 정규화(Canonical)되지 않았을 수 있으므로 대소문자를 구분하지 않고 비교합니다.
 	sensitive := []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
-	for _, key := range sensitive {
-		if masked.Get(key) != "" {
-			masked.Set(key, "***")
+	for key, values := range masked {
+		if len(values) == 0 || !slices.ContainsFunc(sensitive, func(s string) bool { return strings.EqualFold(s, key) }) {
+			continue
 		}
+		masked[key] = []string{"***"}
 	}
 
 	return masked
 }
 
+// redactCookies 쿠키 목록을 값이 마스킹된 "이름=***" 형태의 문자열로 반환합니다.
+//
+// # 목적
+//
+// 로그인 세션 쿠키를 불러왔을 때 어떤 쿠키가 적용되었는지 로그로 확인할 수 있도록 하되,
+// 쿠키 값은 그 자체로 로그인 자격 증명이므로 어떤 경우에도 로그에 남지 않도록 이름만 노출합니다.
+//
+// # 사용 예시
+//
+//	redactCookies([]*http.Cookie{{Name: "NID_AUT", Value: "abc"}, {Name: "NID_SES", Value: "def"}})
+//	// 결과: "NID_AUT=***; NID_SES=***"
+//
+// 매개변수:
+//   - cookies: 마스킹할 쿠키 목록 (nil 허용)
+//
+// 반환값:
+//   - 쿠키 이름만 남긴 문자열 (입력이 비어 있으면 빈 문자열 반환)
+func redactCookies(cookies []*http.Cookie) string {
+	names := make([]string, 0, len(cookies))
+	for _, c := range cookies {
+		if c == nil {
+			continue
+		}
+		names = append(names, c.Name+"=***")
+	}
+
+	return strings.Join(names, "; ")
+}
+
 // redactURL URL에서 민감한 정보를 마스킹하여 안전한 문자열로 반환합니다.
 //
 // # 목적
@@ -303,3 +337,103 @@ func isSensitiveKey(key string) bool {
 
 	return false
 }
+
+// redactBody 요청 본문에서 민감한 값을 마스킹한 사본을 반환합니다.
+//
+// # 목적
+//
+// RecordingFetcher가 요청 본문을 fixture 파일로 저장할 때, 로그인 폼의 비밀번호나 JSON 요청의 토큰 같은 값이
+// 그대로 기록되지 않도록 보호합니다. 쿼리 파라미터와 같은 기준(isSensitiveKey)으로 민감한 키를 판단합니다.
+//
+// # 마스킹 대상
+//
+//   - application/x-www-form-urlencoded: 민감한 키의 값 (`id=1&password=abc` → `id=1&password=xxxxx`)
+//   - application/json (또는 +json): 객체 안 어느 깊이에 있든 민감한 키의 값 (`{"token":"abc"}` → `{"token":"xxxxx"}`)
+//
+// 그 외 형식이거나 본문을 해석할 수 없으면 원본을 그대로 반환합니다.
+// 마스킹할 값이 없으면 원본을 그대로 반환하므로, 민감한 값이 없는 요청의 본문은 녹화 전후가 동일합니다.
+// 마스킹 결과를 다시 마스킹해도 결과가 같으므로(멱등), 녹화와 재생 시점에 같은 매칭 키를 계산할 수 있습니다.
+//
+// 매개변수:
+//   - header: 요청 헤더 (Content-Type으로 본문 형식을 판단합니다, nil 허용)
+//   - body: 마스킹할 요청 본문
+//
+// 반환값:
+//   - 민감한 값이 마스킹된 본문 (원본 바이트 배열은 변경되지 않습니다)
+func redactBody(header http.Header, body []byte) []byte {
+	if len(body) == 0 {
+		return body
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(header.Get("Content-Type"))
+
+	switch {
+	case mediaType == "application/x-www-form-urlencoded":
+		values, err := url.ParseQuery(string(body))
+		if err != nil {
+			return body
+		}
+
+		masked := false
+		for key := range values {
+			if isSensitiveKey(key) {
+				values.Set(key, "xxxxx")
+				masked = true
+			}
+		}
+		if !masked {
+			return body
+		}
+
+		return []byte(values.Encode())
+
+	case mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"):
+		dec := json.NewDecoder(bytes.NewReader(body))
+		dec.UseNumber()
+
+		var v any
+		if err := dec.Decode(&v); err != nil {
+			return body
+		}
+		if !redactJSONValue(v) {
+			return body
+		}
+
+		redacted, err := json.Marshal(v)
+		if err != nil {
+			return body
+		}
+
+		return redacted
+	}
+
+	return body
+}
+
+// redactJSONValue JSON 값(v)을 순회하며 민감한 키의 값을 "xxxxx"로 교체하고, 교체한 값이 있는지 반환합니다.
+func redactJSONValue(v any) bool {
+	masked := false
+
+	switch t := v.(type) {
+	case map[string]any:
+		for key, child := range t {
+			if isSensitiveKey(key) {
+				t[key] = "xxxxx"
+				masked = true
+				continue
+			}
+			if redactJSONValue(child) {
+				masked = true
+			}
+		}
+
+	case []any:
+		for _, child := range t {
+			if redactJSONValue(child) {
+				masked = true
+			}
+		}
+	}
+
+	return masked
+}
@@ -122,6 +122,17 @@ func Test_redactHeaders(t *testing.T) {
 				"Cookie":        []string{"***"},
 			},
 		},
+		{
+			name: "Non-canonical keys set directly on the map are redacted",
+			input: http.Header{
+				"cookie":     []string{"NID_AUT=secret"},
+				"set-cookie": []string{"NID_SES=secret"},
+			},
+			expected: http.Header{
+				"cookie":     []string{"***"},
+				"set-cookie": []string{"***"},
+			},
+		},
 		{
 			name: "Multiple values in sensitive headers (Set-Cookie)",
 			input: http.Header{
@@ -149,6 +160,17 @@ func Test_redactHeaders(t *testing.T) {
 	}
 }
 
+func Test_redactCookies(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "", redactCookies(nil))
+	assert.Equal(t, "NID_AUT=***; NID_SES=***", redactCookies([]*http.Cookie{
+		{Name: "NID_AUT", Value: "secret-aut"},
+		nil,
+		{Name: "NID_SES", Value: "secret-ses"},
+	}))
+}
+
 func Test_redactURL(t *testing.T) {
 	t.Parallel()
 
@@ -369,3 +391,37 @@ func Test_redactRefererURL(t *testing.T) {
 		})
 	}
 }
+
+func Test_redactBody(t *testing.T) {
+	t.Parallel()
+
+	form := http.Header{"Content-Type": {"application/x-www-form-urlencoded; charset=UTF-8"}}
+	jsonHeader := http.Header{"Content-Type": {"application/json"}}
+
+	tests := []struct {
+		name     string
+		header   http.Header
+		body     string
+		expected string
+	}{
+		{name: "폼 본문의 민감한 값 마스킹", header: form, body: "id=admin&password=secret", expected: "id=admin&password=xxxxx"},
+		{name: "민감한 값이 없는 폼 본문은 원본 유지", header: form, body: "seq=10&b=2&a=1", expected: "seq=10&b=2&a=1"},
+		{name: "JSON 중첩 객체의 민감한 값 마스킹", header: jsonHeader, body: `{"user":{"name":"kim","access_token":"abc"},"items":[{"api_key":"k"}],"id":12345678901234567890}`, expected: `{"id":12345678901234567890,"items":[{"api_key":"xxxxx"}],"user":{"access_token":"xxxxx","name":"kim"}}`},
+		{name: "민감한 값이 없는 JSON 본문은 원본 유지", header: jsonHeader, body: `{"b":1, "a":2}`, expected: `{"b":1, "a":2}`},
+		{name: "해석할 수 없는 JSON은 원본 유지", header: jsonHeader, body: `{"token":`, expected: `{"token":`},
+		{name: "그 외 형식은 원본 유지", header: http.Header{"Content-Type": {"text/plain"}}, body: "password=secret", expected: "password=secret"},
+		{name: "Content-Type 없음", header: nil, body: "password=secret", expected: "password=secret"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			redacted := redactBody(tt.header, []byte(tt.body))
+			assert.Equal(t, tt.expected, string(redacted))
+
+			// 마스킹 결과를 다시 마스킹해도 같아야 녹화와 재생 시점의 매칭 키가 일치합니다.
+			assert.Equal(t, string(redacted), string(redactBody(tt.header, redacted)))
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package fetcher
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	apperrors "github.com/darkkaiser/rss-feed-server/internal/errors"
+)
+
+// ReplayFetcher RecordingFetcher가 기록한 fixture 파일로부터 응답을 재생하는 Fetcher 구현체입니다.
+//
+// 실제 네트워크 요청을 전혀 수행하지 않으므로, Provider 파서 테스트를 결정론적(Deterministic)이고
+// 오프라인에서도 실행 가능하게 만들어 줍니다.
+//
+// 매칭 규칙:
+//   - 메서드, 마스킹된 URL, 마스킹된 요청 본문이 모두 일치하는 fixture의 응답을 반환합니다.
+//   - 일치하는 fixture가 없으면 ErrFixtureNotFound 에러를 반환합니다. (실제 네트워크로 폴백하지 않습니다)
+//     원문 서버의 404 응답과 구별되도록 apperrors.NotFound가 아닌 종류를 사용하므로, 파서가 fixture 누락을 원문 삭제로 판정하지 않습니다.
+type ReplayFetcher struct {
+	// fixtures 매칭 키(fixtureKey)를 기준으로 인덱싱된 fixture 목록입니다.
+	fixtures map[string]*fixture
+}
+
+// 컴파일 타임에 인터페이스 구현 여부를 검증합니다.
+var _ Fetcher = (*ReplayFetcher)(nil)
+
+// NewReplayFetcher 지정한 디렉터리의 fixture 파일을 모두 읽어들여 새로운 ReplayFetcher 인스턴스를 생성합니다.
+//
+// 디렉터리를 읽을 수 없거나 fixture 파일의 형식이 올바르지 않으면 에러를 반환합니다.
+// 테스트에서 fixture 손상을 조기에 발견할 수 있도록, 일부 파일만 건너뛰지 않고 즉시 실패합니다.
+func NewReplayFetcher(dir string) (*ReplayFetcher, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, apperrors.Wrapf(err, apperrors.System, "fixture 디렉터리(%s)를 읽을 수 없습니다", dir)
+	}
+
+	f := &ReplayFetcher{
+		fixtures: make(map[string]*fixture, len(entries)),
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), fixtureFileExt) {
+			continue
+		}
+
+		path := filepath.Join(dir, entry.Name())
+		fx, key, err := loadFixture(path)
+		if err != nil {
+			return nil, apperrors.Wrapf(err, apperrors.ParsingFailed, "fixture 파일(%s)을 해석할 수 없습니다", path)
+		}
+
+		f.fixtures[key] = fx
+	}
+
+	return f, nil
+}
+
+// loadFixture fixture 파일 하나를 읽어 구조체와 매칭 키를 반환합니다.
+func loadFixture(path string) (*fixture, string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var fx fixture
+	if err := json.Unmarshal(data, &fx); err != nil {
+		return nil, "", err
+	}
+
+	u, err := url.Parse(fx.Request.URL)
+	if err != nil {
+		return nil, "", err
+	}
+
+	reqBody, err := decodeFixtureBody(fx.Request.Body, fx.Request.BodyEncoding)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// 응답 본문 인코딩 오류는 재생 시점이 아니라 로딩 시점에 발견되도록 미리 검증합니다.
+	if _, err := decodeFixtureBody(fx.Response.Body, fx.Response.BodyEncoding); err != nil {
+		return nil, "", err
+	}
+
+	return &fx, fixtureKey(fx.Request.Method, u, redactBody(fx.Request.Header, reqBody)), nil
+}
+
+// Len 로딩된 fixture의 개수를 반환합니다.
+func (f *ReplayFetcher) Len() int {
+	return len(f.fixtures)
+}
+
+// Do 요청과 일치하는 fixture를 찾아 기록된 응답을 반환합니다.
+//
+// 매개변수:
+//   - req: 처리할 HTTP 요청
+//
+// 반환값:
+//   - 기록된 응답으로 구성한 HTTP 응답 객체
+//   - 에러 (Context 취소, 요청 본문 읽기 실패, 일치하는 fixture 없음)
+func (f *ReplayFetcher) Do(req *http.Request) (*http.Response, error) {
+	if err := req.Context().Err(); err != nil {
+		return nil, err
+	}
+
+	reqBody, err := readRequestBody(req)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.InvalidInput, "재생할 fixture를 찾기 위한 요청 본문을 읽는 과정에서 오류가 발생하였습니다")
+	}
+
+	// fixture에는 마스킹된 본문이 기록되어 있으므로, 재생할 요청의 본문도 같은 규칙으로 마스킹한 뒤 비교합니다.
+	fx, ok := f.fixtures[fixtureKey(req.Method, req.URL, redactBody(req.Header, reqBody))]
+	if !ok {
+		return nil, newErrFixtureNotFound(req.Method, redactURL(req.URL))
+	}
+
+	// 로딩 시점에 이미 검증했으므로 여기서는 에러가 발생하지 않습니다.
+	body, _ := decodeFixtureBody(fx.Response.Body, fx.Response.BodyEncoding)
+
+	status := fx.Response.Status
+	if status == "" {
+		status = fmt.Sprintf("%d %s", fx.Response.StatusCode, http.StatusText(fx.Response.StatusCode))
+	}
+
+	header := fx.Response.Header.Clone()
+	if header == nil {
+		header = make(http.Header)
+	}
+
+	return &http.Response{
+		Status:        status,
+		StatusCode:    fx.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+func (f *ReplayFetcher) Close() error {
+	return nil
+}
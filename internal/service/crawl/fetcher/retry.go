@@ -15,6 +15,9 @@ import (
 
 	apperrors "github.com/darkkaiser/rss-feed-server/internal/errors"
 	applog "github.com/darkkaiser/notify-server/pkg/log"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.39.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -244,6 +247,19 @@ func (f *RetryFetcher) Do(req *http.Request) (*http.Response, error) {
 				WithFields(fields).
 				Warn("재시도 대기 중: 일시적 오류로 인해 요청 재시도를 준비합니다")
 
+			// 바깥의 TracingFetcher가 만든 HTTP 클라이언트 Span에 재시도 이벤트를 남겨,
+			// 추적 화면에서 어느 시도가 왜 실패했고 얼마나 기다렸는지를 요청 하나의 타임라인으로 확인할 수 있게 합니다.
+			// (추적이 비활성화되었거나 TracingFetcher가 없으면 아무 작업도 하지 않습니다)
+			retryEventAttrs := []attribute.KeyValue{
+				semconv.HTTPRequestResendCount(i),
+				attribute.String("retry.delay", delay.String()),
+				attribute.String("retry.reason", retryReason),
+			}
+			if lastErr != nil {
+				retryEventAttrs = append(retryEventAttrs, attribute.String("error.message", lastErr.Error()))
+			}
+			trace.SpanFromContext(req.Context()).AddEvent("http.retry", trace.WithAttributes(retryEventAttrs...))
+
 			// [단계 6: 재시도 대기 및 취소 감지]
 			// 계산된 시간만큼 대기하되, 요청이 취소되면 즉시 중단합니다.
 			timer := time.NewTimer(delay)
@@ -564,7 +580,13 @@ func isRetriable(err error) bool {
 		// 즉시 false를 반환하지 않고 후속 검사(apperrors)로 넘김
 	}
 
-	// [검사 5] 서버 측 일시적 오류 확인
+	// [검사 5] 요청 보류 확인
+	// 서버가 Retry-After로 요구한 보류 시간이 끝나기 전에는 재시도해도 요청을 보내지 않으므로 재시도 제외!
+	if errors.Is(err, ErrHostBlocked) {
+		return false
+	}
+
+	// [검사 6] 서버 측 일시적 오류 확인
 	// apperrors.Unavailable은 서버가 일시적으로 요청을 처리할 수 없는 상태를 나타냅니다.
 	// (예: 5xx 서버 에러, 429 Too Many Requests, 503 Service Unavailable 등)
 	// 단, 501(Not Implemented), 505(HTTP Version Not Supported), 511(Network Authentication Required)은
@@ -585,7 +607,7 @@ func isRetriable(err error) bool {
 		return true
 	}
 
-	// [검사 6] 비즈니스 로직 에러 확인
+	// [검사 7] 비즈니스 로직 에러 확인
 	// 명확한 비즈니스 로직 에러는 재시도해도 동일한 결과가 나오므로 재시도 제외!
 	if apperrors.Is(err, apperrors.ExecutionFailed /* 서버 내부 비즈니스 로직 실패 */) ||
 		apperrors.Is(err, apperrors.InvalidInput /* 잘못된 요청 파라미터 (400 Bad Request) */) ||
@@ -169,6 +169,7 @@ func TestIsRetriable(t *testing.T) {
 		// [Category 8: Complex Wrappings]
 		{"Deeply wrapped Net Error", fmt.Errorf("w1: %w", fmt.Errorf("w2: %w", &net.OpError{Err: context.DeadlineExceeded})), true},
 		{"ErrGetBodyFailed", newErrGetBodyFailed(errors.New("inner")), false},
+		{"ErrHostBlocked", newErrHostBlocked("example.com", "10m0s"), false},
 		{"Unknown generic error", errors.New("unknown error"), true}, // Default safe bet
 	}
 
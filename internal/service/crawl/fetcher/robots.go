@@ -0,0 +1,337 @@
+package fetcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	applog "github.com/darkkaiser/notify-server/pkg/log"
+	apperrors "github.com/darkkaiser/rss-feed-server/internal/errors"
+)
+
+const (
+	// DefaultRobotsUserAgent robots.txt의 규칙 그룹을 고를 때, 요청에 실제로 담긴 User-Agent 다음으로 확인하는 기본 User-agent 제품 토큰입니다.
+	DefaultRobotsUserAgent = "rss-feed-server"
+
+	// DefaultRobotsCacheTTL robots.txt를 다시 읽어오기 전까지 캐시된 규칙을 사용하는 기본 시간입니다.
+	DefaultRobotsCacheTTL = 24 * time.Hour
+
+	// robotsUnreachableTTL robots.txt를 읽지 못한 경우(5xx 응답, 네트워크 오류) 다시 읽어오기 전까지 기다리는 시간입니다.
+	// 일시적인 장애일 수 있으므로 정상 TTL보다 짧게 두어 곧 다시 확인하도록 합니다.
+	robotsUnreachableTTL = 10 * time.Minute
+
+	// maxRobotsTxtBytes 읽어들일 robots.txt 본문의 최대 크기(500KiB)입니다. RFC 9309가 요구하는 최소 처리 크기와 같습니다.
+	maxRobotsTxtBytes = 500 * 1024
+)
+
+// RobotsDisallowedError robots.txt 정책에 따라 수집이 허용되지 않은 요청에 대해 반환하는 에러입니다.
+//
+// 크롤러는 errors.As로 이 에러를 식별하여 일반적인 네트워크 오류와 구분되는 안내 메시지를 보고할 수 있습니다.
+// Cause에는 apperrors.Forbidden 타입의 에러가 담겨 있으므로 RetryFetcher의 재시도 대상에서 제외됩니다.
+type RobotsDisallowedError struct {
+	// URL 차단된 요청의 URL입니다. (민감한 정보는 마스킹됨)
+	URL string
+
+	// UserAgent 요청을 차단한 규칙 그룹의 User-agent 이름입니다. (예: "rss-feed-server", "*")
+	UserAgent string
+
+	// Rule 요청을 차단한 규칙입니다. (예: "Disallow: /ArticleList.nhn")
+	Rule string
+
+	// Cause 에러 타입 분류를 위한 내부 원인 에러입니다.
+	Cause error
+}
+
+// Error 표준 error 인터페이스를 구현합니다.
+func (e *RobotsDisallowedError) Error() string {
+	return fmt.Sprintf("robots.txt 정책에 따라 수집이 허용되지 않은 주소입니다 (URL: %s, User-agent: %s, 규칙: %s)", e.URL, e.UserAgent, e.Rule)
+}
+
+// Unwrap 원인 에러(Cause)를 반환하여 errors.Is, errors.As를 사용할 수 있게 합니다.
+func (e *RobotsDisallowedError) Unwrap() error {
+	return e.Cause
+}
+
+// RobotsPolicy 호스트별 robots.txt를 읽어 캐시하고, 요청 경로의 수집 허용 여부를 판단하는 공유 상태입니다.
+//
+// HostRateLimiter와 마찬가지로 같은 호스트의 robots.txt를 중복해서 읽지 않도록 프로세스에 하나만 생성하여
+// 모든 Fetcher 체인(RobotsFetcher)이 함께 사용해야 합니다.
+// robots.txt는 캐시가 없을 때 확인을 요청한 Fetcher 체인의 robots.txt 조회용 Fetcher로 읽어오므로,
+// 공급자별로 지정한 프록시와 타임아웃이 robots.txt 조회에도 그대로 적용됩니다.
+//
+// 규칙 그룹 선택:
+// 요청에 실제로 담겨 나가는 User-Agent의 제품 토큰과 이름이 같은 그룹을 먼저 찾고, 없으면 userAgent(robots_txt.user_agent 설정)와
+// 이름이 같은 그룹을, 그마저 없으면 "*" 그룹을 적용합니다. 따라서 사이트 운영자가 서버가 실제로 보내는 User-Agent를 보고 작성한 규칙과
+// 이 서버의 이름(기본값 "rss-feed-server")을 지정해 작성한 규칙이 모두 적용됩니다.
+//
+// robots.txt 응답에 따른 처리:
+//   - 2xx: 본문을 파싱하여 User-agent에 해당하는 규칙을 적용합니다.
+//   - 4xx: robots.txt가 없는 것으로 보고 모든 경로를 허용합니다.
+//   - 5xx 또는 네트워크 오류: 경고 로그를 남기고, 이전에 읽어온 규칙이 있으면 그 규칙을, 없으면 모든 경로를 허용한 채로
+//     robotsUnreachableTTL 후에 다시 확인합니다. 수집을 차단하는 것은 robots.txt에 명시된 규칙뿐이며,
+//     사이트의 일시적인 장애나 프록시 문제로 크롤링 전체가 멈추지 않도록 합니다.
+//
+// rateLimiter가 지정되면 robots.txt의 Crawl-delay를 해당 호스트의 요청 간격에 반영합니다.
+type RobotsPolicy struct {
+	userAgent string
+	ttl       time.Duration
+
+	rateLimiter *HostRateLimiter
+
+	mu      sync.Mutex
+	entries map[string]*robotsEntry
+
+	// now 현재 시각을 반환하는 함수입니다. (테스트에서 교체)
+	now func() time.Time
+}
+
+// robotsEntry 호스트 하나의 robots.txt 캐시 항목입니다.
+type robotsEntry struct {
+	// mu 같은 호스트의 robots.txt를 여러 요청이 동시에 읽어오지 않도록 직렬화합니다.
+	mu sync.Mutex
+
+	// fetched robots.txt를 한 번이라도 확인했는지 여부입니다.
+	fetched bool
+
+	// robots 파싱한 robots.txt입니다. robots.txt가 없거나 아직 읽어오지 못했으면 nil(모두 허용)입니다.
+	robots *robotsTxt
+
+	expiresAt time.Time
+}
+
+// NewRobotsPolicy 새로운 RobotsPolicy를 생성합니다.
+//
+// 매개변수:
+//   - userAgent: 요청의 User-Agent와 일치하는 그룹이 없을 때 확인할 User-agent 제품 토큰 (빈 문자열이면 DefaultRobotsUserAgent)
+//   - ttl: robots.txt 캐시 유지 시간 (0 이하이면 DefaultRobotsCacheTTL)
+//   - rateLimiter: Crawl-delay를 반영할 HostRateLimiter (nil이면 Crawl-delay 무시)
+func NewRobotsPolicy(userAgent string, ttl time.Duration, rateLimiter *HostRateLimiter) *RobotsPolicy {
+	if strings.TrimSpace(userAgent) == "" {
+		userAgent = DefaultRobotsUserAgent
+	}
+	if ttl <= 0 {
+		ttl = DefaultRobotsCacheTTL
+	}
+
+	return &RobotsPolicy{
+		userAgent:   strings.TrimSpace(userAgent),
+		ttl:         ttl,
+		rateLimiter: rateLimiter,
+		entries:     make(map[string]*robotsEntry),
+		now:         time.Now,
+	}
+}
+
+// Check userAgent로 보내는 요청 URL의 수집이 robots.txt에 의해 허용되는지 확인합니다.
+// 허용되지 않으면 *RobotsDisallowedError를 반환합니다.
+//
+// 매개변수:
+//   - ctx: robots.txt 조회를 취소할 수 있는 컨텍스트
+//   - f: 캐시가 없거나 만료되었을 때 robots.txt를 읽어올 Fetcher (상태 코드 검증이 비활성화된 Fetcher)
+//   - u: 확인할 요청 URL
+//   - userAgent: 요청에 실제로 담겨 나가는 User-Agent
+func (p *RobotsPolicy) Check(ctx context.Context, f Fetcher, u *url.URL, userAgent string) error {
+	robots, err := p.robotsFor(ctx, f, u, userAgent)
+	if err != nil {
+		return err
+	}
+
+	target := u.EscapedPath()
+	if target == "" {
+		target = "/"
+	}
+	if u.RawQuery != "" {
+		target += "?" + u.RawQuery
+	}
+
+	rules := robots.rules(userAgent, p.userAgent)
+	allowed, rule := rules.allowed(target)
+	if allowed {
+		return nil
+	}
+
+	return &RobotsDisallowedError{
+		URL:       redactURL(u),
+		UserAgent: rules.agent,
+		Rule:      rule.String(),
+		Cause:     apperrors.New(apperrors.Forbidden, "robots.txt 정책에 따라 수집이 허용되지 않았습니다"),
+	}
+}
+
+// robotsFor 호스트의 캐시된 robots.txt를 반환합니다. 캐시가 없거나 만료되었으면 f로 robots.txt를 다시 읽어옵니다.
+//
+// robots.txt를 읽는 도중 ctx가 취소되면 사이트의 상태와 무관한 실패이므로 캐시하지 않고 ctx의 에러를 반환합니다.
+func (p *RobotsPolicy) robotsFor(ctx context.Context, f Fetcher, u *url.URL, userAgent string) (*robotsTxt, error) {
+	key := strings.ToLower(u.Scheme + "://" + u.Host)
+
+	p.mu.Lock()
+	entry, ok := p.entries[key]
+	if !ok {
+		entry = &robotsEntry{}
+		p.entries[key] = entry
+	}
+	p.mu.Unlock()
+
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	if entry.fetched && p.now().Before(entry.expiresAt) {
+		return entry.robots, nil
+	}
+
+	robots, ttl, err := p.fetchRobotsTxt(ctx, f, u, userAgent)
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return nil, ctxErr
+	}
+	if err != nil {
+		// 읽지 못한 경우에는 이전에 읽어온 규칙(없으면 모두 허용)을 그대로 두고 잠시 후 다시 확인합니다.
+		applog.WithComponent(component).WithContext(ctx).WithFields(applog.Fields{
+			"url":         redactURL(u),
+			"error":       err.Error(),
+			"keeps_rules": entry.robots != nil,
+			"retry_after": robotsUnreachableTTL.String(),
+		}).Warn("robots.txt 조회 실패: 사이트의 수집 허용 여부를 확인하지 못해 이전 규칙(없으면 모두 허용)으로 수집을 계속합니다")
+
+		entry.fetched = true
+		entry.expiresAt = p.now().Add(robotsUnreachableTTL)
+
+		return entry.robots, nil
+	}
+
+	entry.fetched = true
+	entry.robots = robots
+	entry.expiresAt = p.now().Add(ttl)
+
+	if p.rateLimiter != nil {
+		p.rateLimiter.SetCrawlDelay(strings.ToLower(u.Host), robots.rules(userAgent, p.userAgent).crawlDelay)
+	}
+
+	return robots, nil
+}
+
+// fetchRobotsTxt f로 호스트의 robots.txt를 읽어 파싱 결과와 캐시 유지 시간을 반환합니다.
+// robots.txt가 없으면(4xx) nil을 반환하며, 5xx 응답이나 네트워크 오류처럼 사이트의 의사를 알 수 없는 경우에는 에러를 반환합니다.
+func (p *RobotsPolicy) fetchRobotsTxt(ctx context.Context, f Fetcher, u *url.URL, userAgent string) (*robotsTxt, time.Duration, error) {
+	robotsURL := &url.URL{Scheme: u.Scheme, Host: u.Host, Path: "/robots.txt"}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, robotsURL.String(), nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	if userAgent != "" {
+		req.Header.Set("User-Agent", userAgent)
+	}
+
+	resp, err := f.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer drainAndCloseBody(resp.Body)
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		body, err := io.ReadAll(io.LimitReader(resp.Body, maxRobotsTxtBytes))
+		if err != nil {
+			return nil, 0, err
+		}
+
+		robots := parseRobotsTxt(body)
+		applog.WithComponent(component).WithContext(ctx).WithFields(applog.Fields{
+			"url":         robotsURL.String(),
+			"group_count": len(robots.groups),
+		}).Debug("robots.txt 조회 완료")
+
+		return robots, p.ttl, nil
+
+	case resp.StatusCode >= 400 && resp.StatusCode < 500:
+		// robots.txt가 없거나 접근할 수 없는 경우(4xx)는 수집 제한이 없는 것으로 간주합니다.
+		return nil, p.ttl, nil
+
+	default:
+		return nil, 0, fmt.Errorf("HTTP %d", resp.StatusCode)
+	}
+}
+
+// RobotsFetcher 공유 RobotsPolicy로 robots.txt에서 허용하지 않은 경로의 요청을 차단하는 미들웨어입니다.
+//
+// 차단된 요청은 네트워크로 보내지 않고 *RobotsDisallowedError를 반환합니다.
+// 재시도해도 결과가 같으므로 RetryFetcher 바깥에 배치합니다.
+type RobotsFetcher struct {
+	delegate Fetcher
+
+	policy *RobotsPolicy
+
+	// robotsFetcher robots.txt를 읽어올 때 사용하는 Fetcher입니다.
+	// 이 체인과 같은 프록시와 타임아웃으로 구성하되, 응답 상태 코드에 따라 처리가 달라지므로 상태 코드 검증 없이 구성해야 합니다.
+	robotsFetcher Fetcher
+
+	// userAgent 요청에 User-Agent가 없을 때 체인의 HTTPFetcher가 대신 담아 보내는 기본 User-Agent입니다.
+	userAgent string
+}
+
+// 컴파일 타임에 인터페이스 구현 여부를 검증합니다.
+var _ Fetcher = (*RobotsFetcher)(nil)
+
+// NewRobotsFetcher 새로운 RobotsFetcher 인스턴스를 생성합니다. policy가 nil이면 delegate를 그대로 반환합니다.
+//
+// 매개변수:
+//   - delegate: robots.txt에서 허용한 요청을 처리할 Fetcher
+//   - policy: 프로세스 전체에서 공유하는 RobotsPolicy
+//   - robotsFetcher: robots.txt를 읽어올 Fetcher (nil이면 delegate를 사용)
+//   - userAgent: 요청에 User-Agent가 없을 때 실제로 보내지는 기본 User-Agent
+func NewRobotsFetcher(delegate Fetcher, policy *RobotsPolicy, robotsFetcher Fetcher, userAgent string) Fetcher {
+	if policy == nil {
+		return delegate
+	}
+	if robotsFetcher == nil {
+		robotsFetcher = delegate
+	}
+
+	return &RobotsFetcher{
+		delegate:      delegate,
+		policy:        policy,
+		robotsFetcher: robotsFetcher,
+		userAgent:     userAgent,
+	}
+}
+
+// Do robots.txt 정책에 따라 요청의 수집 허용 여부를 확인한 뒤 HTTP 요청을 수행합니다.
+//
+// 규칙 그룹은 요청에 실제로 담겨 나가는 User-Agent로 고릅니다. 요청에 User-Agent가 없으면 체인의 기본 User-Agent를 사용합니다.
+//
+// 매개변수:
+//   - req: 처리할 HTTP 요청
+//
+// 반환값:
+//   - HTTP 응답 객체 (성공 시)
+//   - 에러 (robots.txt에 의해 차단된 경우 *RobotsDisallowedError, 그 외 요청 처리 중 발생한 에러)
+func (f *RobotsFetcher) Do(req *http.Request) (*http.Response, error) {
+	// robots.txt 자체를 요청하는 경우는 확인 대상이 아닙니다.
+	if req.URL.Path != "/robots.txt" {
+		userAgent := req.Header.Get("User-Agent")
+		if userAgent == "" {
+			userAgent = f.userAgent
+		}
+
+		if err := f.policy.Check(req.Context(), f.robotsFetcher, req.URL, userAgent); err != nil {
+			return nil, err
+		}
+	}
+
+	return f.delegate.Do(req)
+}
+
+// Close 요청 처리용 Fetcher와 robots.txt 조회용 Fetcher의 리소스를 정리합니다.
+func (f *RobotsFetcher) Close() error {
+	err := f.delegate.Close()
+	if f.robotsFetcher != f.delegate {
+		err = errors.Join(err, f.robotsFetcher.Close())
+	}
+	return err
+}
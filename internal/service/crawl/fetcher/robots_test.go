@@ -0,0 +1,289 @@
+package fetcher_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	apperrors "github.com/darkkaiser/rss-feed-server/internal/errors"
+	"github.com/darkkaiser/rss-feed-server/internal/service/crawl/fetcher"
+	"github.com/darkkaiser/rss-feed-server/internal/service/crawl/fetcher/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newRobotsTestServer robots.txt 요청에는 robotsStatus와 robotsBody로, 그 밖의 요청에는 200 OK로 응답하는 테스트 서버를 생성합니다.
+func newRobotsTestServer(t *testing.T, robotsStatus int, robotsBody string) (*httptest.Server, *atomic.Int32, *atomic.Int32) {
+	t.Helper()
+
+	var status atomic.Int32
+	status.Store(int32(robotsStatus))
+	return newRobotsTestServerWithStatus(t, &status, robotsBody)
+}
+
+// newRobotsTestServerWithStatus robots.txt 요청에 status가 가리키는 현재 상태 코드로 응답하는 테스트 서버를 생성합니다.
+func newRobotsTestServerWithStatus(t *testing.T, status *atomic.Int32, robotsBody string) (*httptest.Server, *atomic.Int32, *atomic.Int32) {
+	t.Helper()
+
+	var robotsHits, pageHits atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			robotsHits.Add(1)
+			w.WriteHeader(int(status.Load()))
+			_, _ = w.Write([]byte(robotsBody))
+			return
+		}
+
+		pageHits.Add(1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &robotsHits, &pageHits
+}
+
+// robotsTxtFetcher 테스트에서 robots.txt를 읽어올 때 사용하는 Fetcher입니다.
+var robotsTxtFetcher = fetcher.NewHTTPFetcher()
+
+func doRobotsRequest(t *testing.T, f fetcher.Fetcher, rawURL string) (*http.Response, error) {
+	t.Helper()
+
+	req, err := http.NewRequest(http.MethodGet, rawURL, nil)
+	require.NoError(t, err)
+
+	resp, err := f.Do(req)
+	if resp != nil {
+		t.Cleanup(func() { _ = resp.Body.Close() })
+	}
+	return resp, err
+}
+
+// TestRobotsFetcher_Disallow robots.txt에서 허용하지 않은 경로는 요청을 보내지 않고 RobotsDisallowedError를 반환하는지 검증합니다.
+func TestRobotsFetcher_Disallow(t *testing.T) {
+	server, robotsHits, pageHits := newRobotsTestServer(t, http.StatusOK, "User-agent: rss-feed-server\nDisallow: /ArticleList.nhn\n")
+
+	policy := fetcher.NewRobotsPolicy("rss-feed-server", time.Hour, nil)
+	f := fetcher.NewRobotsFetcher(fetcher.NewHTTPFetcher(), policy, nil, "Mozilla/5.0 (Windows NT 10.0; Win64; x64)")
+
+	resp, err := doRobotsRequest(t, f, server.URL+"/ArticleList.nhn?search.clubid=1")
+	assert.Nil(t, resp)
+	require.Error(t, err)
+
+	var robotsErr *fetcher.RobotsDisallowedError
+	require.True(t, errors.As(err, &robotsErr))
+	assert.Equal(t, "Disallow: /ArticleList.nhn", robotsErr.Rule)
+	assert.Equal(t, "rss-feed-server", robotsErr.UserAgent)
+	assert.True(t, apperrors.Is(err, apperrors.Forbidden), "재시도 대상에서 제외되도록 Forbidden 타입이어야 합니다")
+	assert.Equal(t, int32(0), pageHits.Load())
+
+	// 허용된 경로는 그대로 요청하며, robots.txt는 캐시된 규칙을 재사용합니다.
+	resp, err = doRobotsRequest(t, f, server.URL+"/ArticleRead.nhn")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int32(1), pageHits.Load())
+	assert.Equal(t, int32(1), robotsHits.Load())
+}
+
+// TestRobotsPolicy_StatusHandling robots.txt 응답 상태 코드에 따른 처리(4xx 허용, 5xx 경고 후 허용)를 검증합니다.
+func TestRobotsPolicy_StatusHandling(t *testing.T) {
+	t.Run("4xx: 모두 허용", func(t *testing.T) {
+		server, _, _ := newRobotsTestServer(t, http.StatusNotFound, "")
+		policy := fetcher.NewRobotsPolicy("", 0, nil)
+
+		req, _ := http.NewRequest(http.MethodGet, server.URL+"/any", nil)
+		assert.NoError(t, policy.Check(context.Background(), robotsTxtFetcher, req.URL, ""))
+	})
+
+	t.Run("5xx: 허용하고 잠시 후 다시 확인", func(t *testing.T) {
+		var status atomic.Int32
+		status.Store(http.StatusServiceUnavailable)
+		server, robotsHits, _ := newRobotsTestServerWithStatus(t, &status, "User-agent: *\nDisallow: /private\n")
+
+		policy := fetcher.NewRobotsPolicy("", time.Hour, nil)
+		now := time.Now()
+		fetcher.SetRobotsPolicyClock(policy, func() time.Time { return now })
+
+		req, _ := http.NewRequest(http.MethodGet, server.URL+"/private", nil)
+		assert.NoError(t, policy.Check(context.Background(), robotsTxtFetcher, req.URL, ""), "robots.txt를 읽지 못한 것만으로 차단하지 않아야 합니다")
+		assert.NoError(t, policy.Check(context.Background(), robotsTxtFetcher, req.URL, ""))
+		assert.Equal(t, int32(1), robotsHits.Load())
+
+		// 10분이 지나면 다시 읽어오고, 명시된 규칙에 따라 차단합니다.
+		status.Store(http.StatusOK)
+		now = now.Add(10*time.Minute + time.Second)
+
+		var robotsErr *fetcher.RobotsDisallowedError
+		require.ErrorAs(t, policy.Check(context.Background(), robotsTxtFetcher, req.URL, ""), &robotsErr)
+		assert.Equal(t, int32(2), robotsHits.Load())
+
+		// 캐시가 만료된 뒤 다시 5xx로 응답하면 이전에 읽어온 규칙을 그대로 적용합니다.
+		status.Store(http.StatusInternalServerError)
+		now = now.Add(time.Hour + time.Second)
+
+		require.ErrorAs(t, policy.Check(context.Background(), robotsTxtFetcher, req.URL, ""), &robotsErr)
+		assert.Equal(t, int32(3), robotsHits.Load())
+	})
+
+	t.Run("네트워크 오류: 허용", func(t *testing.T) {
+		unreachable := mocks.NewMockFetcher()
+		unreachable.On("Do", mock.Anything).Return(nil, apperrors.New(apperrors.Unavailable, "connection refused")).Once()
+
+		policy := fetcher.NewRobotsPolicy("", 0, nil)
+
+		req, _ := http.NewRequest(http.MethodGet, "https://example.com/any", nil)
+		assert.NoError(t, policy.Check(context.Background(), unreachable, req.URL, ""))
+		unreachable.AssertExpectations(t)
+	})
+
+	t.Run("조회 중 컨텍스트가 취소되면 캐시하지 않고 컨텍스트 에러 반환", func(t *testing.T) {
+		server, robotsHits, _ := newRobotsTestServer(t, http.StatusOK, "User-agent: *\nDisallow:\n")
+		policy := fetcher.NewRobotsPolicy("", 0, nil)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		req, _ := http.NewRequest(http.MethodGet, server.URL+"/any", nil)
+		assert.ErrorIs(t, policy.Check(ctx, robotsTxtFetcher, req.URL, ""), context.Canceled)
+
+		assert.NoError(t, policy.Check(context.Background(), robotsTxtFetcher, req.URL, ""))
+		assert.Equal(t, int32(1), robotsHits.Load())
+	})
+}
+
+// TestRobotsPolicy_CacheTTL 캐시 유지 시간이 지나면 robots.txt를 다시 읽어오는지 검증합니다.
+func TestRobotsPolicy_CacheTTL(t *testing.T) {
+	server, robotsHits, _ := newRobotsTestServer(t, http.StatusOK, "User-agent: *\nDisallow: /private\n")
+
+	policy := fetcher.NewRobotsPolicy("", time.Hour, nil)
+	now := time.Now()
+	fetcher.SetRobotsPolicyClock(policy, func() time.Time { return now })
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/public", nil)
+	require.NoError(t, policy.Check(context.Background(), robotsTxtFetcher, req.URL, ""))
+	require.NoError(t, policy.Check(context.Background(), robotsTxtFetcher, req.URL, ""))
+	assert.Equal(t, int32(1), robotsHits.Load())
+
+	fetcher.SetRobotsPolicyClock(policy, func() time.Time { return now.Add(time.Hour + time.Second) })
+	require.NoError(t, policy.Check(context.Background(), robotsTxtFetcher, req.URL, ""))
+	assert.Equal(t, int32(2), robotsHits.Load())
+}
+
+// TestRobotsPolicy_CrawlDelay robots.txt의 Crawl-delay가 공유 HostRateLimiter의 요청 간격에 반영되는지 검증합니다.
+func TestRobotsPolicy_CrawlDelay(t *testing.T) {
+	server, _, _ := newRobotsTestServer(t, http.StatusOK, "User-agent: *\nCrawl-delay: 4\n")
+
+	limiter := fetcher.NewHostRateLimiter(fetcher.HostRateLimit{RequestsPerSecond: 2, Burst: 4}, nil)
+	policy := fetcher.NewRobotsPolicy("", 0, limiter)
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/page", nil)
+	require.NoError(t, policy.Check(context.Background(), robotsTxtFetcher, req.URL, ""))
+
+	assert.InDelta(t, 0.25, fetcher.HostRateLimiterLimit(limiter, req.URL.Host), 0.0001, "Crawl-delay 4초는 초당 0.25회 요청이어야 합니다")
+}
+
+// TestHostRateLimiter_SetCrawlDelay Crawl-delay는 설정된 속도보다 느린 경우에만 적용되는지 검증합니다.
+func TestHostRateLimiter_SetCrawlDelay(t *testing.T) {
+	limiter := fetcher.NewHostRateLimiter(fetcher.HostRateLimit{RequestsPerSecond: 0.5, Burst: 2}, nil)
+
+	// 설정값(0.5 RPS)보다 빠른 Crawl-delay(1초)는 무시합니다.
+	limiter.SetCrawlDelay("example.com", time.Second)
+	assert.InDelta(t, 0.5, fetcher.HostRateLimiterLimit(limiter, "example.com"), 0.0001)
+
+	// 최대값(30초)을 넘는 Crawl-delay는 최대값으로 제한합니다.
+	limiter.SetCrawlDelay("example.com", time.Hour)
+	assert.InDelta(t, 1.0/30, fetcher.HostRateLimiterLimit(limiter, "example.com"), 0.0001)
+
+	// Crawl-delay가 사라지면 설정값으로 복구합니다.
+	limiter.SetCrawlDelay("example.com", 0)
+	assert.InDelta(t, 0.5, fetcher.HostRateLimiterLimit(limiter, "example.com"), 0.0001)
+}
+
+// TestRobotsFetcher_RobotsTxtPassThrough robots.txt 자체에 대한 요청은 확인 없이 통과시키는지 검증합니다.
+func TestRobotsFetcher_RobotsTxtPassThrough(t *testing.T) {
+	server, robotsHits, _ := newRobotsTestServer(t, http.StatusOK, "User-agent: *\nDisallow: /\n")
+
+	policy := fetcher.NewRobotsPolicy("", 0, nil)
+	f := fetcher.NewRobotsFetcher(fetcher.NewHTTPFetcher(), policy, nil, "")
+
+	resp, err := doRobotsRequest(t, f, server.URL+"/robots.txt")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int32(1), robotsHits.Load(), "정책 확인을 위한 robots.txt 조회가 일어나지 않아야 합니다")
+}
+
+// TestRobotsFetcher_MatchesSentUserAgent 요청에 실제로 담겨 나가는 User-Agent의 규칙 그룹이 설정된 제품 토큰의 그룹보다 먼저 적용되는지 검증합니다.
+func TestRobotsFetcher_MatchesSentUserAgent(t *testing.T) {
+	var robotsUA atomic.Value
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			robotsUA.Store(r.Header.Get("User-Agent"))
+			_, _ = w.Write([]byte("User-agent: Mozilla\nDisallow: /board\n\nUser-agent: rss-feed-server\nDisallow: /admin\n"))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	const chromeUA = "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36"
+	policy := fetcher.NewRobotsPolicy("rss-feed-server", time.Hour, nil)
+
+	t.Run("User-Agent가 없으면 체인의 기본 User-Agent로 확인", func(t *testing.T) {
+		f := fetcher.NewRobotsFetcher(fetcher.NewHTTPFetcher(), policy, nil, chromeUA)
+
+		_, err := doRobotsRequest(t, f, server.URL+"/board")
+		var robotsErr *fetcher.RobotsDisallowedError
+		require.ErrorAs(t, err, &robotsErr)
+		assert.Equal(t, "mozilla", robotsErr.UserAgent)
+		assert.Equal(t, chromeUA, robotsUA.Load(), "robots.txt도 실제 요청과 같은 User-Agent로 읽어와야 합니다")
+	})
+
+	t.Run("일치하는 그룹이 없는 User-Agent는 설정된 제품 토큰의 그룹으로 확인", func(t *testing.T) {
+		f := fetcher.NewRobotsFetcher(fetcher.NewHTTPFetcher(), policy, nil, chromeUA)
+
+		req, _ := http.NewRequest(http.MethodGet, server.URL+"/board", nil)
+		req.Header.Set("User-Agent", "Safari/605.1.15")
+		resp, err := f.Do(req)
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+
+		req, _ = http.NewRequest(http.MethodGet, server.URL+"/admin", nil)
+		req.Header.Set("User-Agent", "Safari/605.1.15")
+		_, err = f.Do(req)
+		var robotsErr *fetcher.RobotsDisallowedError
+		require.ErrorAs(t, err, &robotsErr)
+		assert.Equal(t, "rss-feed-server", robotsErr.UserAgent)
+	})
+}
+
+// TestNewFromConfig_RobotsTxtThroughProxy robots.txt를 체인에 지정한 프록시를 통해 읽어오는지 검증합니다.
+func TestNewFromConfig_RobotsTxtThroughProxy(t *testing.T) {
+	var robotsHits atomic.Int32
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			robotsHits.Add(1)
+			_, _ = w.Write([]byte("User-agent: *\nDisallow: /private\n"))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(proxy.Close)
+
+	proxyURL := proxy.URL
+	f := fetcher.NewFromConfig(fetcher.Config{
+		ProxyURL:                &proxyURL,
+		RobotsPolicy:            fetcher.NewRobotsPolicy("", 0, nil),
+		DisableLogging:          true,
+		DisableTransportCaching: true,
+	})
+	t.Cleanup(func() { _ = f.Close() })
+
+	_, err := doRobotsRequest(t, f, "http://robots-proxy.invalid/private")
+	var robotsErr *fetcher.RobotsDisallowedError
+	require.ErrorAs(t, err, &robotsErr)
+	assert.Equal(t, int32(1), robotsHits.Load())
+}
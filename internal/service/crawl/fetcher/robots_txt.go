@@ -0,0 +1,220 @@
+package fetcher
+
+import (
+	"bufio"
+	"bytes"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// robotsRule robots.txt의 Allow/Disallow 규칙 하나입니다.
+type robotsRule struct {
+	// pattern 경로 패턴입니다. '*'(임의의 문자열)와 끝의 '$'(경로 끝 고정)를 지원합니다.
+	pattern string
+
+	// allow Allow 규칙이면 true, Disallow 규칙이면 false입니다.
+	allow bool
+}
+
+// String 알림 메시지에 표시할 원래의 규칙 표기를 반환합니다. (예: "Disallow: /ArticleList.nhn")
+func (r robotsRule) String() string {
+	if r.allow {
+		return "Allow: " + r.pattern
+	}
+	return "Disallow: " + r.pattern
+}
+
+// robotsRules 특정 User-agent에 적용되는 robots.txt 규칙 묶음입니다.
+type robotsRules struct {
+	// agent 규칙을 가져온 그룹의 User-agent 이름입니다. (예: "rss-feed-server", "*")
+	agent string
+
+	rules []robotsRule
+
+	// crawlDelay 연속된 요청 사이에 두어야 할 최소 간격입니다. (0: 지정되지 않음)
+	crawlDelay time.Duration
+}
+
+// allowAllRobotsRules 모든 경로를 허용하는 규칙입니다. (robots.txt가 없거나 적용할 그룹이 없는 경우)
+var allowAllRobotsRules = &robotsRules{}
+
+// allowed target(경로와 쿼리 문자열)의 수집 허용 여부와 판단에 사용된 규칙을 반환합니다.
+//
+// RFC 9309에 따라 일치하는 규칙 중 패턴이 가장 긴(가장 구체적인) 규칙을 적용하며,
+// 길이가 같은 Allow와 Disallow가 함께 일치하면 Allow를 적용합니다. 일치하는 규칙이 없으면 허용합니다.
+func (r *robotsRules) allowed(target string) (bool, *robotsRule) {
+	var matched *robotsRule
+	for i := range r.rules {
+		rule := &r.rules[i]
+		if !matchRobotsPattern(rule.pattern, target) {
+			continue
+		}
+
+		if matched == nil || len(rule.pattern) > len(matched.pattern) || (len(rule.pattern) == len(matched.pattern) && rule.allow) {
+			matched = rule
+		}
+	}
+
+	if matched == nil {
+		return true, nil
+	}
+	return matched.allow, matched
+}
+
+// matchRobotsPattern robots.txt 경로 패턴이 target과 일치하는지 확인합니다.
+//
+// 패턴은 target의 앞부분과 비교하며, '*'는 0개 이상의 임의의 문자와, 패턴 끝의 '$'는 target의 끝과 일치합니다.
+func matchRobotsPattern(pattern, target string) bool {
+	anchored := strings.HasSuffix(pattern, "$")
+	if anchored {
+		pattern = strings.TrimSuffix(pattern, "$")
+	}
+
+	parts := strings.Split(pattern, "*")
+	if !strings.HasPrefix(target, parts[0]) {
+		return false
+	}
+
+	pos := len(parts[0])
+	for i := 1; i < len(parts); i++ {
+		// 경로 끝이 고정된 패턴의 마지막 조각은 target의 끝과 일치해야 합니다.
+		if anchored && i == len(parts)-1 {
+			return strings.HasSuffix(target[pos:], parts[i])
+		}
+
+		idx := strings.Index(target[pos:], parts[i])
+		if idx < 0 {
+			return false
+		}
+		pos += idx + len(parts[i])
+	}
+
+	return !anchored || pos == len(target)
+}
+
+// robotsGroup robots.txt에서 하나 이상의 User-agent 줄과 그 뒤에 이어지는 규칙들로 이루어진 그룹입니다.
+type robotsGroup struct {
+	agents     []string
+	rules      []robotsRule
+	crawlDelay time.Duration
+}
+
+// robotsTxt 파싱한 robots.txt의 모든 그룹입니다.
+//
+// 같은 호스트라도 Fetcher 체인마다 보내는 User-Agent가 다를 수 있으므로, 그룹을 미리 고르지 않고 모두 보관해 두었다가
+// 요청을 확인할 때마다 그 요청의 User-Agent로 적용할 그룹을 고릅니다.
+type robotsTxt struct {
+	groups []*robotsGroup
+}
+
+// parseRobotsTxt robots.txt 본문을 파싱하여 모든 그룹을 반환합니다. 알 수 없는 항목이나 형식이 잘못된 줄은 무시합니다.
+func parseRobotsTxt(body []byte) *robotsTxt {
+	var groups []*robotsGroup
+	var current *robotsGroup
+	lastWasAgent := false
+
+	scanner := bufio.NewScanner(bytes.NewReader(body))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if idx := strings.IndexByte(line, '#'); idx >= 0 {
+			line = line[:idx]
+		}
+
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+		value = strings.TrimSpace(value)
+
+		switch key {
+		case "user-agent":
+			// 규칙이 나온 뒤의 User-agent 줄은 새 그룹의 시작입니다.
+			if current == nil || !lastWasAgent {
+				current = &robotsGroup{}
+				groups = append(groups, current)
+			}
+			current.agents = append(current.agents, strings.ToLower(value))
+			lastWasAgent = true
+
+		case "allow", "disallow":
+			if current == nil {
+				continue
+			}
+			// 값이 빈 Disallow는 "모두 허용"을 뜻하므로 규칙으로 추가하지 않습니다.
+			if value != "" {
+				current.rules = append(current.rules, robotsRule{pattern: value, allow: key == "allow"})
+			}
+			lastWasAgent = false
+
+		case "crawl-delay":
+			if current == nil {
+				continue
+			}
+			if seconds, err := strconv.ParseFloat(value, 64); err == nil && seconds > 0 {
+				current.crawlDelay = time.Duration(seconds * float64(time.Second))
+			}
+			lastWasAgent = false
+		}
+	}
+
+	return &robotsTxt{groups: groups}
+}
+
+// rules userAgents에 적용할 규칙을 반환합니다.
+//
+// userAgents를 앞에서부터 차례로 확인하여, 제품 토큰("rss-feed-server/1.0"이면 "rss-feed-server",
+// "Mozilla/5.0 (Windows NT 10.0; ...)"이면 "mozilla")과 이름이 같은 그룹이 처음 발견된 토큰의 그룹을 적용하고,
+// 어느 토큰과도 일치하지 않으면 "*" 그룹을 적용합니다. 같은 이름의 그룹이 여러 개이면 규칙을 합치고, Crawl-delay는 가장 긴 값을 사용합니다.
+// t가 nil(robots.txt가 없음)이거나 적용할 그룹이 없으면 모두 허용합니다.
+func (t *robotsTxt) rules(userAgents ...string) *robotsRules {
+	if t == nil {
+		return allowAllRobotsRules
+	}
+
+	for _, userAgent := range userAgents {
+		if token := robotsProductToken(userAgent); token != "" && token != "*" {
+			if rules := selectRobotsGroups(t.groups, token); rules != nil {
+				return rules
+			}
+		}
+	}
+
+	if rules := selectRobotsGroups(t.groups, "*"); rules != nil {
+		return rules
+	}
+
+	return allowAllRobotsRules
+}
+
+// robotsProductToken User-Agent 문자열에서 robots.txt 그룹 이름과 비교할 제품 토큰을 소문자로 추출합니다.
+func robotsProductToken(userAgent string) string {
+	token := strings.ToLower(strings.TrimSpace(userAgent))
+	if idx := strings.IndexAny(token, "/ "); idx >= 0 {
+		token = token[:idx]
+	}
+	return token
+}
+
+// selectRobotsGroups agent와 이름이 같은 그룹들의 규칙을 합쳐 반환합니다. 일치하는 그룹이 없으면 nil을 반환합니다.
+func selectRobotsGroups(groups []*robotsGroup, agent string) *robotsRules {
+	var rules *robotsRules
+	for _, g := range groups {
+		for _, a := range g.agents {
+			if a != agent {
+				continue
+			}
+
+			if rules == nil {
+				rules = &robotsRules{agent: agent}
+			}
+			rules.rules = append(rules.rules, g.rules...)
+			rules.crawlDelay = max(rules.crawlDelay, g.crawlDelay)
+			break
+		}
+	}
+
+	return rules
+}
@@ -0,0 +1,123 @@
+package fetcher
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchRobotsPattern(t *testing.T) {
+	tests := []struct {
+		pattern string
+		target  string
+		want    bool
+	}{
+		{"/", "/anything", true},
+		{"/ArticleList.nhn", "/ArticleList.nhn?search.clubid=1", true},
+		{"/ArticleList.nhn", "/ArticleRead.nhn", false},
+		{"/*.do", "/board/selectNttList.do?bbsId=1", true},
+		{"/*.do$", "/board/selectNttList.do", true},
+		{"/*.do$", "/board/selectNttList.do?bbsId=1", false},
+		{"/board/*/list", "/board/notice/list", true},
+		{"/board/*/list", "/board/notice/view", false},
+		{"/exact$", "/exact", true},
+		{"/exact$", "/exact/more", false},
+		{"/*$", "/", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.target, func(t *testing.T) {
+			assert.Equal(t, tt.want, matchRobotsPattern(tt.pattern, tt.target))
+		})
+	}
+}
+
+func TestParseRobotsTxt(t *testing.T) {
+	body := []byte(`
+# 주석은 무시합니다
+User-agent: Googlebot
+Disallow: /private
+
+User-agent: rss-feed-server
+User-agent: other-bot
+Disallow: /ArticleList.nhn
+Allow: /ArticleList.nhn?search.menuid=1
+Crawl-delay: 2.5
+
+User-agent: *
+Disallow: /
+Crawl-delay: 10
+
+Sitemap: https://example.com/sitemap.xml
+`)
+
+	t.Run("제품 토큰과 이름이 같은 그룹 적용", func(t *testing.T) {
+		rules := parseRobotsTxt(body).rules("RSS-Feed-Server/1.0")
+		require.Len(t, rules.rules, 2)
+		assert.Equal(t, 2500*time.Millisecond, rules.crawlDelay)
+
+		allowed, rule := rules.allowed("/ArticleList.nhn?search.clubid=1")
+		assert.False(t, allowed)
+		assert.Equal(t, "Disallow: /ArticleList.nhn", rule.String())
+
+		// 더 긴(구체적인) Allow 규칙이 우선합니다.
+		allowed, _ = rules.allowed("/ArticleList.nhn?search.menuid=1&page=2")
+		assert.True(t, allowed)
+
+		allowed, rule = rules.allowed("/ArticleRead.nhn")
+		assert.True(t, allowed)
+		assert.Nil(t, rule)
+	})
+
+	t.Run("실제로 보내는 User-Agent의 제품 토큰을 먼저 확인", func(t *testing.T) {
+		robots := parseRobotsTxt([]byte("User-agent: rss-feed-server\nDisallow: /a\n\nUser-agent: Mozilla\nDisallow: /b\n"))
+
+		rules := robots.rules("Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36", "rss-feed-server")
+		assert.Equal(t, "mozilla", rules.agent)
+		allowed, _ := rules.allowed("/b")
+		assert.False(t, allowed)
+
+		// 보내는 User-Agent와 일치하는 그룹이 없으면 다음 토큰의 그룹을 적용합니다.
+		rules = robots.rules("Safari/605.1.15", "rss-feed-server")
+		assert.Equal(t, "rss-feed-server", rules.agent)
+	})
+
+	t.Run("robots.txt가 없으면 모두 허용", func(t *testing.T) {
+		var robots *robotsTxt
+		allowed, _ := robots.rules("rss-feed-server").allowed("/anything")
+		assert.True(t, allowed)
+	})
+
+	t.Run("일치하는 그룹이 없으면 * 그룹 적용", func(t *testing.T) {
+		rules := parseRobotsTxt(body).rules("unknown-bot")
+		assert.Equal(t, 10*time.Second, rules.crawlDelay)
+
+		allowed, _ := rules.allowed("/index.html")
+		assert.False(t, allowed)
+	})
+
+	t.Run("적용할 그룹이 없으면 모두 허용", func(t *testing.T) {
+		rules := parseRobotsTxt([]byte("User-agent: Googlebot\nDisallow: /\n")).rules("rss-feed-server")
+		allowed, _ := rules.allowed("/anything")
+		assert.True(t, allowed)
+	})
+
+	t.Run("값이 빈 Disallow는 모두 허용", func(t *testing.T) {
+		rules := parseRobotsTxt([]byte("User-agent: *\nDisallow:\n")).rules("rss-feed-server")
+		assert.Empty(t, rules.rules)
+	})
+
+	t.Run("길이가 같은 Allow와 Disallow는 Allow 우선", func(t *testing.T) {
+		rules := parseRobotsTxt([]byte("User-agent: *\nDisallow: /page\nAllow: /page\n")).rules("rss-feed-server")
+		allowed, _ := rules.allowed("/page")
+		assert.True(t, allowed)
+	})
+
+	t.Run("같은 이름의 그룹은 규칙을 합침", func(t *testing.T) {
+		rules := parseRobotsTxt([]byte("User-agent: *\nDisallow: /a\n\nUser-agent: *\nDisallow: /b\nCrawl-delay: 1\n")).rules("rss-feed-server")
+		assert.Len(t, rules.rules, 2)
+		assert.Equal(t, time.Second, rules.crawlDelay)
+	})
+}
@@ -0,0 +1,350 @@
+package fetcher
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	applog "github.com/darkkaiser/notify-server/pkg/log"
+)
+
+// maxSessionMarkerPeekBytes 세션 만료 표시를 찾기 위해 응답 본문 앞부분에서 미리 읽어 볼 최대 크기(64KB)입니다.
+const maxSessionMarkerPeekBytes = 64 * 1024
+
+// SessionMarkers 응답이 로그인 세션 만료로 인한 것인지 판단하는 표시 문자열 목록입니다.
+type SessionMarkers struct {
+	// URL 최종 응답 URL(리다이렉트를 따라간 뒤의 URL)에 포함되면 세션 만료로 판단하는 문자열입니다. (예: 로그인 페이지 주소)
+	URL []string
+
+	// Body 응답 본문 앞부분에 포함되면 세션 만료로 판단하는 문자열입니다.
+	Body []string
+}
+
+// SessionCookie 쿠키 파일에 저장된 쿠키 하나입니다.
+//
+// 브라우저 확장 프로그램이 내보내는 JSON 배열 형식(name, value, domain, path, secure, httpOnly, expirationDate)과 호환됩니다.
+type SessionCookie struct {
+	Name     string `json:"name"`
+	Value    string `json:"value"`
+	Domain   string `json:"domain"`
+	Path     string `json:"path"`
+	Secure   bool   `json:"secure"`
+	HTTPOnly bool   `json:"httpOnly"`
+
+	// ExpirationDate 쿠키 만료 시각(Unix 초)입니다. 0이면 세션 쿠키로 취급합니다.
+	ExpirationDate float64 `json:"expirationDate"`
+}
+
+// Session 쿠키 파일에서 읽은 로그인 세션 쿠키를 관리하는 http.CookieJar 구현체입니다.
+//
+// WithCookieJar로 특정 공급자의 HTTPFetcher에만 연결하여, 해당 공급자의 요청에만 로그인 쿠키가 포함되도록 합니다.
+// SessionFetcher가 세션 만료를 감지하면 이후 요청에는 쿠키를 보내지 않고(비로그인 상태로 동작) onExpired를 한 번만 호출합니다.
+// 쿠키 파일이 변경되면 다시 읽어 세션을 복구합니다.
+type Session struct {
+	path    string
+	markers SessionMarkers
+
+	// onExpired 세션 만료를 처음 감지했을 때 호출되는 함수입니다. (쿠키 파일을 다시 읽기 전까지 한 번만 호출)
+	onExpired func(ctx context.Context, reason string)
+
+	mu      sync.RWMutex
+	jar     http.CookieJar
+	modTime time.Time
+	expired bool
+
+	now func() time.Time
+}
+
+// 컴파일 타임에 인터페이스 구현 여부를 검증합니다.
+var _ http.CookieJar = (*Session)(nil)
+
+// NewSession path의 쿠키 파일을 읽어 새로운 Session을 생성합니다.
+//
+// 매개변수:
+//   - path: 쿠키 파일 경로 (SessionCookie의 JSON 배열)
+//   - markers: 세션 만료 판단에 사용할 표시 문자열 목록
+//   - onExpired: 세션 만료를 처음 감지했을 때 호출할 함수 (nil 허용)
+//
+// 반환값:
+//   - *Session: 생성된 세션
+//   - error: 쿠키 파일을 읽을 수 없거나 유효한 쿠키가 없는 경우
+func NewSession(path string, markers SessionMarkers, onExpired func(ctx context.Context, reason string)) (*Session, error) {
+	s := &Session{
+		path:      path,
+		markers:   markers,
+		onExpired: onExpired,
+		now:       time.Now,
+	}
+
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Cookies 세션이 유효하면 u에 보낼 쿠키를 반환하고, 만료되었으면 쿠키를 보내지 않습니다.
+func (s *Session) Cookies(u *url.URL) []*http.Cookie {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.expired {
+		return nil
+	}
+	return s.jar.Cookies(u)
+}
+
+// SetCookies 서버가 갱신한 쿠키(Set-Cookie)를 세션에 반영합니다.
+func (s *Session) SetCookies(u *url.URL, cookies []*http.Cookie) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	s.jar.SetCookies(u, cookies)
+}
+
+// Expired 세션 만료가 감지되어 비로그인 상태로 동작 중인지 여부를 반환합니다.
+func (s *Session) Expired() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.expired
+}
+
+// load 쿠키 파일을 읽어 새 CookieJar를 구성하고 세션 상태를 초기화합니다.
+func (s *Session) load() error {
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return fmt.Errorf("세션 쿠키 파일 조회 실패 (%s): %w", s.path, err)
+	}
+
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return fmt.Errorf("세션 쿠키 파일 읽기 실패 (%s): %w", s.path, err)
+	}
+
+	var entries []SessionCookie
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return fmt.Errorf("세션 쿠키 파일 형식 오류 (%s): %w", s.path, err)
+	}
+
+	jar, _ := cookiejar.New(nil)
+	var loaded []*http.Cookie
+	for _, e := range entries {
+		if e.Name == "" || e.Value == "" || e.Domain == "" {
+			return fmt.Errorf("세션 쿠키 파일 형식 오류 (%s): 쿠키의 name, value, domain은 필수입니다", s.path)
+		}
+
+		cookie := &http.Cookie{
+			Name:     e.Name,
+			Value:    e.Value,
+			Domain:   e.Domain,
+			Path:     e.Path,
+			Secure:   e.Secure,
+			HttpOnly: e.HTTPOnly,
+		}
+		if cookie.Path == "" {
+			cookie.Path = "/"
+		}
+		if e.ExpirationDate > 0 {
+			cookie.Expires = time.Unix(int64(e.ExpirationDate), 0)
+			if !cookie.Expires.After(s.now()) {
+				continue
+			}
+		}
+
+		jar.SetCookies(&url.URL{Scheme: "https", Host: strings.TrimPrefix(e.Domain, "."), Path: cookie.Path}, []*http.Cookie{cookie})
+		loaded = append(loaded, cookie)
+	}
+
+	if len(loaded) == 0 {
+		return fmt.Errorf("세션 쿠키 파일에 유효한 쿠키가 없습니다 (%s): 만료되지 않은 쿠키로 파일을 갱신하세요", s.path)
+	}
+
+	s.mu.Lock()
+	s.jar = jar
+	s.modTime = info.ModTime()
+	s.expired = false
+	s.mu.Unlock()
+
+	applog.WithComponentAndFields(component, applog.Fields{
+		"cookies_file": s.path,
+		"cookies":      redactCookies(loaded),
+	}).Info("로그인 세션 쿠키 로드 완료")
+
+	return nil
+}
+
+// reloadIfChanged 쿠키 파일이 마지막으로 읽은 뒤 변경되었으면 다시 읽습니다.
+//
+// 운영자가 재인증 후 쿠키 파일을 교체하면 서버를 재시작하지 않아도 다음 요청부터 새 세션을 사용합니다.
+// 새 파일을 읽지 못하면 기존 상태를 유지합니다.
+func (s *Session) reloadIfChanged() {
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return
+	}
+
+	s.mu.RLock()
+	changed := !info.ModTime().Equal(s.modTime)
+	s.mu.RUnlock()
+	if !changed {
+		return
+	}
+
+	if err := s.load(); err != nil {
+		// 같은 파일을 반복해서 읽지 않도록 변경 시각만 기록해 둡니다.
+		s.mu.Lock()
+		s.modTime = info.ModTime()
+		s.mu.Unlock()
+
+		applog.WithComponentAndFields(component, applog.Fields{
+			"cookies_file": s.path,
+			"error":        err,
+		}).Warn("변경된 세션 쿠키 파일을 읽지 못했습니다: 기존 세션 상태를 유지합니다")
+	}
+}
+
+// expire 세션을 만료 상태로 전환합니다. 처음 만료된 경우에만 onExpired를 호출하고 true를 반환합니다.
+func (s *Session) expire(ctx context.Context, reason string) bool {
+	s.mu.Lock()
+	if s.expired {
+		s.mu.Unlock()
+		return false
+	}
+	s.expired = true
+	s.mu.Unlock()
+
+	applog.WithComponent(component).WithContext(ctx).WithFields(applog.Fields{
+		"cookies_file": s.path,
+		"reason":       reason,
+	}).Warn("로그인 세션 만료 감지: 쿠키 파일을 갱신할 때까지 비로그인 상태로 요청합니다")
+
+	if s.onExpired != nil {
+		s.onExpired(ctx, reason)
+	}
+
+	return true
+}
+
+// SessionFetcher 응답에서 로그인 세션 만료를 감지하는 미들웨어입니다.
+//
+// 최종 응답 URL이나 본문 앞부분에 SessionMarkers의 문자열이 있으면 세션을 만료 상태로 전환하고,
+// 요청 본문을 다시 만들 수 있는 요청이면 쿠키 없이 한 번 더 보내 비로그인 상태의 응답을 반환합니다.
+type SessionFetcher struct {
+	delegate Fetcher
+
+	session *Session
+}
+
+// 컴파일 타임에 인터페이스 구현 여부를 검증합니다.
+var _ Fetcher = (*SessionFetcher)(nil)
+
+// NewSessionFetcher 새로운 SessionFetcher 인스턴스를 생성합니다. session이 nil이면 delegate를 그대로 반환합니다.
+func NewSessionFetcher(delegate Fetcher, session *Session) Fetcher {
+	if session == nil {
+		return delegate
+	}
+
+	return &SessionFetcher{
+		delegate: delegate,
+		session:  session,
+	}
+}
+
+// Do 요청을 보내고, 응답이 세션 만료를 나타내면 세션을 만료 처리한 뒤 비로그인 상태로 다시 요청합니다.
+//
+// 매개변수:
+//   - req: 처리할 HTTP 요청
+//
+// 반환값:
+//   - HTTP 응답 객체 (세션 만료를 감지하여 다시 요청한 경우 비로그인 상태의 응답)
+//   - 에러 (요청 처리 중 발생한 에러, 또는 다시 요청할 수 없는 요청에서 세션 만료를 감지한 경우 ErrSessionExpired)
+func (f *SessionFetcher) Do(req *http.Request) (*http.Response, error) {
+	f.session.reloadIfChanged()
+
+	// 이미 만료된 세션은 쿠키를 보내지 않으므로 만료 여부를 다시 검사할 필요가 없습니다.
+	if f.session.Expired() {
+		return f.delegate.Do(req)
+	}
+
+	resp, err := f.delegate.Do(req)
+	if err != nil {
+		return resp, err
+	}
+
+	reason, err := f.detectExpiry(resp)
+	if err != nil {
+		return nil, err
+	}
+	if reason == "" {
+		return resp, nil
+	}
+
+	drainAndCloseBody(resp.Body)
+	f.session.expire(req.Context(), reason)
+
+	// 쿠키 없이 다시 보낼 수 있는 요청이면 비로그인 상태로 한 번 더 요청합니다.
+	retryReq := req.Clone(req.Context())
+	if req.Body != nil && req.Body != http.NoBody {
+		if req.GetBody == nil {
+			return nil, newErrSessionExpired(reason)
+		}
+
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, newErrGetBodyFailed(err)
+		}
+		retryReq.Body = body
+	}
+
+	return f.delegate.Do(retryReq)
+}
+
+func (f *SessionFetcher) Close() error {
+	return f.delegate.Close()
+}
+
+// detectExpiry 응답이 세션 만료를 나타내면 그 사유를 반환합니다.
+//
+// 본문 표시를 검사하는 경우 본문 앞부분을 미리 읽고, 호출자가 본문 전체를 그대로 읽을 수 있도록 resp.Body를 복원합니다.
+func (f *SessionFetcher) detectExpiry(resp *http.Response) (string, error) {
+	if resp.Request != nil && resp.Request.URL != nil {
+		finalURL := resp.Request.URL.String()
+		for _, marker := range f.session.markers.URL {
+			if strings.Contains(finalURL, marker) {
+				return fmt.Sprintf("로그인 페이지로 이동되었습니다 (URL: %s)", redactURL(resp.Request.URL)), nil
+			}
+		}
+	}
+
+	if len(f.session.markers.Body) == 0 || resp.Body == nil {
+		return "", nil
+	}
+
+	peek, err := io.ReadAll(io.LimitReader(resp.Body, maxSessionMarkerPeekBytes))
+	if err != nil {
+		resp.Body.Close()
+		return "", err
+	}
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(peek), resp.Body), resp.Body}
+
+	for _, marker := range f.session.markers.Body {
+		if bytes.Contains(peek, []byte(marker)) {
+			return fmt.Sprintf("응답 본문에 세션 만료 표시(%q)가 있습니다", marker), nil
+		}
+	}
+
+	return "", nil
+}
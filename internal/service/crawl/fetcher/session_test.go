@@ -0,0 +1,206 @@
+package fetcher_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	apperrors "github.com/darkkaiser/rss-feed-server/internal/errors"
+	"github.com/darkkaiser/rss-feed-server/internal/service/crawl/fetcher"
+	"github.com/darkkaiser/rss-feed-server/internal/service/crawl/fetcher/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeSessionCookies 쿠키 파일을 기록하고, 변경 감지를 위해 수정 시각을 modTime으로 지정합니다.
+func writeSessionCookies(t *testing.T, path string, cookies []fetcher.SessionCookie, modTime time.Time) {
+	t.Helper()
+
+	data, err := json.Marshal(cookies)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+}
+
+// newSessionTestServer 유효한 NID_AUT 쿠키가 있으면 회원용 본문을, 쿠키가 없으면 비회원용 본문을 반환하고,
+// 만료된 쿠키로 요청하면 로그인 페이지로 리다이렉트하는 테스트 서버를 생성합니다.
+func newSessionTestServer(t *testing.T, validValue *atomic.Value) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/nidlogin.login" {
+			_, _ = w.Write([]byte("login page"))
+			return
+		}
+
+		cookie, err := r.Cookie("NID_AUT")
+		switch {
+		case err != nil:
+			_, _ = w.Write([]byte("guest content"))
+		case cookie.Value == validValue.Load().(string):
+			_, _ = w.Write([]byte("member content"))
+		default:
+			http.Redirect(w, r, "/nidlogin.login?url="+r.URL.Path, http.StatusFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func doSessionRequest(t *testing.T, f fetcher.Fetcher, rawURL string) string {
+	t.Helper()
+
+	req, err := http.NewRequest(http.MethodGet, rawURL, nil)
+	require.NoError(t, err)
+
+	resp, err := f.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return string(body)
+}
+
+func TestNewSessionFetcher_NilSession(t *testing.T) {
+	mockF := mocks.NewMockFetcher()
+	assert.Same(t, mockF, fetcher.NewSessionFetcher(mockF, nil))
+}
+
+// TestSession_ExpiryAndReload 세션 만료 시 한 번만 알리고 비로그인 상태로 전환했다가, 쿠키 파일이 갱신되면 복구되는지 검증합니다.
+func TestSession_ExpiryAndReload(t *testing.T) {
+	var validValue atomic.Value
+	validValue.Store("v1")
+	server := newSessionTestServer(t, &validValue)
+
+	path := filepath.Join(t.TempDir(), "navercafe.cookies.json")
+	writeSessionCookies(t, path, []fetcher.SessionCookie{{Name: "NID_AUT", Value: "v1", Domain: "127.0.0.1"}}, time.Now().Add(-time.Hour))
+
+	var alerts atomic.Int32
+	var lastReason atomic.Value
+	session, err := fetcher.NewSession(path, fetcher.SessionMarkers{URL: []string{"/nidlogin.login"}}, func(_ context.Context, reason string) {
+		alerts.Add(1)
+		lastReason.Store(reason)
+	})
+	require.NoError(t, err)
+
+	f := fetcher.NewFromConfig(fetcher.Config{DisableLogging: true, Session: session})
+
+	// 1. 유효한 세션: 회원용 본문을 받습니다.
+	assert.Equal(t, "member content", doSessionRequest(t, f, server.URL+"/board"))
+	assert.False(t, session.Expired())
+
+	// 2. 세션 만료: 로그인 페이지 대신 비로그인 상태로 다시 요청한 결과를 받고, 한 번만 알립니다.
+	validValue.Store("v2")
+	assert.Equal(t, "guest content", doSessionRequest(t, f, server.URL+"/board"))
+	assert.Equal(t, "guest content", doSessionRequest(t, f, server.URL+"/board"))
+	assert.True(t, session.Expired())
+	assert.Equal(t, int32(1), alerts.Load())
+	assert.Contains(t, lastReason.Load().(string), "로그인 페이지로 이동되었습니다")
+
+	// 3. 쿠키 파일 갱신: 다음 요청부터 새 세션을 사용합니다.
+	writeSessionCookies(t, path, []fetcher.SessionCookie{{Name: "NID_AUT", Value: "v2", Domain: "127.0.0.1"}}, time.Now())
+	assert.Equal(t, "member content", doSessionRequest(t, f, server.URL+"/board"))
+	assert.False(t, session.Expired())
+	assert.Equal(t, int32(1), alerts.Load())
+}
+
+// TestSessionFetcher_BodyMarker 응답 본문의 세션 만료 표시를 감지하고, 표시가 없으면 본문을 그대로 전달하는지 검증합니다.
+func TestSessionFetcher_BodyMarker(t *testing.T) {
+	var expiredBody atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, err := r.Cookie("NID_AUT"); err == nil && expiredBody.Load() {
+			_, _ = w.Write([]byte(`{"errorCode":"SESSION_EXPIRED"}`))
+			return
+		}
+		_, _ = w.Write([]byte(strings.Repeat("a", 100*1024)))
+	}))
+	t.Cleanup(server.Close)
+
+	path := filepath.Join(t.TempDir(), "cookies.json")
+	writeSessionCookies(t, path, []fetcher.SessionCookie{{Name: "NID_AUT", Value: "v1", Domain: "127.0.0.1"}}, time.Now())
+
+	session, err := fetcher.NewSession(path, fetcher.SessionMarkers{Body: []string{"SESSION_EXPIRED"}}, nil)
+	require.NoError(t, err)
+	f := fetcher.NewSessionFetcher(fetcher.NewHTTPFetcher(fetcher.WithCookieJar(session)), session)
+
+	// 미리 읽은 앞부분을 포함해 본문 전체를 그대로 읽을 수 있어야 합니다.
+	assert.Len(t, doSessionRequest(t, f, server.URL), 100*1024)
+	assert.False(t, session.Expired())
+
+	expiredBody.Store(true)
+	assert.Len(t, doSessionRequest(t, f, server.URL), 100*1024, "만료 감지 후 쿠키 없이 다시 요청해야 합니다")
+	assert.True(t, session.Expired())
+}
+
+// TestSessionFetcher_NonReplayableBody 요청 본문을 다시 만들 수 없으면 ErrSessionExpired를 반환하는지 검증합니다.
+func TestSessionFetcher_NonReplayableBody(t *testing.T) {
+	var validValue atomic.Value
+	validValue.Store("v2")
+	server := newSessionTestServer(t, &validValue)
+
+	path := filepath.Join(t.TempDir(), "cookies.json")
+	writeSessionCookies(t, path, []fetcher.SessionCookie{{Name: "NID_AUT", Value: "v1", Domain: "127.0.0.1"}}, time.Now())
+
+	session, err := fetcher.NewSession(path, fetcher.SessionMarkers{URL: []string{"/nidlogin.login"}}, nil)
+	require.NoError(t, err)
+	f := fetcher.NewSessionFetcher(fetcher.NewHTTPFetcher(fetcher.WithCookieJar(session)), session)
+
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/board", io.NopCloser(strings.NewReader("payload")))
+	require.NoError(t, err)
+
+	resp, err := f.Do(req)
+	assert.Nil(t, resp)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, fetcher.ErrSessionExpired))
+	assert.True(t, apperrors.Is(err, apperrors.Unauthorized))
+}
+
+// TestNewSession_Errors 쿠키 파일을 사용할 수 없는 경우 에러를 반환하는지 검증합니다.
+func TestNewSession_Errors(t *testing.T) {
+	dir := t.TempDir()
+
+	t.Run("파일 없음", func(t *testing.T) {
+		_, err := fetcher.NewSession(filepath.Join(dir, "missing.json"), fetcher.SessionMarkers{}, nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("형식 오류", func(t *testing.T) {
+		path := filepath.Join(dir, "invalid.json")
+		require.NoError(t, os.WriteFile(path, []byte(`{"NID_AUT":"secret"}`), 0o600))
+
+		_, err := fetcher.NewSession(path, fetcher.SessionMarkers{}, nil)
+		require.Error(t, err)
+		assert.NotContains(t, err.Error(), "secret", "에러 메시지에 쿠키 값이 포함되면 안 됩니다")
+	})
+
+	t.Run("필수 항목 누락", func(t *testing.T) {
+		path := filepath.Join(dir, "no-domain.json")
+		writeSessionCookies(t, path, []fetcher.SessionCookie{{Name: "NID_AUT", Value: "secret"}}, time.Now())
+
+		_, err := fetcher.NewSession(path, fetcher.SessionMarkers{}, nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("모든 쿠키 만료", func(t *testing.T) {
+		path := filepath.Join(dir, "expired.json")
+		writeSessionCookies(t, path, []fetcher.SessionCookie{{
+			Name: "NID_AUT", Value: "secret", Domain: ".naver.com",
+			ExpirationDate: float64(time.Now().Add(-time.Hour).Unix()),
+		}}, time.Now())
+
+		_, err := fetcher.NewSession(path, fetcher.SessionMarkers{}, nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "유효한 쿠키가 없습니다")
+	})
+}
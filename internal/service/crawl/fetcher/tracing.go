@@ -0,0 +1,71 @@
+package fetcher
+
+import (
+	"net/http"
+
+	"github.com/darkkaiser/rss-feed-server/internal/tracing"
+	semconv "go.opentelemetry.io/otel/semconv/v1.39.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracingFetcher HTTP 요청마다 OpenTelemetry 클라이언트 Span을 생성하는 미들웨어입니다.
+//
+// 기록되는 정보:
+//   - 요청 메서드, 대상 호스트, URL (민감 정보 마스킹 처리됨)
+//   - 응답 상태 코드
+//   - 에러 (에러 발생 시 Span 상태를 Error로 설정)
+//   - 재시도 이벤트 (RetryFetcher가 이 Span에 "http.retry" 이벤트를 추가)
+//
+// Span은 요청 컨텍스트를 통해 하위 미들웨어에 전달되므로, 하위 미들웨어가 req.Context()로 남긴 로그에도 같은 trace_id가 기록됩니다.
+// 크롤링 대상 사이트는 추적 시스템에 참여하지 않으므로 traceparent 헤더는 요청에 주입하지 않습니다.
+type TracingFetcher struct {
+	delegate Fetcher
+}
+
+// 컴파일 타임에 인터페이스 구현 여부를 검증합니다.
+var _ Fetcher = (*TracingFetcher)(nil)
+
+// NewTracingFetcher 새로운 TracingFetcher 인스턴스를 생성합니다.
+func NewTracingFetcher(delegate Fetcher) *TracingFetcher {
+	return &TracingFetcher{
+		delegate: delegate,
+	}
+}
+
+// Do HTTP 요청을 클라이언트 Span으로 감싸서 수행합니다.
+//
+// 매개변수:
+//   - req: 처리할 HTTP 요청
+//
+// 반환값:
+//   - HTTP 응답 객체 (성공 시)
+//   - 에러 (요청 처리 중 발생한 에러)
+//
+// 주의사항:
+//   - Span은 응답 헤더를 받은 시점(Do 반환 시점)에 종료되므로, 응답 본문을 읽는 시간은 포함되지 않습니다.
+//   - 재시도 대기 시간을 포함한 전체 요청 시간을 기록하려면 이 미들웨어를 RetryFetcher보다 바깥에 배치해야 합니다.
+func (f *TracingFetcher) Do(req *http.Request) (*http.Response, error) {
+	ctx, span := tracing.Tracer().Start(req.Context(), "HTTP "+req.Method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.HTTPRequestMethodKey.String(req.Method),
+			semconv.URLFull(redactURL(req.URL)),
+			semconv.ServerAddress(req.URL.Hostname()),
+		),
+	)
+
+	resp, err := f.delegate.Do(req.WithContext(ctx))
+
+	// 에러가 발생했더라도 응답 객체가 있을 수 있음 (예: 상태 코드 에러)
+	if resp != nil {
+		span.SetAttributes(semconv.HTTPResponseStatusCode(resp.StatusCode))
+	}
+
+	tracing.EndSpan(span, err)
+
+	return resp, err
+}
+
+func (f *TracingFetcher) Close() error {
+	return f.delegate.Close()
+}
@@ -0,0 +1,128 @@
+package fetcher_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/darkkaiser/rss-feed-server/internal/service/crawl/fetcher"
+	"github.com/darkkaiser/rss-feed-server/internal/service/crawl/fetcher/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// setupSpanRecorder 전역 TracerProvider를 기록용 Provider로 교체하고, 테스트 종료 시 원래대로 되돌립니다.
+func setupSpanRecorder(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+
+	recorder := tracetest.NewSpanRecorder()
+	original := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(original) })
+
+	return recorder
+}
+
+func spanAttr(span sdktrace.ReadOnlySpan, key attribute.Key) attribute.Value {
+	for _, kv := range span.Attributes() {
+		if kv.Key == key {
+			return kv.Value
+		}
+	}
+	return attribute.Value{}
+}
+
+func TestTracingFetcher_Do(t *testing.T) {
+	t.Run("성공 응답", func(t *testing.T) {
+		recorder := setupSpanRecorder(t)
+
+		m := mocks.NewMockFetcher()
+		m.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+			// 하위 미들웨어는 Span이 담긴 컨텍스트를 전달받아야 합니다.
+			return trace.SpanContextFromContext(req.Context()).IsValid()
+		})).Return(mocks.NewMockResponse("ok", http.StatusOK), nil)
+
+		req, _ := http.NewRequest(http.MethodGet, "https://example.com/board?token=secret", nil)
+		resp, err := fetcher.NewTracingFetcher(m).Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		spans := recorder.Ended()
+		require.Len(t, spans, 1)
+		span := spans[0]
+
+		assert.Equal(t, "HTTP GET", span.Name())
+		assert.Equal(t, trace.SpanKindClient, span.SpanKind())
+		assert.Equal(t, "GET", spanAttr(span, "http.request.method").AsString())
+		assert.Equal(t, "example.com", spanAttr(span, "server.address").AsString())
+		assert.Equal(t, int64(http.StatusOK), spanAttr(span, "http.response.status_code").AsInt64())
+		assert.NotContains(t, spanAttr(span, "url.full").AsString(), "secret", "URL의 민감 정보는 마스킹되어야 합니다")
+		assert.Equal(t, codes.Unset, span.Status().Code)
+	})
+
+	t.Run("에러 응답", func(t *testing.T) {
+		recorder := setupSpanRecorder(t)
+
+		m := mocks.NewMockFetcher()
+		m.On("Do", mock.Anything).Return(mocks.NewMockResponse("", http.StatusNotFound), errors.New("not found"))
+
+		req, _ := http.NewRequest(http.MethodGet, "https://example.com/missing", nil)
+		_, err := fetcher.NewTracingFetcher(m).Do(req)
+		require.Error(t, err)
+
+		spans := recorder.Ended()
+		require.Len(t, spans, 1)
+		assert.Equal(t, codes.Error, spans[0].Status().Code)
+		assert.Equal(t, int64(http.StatusNotFound), spanAttr(spans[0], "http.response.status_code").AsInt64())
+	})
+}
+
+// TestTracingFetcher_RetryEvents 재시도가 하나의 Span 안에 재시도 이벤트로 기록되는지 검증합니다.
+func TestTracingFetcher_RetryEvents(t *testing.T) {
+	recorder := setupSpanRecorder(t)
+
+	unavailable := mocks.NewMockResponse("", http.StatusServiceUnavailable)
+	unavailable.Header.Set("Retry-After", "0") // 재시도 대기 없이 바로 재시도합니다.
+
+	m := mocks.NewMockFetcher()
+	m.On("Do", mock.Anything).Return(unavailable, nil).Once()
+	m.On("Do", mock.Anything).Return(mocks.NewMockResponse("ok", http.StatusOK), nil).Once()
+
+	f := fetcher.NewTracingFetcher(fetcher.NewRetryFetcher(m, 2, time.Second, 5*time.Second))
+
+	req, _ := http.NewRequest(http.MethodGet, "https://example.com/board", nil)
+	resp, err := f.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1, "재시도는 별도 Span이 아니라 하나의 요청 Span에 기록되어야 합니다")
+
+	events := spans[0].Events()
+	require.Len(t, events, 1)
+	assert.Equal(t, "http.retry", events[0].Name)
+
+	attrs := attribute.NewSet(events[0].Attributes...)
+	resendCount, _ := attrs.Value("http.request.resend_count")
+	reason, _ := attrs.Value("retry.reason")
+	assert.Equal(t, int64(1), resendCount.AsInt64())
+	assert.Equal(t, "status_code_503", reason.AsString())
+}
+
+func TestNewFromConfig_EnableTracing(t *testing.T) {
+	f := fetcher.NewFromConfig(fetcher.Config{EnableTracing: true})
+	_, ok := f.(*fetcher.TracingFetcher)
+	assert.True(t, ok, "EnableTracing 설정 시 TracingFetcher가 가장 바깥에 위치해야 합니다")
+
+	f = fetcher.NewFromConfig(fetcher.Config{})
+	_, ok = f.(*fetcher.TracingFetcher)
+	assert.False(t, ok)
+}
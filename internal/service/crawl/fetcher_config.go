@@ -0,0 +1,130 @@
+package crawl
+
+import (
+	"strings"
+	"time"
+
+	applog "github.com/darkkaiser/notify-server/pkg/log"
+	"github.com/darkkaiser/rss-feed-server/internal/config"
+	"github.com/darkkaiser/rss-feed-server/internal/service/crawl/fetcher"
+)
+
+// 설정 파일에 HTTP 설정을 지정하지 않았을 때 적용하는 크롤링 요청 기본값입니다.
+const (
+	// defaultMaxRetries 실패한 요청의 기본 재시도 횟수입니다.
+	defaultMaxRetries = 3
+
+	// defaultMinRetryDelay 재시도 대기 시간의 기본 최소값입니다.
+	defaultMinRetryDelay = 5 * time.Second
+
+	// defaultMaxBytes 응답 본문의 기본 최대 허용 크기(10MB)입니다.
+	defaultMaxBytes int64 = 10 * 1024 * 1024
+)
+
+// newFetcherConfig 설정 파일의 HTTP 설정을 Fetcher 체인 설정으로 변환합니다.
+//
+// 재시도 횟수, 최소 재시도 대기 시간, 응답 본문 크기 제한은 지정하지 않으면 위의 기본값을 사용하고,
+// 그 밖의 항목은 지정하지 않으면(nil) Fetcher의 기본값을 따릅니다.
+// rateLimiter는 모든 Fetcher 체인이 같은 인스턴스를 공유해야 호스트별 요청 속도 제한이 공급자 전체에 적용됩니다.
+// robotsPolicy는 기본으로 체인에 포함되며, HTTP 설정의 respect_robots_txt를 false로 지정한 경우에만 제외됩니다.
+// responseCache가 nil이 아니면 모든 체인이 같은 캐시 디렉터리를 사용하여 조건부 요청을 보냅니다.
+func newFetcherConfig(c config.HTTPConfig, rateLimiter *fetcher.HostRateLimiter, robotsPolicy *fetcher.RobotsPolicy, responseCache fetcher.ResponseCache) fetcher.Config {
+	cfg := fetcher.Config{
+		ProxyURL: c.ProxyURL,
+
+		MaxRedirects: c.MaxRedirects,
+		UserAgents:   c.UserAgents,
+
+		MaxIdleConns:        c.MaxIdleConns,
+		MaxIdleConnsPerHost: c.MaxIdleConnsPerHost,
+		MaxConnsPerHost:     c.MaxConnsPerHost,
+
+		Timeout:               c.Timeout,
+		TLSHandshakeTimeout:   c.TLSHandshakeTimeout,
+		ResponseHeaderTimeout: c.ResponseHeaderTimeout,
+		IdleConnTimeout:       c.IdleConnTimeout,
+
+		MaxRetries:    valueOrDefault(c.MaxRetries, defaultMaxRetries),
+		MinRetryDelay: valueOrDefault(c.MinRetryDelay, defaultMinRetryDelay),
+		MaxRetryDelay: c.MaxRetryDelay,
+
+		MaxBytes:         valueOrDefault(c.MaxBytes, defaultMaxBytes),
+		AllowedMimeTypes: c.AllowedMimeTypes,
+
+		RateLimiter:   rateLimiter,
+		ResponseCache: responseCache,
+
+		// 추적 기록 여부는 전역 TracerProvider(tracing.Setup)가 결정하므로 항상 활성화해 둡니다.
+		EnableTracing: true,
+	}
+
+	if c.RandomizeUserAgent != nil {
+		cfg.EnableUserAgentRandomization = *c.RandomizeUserAgent
+	}
+	if c.RespectRobotsTxt == nil || *c.RespectRobotsTxt {
+		cfg.RobotsPolicy = robotsPolicy
+	}
+
+	return cfg
+}
+
+// newHostRateLimiter 설정 파일의 요청 속도 제한 설정으로 프로세스 전체에서 공유할 HostRateLimiter를 생성합니다.
+func newHostRateLimiter(c config.RateLimitConfig) *fetcher.HostRateLimiter {
+	defaultLimit := fetcher.HostRateLimit{
+		RequestsPerSecond: c.RequestsPerSecond,
+		Burst:             c.Burst,
+	}
+
+	// 호스트별 설정에서 생략한 항목은 공통 설정 값을 따릅니다.
+	hostLimits := make(map[string]fetcher.HostRateLimit, len(c.Hosts))
+	for _, h := range c.Hosts {
+		limit := defaultLimit
+		if h.RequestsPerSecond > 0 {
+			limit.RequestsPerSecond = h.RequestsPerSecond
+		}
+		if h.Burst > 0 {
+			limit.Burst = h.Burst
+		}
+		hostLimits[strings.TrimSpace(h.Host)] = limit
+	}
+
+	return fetcher.NewHostRateLimiter(defaultLimit, hostLimits)
+}
+
+// newResponseCache 설정 파일의 응답 캐시 설정으로 프로세스 전체에서 공유할 ResponseCache를 생성합니다.
+//
+// 응답 캐시는 요청량을 줄이기 위한 부가 기능이므로, 캐시 디렉터리를 사용할 수 없으면 경고만 남기고 캐시 없이 동작합니다.
+// 설정하지 않았거나 생성에 실패하면 nil을 반환합니다.
+func newResponseCache(c config.HTTPCacheConfig) fetcher.ResponseCache {
+	if !c.Enabled() {
+		return nil
+	}
+
+	cache, err := fetcher.NewDiskResponseCache(strings.TrimSpace(c.Dir), c.MaxBytes)
+	if err != nil {
+		applog.WithComponentAndFields(component, applog.Fields{
+			"dir":   c.Dir,
+			"error": err,
+		}).Warn("HTTP 응답 캐시 초기화 실패: 응답 캐시 없이 크롤링을 진행합니다")
+
+		return nil
+	}
+
+	return cache
+}
+
+// newRobotsPolicy 프로세스 전체에서 공유할 RobotsPolicy를 생성합니다.
+//
+// robots.txt는 이 정책을 사용하는 각 Fetcher 체인이 자신의 프록시와 타임아웃으로 읽어오므로,
+// 공급자별 HTTP 설정이 robots.txt 조회에도 그대로 적용됩니다. Crawl-delay는 rateLimiter의 요청 간격에 반영됩니다.
+func newRobotsPolicy(cfg *config.RSSFeedConfig, rateLimiter *fetcher.HostRateLimiter) *fetcher.RobotsPolicy {
+	return fetcher.NewRobotsPolicy(cfg.RobotsTxt.UserAgent, cfg.RobotsTxt.CacheTTL, rateLimiter)
+}
+
+// valueOrDefault v가 nil이면 기본값 def를 가리키는 포인터를, 아니면 v를 그대로 반환합니다.
+func valueOrDefault[T any](v *T, def T) *T {
+	if v != nil {
+		return v
+	}
+	return &def
+}
@@ -0,0 +1,101 @@
+package crawl
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/darkkaiser/rss-feed-server/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFetcherConfig(t *testing.T) {
+	t.Run("HTTP 설정이 없으면 기존 기본값(재시도 3회, 최소 대기 5초, 본문 10MB)을 사용", func(t *testing.T) {
+		cfg := newFetcherConfig(config.HTTPConfig{}, nil, nil, nil)
+
+		require.NotNil(t, cfg.MaxRetries)
+		assert.Equal(t, 3, *cfg.MaxRetries)
+		assert.Equal(t, 5*time.Second, *cfg.MinRetryDelay)
+		assert.Equal(t, int64(10*1024*1024), *cfg.MaxBytes)
+		assert.Nil(t, cfg.Timeout, "지정하지 않은 항목은 Fetcher 기본값을 따르도록 nil이어야 합니다")
+		assert.Nil(t, cfg.ProxyURL)
+		assert.False(t, cfg.EnableUserAgentRandomization)
+		assert.Nil(t, cfg.RateLimiter)
+	})
+
+	t.Run("공유 HostRateLimiter를 그대로 전달", func(t *testing.T) {
+		limiter := newHostRateLimiter(config.RateLimitConfig{
+			RequestsPerSecond: 1,
+			Hosts:             []*config.HostRateLimitConfig{{Host: "cafe.naver.com", Burst: 1}},
+		})
+		require.NotNil(t, limiter)
+
+		cfg := newFetcherConfig(config.HTTPConfig{}, limiter, nil, nil)
+		assert.Same(t, limiter, cfg.RateLimiter)
+	})
+
+	t.Run("지정한 항목을 그대로 전달", func(t *testing.T) {
+		proxy, timeout, retries, randomize := "http://proxy:8080", time.Minute, 0, true
+
+		cfg := newFetcherConfig(config.HTTPConfig{
+			ProxyURL:           &proxy,
+			Timeout:            &timeout,
+			MaxRetries:         &retries,
+			RandomizeUserAgent: &randomize,
+			UserAgents:         []string{"agent"},
+			AllowedMimeTypes:   []string{"text/html"},
+		}, nil, nil, nil)
+
+		assert.Equal(t, proxy, *cfg.ProxyURL)
+		assert.Equal(t, timeout, *cfg.Timeout)
+		assert.Equal(t, 0, *cfg.MaxRetries)
+		assert.True(t, cfg.EnableUserAgentRandomization)
+		assert.Equal(t, []string{"agent"}, cfg.UserAgents)
+		assert.Equal(t, []string{"text/html"}, cfg.AllowedMimeTypes)
+	})
+
+	t.Run("respect_robots_txt가 false인 경우를 제외하면 RobotsPolicy를 전달", func(t *testing.T) {
+		rssCfg := &config.RSSFeedConfig{}
+		policy := newRobotsPolicy(rssCfg, nil)
+
+		respect, ignore := true, false
+		assert.Same(t, policy, newFetcherConfig(config.HTTPConfig{}, nil, policy, nil).RobotsPolicy, "지정하지 않으면 robots.txt를 준수해야 합니다")
+		assert.Nil(t, newFetcherConfig(config.HTTPConfig{RespectRobotsTxt: &ignore}, nil, policy, nil).RobotsPolicy)
+		assert.Same(t, policy, newFetcherConfig(config.HTTPConfig{RespectRobotsTxt: &respect}, nil, policy, nil).RobotsPolicy)
+	})
+
+	t.Run("공급자 설정에서 false로 지정하면 해당 공급자만 RobotsPolicy를 전달하지 않는다", func(t *testing.T) {
+		rssCfg := &config.RSSFeedConfig{}
+		policy := newRobotsPolicy(rssCfg, nil)
+
+		ignore := false
+		assert.Same(t, policy, newFetcherConfig(rssCfg.HTTP.Merge(&config.HTTPConfig{}), nil, policy, nil).RobotsPolicy)
+		assert.Nil(t, newFetcherConfig(rssCfg.HTTP.Merge(&config.HTTPConfig{RespectRobotsTxt: &ignore}), nil, policy, nil).RobotsPolicy)
+	})
+}
+
+func TestNewResponseCache(t *testing.T) {
+	t.Run("dir을 지정하지 않으면 nil", func(t *testing.T) {
+		assert.Nil(t, newResponseCache(config.HTTPCacheConfig{}))
+	})
+
+	t.Run("dir을 지정하면 디스크 캐시 생성", func(t *testing.T) {
+		dir := filepath.Join(t.TempDir(), "http-cache")
+
+		cache := newResponseCache(config.HTTPCacheConfig{Dir: dir})
+		require.NotNil(t, cache)
+		assert.DirExists(t, dir)
+
+		cfg := newFetcherConfig(config.HTTPConfig{}, nil, nil, cache)
+		assert.Same(t, cache, cfg.ResponseCache)
+	})
+
+	t.Run("디렉터리를 만들 수 없으면 캐시 없이 동작", func(t *testing.T) {
+		file := filepath.Join(t.TempDir(), "file")
+		require.NoError(t, os.WriteFile(file, nil, 0o600))
+
+		assert.Nil(t, newResponseCache(config.HTTPCacheConfig{Dir: filepath.Join(file, "cache")}))
+	})
+}
@@ -12,7 +12,22 @@ import (
 	"github.com/darkkaiser/notify-server/pkg/notify"
 	"github.com/darkkaiser/rss-feed-server/internal/config"
 	"github.com/darkkaiser/rss-feed-server/internal/feed"
+	"github.com/darkkaiser/rss-feed-server/internal/service/crawl/fetcher"
 	"github.com/darkkaiser/rss-feed-server/internal/service/crawl/scraper"
+	"github.com/darkkaiser/rss-feed-server/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// 크롤링 Span에 기록하는 속성 키입니다.
+const (
+	attrProviderID   = attribute.Key("crawl.provider_id")
+	attrBoardID      = attribute.Key("crawl.board_id")
+	attrPage         = attribute.Key("crawl.page")
+	attrArticleID    = attribute.Key("crawl.article_id")
+	attrArticleCount = attribute.Key("crawl.article_count")
+	attrAttempts     = attribute.Key("crawl.attempts")
 )
 
 // EmptyBoardID 크롤링 커서를 게시판별로 관리하지 않고 사이트 전체 단위로 단일 관리하는 크롤러에서
@@ -67,9 +82,21 @@ type Base struct {
 	// SetCrawlArticles() 메서드를 통해 개별 크롤러 구현체에서 주입됩니다.
 	crawlArticles CrawlArticlesFunc
 
+	// crawlArticleContent 단일 게시글의 본문을 수집하는 함수입니다.
+	// SetCrawlArticleContent() 메서드를 통해 주입되며, 주입되지 않은 크롤러는 재검증(Revalidate)과 삭제 점검(CheckDeleted)을 건너뜁니다.
+	crawlArticleContent CrawlArticleContentFunc
+
+	// revalidateArticleContent 재검증(Revalidate) 전용 본문 수집 함수입니다.
+	// SetRevalidateArticleContent() 메서드를 통해 주입되며, 주입되지 않으면 crawlArticleContent를 그대로 사용합니다.
+	revalidateArticleContent CrawlArticleContentFunc
+
 	// scraper 웹 요청(HTTP) 및 HTML/JSON 파싱을 수행하는 컴포넌트입니다.
 	scraper scraper.Scraper
 
+	// dateParser 게시글 작성일 문자열을 time.Time으로 변환하는 컴포넌트입니다.
+	// 공급자 설정의 date_format(사이트 전용 레이아웃, 시간대, 엄격 모드)이 반영되어 있습니다.
+	dateParser *DateParser
+
 	// feedRepo 크롤링된 게시글과 커서 정보를 영구 저장하고 조회하는 저장소 인터페이스입니다.
 	feedRepo feed.Repository
 
@@ -86,7 +113,11 @@ type Base struct {
 }
 
 // 컴파일 타임에 인터페이스 구현 여부를 검증합니다.
-var _ Crawler = (*Base)(nil)
+var (
+	_ Crawler         = (*Base)(nil)
+	_ Revalidator     = (*Base)(nil)
+	_ DeletionChecker = (*Base)(nil)
+)
 
 // baseParams Base 구조체 초기화에 필요한 매개변수들을 그룹화한 구조체입니다.
 //
@@ -123,6 +154,7 @@ func newBase(p baseParams) *Base {
 		maxPageCount: p.maxPageCount,
 
 		scraper:      p.Scraper,
+		dateParser:   newDateParserFromConfig(p.Config.DateFormat),
 		feedRepo:     p.FeedRepo,
 		notifyClient: p.NotifyClient,
 
@@ -158,12 +190,21 @@ func NewBase(p NewCrawlerParams, maxPageCount int) *Base {
 		Config:       p.Config,
 		maxPageCount: maxPageCount,
 
-		Scraper:      scraper.New(p.Fetcher),
+		Scraper:      scraper.New(p.Fetcher, scraperOptions(p.Config)...),
 		FeedRepo:     p.FeedRepo,
 		NotifyClient: p.NotifyClient,
 	})
 }
 
+// scraperOptions 공급자 설정에서 Scraper에 적용할 옵션을 구성합니다.
+func scraperOptions(c *config.ProviderDetailConfig) []scraper.Option {
+	var opts []scraper.Option
+	if c.Charset != "" {
+		opts = append(opts, scraper.WithCharset(c.Charset))
+	}
+	return opts
+}
+
 func (b *Base) ProviderID() string {
 	return b.providerID
 }
@@ -176,14 +217,57 @@ func (b *Base) MaxPageCount() int {
 	return b.maxPageCount
 }
 
+// SetCrawlArticles 신규 게시글 목록을 수집하는 크롤러별 로직을 주입합니다.
+// Run()은 이 함수가 반환한 게시글과 커서로 DB 저장 및 커서 전진을 수행합니다.
 func (b *Base) SetCrawlArticles(crawlArticles CrawlArticlesFunc) {
 	b.crawlArticles = crawlArticles
 }
 
+// SetCrawlArticleContent 단일 게시글의 본문을 수집하는 크롤러별 로직을 주입합니다.
+// 주입된 함수는 크롤러 내부의 본문 수집뿐 아니라 재검증(Revalidate)과 삭제 점검(CheckDeleted)에서도 사용되며,
+// 주입하지 않은 크롤러는 두 작업을 건너뜁니다.
+func (b *Base) SetCrawlArticleContent(crawlArticleContent CrawlArticleContentFunc) {
+	b.crawlArticleContent = crawlArticleContent
+}
+
+// SetRevalidateArticleContent 재검증(Revalidate)에서만 사용할 본문 수집 로직을 주입합니다.
+//
+// 본문 수집이 실패했을 때 검색 결과 요약처럼 원문 일부만 대신 채우는 크롤러는, 그 결과를 저장된 전체 본문과 비교하면
+// 수정되지 않은 게시글을 수정된 것으로 오판하게 됩니다. 이런 크롤러는 원문 전체를 가져올 수 있는 경로만 사용하는 함수를 주입하여,
+// 원문을 가져오지 못한 게시글이 판단 유보(빈 본문)로 처리되도록 해야 합니다.
+func (b *Base) SetRevalidateArticleContent(revalidateArticleContent CrawlArticleContentFunc) {
+	b.revalidateArticleContent = revalidateArticleContent
+}
+
 func (b *Base) Scraper() scraper.Scraper {
 	return b.scraper
 }
 
+func (b *Base) DateParser() *DateParser {
+	return b.dateParser
+}
+
+// ParseCreatedAt 공급자 설정이 반영된 DateParser로 게시글 작성일 문자열을 time.Time으로 변환합니다.
+//
+// "3분 전"처럼 정확도가 낮은 표현은 크롤링 시점에 따라 작성일이 조금씩 달라질 수 있으므로,
+// 작성일 관련 문제를 추적할 수 있도록 디버그 로그를 남깁니다.
+func (b *Base) ParseCreatedAt(s string) (time.Time, error) {
+	parsed, err := b.dateParser.Parse(s)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if parsed.Confidence < DateConfidenceMedium {
+		b.logger.WithFields(applog.Fields{
+			"created_at_text": s,
+			"created_at":      parsed.Time,
+			"confidence":      parsed.Confidence.String(),
+		}).Debug("상대 시간 표현의 작성일을 크롤링 시각 기준으로 역산하였습니다")
+	}
+
+	return parsed.Time, nil
+}
+
 func (b *Base) FeedRepo() feed.Repository {
 	return b.feedRepo
 }
@@ -199,7 +283,12 @@ func (b *Base) Logger() *applog.Entry {
 //  3. finalizeExecution: 수집 결과를 DB에 저장하고 커서를 전진
 //
 // 이 메서드 자체는 각 단계를 직접 구현하지 않고, 파이프라인 흐름의 조율과 런타임 패닉 복구라는 두 가지 책임만을 담당합니다.
+//
+// 실행 전체는 "crawl.run" Span 하나로 기록되며, 목록 페이지(StartPageSpan)·본문 수집·HTTP 요청·DB 저장 Span이 모두 그 하위에 연결됩니다.
 func (b *Base) Run(ctx context.Context) {
+	ctx, span := tracing.Tracer().Start(ctx, "crawl.run", trace.WithAttributes(attrProviderID.String(b.providerID)))
+	defer span.End()
+
 	// 크롤링 실행 중 예상치 못한 런타임 패닉이 발생하더라도,
 	// defer로 등록된 이 복구 핸들러가 패닉을 가로채어 스케줄러(cron 등)의 메인 고루틴이 죽지 않도록 방어합니다.
 	// 복구 후에는 에러를 로깅하고 관리자 알림까지 전송하여 패닉의 발생 사실을 알립니다.
@@ -207,7 +296,8 @@ func (b *Base) Run(ctx context.Context) {
 		if r := recover(); r != nil {
 			msg := b.Messagef("크롤링 작업 중단: 런타임 패닉 발생 (상세: %v)", r)
 
-			b.logger.Error(msg)
+			b.logger.WithContext(ctx).Error(msg)
+			span.SetStatus(codes.Error, msg)
 
 			// ReportError는 내부적으로 타임아웃 컨텍스트와 2차 패닉 방어 코드를 갖추고 있으므로,
 			// 알림 전송의 안전성 보장은 ReportError에 완전히 위임합니다.
@@ -225,11 +315,12 @@ func (b *Base) Run(ctx context.Context) {
 	// 신규 게시글(articles)과 다음 크롤링 시작 기준점(cursors)을 반환합니다.
 	// 실행 중 에러가 발생하면 execute 내부에서 로깅 및 알림을 처리하고 (nil, nil)을 반환합니다.
 	articles, cursors := b.execute(execCtx)
+	span.SetAttributes(attrArticleCount.Int(len(articles)))
 
 	// [3단계] 후처리
 	// 수집한 게시글을 DB에 저장하고, 다음 사이클을 위한 커서를 전진시킵니다.
 	// articles가 nil인 경우(2단계 실패)를 스스로 감지하여 안전하게 조기 종료합니다.
-	b.finalizeExecution(articles, cursors)
+	b.finalizeExecution(ctx, articles, cursors)
 }
 
 // prepareExecution 크롤링 작업 시작 전 사전 조건을 검증하고,
@@ -293,6 +384,14 @@ func (b *Base) prepareExecution(ctx context.Context) (context.Context, context.C
 func (b *Base) execute(ctx context.Context) ([]*feed.Article, map[string]string) {
 	articles, cursors, errMsg, err := b.crawlArticles(ctx)
 	if err != nil {
+		// robots.txt에 의한 차단은 사이트 장애가 아니라 정책에 따른 중단이므로,
+		// 일반적인 수집 실패 메시지 대신 차단 규칙과 해결 방법을 안내하는 메시지로 보고합니다.
+		var robotsErr *fetcher.RobotsDisallowedError
+		if errors.As(err, &robotsErr) {
+			errMsg = b.Messagef("크롤링 작업 중단: robots.txt 정책에 따라 수집이 허용되지 않은 주소입니다 (URL: %s, 규칙: %s). 사이트 운영자의 수집 허가를 받은 경우 공급자 설정의 http.respect_robots_txt를 false로 지정하세요", robotsErr.URL, robotsErr.Rule)
+		}
+
+		tracing.RecordError(trace.SpanFromContext(ctx), err)
 		b.ReportError(errMsg, err)
 		return nil, nil
 	}
@@ -312,7 +411,9 @@ func (b *Base) execute(ctx context.Context) ([]*feed.Article, map[string]string)
 //   - DB 저장에 실패하면 커서 전진을 취소합니다. 저장에 실패한 게시글이 있는 상태에서 커서를 전진시키면
 //     해당 게시글이 영구적으로 유실되기 때문입니다. 다음 사이클에서 재수집 시 DB 유니크 제약조건이
 //     이미 저장된 게시글의 중복 삽입을 안전하게 방어합니다.
-func (b *Base) finalizeExecution(articles []*feed.Article, cursors map[string]string) {
+//
+// ctx는 실행 Span을 이어받기 위해서만 사용하며, 그 취소·만료 여부는 DB 저장에 영향을 주지 않습니다.
+func (b *Base) finalizeExecution(ctx context.Context, articles []*feed.Article, cursors map[string]string) {
 	// articles가 nil이면 execute 단계에서 에러가 발생한 것입니다.
 	// 에러 로깅과 알림은 이미 execute 내부에서 완료되었으므로 여기서는 아무 처리 없이 종료합니다.
 	if articles == nil {
@@ -320,7 +421,8 @@ func (b *Base) finalizeExecution(articles []*feed.Article, cursors map[string]st
 	}
 
 	// DB 저장/커서 갱신 전용으로 독립적인 1분 타임아웃 컨텍스트를 새로 생성합니다.
-	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Minute)
+	// 크롤링 execCtx가 이미 만료되었더라도 저장은 진행되어야 하므로 취소 신호는 끊고, DB Span이 실행 Span 아래에 기록되도록 값(Span)만 이어받습니다.
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 1*time.Minute)
 	defer cancel()
 
 	if len(articles) > 0 {
@@ -453,6 +555,19 @@ func (b *Base) Messagef(format string, args ...any) string {
 	return fmt.Sprintf("%s('%s')의 %s", b.config.Name, b.config.ID, msg)
 }
 
+// StartPageSpan 게시글 목록 페이지 하나를 수집하는 구간(Span)을 시작합니다.
+//
+// 크롤러는 페이지를 요청할 때 반환된 컨텍스트를 사용해야 해당 페이지의 HTTP 요청 Span이 이 Span의 하위로 기록되며,
+// 페이지 처리가 끝나면 tracing.EndSpan으로 결과와 함께 Span을 종료해야 합니다.
+// 게시판 구분 없이 커서를 관리하는 크롤러는 boardID에 빈 문자열을 전달합니다.
+func (b *Base) StartPageSpan(ctx context.Context, boardID string, pageNo int) (context.Context, trace.Span) {
+	return tracing.Tracer().Start(ctx, "crawl.page", trace.WithAttributes(
+		attrProviderID.String(b.providerID),
+		attrBoardID.String(boardID),
+		attrPage.Int(pageNo),
+	))
+}
+
 // CrawlArticleContentsConcurrently 주어진 게시글 목록의 본문을 병렬로 수집하는 메서드입니다.
 //
 // 동시성 제어:
@@ -483,13 +598,27 @@ func (b *Base) CrawlArticleContentsConcurrently(ctx context.Context, articles []
 
 	for _, article := range articles {
 		g.Go(func() (err error) {
+			// 게시글 하나의 본문 수집(재시도 포함)을 하나의 Span으로 기록합니다. 시도 횟수는 종료 시점에 속성으로 남깁니다.
+			spanCtx, span := tracing.Tracer().Start(gCtx, "crawl.content", trace.WithAttributes(
+				attrProviderID.String(b.providerID),
+				attrBoardID.String(article.BoardID),
+				attrArticleID.String(article.ArticleID),
+			))
+			attempts := 0
+			var fetchErr error
+			defer func() {
+				span.SetAttributes(attrAttempts.Int(attempts))
+				tracing.EndSpan(span, fetchErr)
+			}()
+
 			// fetchContent 콜백 또는 본문 파싱 로직 내부에서 예상치 못한 패닉이 발생하더라도,
 			// 이 복구 핸들러가 패닉을 가로채어 errgroup 전체가 중단되는 것을 방지합니다.
 			// 패닉이 발생한 게시글은 빈 본문 상태로 남겨 부분 실패로 처리합니다.
 			// (에러를 반환하면 gCtx가 취소되어 나머지 고루틴까지 중단되므로 nil을 반환합니다)
 			defer func() {
 				if r := recover(); r != nil {
-					b.logger.Errorf("게시글 본문 크롤링 중단 (ArticleID: %s): 런타임 패닉 발생 (상세: %v)", article.ArticleID, r)
+					b.logger.WithContext(spanCtx).Errorf("게시글 본문 크롤링 중단 (ArticleID: %s): 런타임 패닉 발생 (상세: %v)", article.ArticleID, r)
+					fetchErr = fmt.Errorf("런타임 패닉 발생: %v", r)
 					err = nil
 				}
 			}()
@@ -502,7 +631,9 @@ func (b *Base) CrawlArticleContentsConcurrently(ctx context.Context, articles []
 					break
 				}
 
-				err = fetchContent(gCtx, article)
+				attempts = attempt
+				err = fetchContent(spanCtx, article)
+				fetchErr = err
 
 				// fetchContent 내부의 개별 HTTP 요청 타임아웃과 시스템 레벨 취소를 구별합니다.
 				// fetchContent가 반환한 err이 HTTP 내부 타임아웃이라면 gCtx는 여전히 유효하므로 재시도를 진행합니다.
@@ -3,16 +3,27 @@ package provider_test
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/darkkaiser/rss-feed-server/internal/config"
 	"github.com/darkkaiser/rss-feed-server/internal/feed"
+	"github.com/darkkaiser/rss-feed-server/internal/service/crawl/fetcher"
 	"github.com/darkkaiser/rss-feed-server/internal/service/crawl/provider"
+	"github.com/darkkaiser/rss-feed-server/internal/tracing"
 )
 
 // =============================================================================
@@ -105,6 +116,30 @@ func TestNewBase_Success(t *testing.T) {
 	assert.NotNil(t, base.Scraper())
 	assert.NotNil(t, base.FeedRepo())
 	assert.NotNil(t, base.Logger())
+	assert.Equal(t, provider.DefaultDateLocation, base.DateParser().Location())
+}
+
+// TestBase_ParseCreatedAt 공급자 설정의 작성일 포맷(date_format)이 Base.ParseCreatedAt에 반영되는지 검증합니다.
+func TestBase_ParseCreatedAt(t *testing.T) {
+	t.Parallel()
+
+	base := provider.NewBase(provider.NewCrawlerParams{
+		ProviderID: "test-provider",
+		Config: &config.ProviderDetailConfig{
+			ID:         "test",
+			Name:       "테스트사이트",
+			DateFormat: &config.DateFormatConfig{Layouts: []string{"02/01/2006 15:04"}, Strict: true},
+		},
+		Fetcher:  &dummyFetcher{},
+		FeedRepo: &mockRepository{},
+	}, 5)
+
+	got, err := base.ParseCreatedAt("10/03/2024 14:30")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.March, 10, 14, 30, 0, 0, provider.DefaultDateLocation), got)
+
+	_, err = base.ParseCreatedAt("3분 전")
+	assert.ErrorIs(t, err, provider.ErrLowConfidenceCreatedAt)
 }
 
 // =============================================================================
@@ -171,6 +206,33 @@ func TestExecute_Error(t *testing.T) {
 	})
 }
 
+func TestExecute_RobotsDisallowed(t *testing.T) {
+	hook := test.NewGlobal()
+
+	base := provider.NewBase(provider.NewCrawlerParams{
+		ProviderID: "test-provider",
+		Config:     &config.ProviderDetailConfig{ID: "test", Name: "테스트사이트"},
+		Fetcher:    &dummyFetcher{},
+	}, 1)
+
+	robotsErr := &fetcher.RobotsDisallowedError{URL: "https://example.com/ArticleList.nhn", UserAgent: "rss-feed-server", Rule: "Disallow: /ArticleList.nhn"}
+	base.SetCrawlArticles(func(ctx context.Context) ([]*feed.Article, map[string]string, string, error) {
+		return nil, nil, "게시글 목록 수집 실패", fmt.Errorf("wrapped: %w", robotsErr)
+	})
+
+	base.Run(context.Background())
+
+	var found bool
+	for _, entry := range hook.AllEntries() {
+		if strings.Contains(entry.Message, "robots.txt 정책에 따라 수집이 허용되지 않은 주소입니다") &&
+			strings.Contains(entry.Message, "Disallow: /ArticleList.nhn") &&
+			strings.Contains(entry.Message, "http.respect_robots_txt") {
+			found = true
+		}
+	}
+	assert.True(t, found, "robots.txt 차단은 차단 규칙과 해결 방법을 안내하는 메시지로 보고되어야 합니다")
+}
+
 func TestFinalizeExecution_DBSaveSuccessAndCursorUpdate(t *testing.T) {
 	t.Parallel()
 
@@ -449,3 +511,98 @@ func TestCrawlArticleContentsConcurrently_ContextCanceled(t *testing.T) {
 
 	assert.ErrorIs(t, err, context.Canceled, "취소된 컨텍스트가 주입되면 동시성 파이프라인이 즉시 오류를 반환해야 합니다.")
 }
+
+// =============================================================================
+// 분산 추적
+// =============================================================================
+
+// TestRun_Tracing 실행 Span 아래에 목록 페이지·본문 수집·DB 저장이 하위 구간으로 연결되는지 검증합니다.
+// 전역 TracerProvider를 교체하므로 병렬로 실행하지 않습니다.
+func TestRun_Tracing(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	original := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(original) })
+
+	var saveSpan trace.SpanContext
+	repo := &mockRepository{
+		SaveArticlesFunc: func(ctx context.Context, providerID string, articles []*feed.Article) (int, error) {
+			saveSpan = trace.SpanContextFromContext(ctx)
+			return len(articles), nil
+		},
+		UpsertLatestCrawledArticleIDFunc: func(ctx context.Context, providerID, boardID, articleID string) error {
+			return nil
+		},
+	}
+
+	base := provider.NewBase(provider.NewCrawlerParams{
+		ProviderID: "test-provider",
+		Config:     &config.ProviderDetailConfig{ID: "test", Name: "테스트사이트"},
+		Fetcher:    &dummyFetcher{},
+		FeedRepo:   repo,
+	}, 1)
+
+	base.SetCrawlArticles(func(ctx context.Context) ([]*feed.Article, map[string]string, string, error) {
+		_, pageSpan := base.StartPageSpan(ctx, "b1", 1)
+		tracing.EndSpan(pageSpan, nil)
+
+		articles := []*feed.Article{{BoardID: "b1", ArticleID: "1"}}
+		attempts := 0
+		err := base.CrawlArticleContentsConcurrently(ctx, articles, 1, func(ctx context.Context, article *feed.Article) error {
+			attempts++
+			if attempts == 1 {
+				return errors.New("일시적 오류")
+			}
+			article.Content = "본문"
+			return nil
+		})
+		return articles, map[string]string{"b1": "1"}, "", err
+	})
+
+	base.Run(context.Background())
+
+	spans := map[string]sdktrace.ReadOnlySpan{}
+	for _, s := range recorder.Ended() {
+		spans[s.Name()] = s
+	}
+	require.Contains(t, spans, "crawl.run")
+	require.Contains(t, spans, "crawl.page")
+	require.Contains(t, spans, "crawl.content")
+
+	run := spans["crawl.run"]
+	assert.Equal(t, run.SpanContext().SpanID(), spans["crawl.page"].Parent().SpanID())
+	assert.Equal(t, run.SpanContext().SpanID(), spans["crawl.content"].Parent().SpanID())
+	assert.Contains(t, run.Attributes(), attribute.String("crawl.provider_id", "test-provider"))
+	assert.Contains(t, run.Attributes(), attribute.Int("crawl.article_count", 1))
+	assert.Contains(t, spans["crawl.page"].Attributes(), attribute.Int("crawl.page", 1))
+	assert.Contains(t, spans["crawl.content"].Attributes(), attribute.Int("crawl.attempts", 2))
+	assert.Equal(t, codes.Unset, spans["crawl.content"].Status().Code, "재시도 끝에 성공한 본문 수집은 에러로 기록되지 않아야 합니다")
+
+	// 실행 컨텍스트와 독립된 DB 저장 컨텍스트도 실행 Span을 이어받아야 합니다.
+	assert.Equal(t, run.SpanContext().SpanID(), saveSpan.SpanID())
+}
+
+// TestRun_TracingError 크롤링 실패가 실행 Span에 에러로 기록되는지 검증합니다.
+func TestRun_TracingError(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	original := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(original) })
+
+	base := provider.NewBase(provider.NewCrawlerParams{
+		ProviderID: "test-provider",
+		Config:     &config.ProviderDetailConfig{ID: "test", Name: "테스트사이트"},
+		Fetcher:    &dummyFetcher{},
+	}, 1)
+	base.SetCrawlArticles(func(ctx context.Context) ([]*feed.Article, map[string]string, string, error) {
+		return nil, nil, "목록 수집 실패", errors.New("connection refused")
+	})
+
+	base.Run(context.Background())
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	assert.Equal(t, "crawl.run", spans[0].Name())
+	assert.Equal(t, codes.Error, spans[0].Status().Code)
+	assert.Equal(t, "connection refused", spans[0].Status().Description)
+}
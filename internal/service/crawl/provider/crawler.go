@@ -25,6 +25,24 @@ const component = "crawl.provider"
 // 이를 통해 소중한 서버 자원을 아끼고, 전체 크롤링 파이프라인이 정체되는 것을 안전하게 방어합니다.
 var ErrContentUnavailable = apperrors.New(apperrors.ExecutionFailed, "접근 권한 제한 또는 게시글 삭제 등의 사유로 본문 수집이 불가하여 재시도 작업을 영구적으로 중단합니다")
 
+// ErrArticleNotFound 원문 게시글이 삭제되었음이 명확하게 확인된 경우에만 반환하는 센티넬 에러입니다.
+//
+// HTTP 404 응답이나 사이트가 명시적으로 표시하는 '삭제된 게시글' 안내처럼 삭제를 확신할 수 있는 신호가 있을 때만 사용하며,
+// 권한 제한이나 본문 영역을 찾지 못한 경우(사이트 레이아웃 변경 가능성)에는 반환하지 않습니다.
+// 원문 삭제 감지(CheckDeleted)는 이 에러를 받은 게시글만 삭제된 것으로 표시합니다.
+//
+// ErrContentUnavailable을 감싸고 있으므로 errors.Is(err, ErrContentUnavailable)도 true가 되어,
+// 동시성 크롤러의 재시도 중단 로직은 기존과 동일하게 동작합니다.
+var ErrArticleNotFound = apperrors.Wrap(ErrContentUnavailable, apperrors.NotFound, "원문 게시글이 삭제되었거나 존재하지 않습니다")
+
+// ErrArticleAccessDenied 원문 사이트가 게시글 접근을 명시적으로 거부(HTTP 403/401)한 경우에 반환하는 센티넬 에러입니다.
+//
+// 회원 전용 게시판으로 옮겨졌거나 비공개로 전환된 게시글처럼 '삭제'와는 구별되는 상태이므로 ErrArticleNotFound와 분리하며,
+// 원문 삭제 감지(CheckDeleted)는 이 에러를 받은 게시글을 접근 제한(feed.DeletedReasonAccessDenied) 상태로 따로 기록합니다.
+//
+// ErrArticleNotFound와 마찬가지로 ErrContentUnavailable을 감싸고 있으므로, 동시성 크롤러의 재시도 중단 로직은 기존과 동일하게 동작합니다.
+var ErrArticleAccessDenied = apperrors.Wrap(ErrContentUnavailable, apperrors.Forbidden, "원문 게시글에 대한 접근이 거부되었습니다")
+
 // Crawler 개별 크롤러 인스턴스의 생명주기를 제어하고 상태를 조회하기 위한 인터페이스입니다.
 //
 // 이 인터페이스는 Service 레이어와 구체적인 크롤러 구현체(Base 기반) 사이의 계약을 정의합니다.
@@ -45,6 +63,41 @@ type Crawler interface {
 	Run(ctx context.Context)
 }
 
+// Revalidator 이미 수집한 게시글의 원문을 다시 확인하여 수정 여부를 감지할 수 있는 크롤러가 구현하는 선택적 인터페이스입니다.
+//
+// 크롤링 커서는 한 번 지나간 게시글을 다시 방문하지 않으므로, 작성자가 게시 후 본문을 수정(예: 마감일 변경)해도
+// 피드에는 반영되지 않습니다. Service는 크롤러가 이 인터페이스를 구현한 경우에만 주기적인 재검증 작업을 등록합니다.
+type Revalidator interface {
+	// Revalidate 작성된 지 days일 이내의 게시글 본문을 다시 수집하여, 저장된 본문과 해시가 다르면 수정 이력으로 기록합니다.
+	Revalidate(ctx context.Context, days uint)
+}
+
+// DeletionChecker 이미 수집한 게시글이 원문 사이트에서 삭제되었는지 점검할 수 있는 크롤러가 구현하는 선택적 인터페이스입니다.
+//
+// 원문에서 삭제되거나 비공개로 전환된 게시글도 저장소에는 그대로 남아 있으므로, 별도의 점검 없이는 피드에서 사라지지 않습니다.
+// Service는 크롤러가 이 인터페이스를 구현한 경우에만 주기적인 삭제 점검 작업을 등록합니다.
+type DeletionChecker interface {
+	// CheckDeleted 작성된 지 days일 이내의 게시글 중 최대 sampleSize개를 무작위로 골라 원문 접근 가능 여부를 확인하고,
+	// 접근할 수 없게 된 게시글의 삭제 감지 일시를 기록합니다.
+	CheckDeleted(ctx context.Context, days, sampleSize uint)
+}
+
+// ParseSnapshotReplayer 저장된 파싱 실패 스냅샷을 자신의 목록 파서에 다시 통과시켜 볼 수 있는 크롤러가 구현하는 선택적 인터페이스입니다.
+//
+// 파서를 수정한 뒤 실패 당시의 원본으로 수정 결과를 확인하거나, 실패 원인이 일시적이었는지 판별하는 데 사용합니다.
+// 네트워크 요청 없이 스냅샷 본문만으로 동작해야 합니다.
+type ParseSnapshotReplayer interface {
+	// ReplayParseSnapshot 스냅샷 본문에서 게시글 행을 찾아 파서로 추출한 결과를 반환합니다.
+	ReplayParseSnapshot(snapshot *feed.ParseSnapshot) (*feed.ParseReplayResult, error)
+}
+
+// CrawlArticleContentFunc 단일 게시글의 상세 페이지에서 본문(Content)을 수집하여 article에 채우는 함수 타입입니다.
+//
+// 각 크롤러 구현체는 SetCrawlArticleContent()를 통해 자신의 본문 수집 로직을 Base에 주입하며,
+// Base는 이를 재검증(Revalidate) 시 이미 수집한 게시글의 본문을 다시 가져오거나, 삭제 점검(CheckDeleted) 시 원문 접근 가능 여부를 확인하는 데 사용합니다.
+// 영구적으로 본문을 수집할 수 없는 경우에는 ErrContentUnavailable을 반환해야 합니다.
+type CrawlArticleContentFunc func(ctx context.Context, article *feed.Article) error
+
 // CrawlArticlesFunc 실제 웹 페이지 크롤링을 수행하는 함수 타입입니다.
 //
 // Base 구조체는 이 타입의 함수를 필드로 보유하며, 각 크롤러 구현체는
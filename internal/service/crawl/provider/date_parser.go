@@ -1,64 +1,366 @@
 package provider
 
 import (
-	"fmt"
 	"regexp"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/darkkaiser/rss-feed-server/internal/config"
 	apperrors "github.com/darkkaiser/rss-feed-server/internal/errors"
 )
 
-// ParseCreatedAt 크롤링으로 수집한 게시글 작성일 문자열을 time.Time 객체로 변환합니다.
+// ErrUnsupportedCreatedAtFormat 작성일 문자열이 지원하는 어떤 포맷과도 일치하지 않을 때 ParseCreatedAt이 반환하는 에러의 원인(Cause)입니다.
+//
+// 작성자 이름처럼 날짜가 아닌 값이 작성일 셀에 들어오는 경우가 대표적이며, 이는 게시판의 컬럼 배치가
+// 바뀌었다는 신호일 수 있으므로 레이아웃 변경 감지(ObserveLayout → CheckLayoutDrift)에서 errors.Is로 구분하여 작성일 파싱 실패 비율(DateParseFailureRatio)로 집계합니다.
+var ErrUnsupportedCreatedAtFormat = apperrors.New(apperrors.ParsingFailed, "지원되지 않는 작성일 포맷")
+
+// ErrLowConfidenceCreatedAt 엄격 모드(Strict)의 DateParser가 "3분 전"처럼 정확도가 낮은(DateConfidenceLow) 작성일 표현을 거부할 때 반환하는 에러의 원인(Cause)입니다.
+var ErrLowConfidenceCreatedAt = apperrors.New(apperrors.ParsingFailed, "정확도가 낮은 작성일 표현")
+
+// DefaultDateLocation 작성일 문자열에 시간대 정보가 없을 때 적용하는 기본 시간대(Asia/Seoul)입니다.
+//
+// 수집 대상 사이트가 모두 국내 사이트이므로, 서버가 실행되는 머신의 시간대(time.Local)와 무관하게
+// 항상 한국 표준시로 해석해야 컨테이너(UTC) 환경에서도 작성일이 9시간씩 어긋나지 않습니다.
+// 시간대 데이터베이스(tzdata)가 없는 환경에서는 동일한 오프셋의 고정 시간대로 대체합니다.
+var DefaultDateLocation = loadDefaultDateLocation()
+
+func loadDefaultDateLocation() *time.Location {
+	if loc, err := time.LoadLocation("Asia/Seoul"); err == nil {
+		return loc
+	}
+	return time.FixedZone("KST", 9*60*60)
+}
+
+// DateConfidence 파싱한 작성일이 실제 작성 시각을 얼마나 정확하게 나타내는지를 표현하는 등급입니다.
+type DateConfidence int
+
+const (
+	// DateConfidenceLow "3분 전", "방금"처럼 크롤링 시각을 기준으로 역산한 상대 시간 표현입니다.
+	// 크롤링 시점에 따라 결과가 달라지며, 사이트가 반올림해서 표시하므로 오차가 큽니다.
+	DateConfidenceLow DateConfidence = iota + 1
+
+	// DateConfidenceMedium 날짜 또는 시각 중 한쪽만 표시되어 나머지를 추정한 값입니다.
+	// (예: "2024.03.15." → 00:00:00으로 고정, "14:30" → 오늘 날짜로 합성, "24.03.05" → 2000년대로 해석)
+	DateConfidenceMedium
+
+	// DateConfidenceHigh 연도를 포함한 날짜와 시각이 모두 명시된 값입니다.
+	DateConfidenceHigh
+)
+
+func (c DateConfidence) String() string {
+	switch c {
+	case DateConfidenceLow:
+		return "low"
+	case DateConfidenceMedium:
+		return "medium"
+	case DateConfidenceHigh:
+		return "high"
+	default:
+		return "unknown"
+	}
+}
+
+// ParsedDate DateParser.Parse의 결과로, 변환된 시각과 그 정확도 등급을 함께 담습니다.
+type ParsedDate struct {
+	Time       time.Time
+	Confidence DateConfidence
+}
+
+// DateParserConfig DateParser의 동작을 결정하는 설정 구조체입니다.
+type DateParserConfig struct {
+	// Location 작성일을 해석할 시간대입니다. (nil: DefaultDateLocation)
+	Location *time.Location
+
+	// Layouts 기본 포맷보다 먼저 시도할 사이트 전용 Go 시간 레이아웃 목록입니다. (예: "2006/01/02 15:04", "01-02 15:04")
+	// 연도가 없는 레이아웃은 현재 연도로 해석하되, 결과가 미래이면 작년으로 교정합니다.
+	Layouts []string
+
+	// Strict true이면 정확도가 DateConfidenceLow인 상대 시간 표현을 ErrLowConfidenceCreatedAt 에러로 거부합니다.
+	Strict bool
+
+	// Now 현재 시각을 반환하는 함수입니다. 테스트에서 시각을 고정할 때 사용합니다. (nil: time.Now)
+	Now func() time.Time
+}
+
+// DateParser 크롤링으로 수집한 게시글 작성일 문자열을 time.Time으로 변환하는 컴포넌트입니다.
 //
 // 각 사이트는 작성일을 표시하는 방식이 제각각입니다.
-// 오늘 작성된 글은 "14:30"처럼 시간만, 과거 글은 "2024-03-15"처럼 날짜만 표시하는 것이 대표적입니다.
-// 이 함수는 그러한 파편화된 포맷들을 하나의 일관된 time.Time 값으로 정규화하는 역할을 합니다.
+// 오늘 작성된 글은 "14:30"이나 "3분 전"처럼, 과거 글은 "2024-03-15"나 "2024.3.5."처럼 표시하는 것이 대표적입니다.
+// DateParser는 그러한 파편화된 포맷들을 지정된 시간대의 일관된 time.Time 값으로 정규화하고,
+// 추정이 개입된 정도를 DateConfidence로 함께 보고합니다.
+//
+// # 지원 포맷 (위에서부터 순서대로 시도)
+//
+//  1. 사이트 전용 레이아웃 (DateParserConfig.Layouts)
+//  2. 상대 시간: "방금", "방금 전", "N초/분/시간/일/주 전" (DateConfidenceLow)
+//  3. 상대 날짜: "오늘", "어제", "그제"/"그저께" (+ 선택적 "HH:MM[:SS]")
+//  4. 시각: "HH:MM[:SS]" (오늘 날짜로 합성)
+//  5. 날짜: "yyyy-MM-dd", "yyyy.MM.dd[.]", "yyyy/MM/dd", "yy.MM.dd", "yyyy년 M월 d일" (+ 선택적 "HH:MM[:SS]")
+//     월/일의 0 채움(Zero Padding)은 생략할 수 있으며, "2024. 3. 5."처럼 구분자 뒤의 공백도 허용합니다.
+//
+// # 결정론적 결과 보장
 //
-// # 지원 포맷
+// 시/분/초가 없는 날짜에 현재 시각을 그대로 붙이면, 크롤링 실행 시점에 따라 동일 날짜의 게시글이
+// 서로 다른 시각을 갖게 되어 재크롤링 시 게시글 순서 역전(Sorting Inversion)을 일으킬 수 있습니다.
+// 이를 방지하기 위해 날짜만 있는 입력("N일 전", "어제" 포함)은 항상 00:00:00으로 고정합니다.
 //
-// 시간 포맷 ("HH:MM:SS" 또는 "HH:MM"):
-//   - 오늘 날짜를 기준으로 시각을 합성합니다.
-//   - 합성 결과가 현재 시각보다 미래인 경우(예: 자정 직후 전날 23:50분 글 수집 시),
-//     자정 경계를 넘어 날짜가 오늘로 잘못 합성된 것이므로 24시간을 차감해 교정합니다.
+// 시각만 있는 입력은 오늘 날짜로 합성하되, 결과가 현재 시각보다 미래이면(예: 자정 직후 전날 23:50분 글 수집 시)
+// 자정 경계를 넘어 날짜가 잘못 합성된 것이므로 하루를 차감해 교정합니다.
 //
-// 날짜 포맷 ("yyyy-MM-dd" 또는 "yyyy.MM.dd."):
-//   - 시/분/초가 없는 날짜에 현재 시간을 그대로 붙이면, 크롤링 실행 시점에 따라 동일 날짜의
-//     게시글이 서로 다른 시각을 갖게 됩니다. 이는 재크롤링 시 게시글 순서 역전(Sorting Inversion)을
-//     일으켜 커서(Cursor) 기반 중복 수집 방지 로직을 깨뜨릴 수 있습니다.
-//   - 이를 방지하기 위해 날짜 포맷 입력은 항상 "00:00:00"을 고정하여, 크롤링 시점과
-//     무관하게 결정론적(Deterministic)인 값을 보장합니다.
+// DateParser는 생성 이후 상태를 변경하지 않으므로 여러 고루틴에서 동시에 사용해도 안전합니다.
+type DateParser struct {
+	location *time.Location
+	layouts  []string
+	strict   bool
+	now      func() time.Time
+}
+
+// NewDateParser 주어진 설정으로 DateParser를 생성합니다. 설정하지 않은 항목에는 기본값을 적용합니다.
+func NewDateParser(cfg DateParserConfig) *DateParser {
+	p := &DateParser{
+		location: cfg.Location,
+		strict:   cfg.Strict,
+		now:      cfg.Now,
+	}
+	if p.location == nil {
+		p.location = DefaultDateLocation
+	}
+	if p.now == nil {
+		p.now = time.Now
+	}
+	for _, layout := range cfg.Layouts {
+		if layout = strings.TrimSpace(layout); layout != "" {
+			p.layouts = append(p.layouts, layout)
+		}
+	}
+
+	return p
+}
+
+// newDateParserFromConfig 공급자 설정의 작성일 포맷(date_format)으로 DateParser를 생성합니다. 설정이 없으면 기본 DateParser를 반환합니다.
+//
+// 시간대 이름은 설정 검증 단계에서 이미 확인되었으므로, 여기서 읽지 못하는 경우(검증을 거치지 않은 테스트 설정 등)에는 기본 시간대를 사용합니다.
+func newDateParserFromConfig(c *config.DateFormatConfig) *DateParser {
+	if c == nil {
+		return defaultDateParser
+	}
+
+	var location *time.Location
+	if tz := strings.TrimSpace(c.Timezone); tz != "" {
+		location, _ = time.LoadLocation(tz)
+	}
+
+	return NewDateParser(DateParserConfig{
+		Location: location,
+		Layouts:  c.Layouts,
+		Strict:   c.Strict,
+	})
+}
+
+// defaultDateParser ParseCreatedAt이 사용하는 기본 설정(Asia/Seoul, 관대한 모드)의 DateParser입니다.
+var defaultDateParser = NewDateParser(DateParserConfig{})
+
+// ParseCreatedAt 기본 설정의 DateParser로 작성일 문자열을 time.Time으로 변환합니다.
+//
+// 사이트 전용 레이아웃이나 엄격 모드가 필요하면 Base.ParseCreatedAt을 사용합니다.
 func ParseCreatedAt(s string) (time.Time, error) {
-	now := time.Now()
+	return defaultDateParser.ParseTime(s)
+}
 
-	// HH:MM:SS 형식
-	if matched, _ := regexp.MatchString(`^[0-9]{2}:[0-9]{2}:[0-9]{2}$`, s); matched {
-		t, err := time.ParseInLocation("2006-01-02 15:04:05", fmt.Sprintf("%04d-%02d-%02d %s", now.Year(), now.Month(), now.Day(), s), time.Local)
-		if err == nil && t.After(now) {
-			t = t.Add(-24 * time.Hour)
+var (
+	// relativeAgoPattern "3분 전", "2 시간 전", "방금 전" 형식의 상대 시간 표현
+	relativeAgoPattern = regexp.MustCompile(`^(?:방금(?:\s*전)?|(\d+)\s*(초|분|시간|일|주)\s*전)$`)
+
+	// relativeDayPattern "오늘", "어제 14:20", "그저께 09:05:10" 형식의 상대 날짜 표현
+	relativeDayPattern = regexp.MustCompile(`^(오늘|어제|그제|그저께)(?:\s+(\d{1,2}):(\d{2})(?::(\d{2}))?)?$`)
+
+	// clockPattern "14:30", "9:05:10" 형식의 시각 표현
+	clockPattern = regexp.MustCompile(`^(\d{1,2}):(\d{2})(?::(\d{2}))?$`)
+
+	// numericDatePattern "2024-03-15", "2024.3.5.", "2024. 03. 15. 14:30", "24/03/05" 형식의 숫자 날짜 표현
+	// Go 정규식(RE2)은 역참조를 지원하지 않으므로, 두 구분자가 같은지는 매칭 후에 별도로 확인합니다.
+	numericDatePattern = regexp.MustCompile(`^(\d{4}|\d{2})([-./])\s*(\d{1,2})([-./])\s*(\d{1,2})(\.?)(?:\s+(\d{1,2}):(\d{2})(?::(\d{2}))?)?$`)
+
+	// koreanDatePattern "2024년 3월 5일", "2024년 03월 15일 14:30" 형식의 한국어 날짜 표현
+	koreanDatePattern = regexp.MustCompile(`^(\d{4})년\s*(\d{1,2})월\s*(\d{1,2})일(?:\s+(\d{1,2}):(\d{2})(?::(\d{2}))?)?$`)
+)
+
+// Parse 작성일 문자열을 변환하고, 결과의 정확도 등급을 함께 반환합니다.
+//
+// 어떤 포맷과도 일치하지 않으면 ErrUnsupportedCreatedAtFormat을, 포맷은 맞지만 "2024-13-45"처럼 존재하지 않는
+// 날짜나 시각이면 apperrors.ParsingFailed 에러를, 엄격 모드에서 상대 시간 표현이면 ErrLowConfidenceCreatedAt을 반환합니다.
+func (p *DateParser) Parse(s string) (ParsedDate, error) {
+	s = strings.TrimSpace(s)
+
+	parsed, matched, err := p.parse(s)
+	if err != nil {
+		return ParsedDate{}, err
+	}
+	if !matched {
+		return ParsedDate{}, apperrors.Wrapf(ErrUnsupportedCreatedAtFormat, apperrors.ParsingFailed, "지원되지 않는 작성일 데이터 포맷('%s')이 감지되어 시간 변환에 실패하였습니다.", s)
+	}
+
+	if p.strict && parsed.Confidence < DateConfidenceMedium {
+		return ParsedDate{}, apperrors.Wrapf(ErrLowConfidenceCreatedAt, apperrors.ParsingFailed, "작성일('%s')이 상대 시간 표현이어서 엄격 모드에서 허용되지 않습니다", s)
+	}
+
+	return parsed, nil
+}
+
+// ParseTime Parse와 같지만 정확도 등급 없이 변환된 시각만 반환합니다.
+func (p *DateParser) ParseTime(s string) (time.Time, error) {
+	parsed, err := p.Parse(s)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return parsed.Time, nil
+}
+
+// Location 작성일을 해석하는 시간대를 반환합니다.
+func (p *DateParser) Location() *time.Location {
+	return p.location
+}
+
+// parse 지원 포맷을 순서대로 시도합니다. 일치하는 포맷이 없으면 matched가 false입니다.
+func (p *DateParser) parse(s string) (parsed ParsedDate, matched bool, err error) {
+	if s == "" {
+		return ParsedDate{}, false, nil
+	}
+
+	now := p.now().In(p.location)
+
+	// 1. 사이트 전용 레이아웃
+	for _, layout := range p.layouts {
+		if parsed, ok := p.parseLayout(layout, s, now); ok {
+			return parsed, true, nil
 		}
+	}
 
-		return t, err
+	// 2. 상대 시간 ("3분 전", "방금")
+	if m := relativeAgoPattern.FindStringSubmatch(s); m != nil {
+		return ParsedDate{Time: relativeAgo(now, m[1], m[2]), Confidence: DateConfidenceLow}, true, nil
 	}
 
-	// HH:MM 형식 (초 정보 없음, 0초로 설정)
-	if matched, _ := regexp.MatchString(`^[0-9]{2}:[0-9]{2}$`, s); matched {
-		t, err := time.ParseInLocation("2006-01-02 15:04:05", fmt.Sprintf("%04d-%02d-%02d %s:00", now.Year(), now.Month(), now.Day(), s), time.Local)
+	// 3. 상대 날짜 ("어제 14:20")
+	if m := relativeDayPattern.FindStringSubmatch(s); m != nil {
+		day := now.AddDate(0, 0, -relativeDayOffsets[m[1]])
+
+		t, err := p.compose(s, day.Year(), int(day.Month()), day.Day(), m[2], m[3], m[4])
+		return ParsedDate{Time: t, Confidence: DateConfidenceMedium}, true, err
+	}
+
+	// 4. 시각 ("14:30")
+	if m := clockPattern.FindStringSubmatch(s); m != nil {
+		t, err := p.compose(s, now.Year(), int(now.Month()), now.Day(), m[1], m[2], m[3])
 		if err == nil && t.After(now) {
-			t = t.Add(-24 * time.Hour)
+			t = t.AddDate(0, 0, -1)
+		}
+		return ParsedDate{Time: t, Confidence: DateConfidenceMedium}, true, err
+	}
+
+	// 5. 숫자 날짜 ("2024-03-15", "2024.3.5.", "24.03.05")
+	if m := numericDatePattern.FindStringSubmatch(s); m != nil {
+		sep := m[2]
+		if m[4] != sep || (m[6] != "" && sep != ".") {
+			return ParsedDate{}, false, nil
+		}
+
+		year, _ := strconv.Atoi(m[1])
+		twoDigitYear := len(m[1]) == 2
+		if twoDigitYear {
+			year += 2000
+		}
+		month, _ := strconv.Atoi(m[3])
+		day, _ := strconv.Atoi(m[5])
+
+		t, err := p.compose(s, year, month, day, m[7], m[8], m[9])
+		return ParsedDate{Time: t, Confidence: dateConfidence(m[7] != "" && !twoDigitYear)}, true, err
+	}
+
+	// 6. 한국어 날짜 ("2024년 3월 5일")
+	if m := koreanDatePattern.FindStringSubmatch(s); m != nil {
+		year, _ := strconv.Atoi(m[1])
+		month, _ := strconv.Atoi(m[2])
+		day, _ := strconv.Atoi(m[3])
+
+		t, err := p.compose(s, year, month, day, m[4], m[5], m[6])
+		return ParsedDate{Time: t, Confidence: dateConfidence(m[4] != "")}, true, err
+	}
+
+	return ParsedDate{}, false, nil
+}
+
+// parseLayout 사이트 전용 레이아웃 하나로 변환을 시도합니다.
+func (p *DateParser) parseLayout(layout, s string, now time.Time) (ParsedDate, bool) {
+	t, err := time.ParseInLocation(layout, s, p.location)
+	if err != nil {
+		return ParsedDate{}, false
+	}
+
+	// Go 레이아웃에서 분(minute)을 나타내는 "04"가 있으면 시각이 포함된 레이아웃으로 판단합니다.
+	hasClock := strings.Contains(layout, "04")
+
+	// 연도가 없는 레이아웃("01-02 15:04")은 0년으로 해석되므로 현재 연도로 옮기고,
+	// 미래가 되면(예: 1월 초에 12월 말 글 수집 시) 작년 글로 교정합니다.
+	if t.Year() == 0 {
+		t = t.AddDate(now.Year(), 0, 0)
+		if t.After(now) {
+			t = t.AddDate(-1, 0, 0)
 		}
+		return ParsedDate{Time: t, Confidence: DateConfidenceMedium}, true
+	}
+
+	return ParsedDate{Time: t, Confidence: dateConfidence(hasClock)}, true
+}
 
-		return t, err
+// compose 연/월/일과 시각 문자열(비어 있으면 00:00:00)로 time.Time을 만들고, 범위를 벗어난 값은 에러로 처리합니다.
+//
+// time.Date는 "13월 45일"을 다음 해 2월로 정규화해 버리므로, 입력값이 결과에 그대로 보존되었는지 확인해야 합니다.
+func (p *DateParser) compose(s string, year, month, day int, hourStr, minStr, secStr string) (time.Time, error) {
+	hour, _ := strconv.Atoi(hourStr)
+	minute, _ := strconv.Atoi(minStr)
+	sec, _ := strconv.Atoi(secStr)
+
+	t := time.Date(year, time.Month(month), day, hour, minute, sec, 0, p.location)
+	if t.Year() != year || int(t.Month()) != month || t.Day() != day || hour > 23 || minute > 59 || sec > 59 {
+		return time.Time{}, apperrors.Newf(apperrors.ParsingFailed, "작성일('%s')이 존재하지 않는 날짜 또는 시각이어서 시간 변환에 실패하였습니다", s)
 	}
 
-	// yyyy-MM-dd 형식
-	if matched, _ := regexp.MatchString(`^[0-9]{4}-[0-9]{2}-[0-9]{2}$`, s); matched {
-		return time.ParseInLocation("2006-01-02 15:04:05", s+" 00:00:00", time.Local)
+	return t, nil
+}
+
+var relativeDayOffsets = map[string]int{"오늘": 0, "어제": 1, "그제": 2, "그저께": 2}
+
+var relativeUnits = map[string]time.Duration{"초": time.Second, "분": time.Minute, "시간": time.Hour}
+
+// relativeAgo "N단위 전" 표현을 기준 시각(now)에서 역산합니다. amount가 비어 있으면 "방금"으로 보고 now를 반환합니다.
+//
+// 사이트가 표시 단위 이하를 버리므로 결과도 해당 단위로 절삭합니다. 일/주 단위는 날짜만 있는 입력과 같이 00:00:00으로 고정합니다.
+func relativeAgo(now time.Time, amount, unit string) time.Time {
+	if amount == "" {
+		return now.Truncate(time.Second)
 	}
 
-	// yyyy.MM.dd. 형식 (네이버 카페 등 점(.) 구분자 사용 사이트)
-	if matched, _ := regexp.MatchString(`^[0-9]{4}\.[0-9]{2}\.[0-9]{2}\.$`, s); matched {
-		return time.ParseInLocation("2006.01.02. 15:04:05", s+" 00:00:00", time.Local)
+	n, _ := strconv.Atoi(amount)
+	switch unit {
+	case "일", "주":
+		days := n
+		if unit == "주" {
+			days *= 7
+		}
+		d := now.AddDate(0, 0, -days)
+		return time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, now.Location())
+	default:
+		step := relativeUnits[unit]
+		return now.Add(-time.Duration(n) * step).Truncate(step)
 	}
+}
 
-	return time.Time{}, apperrors.Newf(apperrors.ParsingFailed, "지원되지 않는 작성일 데이터 포맷('%s')이 감지되어 시간 변환에 실패하였습니다.", s)
+func dateConfidence(hasClock bool) DateConfidence {
+	if hasClock {
+		return DateConfidenceHigh
+	}
+	return DateConfidenceMedium
 }
@@ -1,208 +1,225 @@
 package provider
 
 import (
+	"errors"
 	"testing"
 	"time"
 
+	"github.com/darkkaiser/rss-feed-server/internal/config"
 	apperrors "github.com/darkkaiser/rss-feed-server/internal/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
-// fixedNow는 테스트 시각 의존성을 없애기 위해 ParseCreatedAt 내부의 time.Now()와
-// 동일한 정밀도(초 단위 이하 제거)로 맞춘 고정 기준 시각입니다.
-// 단, ParseCreatedAt은 time.Now()를 직접 호출하므로, 시간 포맷 테스트에서는
-// 테스트 시작 직전 now를 캡처하여 결과 검증에 사용합니다.
-
-func TestParseCreatedAt_TimeFormat_HHMMSS(t *testing.T) {
-	t.Run("정상: 현재 시각보다 과거인 시간 문자열 (HH:MM:SS)", func(t *testing.T) {
-		// 과거 시각을 보장하기 위해 자정(00:00:01)으로 고정
-		// 실제 테스트 환경에서는 함수 내부 time.Now()를 제어할 수 없으므로,
-		// 자정 이후 1초 후라는 가장 안전한 과거 케이스를 선택합니다.
-		now := time.Now()
-		// 현재 시각의 HH:MM:SS 보다 1초 앞선 과거 시각을 생성합니다.
-		pastTime := now.Add(-1 * time.Hour)
-		input := pastTime.Format("15:04:05")
-
-		got, err := ParseCreatedAt(input)
-
-		require.NoError(t, err)
-		// 날짜가 오늘 또는 어제(자정 경계 교정)인지 확인
-		assert.False(t, got.IsZero())
-		// 반환된 시각이 현재보다 미래가 아닌지 확인 (핵심 불변조건)
-		assert.False(t, got.After(time.Now()), "반환된 시각이 현재 시각보다 미래여서는 안 됩니다")
-		// 시/분/초가 입력값과 일치하는지 확인
-		assert.Equal(t, input, got.Format("15:04:05"))
-	})
-
-	t.Run("정상: 자정 경계 교정 — 미래 시각은 전날로 보정 (HH:MM:SS)", func(t *testing.T) {
-		// 현재보다 1시간 뒤 시각 문자열을 입력 → 미래로 판정되어 -24h 교정되어야 합니다.
-		futureTime := time.Now().Add(1 * time.Hour)
-		input := futureTime.Format("15:04:05")
-
-		got, err := ParseCreatedAt(input)
-
-		require.NoError(t, err)
-		// 교정 후에는 현재 시각보다 반드시 과거여야 합니다.
-		assert.True(t, got.Before(time.Now()), "미래 시각은 24시간 차감 후 현재보다 과거여야 합니다")
-	})
-
-	t.Run("실패: 유효하지 않은 시각 값 (HH:MM:SS 형식이나 값이 범위 초과)", func(t *testing.T) {
-		// 패턴은 정규식을 통과하지만 time.ParseInLocation 에서 실패하는 케이스
-		_, err := ParseCreatedAt("25:61:99")
-
-		assert.Error(t, err)
-	})
-}
-
-func TestParseCreatedAt_TimeFormat_HHMM(t *testing.T) {
-	t.Run("정상: 현재 시각보다 과거인 시간 문자열 (HH:MM)", func(t *testing.T) {
-		pastTime := time.Now().Add(-1 * time.Hour)
-		input := pastTime.Format("15:04")
-
-		got, err := ParseCreatedAt(input)
-
-		require.NoError(t, err)
-		assert.False(t, got.IsZero())
-		assert.False(t, got.After(time.Now()), "반환된 시각이 현재 시각보다 미래여서는 안 됩니다")
-		// 초는 항상 00으로 고정됨
-		assert.Equal(t, "00", got.Format("05"), "초(second)는 항상 00이어야 합니다")
-		assert.Equal(t, input, got.Format("15:04"))
-	})
-
-	t.Run("정상: 자정 경계 교정 — 미래 시각은 전날로 보정 (HH:MM)", func(t *testing.T) {
-		futureTime := time.Now().Add(1 * time.Hour)
-		input := futureTime.Format("15:04")
+// kst 테스트 기대값을 만들 때 사용하는 시간대입니다.
+var kst = DefaultDateLocation
 
-		got, err := ParseCreatedAt(input)
-
-		require.NoError(t, err)
-		assert.True(t, got.Before(time.Now()), "미래 시각은 24시간 차감 후 현재보다 과거여야 합니다")
-	})
-
-	t.Run("실패: 유효하지 않은 시각 값 (HH:MM 형식이나 값이 범위 초과)", func(t *testing.T) {
-		_, err := ParseCreatedAt("25:61")
-
-		assert.Error(t, err)
-	})
+// fakeNow 모든 테스트의 기준 시각(2024-03-15 14:30:45 KST)을 반환하는 가짜 시계입니다.
+func fakeNow() time.Time {
+	return time.Date(2024, time.March, 15, 14, 30, 45, 0, kst)
 }
 
-func TestParseCreatedAt_DateFormat_YyyyMMDD_Dash(t *testing.T) {
-	t.Run("정상: 과거 날짜 (yyyy-MM-dd)", func(t *testing.T) {
-		got, err := ParseCreatedAt("2024-03-15")
+func newTestDateParser(cfg DateParserConfig) *DateParser {
+	cfg.Now = fakeNow
+	return NewDateParser(cfg)
+}
 
-		require.NoError(t, err)
-		assert.Equal(t, 2024, got.Year())
-		assert.Equal(t, time.March, got.Month())
-		assert.Equal(t, 15, got.Day())
-	})
+func TestDateParser_Parse(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		want       time.Time
+		confidence DateConfidence
+	}{
+		// 시각만 있는 포맷: 오늘 날짜로 합성
+		{"HH:MM:SS", "14:20:10", time.Date(2024, 3, 15, 14, 20, 10, 0, kst), DateConfidenceMedium},
+		{"HH:MM", "09:05", time.Date(2024, 3, 15, 9, 5, 0, 0, kst), DateConfidenceMedium},
+		{"H:MM", "9:05", time.Date(2024, 3, 15, 9, 5, 0, 0, kst), DateConfidenceMedium},
+		{"앞뒤 공백", "  09:05\n", time.Date(2024, 3, 15, 9, 5, 0, 0, kst), DateConfidenceMedium},
+		{"자정 경계 교정: 미래 시각은 전날", "23:50", time.Date(2024, 3, 14, 23, 50, 0, 0, kst), DateConfidenceMedium},
+
+		// 날짜만 있는 포맷: 00:00:00으로 고정
+		{"yyyy-MM-dd", "2024-03-10", time.Date(2024, 3, 10, 0, 0, 0, 0, kst), DateConfidenceMedium},
+		{"yyyy.MM.dd.", "2024.03.10.", time.Date(2024, 3, 10, 0, 0, 0, 0, kst), DateConfidenceMedium},
+		{"yyyy.MM.dd", "2024.03.10", time.Date(2024, 3, 10, 0, 0, 0, 0, kst), DateConfidenceMedium},
+		{"0 채움 없는 yyyy.M.d", "2024.3.5", time.Date(2024, 3, 5, 0, 0, 0, 0, kst), DateConfidenceMedium},
+		{"구분자 뒤 공백", "2024. 3. 5.", time.Date(2024, 3, 5, 0, 0, 0, 0, kst), DateConfidenceMedium},
+		{"yyyy/MM/dd", "2024/03/10", time.Date(2024, 3, 10, 0, 0, 0, 0, kst), DateConfidenceMedium},
+		{"두 자리 연도 yy.MM.dd", "24.03.05", time.Date(2024, 3, 5, 0, 0, 0, 0, kst), DateConfidenceMedium},
+		{"한국어 날짜", "2024년 3월 5일", time.Date(2024, 3, 5, 0, 0, 0, 0, kst), DateConfidenceMedium},
+
+		// 날짜와 시각이 모두 있는 포맷
+		{"yyyy-MM-dd HH:MM:SS", "2024-03-10 14:30:00", time.Date(2024, 3, 10, 14, 30, 0, 0, kst), DateConfidenceHigh},
+		{"yyyy.MM.dd. HH:MM", "2024.03.10. 08:15", time.Date(2024, 3, 10, 8, 15, 0, 0, kst), DateConfidenceHigh},
+		{"한국어 날짜와 시각", "2024년 03월 10일 14:30", time.Date(2024, 3, 10, 14, 30, 0, 0, kst), DateConfidenceHigh},
+		{"두 자리 연도와 시각", "24.03.10 14:30", time.Date(2024, 3, 10, 14, 30, 0, 0, kst), DateConfidenceMedium},
+
+		// 상대 날짜
+		{"오늘", "오늘", time.Date(2024, 3, 15, 0, 0, 0, 0, kst), DateConfidenceMedium},
+		{"어제 HH:MM", "어제 14:20", time.Date(2024, 3, 14, 14, 20, 0, 0, kst), DateConfidenceMedium},
+		{"그저께 HH:MM:SS", "그저께 09:05:10", time.Date(2024, 3, 13, 9, 5, 10, 0, kst), DateConfidenceMedium},
+
+		// 상대 시간
+		{"방금", "방금", time.Date(2024, 3, 15, 14, 30, 45, 0, kst), DateConfidenceLow},
+		{"방금 전", "방금 전", time.Date(2024, 3, 15, 14, 30, 45, 0, kst), DateConfidenceLow},
+		{"N초 전", "30초 전", time.Date(2024, 3, 15, 14, 30, 15, 0, kst), DateConfidenceLow},
+		{"N분 전", "3분 전", time.Date(2024, 3, 15, 14, 27, 0, 0, kst), DateConfidenceLow},
+		{"N 시간 전", "2 시간 전", time.Date(2024, 3, 15, 12, 0, 0, 0, kst), DateConfidenceLow},
+		{"N일 전", "3일 전", time.Date(2024, 3, 12, 0, 0, 0, 0, kst), DateConfidenceLow},
+		{"N주 전", "1주 전", time.Date(2024, 3, 8, 0, 0, 0, 0, kst), DateConfidenceLow},
+	}
 
-	t.Run("정상: 시각은 항상 00:00:00 으로 고정 (결정론적 보장)", func(t *testing.T) {
-		// 동일 날짜는 크롤링 시점에 관계없이 항상 같은 시각을 반환해야 합니다.
-		got1, err1 := ParseCreatedAt("2024-03-15")
-		got2, err2 := ParseCreatedAt("2024-03-15")
+	p := newTestDateParser(DateParserConfig{})
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := p.Parse(tc.input)
 
-		require.NoError(t, err1)
-		require.NoError(t, err2)
-		assert.Equal(t, got1, got2, "동일 날짜 입력은 항상 동일한 time.Time을 반환해야 합니다")
-		assert.Equal(t, "00:00:00", got1.Format("15:04:05"), "시각은 항상 00:00:00 이어야 합니다")
-	})
+			require.NoError(t, err)
+			assert.True(t, tc.want.Equal(got.Time), "기대값: %s, 실제값: %s", tc.want, got.Time)
+			assert.Equal(t, kst, got.Time.Location(), "Asia/Seoul 시간대가 적용되어야 합니다")
+			assert.Equal(t, tc.confidence, got.Confidence)
+		})
+	}
+}
 
-	t.Run("정상: 로컬 시간대(time.Local) 적용 확인", func(t *testing.T) {
-		got, err := ParseCreatedAt("2024-03-15")
+// TestDateParser_Location 머신의 시간대와 무관하게 설정한 시간대로 해석하는지 검증합니다.
+func TestDateParser_Location(t *testing.T) {
+	utc := newTestDateParser(DateParserConfig{Location: time.UTC})
 
-		require.NoError(t, err)
-		assert.Equal(t, time.Local, got.Location(), "로컬 시간대가 적용되어야 합니다")
-	})
+	got, err := utc.ParseTime("2024-03-10 09:00")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 3, 10, 9, 0, 0, 0, time.UTC), got)
 
-	t.Run("실패: 유효하지 않은 날짜 값 (yyyy-MM-dd 형식이나 존재하지 않는 날짜)", func(t *testing.T) {
-		// 패턴은 통과하나 존재하지 않는 날짜
-		_, err := ParseCreatedAt("2024-13-45")
+	seoul, err := newTestDateParser(DateParserConfig{}).ParseTime("2024-03-10 09:00")
+	require.NoError(t, err)
+	assert.Equal(t, 9*time.Hour, got.Sub(seoul), "같은 문자열도 시간대에 따라 다른 시각이어야 합니다")
 
-		assert.Error(t, err)
-	})
+	// 기준 시각이 다른 시간대로 주어져도 설정한 시간대의 날짜를 기준으로 합성합니다. (UTC 3/15 20:00 = KST 3/16 05:00)
+	p := NewDateParser(DateParserConfig{Now: func() time.Time { return time.Date(2024, 3, 15, 20, 0, 0, 0, time.UTC) }})
+	got, err = p.ParseTime("04:00")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 3, 16, 4, 0, 0, 0, kst), got)
 }
 
-func TestParseCreatedAt_DateFormat_YyyyMMDD_Dot(t *testing.T) {
-	t.Run("정상: 과거 날짜 (yyyy.MM.dd.)", func(t *testing.T) {
-		got, err := ParseCreatedAt("2024.03.15.")
+func TestDateParser_Layouts(t *testing.T) {
+	p := newTestDateParser(DateParserConfig{Layouts: []string{"02/01/2006 15:04", "01-02 15:04", " "}})
 
-		require.NoError(t, err)
-		assert.Equal(t, 2024, got.Year())
-		assert.Equal(t, time.March, got.Month())
-		assert.Equal(t, 15, got.Day())
-	})
+	tests := []struct {
+		name       string
+		input      string
+		want       time.Time
+		confidence DateConfidence
+	}{
+		// 기본 포맷으로는 yyyy/MM/dd로 해석할 수 없는 dd/MM/yyyy 레이아웃
+		{"사이트 전용 레이아웃", "10/03/2024 14:30", time.Date(2024, 3, 10, 14, 30, 0, 0, kst), DateConfidenceHigh},
+		{"연도 없는 레이아웃: 올해", "03-10 14:30", time.Date(2024, 3, 10, 14, 30, 0, 0, kst), DateConfidenceMedium},
+		{"연도 없는 레이아웃: 미래이면 작년", "12-28 09:00", time.Date(2023, 12, 28, 9, 0, 0, 0, kst), DateConfidenceMedium},
+		{"레이아웃과 다르면 기본 포맷", "2024.03.10.", time.Date(2024, 3, 10, 0, 0, 0, 0, kst), DateConfidenceMedium},
+	}
 
-	t.Run("정상: 시각은 항상 00:00:00 으로 고정 (결정론적 보장)", func(t *testing.T) {
-		got1, err1 := ParseCreatedAt("2024.03.15.")
-		got2, err2 := ParseCreatedAt("2024.03.15.")
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := p.Parse(tc.input)
 
-		require.NoError(t, err1)
-		require.NoError(t, err2)
-		assert.Equal(t, got1, got2, "동일 날짜 입력은 항상 동일한 time.Time을 반환해야 합니다")
-		assert.Equal(t, "00:00:00", got1.Format("15:04:05"), "시각은 항상 00:00:00 이어야 합니다")
-	})
+			require.NoError(t, err)
+			assert.True(t, tc.want.Equal(got.Time), "기대값: %s, 실제값: %s", tc.want, got.Time)
+			assert.Equal(t, tc.confidence, got.Confidence)
+		})
+	}
+}
 
-	t.Run("정상: 로컬 시간대(time.Local) 적용 확인", func(t *testing.T) {
-		got, err := ParseCreatedAt("2024.03.15.")
+func TestDateParser_Strict(t *testing.T) {
+	p := newTestDateParser(DateParserConfig{Strict: true})
 
-		require.NoError(t, err)
-		assert.Equal(t, time.Local, got.Location(), "로컬 시간대가 적용되어야 합니다")
-	})
+	for _, input := range []string{"방금", "3분 전", "2일 전"} {
+		_, err := p.Parse(input)
 
-	t.Run("실패: 유효하지 않은 날짜 값 (yyyy.MM.dd. 형식이나 존재하지 않는 날짜)", func(t *testing.T) {
-		_, err := ParseCreatedAt("2024.13.45.")
+		require.Error(t, err, input)
+		assert.True(t, errors.Is(err, ErrLowConfidenceCreatedAt), input)
+		assert.True(t, apperrors.Is(err, apperrors.ParsingFailed), input)
+	}
 
-		assert.Error(t, err)
-	})
+	// 정확도가 Medium 이상인 표현은 엄격 모드에서도 허용됩니다.
+	for _, input := range []string{"어제 14:20", "14:20", "2024.3.5"} {
+		_, err := p.Parse(input)
+		assert.NoError(t, err, input)
+	}
 }
 
-func TestParseCreatedAt_DateFormatEquivalence(t *testing.T) {
-	t.Run("두 날짜 포맷(대시/점)은 동일 날짜에 대해 동등한 time.Time을 반환해야 합니다", func(t *testing.T) {
-		// yyyy-MM-dd 와 yyyy.MM.dd. 는 동일 날짜를 표현하므로 결과가 같아야 합니다.
-		dashResult, err1 := ParseCreatedAt("2024-03-15")
-		dotResult, err2 := ParseCreatedAt("2024.03.15.")
+func TestDateParser_InvalidValue(t *testing.T) {
+	p := newTestDateParser(DateParserConfig{})
 
-		require.NoError(t, err1)
-		require.NoError(t, err2)
-		assert.True(t, dashResult.Equal(dotResult), "yyyy-MM-dd 와 yyyy.MM.dd. 포맷은 동일한 time.Time을 반환해야 합니다")
-	})
+	// 포맷은 일치하지만 존재하지 않는 날짜/시각은 레이아웃 변경이 아니므로 ErrUnsupportedCreatedAtFormat으로 분류하지 않습니다.
+	for _, input := range []string{"25:61:99", "25:61", "2024-13-45", "2024.13.45.", "2023-02-29", "2024년 2월 30일", "어제 24:00"} {
+		t.Run(input, func(t *testing.T) {
+			got, err := p.ParseTime(input)
+
+			require.Error(t, err)
+			assert.True(t, got.IsZero())
+			assert.True(t, apperrors.Is(err, apperrors.ParsingFailed))
+			assert.False(t, errors.Is(err, ErrUnsupportedCreatedAtFormat))
+		})
+	}
 }
 
-func TestParseCreatedAt_UnsupportedFormat(t *testing.T) {
+func TestDateParser_UnsupportedFormat(t *testing.T) {
 	unsupportedCases := []struct {
 		name  string
 		input string
 	}{
 		{"빈 문자열", ""},
 		{"공백 문자열", "   "},
-		{"날짜-시간 복합 형식 (지원 안 함)", "2024-03-15 14:30:00"},
+		{"작성자 이름", "홍길동"},
 		{"구분자 없는 숫자열", "20240315"},
-		{"후행 점 없는 yyyy.MM.dd 형식", "2024.03.15"},
-		{"슬래시 구분자 날짜", "2024/03/15"},
-		{"한국어 날짜 형식", "2024년 03월 15일"},
+		{"서로 다른 구분자", "2024-03.15"},
+		{"대시 구분자의 후행 점", "2024-03-15."},
+		{"세 자리 연도", "202.03.15"},
 		{"초가 포함된 HH:MM:SS:ms 형식", "14:30:00:123"},
+		{"단위 없는 상대 시간", "3 전"},
 	}
 
+	p := newTestDateParser(DateParserConfig{})
 	for _, tc := range unsupportedCases {
-		tc := tc // 루프 변수 캡처
 		t.Run(tc.name, func(t *testing.T) {
-			got, err := ParseCreatedAt(tc.input)
+			got, err := p.ParseTime(tc.input)
 
-			assert.Error(t, err, "지원하지 않는 포맷은 반드시 에러를 반환해야 합니다")
+			require.Error(t, err, "지원하지 않는 포맷은 반드시 에러를 반환해야 합니다")
 			assert.True(t, got.IsZero(), "에러 시 반환되는 time.Time은 zero value여야 합니다")
+			assert.True(t, errors.Is(err, ErrUnsupportedCreatedAtFormat))
+			assert.True(t, apperrors.Is(err, apperrors.ParsingFailed))
 		})
 	}
 }
 
-func TestParseCreatedAt_UnsupportedFormat_ErrorType(t *testing.T) {
-	t.Run("지원하지 않는 포맷 에러는 apperrors.ParsingFailed 타입이어야 합니다", func(t *testing.T) {
-		_, err := ParseCreatedAt("invalid-format")
+func TestDateConfidence_String(t *testing.T) {
+	assert.Equal(t, "low", DateConfidenceLow.String())
+	assert.Equal(t, "medium", DateConfidenceMedium.String())
+	assert.Equal(t, "high", DateConfidenceHigh.String())
+	assert.Equal(t, "unknown", DateConfidence(0).String())
+}
+
+// TestParseCreatedAt 패키지 수준 함수가 기본 설정(Asia/Seoul, 관대한 모드)을 사용하는지 검증합니다.
+func TestParseCreatedAt(t *testing.T) {
+	got, err := ParseCreatedAt("2024.03.15.")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 3, 15, 0, 0, 0, 0, kst), got)
+
+	got, err = ParseCreatedAt("3분 전")
+	require.NoError(t, err)
+	assert.False(t, got.After(time.Now()))
+
+	_, err = ParseCreatedAt("invalid-format")
+	assert.True(t, errors.Is(err, ErrUnsupportedCreatedAtFormat))
+}
+
+func TestNewDateParserFromConfig(t *testing.T) {
+	assert.Same(t, defaultDateParser, newDateParserFromConfig(nil))
+
+	p := newDateParserFromConfig(&config.DateFormatConfig{Layouts: []string{"2006/01/02 15:04"}, Timezone: "UTC", Strict: true})
+	assert.Equal(t, time.UTC, p.Location())
+	assert.Equal(t, []string{"2006/01/02 15:04"}, p.layouts)
+	assert.True(t, p.strict)
 
-		require.Error(t, err)
-		assert.True(t,
-			apperrors.Is(err, apperrors.ParsingFailed),
-			"반환된 에러는 apperrors.ParsingFailed 타입이어야 합니다",
-		)
-	})
+	// 검증을 거치지 않은 잘못된 시간대 이름은 기본 시간대로 대체합니다.
+	assert.Equal(t, DefaultDateLocation, newDateParserFromConfig(&config.DateFormatConfig{Timezone: "Mars/Olympus"}).Location())
 }
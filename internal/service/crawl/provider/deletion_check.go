@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+)
+
+// deletionCheckTimeout 한 번의 삭제 점검 사이클(점검 대상 추출 → 원문 접근 확인 → 삭제 기록)에 허용되는 최대 실행 시간입니다.
+const deletionCheckTimeout = 10 * time.Minute
+
+// deletionCheckConcurrency 삭제 점검 시 동시에 원문 페이지에 접근하는 최대 고루틴 수입니다.
+const deletionCheckConcurrency = 2
+
+// CheckDeleted 작성된 지 days일 이내의 게시글 중 최대 sampleSize개를 무작위로 추출하여 원문 페이지(Link)에 다시 접근해 보고,
+// 삭제 또는 접근 제한이 명확하게 확인된 게시글의 삭제 감지 일시(DeletedAt)와 판정 근거(DeletedReason)를 기록합니다.
+//
+// 실행 흐름:
+//  1. 저장소(feed.DeletionRepository)에서 아직 삭제가 감지되지 않았고 본문이 수집된 점검 대상 게시글을 무작위로 추출합니다.
+//  2. 본문을 비운 복사본을 만들어 주입된 본문 수집 함수(crawlArticleContent)로 원문에 병렬 접근합니다.
+//  3. ErrArticleNotFound를 반환한 게시글은 원문 삭제(feed.DeletedReasonRemoved)로,
+//     ErrArticleAccessDenied를 반환한 게시글은 접근 제한(feed.DeletedReasonAccessDenied)으로 기록합니다.
+//
+// 판정 기준:
+// 각 크롤러의 본문 수집 함수는 HTTP 404 응답이나 사이트가 표시하는 삭제 안내 페이지(예: 네이버 카페의 '삭제되었거나 없는 게시글입니다')처럼
+// 삭제를 확신할 수 있는 경우에만 ErrArticleNotFound를, 원문 사이트가 HTTP 403/401로 접근을 거부한 경우에만 ErrArticleAccessDenied를 반환합니다.
+// 점검 대상은 한 번 본문을 수집했던 게시글이므로, 접근 거부는 회원 전용 전환이나 비공개 처리처럼 게시글의 상태가 바뀐 것으로 보고
+// 피드 정책(deleted_article_policy)이 원문 삭제와 구별하여 다룰 수 있도록 별도의 근거로 기록합니다.
+//
+// 단, 같은 점검 주기에서 본문 수집에 성공한 게시글이 하나도 없다면 접근 거부는 로그인 세션 만료나 사이트 차단처럼
+// 크롤러 자신의 접근 문제일 가능성이 높으므로 기록하지 않고 경고 로그만 남깁니다.
+// 본문 컨테이너 부재처럼 ErrContentUnavailable만 반환되는 경우는 사이트 레이아웃 변경일 수 있으므로 어떤 상태로도 기록하지 않으며,
+// 네트워크 오류나 타임아웃처럼 일시적일 수 있는 실패 역시 다음 점검 주기로 판단을 미룹니다.
+func (b *Base) CheckDeleted(ctx context.Context, days, sampleSize uint) {
+	// 점검 중 발생한 런타임 패닉이 스케줄러 고루틴으로 전파되지 않도록 방어합니다.
+	defer func() {
+		if r := recover(); r != nil {
+			msg := b.Messagef("삭제 점검 작업 중단: 런타임 패닉 발생 (상세: %v)", r)
+
+			b.logger.Error(msg)
+			b.ReportError(msg, nil)
+		}
+	}()
+
+	if days == 0 || sampleSize == 0 {
+		return
+	}
+
+	if b.crawlArticleContent == nil {
+		b.logger.Debug(b.Messagef("삭제 점검 생략: 본문 수집 함수 미주입 (SetCrawlArticleContent 호출 필요)"))
+		return
+	}
+
+	repo, ok := b.feedRepo.(feed.DeletionRepository)
+	if !ok {
+		b.logger.Debug(b.Messagef("삭제 점검 생략: 저장소가 게시글 삭제 기록을 지원하지 않음"))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deletionCheckTimeout)
+	defer cancel()
+
+	// [1단계] 점검 대상 게시글 추출
+	since := time.Now().AddDate(0, 0, -int(days))
+	samples, err := repo.SampleRecentArticles(ctx, b.providerID, since, sampleSize)
+	if err != nil {
+		b.ReportError(b.Messagef("삭제 점검 작업 실패: 최근 %d일 이내 게시글 추출 중 오류 발생", days), err)
+		return
+	}
+	if len(samples) == 0 {
+		b.logger.Debug(b.Messagef("삭제 점검 종료: 최근 %d일 이내 점검 대상 게시글 없음", days))
+		return
+	}
+
+	// [2단계] 원문 접근 확인
+	// CrawlArticleContentsConcurrently는 개별 게시글의 수집 실패를 외부로 전파하지 않으므로,
+	// 본문 수집 함수를 감싸 게시글별 판정 근거와 본문 수집에 성공한 게시글 수를 별도로 기록합니다.
+	var (
+		mu         sync.Mutex
+		reasons    = make(map[*feed.Article]feed.DeletedReason)
+		accessible int
+	)
+	probe := func(ctx context.Context, article *feed.Article) error {
+		err := b.crawlArticleContent(ctx, article)
+
+		mu.Lock()
+		switch {
+		case errors.Is(err, ErrArticleNotFound):
+			reasons[article] = feed.DeletedReasonRemoved
+		case errors.Is(err, ErrArticleAccessDenied):
+			reasons[article] = feed.DeletedReasonAccessDenied
+		case err == nil && article.Content != "":
+			accessible++
+		}
+		mu.Unlock()
+
+		return err
+	}
+
+	probes := make([]*feed.Article, len(samples))
+	for i, article := range samples {
+		clone := *article
+		clone.Content = ""
+		probes[i] = &clone
+	}
+
+	if err := b.CrawlArticleContentsConcurrently(ctx, probes, deletionCheckConcurrency, probe); err != nil {
+		b.logger.Warnf("%s: %v", b.Messagef("삭제 점검 작업 중단: 원문 접근 중 실행 컨텍스트 취소 또는 타임아웃 발생"), err)
+		return
+	}
+
+	// [3단계] 삭제 감지 기록
+	trustAccessDenied := accessible > 0
+	deletedAt := time.Now()
+	var deletedCount, accessDeniedCount, skippedCount int
+
+	for _, article := range probes {
+		reason, ok := reasons[article]
+		if !ok {
+			continue
+		}
+		if reason == feed.DeletedReasonAccessDenied && !trustAccessDenied {
+			skippedCount++
+			continue
+		}
+
+		if err := repo.MarkArticleDeleted(ctx, b.providerID, article.BoardID, article.ArticleID, deletedAt, reason); err != nil {
+			b.ReportError(b.Messagef("삭제 점검 작업 실패: 게시글(ID: %s)의 삭제 기록 중 오류 발생", article.ArticleID), err)
+			continue
+		}
+
+		if reason == feed.DeletedReasonAccessDenied {
+			accessDeniedCount++
+		} else {
+			deletedCount++
+		}
+	}
+
+	if skippedCount > 0 {
+		b.logger.Warn(b.Messagef("삭제 점검: 본문 수집에 성공한 게시글 없이 %d건의 원문 접근이 거부되어 접근 제한으로 기록하지 않음 (로그인 세션 만료 또는 접근 차단 여부 확인 필요)", skippedCount))
+	}
+
+	b.logger.Debug(b.Messagef("삭제 점검 종료: 점검 게시글 %d건 중 %d건 삭제 감지, %d건 접근 제한 감지", len(samples), deletedCount, accessDeniedCount))
+}
@@ -0,0 +1,202 @@
+package provider_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+	"github.com/darkkaiser/rss-feed-server/internal/service/crawl/provider"
+)
+
+// mockDeletionRepository는 feed.Repository와 feed.DeletionRepository를 함께 만족하는 테스트 전용 객체입니다.
+type mockDeletionRepository struct {
+	mockRepository
+
+	mu sync.Mutex
+
+	samples    []*feed.Article
+	since      time.Time
+	limit      uint
+	deletedIDs []string
+	reasons    map[string]feed.DeletedReason
+}
+
+func (m *mockDeletionRepository) SampleRecentArticles(ctx context.Context, providerID string, since time.Time, limit uint) ([]*feed.Article, error) {
+	m.since = since
+	m.limit = limit
+	return m.samples, nil
+}
+
+func (m *mockDeletionRepository) MarkArticleDeleted(ctx context.Context, providerID, boardID, articleID string, deletedAt time.Time, reason feed.DeletedReason) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.reasons == nil {
+		m.reasons = make(map[string]feed.DeletedReason)
+	}
+	m.deletedIDs = append(m.deletedIDs, articleID)
+	m.reasons[articleID] = reason
+	return nil
+}
+
+func TestCheckDeleted_MarksOnlyNotFoundArticles(t *testing.T) {
+	t.Parallel()
+
+	repo := &mockDeletionRepository{
+		samples: []*feed.Article{
+			{BoardID: "b1", ArticleID: "alive", Content: "살아있는 글", Link: "https://example.com/alive"},
+			{BoardID: "b1", ArticleID: "removed", Content: "삭제된 글", Link: "https://example.com/removed"},
+			{BoardID: "b1", ArticleID: "flaky", Content: "일시 장애", Link: "https://example.com/flaky"},
+			{BoardID: "b1", ArticleID: "restricted", Content: "권한 제한 또는 레이아웃 변경", Link: "https://example.com/restricted"},
+		},
+	}
+	base := newRevalidateTestBase(repo)
+
+	var mu sync.Mutex
+	probedLinks := make(map[string]int)
+	base.SetCrawlArticleContent(func(ctx context.Context, article *feed.Article) error {
+		mu.Lock()
+		probedLinks[article.Link]++
+		mu.Unlock()
+
+		switch article.ArticleID {
+		case "alive":
+			article.Content = "살아있는 글"
+		case "removed":
+			return provider.ErrArticleNotFound
+		case "restricted":
+			return provider.ErrContentUnavailable
+		case "flaky":
+			return errors.New("connection reset by peer")
+		}
+		return nil
+	})
+
+	before := time.Now()
+	base.CheckDeleted(context.Background(), 14, 20)
+
+	assert.Equal(t, []string{"removed"}, repo.deletedIDs, "ErrArticleNotFound를 반환한 게시글만 삭제로 기록되어야 합니다")
+	assert.Equal(t, feed.DeletedReasonRemoved, repo.reasons["removed"])
+	assert.Equal(t, uint(20), repo.limit)
+	assert.WithinDuration(t, before.AddDate(0, 0, -14), repo.since, time.Minute)
+
+	// 저장된 본문이 있더라도 원문 링크에 실제로 접근해야 합니다.
+	assert.Equal(t, 1, probedLinks["https://example.com/alive"])
+	assert.Equal(t, 1, probedLinks["https://example.com/removed"], "영구 오류는 재시도하지 않아야 합니다")
+	assert.Equal(t, 1, probedLinks["https://example.com/restricted"], "영구 오류는 재시도하지 않아야 합니다")
+
+	// 원본 목록의 본문은 변경되지 않아야 합니다. (복사본으로 접근 확인)
+	assert.Equal(t, "삭제된 글", repo.samples[1].Content)
+}
+
+func TestCheckDeleted_RecordsAccessDeniedSeparately(t *testing.T) {
+	t.Parallel()
+
+	repo := &mockDeletionRepository{
+		samples: []*feed.Article{
+			{BoardID: "b1", ArticleID: "alive", Content: "살아있는 글", Link: "https://example.com/alive"},
+			{BoardID: "b1", ArticleID: "removed", Content: "삭제된 글", Link: "https://example.com/removed"},
+			{BoardID: "b1", ArticleID: "members-only", Content: "회원 전용으로 전환된 글", Link: "https://example.com/members-only"},
+		},
+	}
+	base := newRevalidateTestBase(repo)
+	base.SetCrawlArticleContent(func(ctx context.Context, article *feed.Article) error {
+		switch article.ArticleID {
+		case "alive":
+			article.Content = "살아있는 글"
+		case "removed":
+			return provider.ErrArticleNotFound
+		case "members-only":
+			return provider.ErrArticleAccessDenied
+		}
+		return nil
+	})
+
+	base.CheckDeleted(context.Background(), 14, 20)
+
+	assert.ElementsMatch(t, []string{"removed", "members-only"}, repo.deletedIDs)
+	assert.Equal(t, feed.DeletedReasonRemoved, repo.reasons["removed"])
+	assert.Equal(t, feed.DeletedReasonAccessDenied, repo.reasons["members-only"], "접근 거부는 원문 삭제와 구별되는 근거로 기록되어야 합니다")
+}
+
+func TestCheckDeleted_IgnoresAccessDeniedWithoutAnyAccessibleArticle(t *testing.T) {
+	t.Parallel()
+
+	repo := &mockDeletionRepository{
+		samples: []*feed.Article{
+			{BoardID: "b1", ArticleID: "1", Content: "본문 1", Link: "https://example.com/1"},
+			{BoardID: "b1", ArticleID: "2", Content: "본문 2", Link: "https://example.com/2"},
+			{BoardID: "b1", ArticleID: "removed", Content: "삭제된 글", Link: "https://example.com/removed"},
+		},
+	}
+	base := newRevalidateTestBase(repo)
+	base.SetCrawlArticleContent(func(ctx context.Context, article *feed.Article) error {
+		if article.ArticleID == "removed" {
+			return provider.ErrArticleNotFound
+		}
+		return provider.ErrArticleAccessDenied
+	})
+
+	base.CheckDeleted(context.Background(), 14, 20)
+
+	assert.Equal(t, []string{"removed"}, repo.deletedIDs, "본문 수집에 성공한 게시글이 없으면 세션 만료 등으로 보고 접근 제한을 기록하지 않아야 합니다")
+}
+
+func TestCheckDeleted_SkipsWithoutPrerequisites(t *testing.T) {
+	t.Parallel()
+
+	t.Run("본문 수집 함수 미주입", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &mockDeletionRepository{samples: []*feed.Article{{ArticleID: "1", Content: "본문"}}}
+		base := newRevalidateTestBase(repo)
+
+		assert.NotPanics(t, func() { base.CheckDeleted(context.Background(), 14, 20) })
+		assert.True(t, repo.since.IsZero(), "본문 수집 함수가 없으면 저장소를 조회하지 않아야 합니다")
+	})
+
+	t.Run("삭제 기록을 지원하지 않는 저장소", func(t *testing.T) {
+		t.Parallel()
+
+		called := false
+		base := newRevalidateTestBase(&mockRepository{})
+		base.SetCrawlArticleContent(func(ctx context.Context, article *feed.Article) error {
+			called = true
+			return nil
+		})
+
+		assert.NotPanics(t, func() { base.CheckDeleted(context.Background(), 14, 20) })
+		assert.False(t, called)
+	})
+
+	t.Run("점검 기간 또는 표본 크기 0", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &mockDeletionRepository{}
+		base := newRevalidateTestBase(repo)
+		base.SetCrawlArticleContent(func(ctx context.Context, article *feed.Article) error { return nil })
+
+		base.CheckDeleted(context.Background(), 0, 20)
+		base.CheckDeleted(context.Background(), 14, 0)
+		assert.True(t, repo.since.IsZero())
+	})
+}
+
+func TestCheckDeleted_PanicRecovery(t *testing.T) {
+	t.Parallel()
+
+	repo := &mockDeletionRepository{samples: []*feed.Article{nil}}
+	base := newRevalidateTestBase(repo)
+	base.SetCrawlArticleContent(func(ctx context.Context, article *feed.Article) error {
+		return nil
+	})
+
+	require.NotPanics(t, func() { base.CheckDeleted(context.Background(), 14, 20) })
+	assert.Empty(t, repo.deletedIDs)
+}
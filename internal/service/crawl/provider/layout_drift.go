@@ -0,0 +1,236 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+)
+
+const (
+	// layoutBaselineWindow 기준선(Baseline) 계산에 사용하는 최근 측정 기록의 개수이자, 게시판별로 보관하는 최대 기록 개수입니다.
+	layoutBaselineWindow = 10
+
+	// layoutBaselineMinSamples 기준선을 신뢰할 수 있다고 판단하기 위한 최소 측정 기록 개수입니다.
+	// 서비스 도입 직후처럼 기록이 충분하지 않으면 우연한 변동을 레이아웃 변경으로 오판할 수 있으므로 판정을 보류합니다.
+	layoutBaselineMinSamples = 5
+
+	// layoutSnapshotMaxBytes 레이아웃 변경이 의심될 때 저장하는 HTML 스냅샷의 최대 크기입니다.
+	layoutSnapshotMaxBytes = 64 * 1024
+
+	// layoutAlertExcerptBytes 알림 메시지에 첨부하는 HTML 스냅샷 발췌본의 최대 크기입니다.
+	// 전체 스냅샷은 SQLite(rss_board_layout_stats.snapshot)에 보관되며, 알림에는 원인 파악을 위한 앞부분만 포함합니다.
+	layoutAlertExcerptBytes = 2 * 1024
+)
+
+// 기준선 대비 편차 허용 한계입니다. 이 값을 넘어서는 지표가 하나라도 있으면 레이아웃 변경으로 판정합니다.
+const (
+	// layoutRowCountMinRatio 게시글 행 수가 기준선 평균의 이 비율 미만으로 떨어지면 변경으로 판정합니다.
+	layoutRowCountMinRatio = 0.5
+
+	// layoutEmptyFieldMaxIncrease 빈 필드 비율이 기준선 평균보다 이 값 이상 증가하면 변경으로 판정합니다.
+	layoutEmptyFieldMaxIncrease = 0.3
+
+	// layoutDateFailureMaxIncrease 작성일 해석 실패 비율이 기준선 평균보다 이 값 이상 증가하면 변경으로 판정합니다.
+	layoutDateFailureMaxIncrease = 0.2
+
+	// layoutSelectorHitMaxDecrease 셀렉터 적중 비율이 기준선 평균보다 이 값 이상 감소하면 변경으로 판정합니다.
+	layoutSelectorHitMaxDecrease = 0.2
+)
+
+// ObserveLayout 게시글 목록 한 페이지의 모든 행을 파싱해 보고, 레이아웃 변경 감지에 사용할 구조 지표를 측정합니다.
+//
+// 크롤러의 본 수집 루프는 이미 수집한 게시글을 만나는 즉시 순회를 멈추므로, 그 결과만으로는 행 수나 파싱 성공률이
+// 매번 달라져 기준선으로 삼을 수 없습니다. 따라서 이 함수는 커서와 무관하게 페이지의 모든 행을 동일한 파서(extract)로
+// 한 번 더 해석하여, 실행 시점에 상관없이 비교 가능한 지표를 만들어 냅니다.
+//
+// 측정 지표:
+//   - RowCount: 셀렉터로 찾은 게시글 행의 수
+//   - SelectorHitRatio: 파서가 에러 없이 게시글을 추출한 행의 비율
+//   - EmptyFieldRatio: 추출에 성공한 게시글의 주요 필드(게시글 ID, 제목, 작성자, 링크) 중 빈 값의 비율
+//   - DateParseFailureRatio: 작성일 문자열이 지원하지 않는 포맷이어서 추출에 실패한 행의 비율
+//     (작성자 이름이 작성일 셀로 밀려 들어오는 컬럼 이동이 대표적인 원인입니다)
+//
+// 매개변수:
+//   - boardID: 측정 대상 게시판 ID (게시판 구분 없이 전체 목록을 수집하는 크롤러는 빈 문자열을 전달합니다)
+//   - doc: 스냅샷 생성에 사용할 페이지 문서
+//   - rows: 게시글 행 셀렉션
+//   - extract: 행 하나를 게시글로 변환하는 파서. (nil, nil)을 반환한 행(예: 답글 토글 행)은 측정에서 제외됩니다.
+func ObserveLayout(boardID string, doc *goquery.Document, rows *goquery.Selection, extract func(s *goquery.Selection) (*feed.Article, error)) *feed.LayoutStats {
+	var observed, hits, emptyFields, dateFailures int
+
+	rows.Each(func(_ int, s *goquery.Selection) {
+		article, err := extract(s)
+		if err != nil {
+			observed++
+			if errors.Is(err, ErrUnsupportedCreatedAtFormat) {
+				dateFailures++
+			}
+			return
+		}
+		if article == nil {
+			return
+		}
+
+		observed++
+		hits++
+
+		for _, v := range []string{article.ArticleID, article.Title, article.Author, article.Link} {
+			if strings.TrimSpace(v) == "" {
+				emptyFields++
+			}
+		}
+	})
+
+	stats := &feed.LayoutStats{
+		BoardID:    boardID,
+		RowCount:   observed,
+		RecordedAt: time.Now(),
+	}
+	if observed > 0 {
+		stats.SelectorHitRatio = float64(hits) / float64(observed)
+		stats.DateParseFailureRatio = float64(dateFailures) / float64(observed)
+	}
+	if hits > 0 {
+		stats.EmptyFieldRatio = float64(emptyFields) / float64(hits*4)
+	}
+
+	if doc != nil {
+		if html, err := doc.Html(); err == nil {
+			stats.Snapshot = truncateUTF8(html, layoutSnapshotMaxBytes)
+		}
+	}
+
+	return stats
+}
+
+// CheckLayoutDrift 측정된 레이아웃 지표를 최근 기록의 평균(기준선)과 비교하고, 그 결과를 저장소에 기록합니다.
+//
+// 셀렉터가 아무 노드도 찾지 못하는 구조 변경은 각 크롤러가 즉시 감지하지만, 컬럼 순서가 바뀌어 작성자가 작성일 자리에
+// 들어오는 것처럼 셀렉터는 여전히 적중하는 미묘한 변경은 개별 행의 파싱 경고로만 남고 묻히기 쉽습니다.
+// 이 메서드는 그러한 변화를 게시판 단위의 통계 편차로 포착합니다.
+//
+// 처리 흐름:
+//  1. 저장소(feed.LayoutStatsRepository)에서 최근 측정 기록을 조회하여 기준선을 계산합니다.
+//  2. 기준선 대비 편차가 허용 한계를 넘으면 변경(Drifted)으로 판정합니다. 기준선에는 이전에 변경으로 판정된 기록을 포함하지 않습니다.
+//  3. 측정 결과를 기록합니다. HTML 스냅샷은 변경으로 판정된 경우에만 함께 저장합니다.
+//  4. 직전 측정이 정상이었다가 이번에 변경으로 전환된 경우에만 "레이아웃 변경 의심" 알림을 전송하여,
+//     사이트가 고쳐질 때까지 매 크롤링마다 같은 알림이 반복되지 않도록 합니다.
+//
+// 저장소가 레이아웃 지표 기록을 지원하지 않거나 처리 중 오류가 발생하더라도 크롤링 결과에는 영향을 주지 않습니다.
+func (b *Base) CheckLayoutDrift(ctx context.Context, stats *feed.LayoutStats) {
+	if stats == nil {
+		return
+	}
+
+	repo, ok := b.feedRepo.(feed.LayoutStatsRepository)
+	if !ok {
+		return
+	}
+
+	// [1단계] 기준선 계산
+	history, err := repo.GetLayoutStats(ctx, b.providerID, stats.BoardID, layoutBaselineWindow)
+	if err != nil {
+		b.logger.Warnf("%s: %v", b.Messagef("레이아웃 변경 감지 생략: 최근 측정 기록 조회 실패 (게시판: '%s')", stats.BoardID), err)
+		return
+	}
+
+	// [2단계] 편차 판정
+	deviations := layoutDeviations(stats, history)
+	stats.Drifted = len(deviations) > 0
+	if !stats.Drifted {
+		stats.Snapshot = ""
+	}
+
+	// [3단계] 측정 결과 기록
+	if err := repo.SaveLayoutStats(ctx, b.providerID, stats, layoutBaselineWindow); err != nil {
+		b.logger.Warnf("%s: %v", b.Messagef("레이아웃 측정 결과 기록 실패 (게시판: '%s')", stats.BoardID), err)
+	}
+
+	// [4단계] 변경 전환 시점에만 알림
+	if !stats.Drifted || (len(history) > 0 && history[0].Drifted) {
+		return
+	}
+
+	var sb strings.Builder
+	sb.WriteString(b.Messagef("'%s' 게시판에서 레이아웃 변경(Layout Drift)이 의심됩니다. 셀렉터는 여전히 적중하지만 파싱 결과의 통계가 최근 기준선에서 크게 벗어났습니다. 파싱 규칙의 점검이 요구됩니다.", stats.BoardID))
+	sb.WriteString("\r\n")
+	for _, d := range deviations {
+		sb.WriteString("\r\n- ")
+		sb.WriteString(d)
+	}
+	if stats.Snapshot != "" {
+		sb.WriteString("\r\n\r\n[HTML 스냅샷 발췌] (전체 스냅샷은 rss_board_layout_stats 테이블에 보관됩니다)\r\n")
+		sb.WriteString(truncateUTF8(stats.Snapshot, layoutAlertExcerptBytes))
+	}
+
+	b.ReportError(sb.String(), nil)
+}
+
+// layoutDeviations 측정 지표를 기준선과 비교하여, 허용 한계를 벗어난 항목의 설명 목록을 반환합니다.
+// 기준선으로 사용할 정상 기록이 layoutBaselineMinSamples개 미만이면 판정을 보류하고 nil을 반환합니다.
+func layoutDeviations(stats *feed.LayoutStats, history []*feed.LayoutStats) []string {
+	var n int
+	var rowCount, emptyField, dateFailure, selectorHit float64
+	for _, h := range history {
+		if h.Drifted {
+			continue
+		}
+		n++
+		rowCount += float64(h.RowCount)
+		emptyField += h.EmptyFieldRatio
+		dateFailure += h.DateParseFailureRatio
+		selectorHit += h.SelectorHitRatio
+	}
+	if n < layoutBaselineMinSamples {
+		return nil
+	}
+
+	rowCount /= float64(n)
+	emptyField /= float64(n)
+	dateFailure /= float64(n)
+	selectorHit /= float64(n)
+
+	var deviations []string
+	if float64(stats.RowCount) < rowCount*layoutRowCountMinRatio {
+		deviations = append(deviations, fmt.Sprintf("게시글 행 수: %d (기준선 평균 %.1f)", stats.RowCount, rowCount))
+	}
+	if stats.EmptyFieldRatio-emptyField >= layoutEmptyFieldMaxIncrease {
+		deviations = append(deviations, fmt.Sprintf("빈 필드 비율: %s (기준선 평균 %s)", percent(stats.EmptyFieldRatio), percent(emptyField)))
+	}
+	if stats.DateParseFailureRatio-dateFailure >= layoutDateFailureMaxIncrease {
+		deviations = append(deviations, fmt.Sprintf("작성일 해석 실패 비율: %s (기준선 평균 %s)", percent(stats.DateParseFailureRatio), percent(dateFailure)))
+	}
+	if selectorHit-stats.SelectorHitRatio >= layoutSelectorHitMaxDecrease {
+		deviations = append(deviations, fmt.Sprintf("셀렉터 적중 비율: %s (기준선 평균 %s)", percent(stats.SelectorHitRatio), percent(selectorHit)))
+	}
+
+	return deviations
+}
+
+// percent 0~1 범위의 비율을 "12.3%" 형태의 문자열로 변환합니다.
+func percent(ratio float64) string {
+	return fmt.Sprintf("%.1f%%", math.Round(ratio*1000)/10)
+}
+
+// truncateUTF8 문자열을 최대 maxBytes 바이트로 자릅니다. 멀티바이트 문자(한글 등)가 중간에 잘리지 않도록 문자 경계에서 자릅니다.
+func truncateUTF8(s string, maxBytes int) string {
+	if len(s) <= maxBytes {
+		return s
+	}
+
+	cut := 0
+	for i := range s {
+		if i > maxBytes {
+			break
+		}
+		cut = i
+	}
+
+	return s[:cut]
+}
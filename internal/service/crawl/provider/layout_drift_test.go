@@ -0,0 +1,232 @@
+package provider_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/darkkaiser/notify-server/pkg/notify"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/darkkaiser/rss-feed-server/internal/config"
+	apperrors "github.com/darkkaiser/rss-feed-server/internal/errors"
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+	"github.com/darkkaiser/rss-feed-server/internal/service/crawl/provider"
+)
+
+// mockLayoutStatsRepository는 feed.Repository와 feed.LayoutStatsRepository를 함께 만족하는 테스트 전용 객체입니다.
+type mockLayoutStatsRepository struct {
+	mockRepository
+
+	history []*feed.LayoutStats
+	saved   []*feed.LayoutStats
+	keep    int
+}
+
+func (m *mockLayoutStatsRepository) GetLayoutStats(ctx context.Context, providerID, boardID string, limit int) ([]*feed.LayoutStats, error) {
+	return m.history, nil
+}
+
+func (m *mockLayoutStatsRepository) SaveLayoutStats(ctx context.Context, providerID string, stats *feed.LayoutStats, keep int) error {
+	m.saved = append(m.saved, stats)
+	m.keep = keep
+	return nil
+}
+
+// newHealthyLayoutHistory는 정상 상태의 측정 기록 n개를 생성합니다.
+func newHealthyLayoutHistory(n int) []*feed.LayoutStats {
+	history := make([]*feed.LayoutStats, 0, n)
+	for i := 0; i < n; i++ {
+		history = append(history, &feed.LayoutStats{BoardID: "b1", RowCount: 10, SelectorHitRatio: 1})
+	}
+	return history
+}
+
+// newLayoutDriftTestBase는 알림 요청 본문을 수집하는 가짜 알림 서버와 연결된 Base를 생성합니다.
+func newLayoutDriftTestBase(t *testing.T, repo feed.Repository) (*provider.Base, <-chan string) {
+	t.Helper()
+
+	notified := make(chan string, 4)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		notified <- string(body)
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(ts.Close)
+
+	notifyClient, err := notify.NewClient(&notify.Config{URL: ts.URL, AppKey: "test", ApplicationID: "test"})
+	require.NoError(t, err)
+
+	return provider.NewBase(provider.NewCrawlerParams{
+		ProviderID:   "test-provider",
+		Config:       &config.ProviderDetailConfig{ID: "test", Name: "테스트사이트"},
+		Fetcher:      &dummyFetcher{},
+		FeedRepo:     repo,
+		NotifyClient: notifyClient,
+	}, 1), notified
+}
+
+func TestObserveLayout(t *testing.T) {
+	t.Parallel()
+
+	html := `<html><body><table>
+		<tr class="row"><td class="id">1</td><td class="title">첫 글</td><td class="author">홍길동</td><td class="date">2024-03-15</td></tr>
+		<tr class="row"><td class="id">2</td><td class="title">둘째 글</td><td class="author"></td><td class="date">2024-03-16</td></tr>
+		<tr class="row"><td class="id">3</td><td class="title">셋째 글</td><td class="author">2024-03-17</td><td class="date">홍길동</td></tr>
+		<tr class="row reply"></tr>
+	</table></body></html>`
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+	require.NoError(t, err)
+
+	extract := func(s *goquery.Selection) (*feed.Article, error) {
+		if s.HasClass("reply") {
+			return nil, nil
+		}
+
+		createdAt, err := provider.ParseCreatedAt(strings.TrimSpace(s.Find("td.date").Text()))
+		if err != nil {
+			return nil, err
+		}
+
+		return &feed.Article{
+			ArticleID: s.Find("td.id").Text(),
+			Title:     s.Find("td.title").Text(),
+			Author:    s.Find("td.author").Text(),
+			Link:      "https://example.com/" + s.Find("td.id").Text(),
+			CreatedAt: createdAt,
+		}, nil
+	}
+
+	stats := provider.ObserveLayout("b1", doc, doc.Find("tr"), extract)
+
+	assert.Equal(t, "b1", stats.BoardID)
+	assert.Equal(t, 3, stats.RowCount, "파서가 (nil, nil)을 반환한 행은 측정에서 제외되어야 합니다")
+	assert.InDelta(t, 2.0/3.0, stats.SelectorHitRatio, 1e-9)
+	assert.InDelta(t, 1.0/3.0, stats.DateParseFailureRatio, 1e-9, "작성일 자리에 작성자가 들어온 행은 작성일 해석 실패로 집계되어야 합니다")
+	assert.InDelta(t, 1.0/8.0, stats.EmptyFieldRatio, 1e-9)
+	assert.Contains(t, stats.Snapshot, "첫 글")
+	assert.False(t, stats.RecordedAt.IsZero())
+}
+
+func TestObserveLayout_OnlyUnsupportedDateFormatCountsAsDateFailure(t *testing.T) {
+	t.Parallel()
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(`<ul><li>a</li><li>b</li></ul>`))
+	require.NoError(t, err)
+
+	stats := provider.ObserveLayout("b1", doc, doc.Find("li"), func(s *goquery.Selection) (*feed.Article, error) {
+		return nil, apperrors.New(apperrors.ParsingFailed, "제목 마크업을 식별할 수 없습니다")
+	})
+
+	assert.Equal(t, 2, stats.RowCount)
+	assert.Zero(t, stats.SelectorHitRatio)
+	assert.Zero(t, stats.DateParseFailureRatio)
+}
+
+func TestCheckLayoutDrift(t *testing.T) {
+	t.Parallel()
+
+	columnShift := func() *feed.LayoutStats {
+		return &feed.LayoutStats{BoardID: "b1", RowCount: 10, SelectorHitRatio: 0.1, DateParseFailureRatio: 0.9, Snapshot: "<table>변경된 레이아웃</table>"}
+	}
+
+	t.Run("기준선 대비 편차가 없으면 정상으로 기록하고 스냅샷은 저장하지 않는다", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &mockLayoutStatsRepository{history: newHealthyLayoutHistory(10)}
+		base, notified := newLayoutDriftTestBase(t, repo)
+
+		base.CheckLayoutDrift(context.Background(), &feed.LayoutStats{BoardID: "b1", RowCount: 9, SelectorHitRatio: 1, Snapshot: "<html/>"})
+
+		require.Len(t, repo.saved, 1)
+		assert.False(t, repo.saved[0].Drifted)
+		assert.Empty(t, repo.saved[0].Snapshot)
+		assert.Equal(t, 10, repo.keep)
+
+		select {
+		case <-notified:
+			t.Fatal("정상 측정에서는 알림이 전송되지 않아야 합니다")
+		case <-time.After(100 * time.Millisecond):
+		}
+	})
+
+	t.Run("컬럼 이동으로 작성일 해석이 실패하면 레이아웃 변경 알림을 스냅샷과 함께 전송한다", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &mockLayoutStatsRepository{history: newHealthyLayoutHistory(10)}
+		base, notified := newLayoutDriftTestBase(t, repo)
+
+		base.CheckLayoutDrift(context.Background(), columnShift())
+
+		require.Len(t, repo.saved, 1)
+		assert.True(t, repo.saved[0].Drifted)
+		assert.Equal(t, "<table>변경된 레이아웃</table>", repo.saved[0].Snapshot)
+
+		select {
+		case body := <-notified:
+			assert.Contains(t, body, "Layout Drift")
+			assert.Contains(t, body, "작성일 해석 실패 비율")
+			assert.Contains(t, body, "셀렉터 적중 비율")
+			assert.Contains(t, body, "변경된 레이아웃")
+		case <-time.After(2 * time.Second):
+			t.Fatal("레이아웃 변경 알림이 전송되지 않았습니다")
+		}
+	})
+
+	t.Run("직전 측정이 이미 변경 상태이면 알림을 반복하지 않는다", func(t *testing.T) {
+		t.Parallel()
+
+		history := append([]*feed.LayoutStats{columnShift()}, newHealthyLayoutHistory(9)...)
+		history[0].Drifted = true
+		repo := &mockLayoutStatsRepository{history: history}
+		base, notified := newLayoutDriftTestBase(t, repo)
+
+		base.CheckLayoutDrift(context.Background(), columnShift())
+
+		require.Len(t, repo.saved, 1)
+		assert.True(t, repo.saved[0].Drifted, "변경으로 판정된 기록은 기준선에서 제외되므로 계속 변경 상태로 기록되어야 합니다")
+
+		select {
+		case <-notified:
+			t.Fatal("변경 상태가 지속되는 동안에는 알림이 반복되지 않아야 합니다")
+		case <-time.After(100 * time.Millisecond):
+		}
+	})
+
+	t.Run("기준선 기록이 부족하면 판정을 보류한다", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &mockLayoutStatsRepository{history: newHealthyLayoutHistory(4)}
+		base, _ := newLayoutDriftTestBase(t, repo)
+
+		base.CheckLayoutDrift(context.Background(), columnShift())
+
+		require.Len(t, repo.saved, 1)
+		assert.False(t, repo.saved[0].Drifted)
+	})
+
+	t.Run("행 수가 기준선의 절반 미만으로 줄어들면 변경으로 판정한다", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &mockLayoutStatsRepository{history: newHealthyLayoutHistory(5)}
+		base, _ := newLayoutDriftTestBase(t, repo)
+
+		base.CheckLayoutDrift(context.Background(), &feed.LayoutStats{BoardID: "b1", RowCount: 4, SelectorHitRatio: 1})
+
+		require.Len(t, repo.saved, 1)
+		assert.True(t, repo.saved[0].Drifted)
+	})
+
+	t.Run("저장소가 레이아웃 지표 기록을 지원하지 않으면 아무 작업도 하지 않는다", func(t *testing.T) {
+		t.Parallel()
+
+		base := newRevalidateTestBase(&mockRepository{})
+		assert.NotPanics(t, func() { base.CheckLayoutDrift(context.Background(), columnShift()) })
+	})
+}
@@ -11,15 +11,28 @@ import (
 	"github.com/darkkaiser/rss-feed-server/internal/config"
 	apperrors "github.com/darkkaiser/rss-feed-server/internal/errors"
 	"github.com/darkkaiser/rss-feed-server/internal/feed"
+	"github.com/darkkaiser/rss-feed-server/internal/service/crawl/fetcher"
 	"github.com/darkkaiser/rss-feed-server/internal/service/crawl/provider"
+	"github.com/darkkaiser/rss-feed-server/internal/tracing"
 )
 
 // component 크롤링 서비스의 네이버 카페 Provider 로깅용 컴포넌트 이름
 const component = "crawl.provider.navercafe"
 
+// sessionExpiredURLMarker 로그인 세션이 만료되었을 때 리다이렉트되는 네이버 로그인 페이지 주소입니다.
+const sessionExpiredURLMarker = "nid.naver.com/nidlogin.login"
+
+// articleRowSelector 전체글보기 목록에서 공지사항(board-notice)을 제외한 일반 게시글 행(tr)을 선택하는 CSS 셀렉터입니다.
+const articleRowSelector = "div.article-board > table > tbody > tr:not(.board-notice)"
+
 func init() {
 	provider.MustRegister(config.ProviderSiteNaverCafe, &provider.CrawlerConfig{
 		NewCrawler: newCrawler,
+
+		// 로그인 쿠키가 만료되면 회원 전용 페이지 요청이 네이버 로그인 페이지로 리다이렉트됩니다.
+		SessionMarkers: fetcher.SessionMarkers{
+			URL: []string{sessionExpiredURLMarker},
+		},
 	})
 }
 
@@ -38,6 +51,8 @@ func newCrawler(params provider.NewCrawlerParams) (provider.Crawler, error) {
 	}
 
 	c.SetCrawlArticles(c.crawlArticles)
+	c.SetCrawlArticleContent(c.crawlArticleContent)
+	c.SetRevalidateArticleContent(c.revalidateArticleContent)
 
 	c.Logger().WithFields(applog.Fields{
 		"component":     component,
@@ -71,7 +86,10 @@ type crawler struct {
 }
 
 // 컴파일 타임에 인터페이스 구현 여부를 검증합니다.
-var _ provider.Crawler = (*crawler)(nil)
+var (
+	_ provider.Crawler               = (*crawler)(nil)
+	_ provider.ParseSnapshotReplayer = (*crawler)(nil)
+)
 
 // crawlArticles 네이버 카페의 게시글 목록과 본문을 수집합니다.
 //
@@ -150,7 +168,10 @@ func (c *crawler) crawlArticles(ctx context.Context) ([]*feed.Article, map[strin
 		// 카페 전체의 최신 게시글을 50개 단위로 반환하는 '전체글보기' 접속용 최종 웹사이트 주소를 만듭니다.
 		pageURL := fmt.Sprintf("%s/ArticleList.nhn?search.clubid=%s&userDisplay=50&search.boardtype=L&search.totalCount=501&search.page=%d", c.Config().URL, c.clubID, page)
 
-		doc, err := c.Scraper().FetchHTMLDocument(ctx, pageURL, nil)
+		// 페이지 요청(재시도 포함)을 실행 Span 아래의 페이지 Span으로 기록합니다.
+		pageCtx, pageSpan := c.StartPageSpan(ctx, "", page)
+		doc, err := c.Scraper().FetchHTMLDocument(pageCtx, pageURL, nil)
+		tracing.EndSpan(pageSpan, err)
 		if err != nil {
 			// [전체 롤백 정책] 에러 발생 시, 이전 페이지들에서 성공적으로 모아둔 데이터도 미련 없이 버리고 즉시 중단합니다.
 			// 1. 에러를 무시하고 커서를 전진시키면: 해당 페이지의 게시물들이 영구적으로 수집 누락됩니다.
@@ -166,7 +187,7 @@ func (c *crawler) crawlArticles(ctx context.Context) ([]*feed.Article, map[strin
 		// ----------------------------------------
 
 		// 공지사항(board-notice)을 제외한 일반 게시글 행(tr)만 선택합니다.
-		articleRows := doc.Find("div.article-board > table > tbody > tr:not(.board-notice)")
+		articleRows := doc.Find(articleRowSelector)
 
 		// 게시글이 하나도 없을 때: 마지막 페이지 도달 / 빈 게시판 / CSS 셀렉터 오류 중 하나를 판별합니다.
 		if len(articleRows.Nodes) == 0 {
@@ -191,6 +212,12 @@ func (c *crawler) crawlArticles(ctx context.Context) ([]*feed.Article, map[strin
 			return nil, nil, msg, errExtract
 		}
 
+		// [레이아웃 변경 감지]
+		// 네이버 카페는 게시판 구분 없이 전체글 목록 하나를 수집하므로, 게시판 ID 없이(빈 문자열) 목록 단위로 측정합니다.
+		if page == 1 {
+			c.CheckLayoutDrift(ctx, provider.ObserveLayout("", doc, articleRows, c.extractArticle))
+		}
+
 		// ----------------------------------------
 		// 3-4단계: 게시글 행 순회 (중복 판별 & 커서 갱신)
 		// ----------------------------------------
@@ -206,6 +233,9 @@ func (c *crawler) crawlArticles(ctx context.Context) ([]*feed.Article, map[strin
 		// 외부 루프 하단에서 이 상태값을 확인하여 불필요한 다음 페이지 호출을 완전히 종료(break)하기 위함입니다.
 		var reachedLastCursor = false
 
+		// 파싱 실패 스냅샷은 페이지당 한 건만 기록합니다. (같은 페이지의 여러 행이 실패해도 원본 HTML은 동일합니다)
+		var snapshotSaved = false
+
 		// 수집된 웹페이지의 게시글 행(Row)을 위에서 아래로 순서대로 순회합니다.
 		// 중간에 중단 조건(예: 예전 글 발견)이 발생하면 false를 반환하여 행 순회를 즉시 중단할 수 있습니다.
 		articleRows.EachWithBreak(func(i int, s *goquery.Selection) bool {
@@ -228,6 +258,11 @@ func (c *crawler) crawlArticles(ctx context.Context) ([]*feed.Article, map[strin
 					"error":     err.Error(),
 				}).Warn(c.Messagef("개별 게시글 처리 스킵: 데이터 추출 실패"))
 
+				if !snapshotSaved {
+					snapshotSaved = true
+					c.SaveParseSnapshot(ctx, provider.NewHTMLParseSnapshot("", page, pageURL, nil, doc, fmt.Errorf("%d번째 행: %w", i+1, err)))
+				}
+
 				return true
 			}
 			// [답글(Reply) 제외 처리]
@@ -284,7 +319,7 @@ func (c *crawler) crawlArticles(ctx context.Context) ([]*feed.Article, map[strin
 			// 이미 수집이 완료된 날짜 구간이므로 탐색을 즉시 중단합니다.
 			//
 			// [왜 시각(Time)이 아닌 날짜(Date) 단위로만 비교하는가?]
-			// provider.ParseCreatedAt은 파싱 포맷에 따라 CreatedAt이 다르게 생성됩니다.
+			// ParseCreatedAt은 파싱 포맷에 따라 CreatedAt이 다르게 생성됩니다.
 			//   - 당일 게시글 (예: "14:30"): 정확한 시각(HH:MM:SS)으로 파싱됩니다.
 			//   - 과거 날짜 게시글 (예: "2024-03-15"): 시각 정보가 없어 항상 00:00:00으로 고정됩니다.
 			// 따라서 두 값을 time.Time으로 직접 비교하면, 같은 날에 등록된 글이라도 시각 차이로 인해
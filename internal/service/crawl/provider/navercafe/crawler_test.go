@@ -15,6 +15,7 @@ import (
 	"github.com/darkkaiser/rss-feed-server/internal/service/crawl/provider"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 // ─────────────────────────────────────────────────────────────────────────────
@@ -254,10 +255,10 @@ func TestCrawlArticles_DelayCutoff(t *testing.T) {
 
 	r.On("GetCrawlingCursor", mock.Anything, "navercafe-test", "").Return("12", time.Time{}, nil)
 
-	// 현재 시간 기준 딜레이 통과/미통과 항목 작성
+	// 현재 시간 기준 딜레이 통과/미통과 항목 작성 (사이트는 한국 표준시로 표시합니다)
 	// c.crawlingDelayMinutes = 40 이므로 10분 전에 쓰인 새 글은 딜레이 미통과로 Skip!
-	recentTimeStr := time.Now().Add(-10 * time.Minute).Format("15:04")
-	pastTimeStr := time.Now().Add(-50 * time.Minute).Format("15:04")
+	recentTimeStr := time.Now().In(provider.DefaultDateLocation).Add(-10 * time.Minute).Format("15:04")
+	pastTimeStr := time.Now().In(provider.DefaultDateLocation).Add(-50 * time.Minute).Format("15:04")
 
 	htmlList := `<html><body><div class="article-board"><table><tbody>
 		<tr>
@@ -302,3 +303,43 @@ func TestCrawlArticles_DelayCutoff(t *testing.T) {
 }
 
 
+
+// ─────────────────────────────────────────────────────────────────────────────
+// TestCrawlArticles_ReplayFixtures
+// ─────────────────────────────────────────────────────────────────────────────
+
+// TestCrawlArticles_ReplayFixtures testdata/fixtures에 녹화된 게시글 목록 페이지와 본문 API 응답을 ReplayFetcher로 재생하여,
+// 네트워크 없이 목록 파싱, API 본문 수집, 작성 시각 보정까지 전체 수집 흐름이 동작하는지 검증합니다.
+func TestCrawlArticles_ReplayFixtures(t *testing.T) {
+	f, err := fetcher.NewReplayFetcher("testdata/fixtures")
+	require.NoError(t, err)
+	require.Equal(t, 3, f.Len())
+
+	r := new(mockFeedRepo)
+	b := &config.BoardConfig{ID: "100", Name: "자유게시판"}
+	c := setupTestCrawler(t, f, r, []*config.BoardConfig{b})
+
+	// 마지막 커서: 90400 → 90412, 90418만 신규 수집
+	r.On("GetCrawlingCursor", mock.Anything, "navercafe-test", "").Return("90400", time.Time{}, nil)
+
+	articles, cursors, msg, err := c.crawlArticles(context.Background())
+
+	require.NoError(t, err)
+	assert.Empty(t, msg)
+	assert.Equal(t, "90418", cursors[provider.EmptyBoardID])
+
+	require.Len(t, articles, 2)
+	assert.Equal(t, "90412", articles[0].ArticleID)
+	assert.Equal(t, "100", articles[0].BoardID)
+	assert.Equal(t, "이번 주 정기 모임 공지", articles[0].Title)
+	assert.Equal(t, "바다사랑", articles[0].Author)
+	assert.Equal(t, "https://cafe.naver.com/testcafe/ArticleRead.nhn?articleid=90412&clubid=12345678", articles[0].Link)
+	assert.Equal(t, "이번 주 토요일 오전 10시 돌산공원에서 정기 모임이 있습니다.\r\n<img src=\"https://cafeptthumb-phinf.pstatic.net/meeting.jpg\" alt=\"모임 장소\" style=\"\">", articles[0].Content)
+	// 목록의 날짜만 있던 작성일이 API의 writeDate로 보정되어야 합니다.
+	assert.True(t, time.UnixMilli(1760580000000).Equal(articles[0].CreatedAt))
+
+	assert.Equal(t, "90418", articles[1].ArticleID)
+	assert.Equal(t, "분실물 보관 안내", articles[1].Title)
+	assert.Equal(t, "분실물 보관 중입니다. 찾아가실 분은 댓글 남겨 주세요.", articles[1].Content)
+	r.AssertExpectations(t)
+}
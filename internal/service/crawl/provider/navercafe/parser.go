@@ -2,6 +2,7 @@ package navercafe
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"html"
 	"net/url"
@@ -109,7 +110,7 @@ func (c *crawler) extractArticle(s *goquery.Selection) (*feed.Article, error) {
 	//   <td class="td_date">14:30</td>        ← 오늘 등록된 글: 시각(HH:MM)만 표시
 	//   <td class="td_date">2024.03.15.</td>  ← 과거 날짜 글: 연월일만 표시 (끝에 점 포함)
 	//
-	// provider.ParseCreatedAt은 두 형식을 모두 인식합니다.
+	// c.ParseCreatedAt(공급자 설정이 반영된 DateParser)은 두 형식을 모두 인식합니다.
 	// 단, 과거 날짜 글은 시각 정보가 없으므로 CreatedAt의 시각 부분이 00:00:00으로 고정됩니다.
 	// 이 경우 이후의 crawlContentViaAPI 단계에서 API 응답의 writeDate로 시각을 보정합니다.
 	//
@@ -121,7 +122,7 @@ func (c *crawler) extractArticle(s *goquery.Selection) (*feed.Article, error) {
 		return nil, apperrors.New(apperrors.ParsingFailed, "게시글 HTML 요소에서 작성일 마크업을 식별할 수 없어 데이터 파싱에 실패했습니다")
 	}
 
-	createdAt, err := provider.ParseCreatedAt(strings.TrimSpace(dateNode.Text()))
+	createdAt, err := c.ParseCreatedAt(strings.TrimSpace(dateNode.Text()))
 	if err != nil {
 		return nil, err
 	}
@@ -275,18 +276,35 @@ func (c *crawler) extractArticle(s *goquery.Selection) (*feed.Article, error) {
 	return article, nil
 }
 
-// crawlArticleContent 주어진 게시글(article)의 본문(Content)을 parsers 목록에 정의된 파서를 순차 시도하여 채웁니다.
+// crawlArticleContent 주어진 게시글(article)의 본문(Content)을 API → 상세 페이지 → 검색 결과 요약 순서로 시도하여 채웁니다.
+// 각 단계의 시도 및 오류 처리 규칙은 crawlContentWithFallback을 참고하세요.
+func (c *crawler) crawlArticleContent(ctx context.Context, article *feed.Article) error {
+	return c.crawlContentWithFallback(ctx, article, c.crawlContentViaAPI, c.crawlContentViaPage, c.crawlContentViaSearch)
+}
+
+// revalidateArticleContent 재검증(Revalidate) 전용 본문 수집 함수로, API → 상세 페이지 순서로만 시도합니다.
+//
+// 검색 결과 요약(crawlContentViaSearch)은 본문 일부와 줄임표만 담고 있어, 저장된 전체 본문과 비교하면 항상 달라 보입니다.
+// 이를 그대로 사용하면 수정되지 않은 게시글에 거짓 수정 이력이 기록되므로, 원문 전체를 가져올 수 있는 경로만 사용하고
+// 둘 다 실패하면 본문을 비워 두어 재검증이 판단을 유보하도록 합니다.
+func (c *crawler) revalidateArticleContent(ctx context.Context, article *feed.Article) error {
+	return c.crawlContentWithFallback(ctx, article, c.crawlContentViaAPI, c.crawlContentViaPage)
+}
+
+// crawlContentWithFallback 주어진 게시글(article)의 본문(Content)을 parsers에 지정된 파서를 순차 시도하여 채웁니다.
 //
 // [본문 수집 전략 — 단계별 Fallback]
 // 네이버 카페는 로그인 여부·카페 공개 설정에 따라 콘텐츠 접근 방식이 달라집니다.
-// 따라서 parsers 목록의 순서대로 파서를 시도하며, 앞 단계가 본문을 채우는 데 성공하면 이후 단계는 건너뜁니다.
-// 현재 등록된 파서 및 순서는 함수 본문의 parsers 슬라이스 선언부를 참고하세요.
+// 따라서 parsers의 순서대로 파서를 시도하며, 앞 단계가 본문을 채우는 데 성공하면 이후 단계는 건너뜁니다.
 //
 // [오류 보존 정책]
 // 각 단계가 실패하더라도 즉시 반환하지 않고 마지막 오류(lastErr)를 보존합니다.
 // 이후 단계가 본문을 성공적으로 채운 경우에만 lastErr를 nil로 초기화하여,
 // 일시적 네트워크 오류로 인한 재시도 기회가 영구 손실되는 것을 방지합니다.
 //
+// [원문 삭제 즉시 전파]
+// 어느 단계든 provider.ErrArticleNotFound를 반환하면 원문이 삭제된 것이 확실하므로 이후 단계를 실행하지 않고 즉시 반환합니다.
+//
 // [컨텍스트 취소 즉시 전파]
 // ctx가 취소된 경우에는 이후 단계를 실행하지 않고 즉시 ctx.Err()를 반환합니다.
 // 이를 전파하지 않으면 이후 단계가 에러 없이 반환될 때 lastErr가 nil이 되어
@@ -301,21 +319,17 @@ func (c *crawler) extractArticle(s *goquery.Selection) (*feed.Article, error) {
 // 매개변수:
 //   - ctx: 요청 타임아웃이나 시스템 종료 시그널에 의해 작업을 취소할 수 있는 컨텍스트
 //   - article: 본문을 채워 넣을 대상 게시글 포인터 (Content 필드가 직접 수정됩니다)
+//   - parsers: 우선순위 순서대로 시도할 본문 수집 함수 목록
 //
 // 반환값:
 //   - nil: 파서 중 하나가 성공적으로 본문을 채운 경우
+//   - provider.ErrArticleNotFound: 원문 게시글이 삭제된 것으로 확인된 경우 (재시도 스킵)
+//   - provider.ErrArticleAccessDenied: 원문 접근이 거부되었고 이후 단계에서도 본문을 채우지 못한 경우 (재시도 스킵)
 //   - provider.ErrContentUnavailable: 어떠한 시스템 오류도 없었으나 본문이 없는 경우 (재시도 스킵)
 //   - error: 일시적 네트워크 오류 등으로 인해 재시도가 필요한 경우
-func (c *crawler) crawlArticleContent(ctx context.Context, article *feed.Article) error {
+func (c *crawler) crawlContentWithFallback(ctx context.Context, article *feed.Article, parsers ...func(context.Context, *feed.Article) error) error {
 	var lastErr error
 
-	// 파서(본문 수집 전략) 목록을 우선순위 순서대로 정의합니다.
-	parsers := []func(context.Context, *feed.Article) error{
-		c.crawlContentViaAPI,
-		c.crawlContentViaPage,
-		c.crawlContentViaSearch,
-	}
-
 	for _, parser := range parsers {
 		// 이전 단계에서 이미 본문이 채워졌다면 이후 파서를 실행할 필요가 없으므로 루프를 종료합니다.
 		if article.Content != "" {
@@ -330,6 +344,12 @@ func (c *crawler) crawlArticleContent(ctx context.Context, article *feed.Article
 				return ctx.Err()
 			}
 
+			// 원문 삭제가 확인된 경우에는 이후 단계(특히 검색 결과 요약)로 본문을 채우면 삭제된 게시글이 살아있는 것처럼
+			// 보이게 되므로, 남은 파서를 실행하지 않고 즉시 전파합니다.
+			if errors.Is(err, provider.ErrArticleNotFound) {
+				return err
+			}
+
 			lastErr = err
 		} else if article.Content != "" {
 			// 에러 없이 본문이 성공적으로 채워진 경우, 이전 단계에서 저장한 에러를 초기화하고 탈출합니다.
@@ -362,11 +382,14 @@ func (c *crawler) crawlArticleContent(ctx context.Context, article *feed.Article
 // crawlContentViaAPI 네이버 카페 공식 gw/v4 API를 직접 호출하여 게시글 본문(Content)을 article에 채웁니다.
 //
 // 인증 토큰 없이 API를 직접 호출합니다. 비공개·회원 전용 게시글에는 401이 반환됩니다.
+// 단, 공급자에 로그인 세션(session)을 설정한 경우에는 Fetcher가 로그인 쿠키를 함께 보내므로 회원 전용 게시글도 응답을 받을 수 있습니다.
 //
 // [오류 처리 정책 — API 요청 실패]
+//   - apperrors.NotFound:
+//     원문 게시글이 삭제된 경우입니다. provider.ErrArticleNotFound를 반환합니다.
 //   - apperrors.Forbidden 또는 apperrors.Unauthorized:
 //     로그인이 없어 접근 자체가 거부된 게시글입니다. 재시도해도 결과는 동일하므로
-//     ErrContentUnavailable을 반환하여 상위 루프가 조용히 건너뛰도록 합니다.
+//     provider.ErrArticleAccessDenied(ErrContentUnavailable을 감쌈)를 반환하여 상위 루프가 조용히 건너뛰도록 합니다.
 //   - 그 외 오류(네트워크 에러, 타임아웃 등):
 //     일시적 장애일 수 있으므로 경고 로그(Warn)를 남긴 뒤 오류를 전파합니다.
 //
@@ -387,7 +410,8 @@ func (c *crawler) crawlArticleContent(ctx context.Context, article *feed.Article
 //
 // 반환값:
 //   - nil: 성공적으로 본문을 채운 경우
-//   - provider.ErrContentUnavailable: 로그인 없이 접근할 수 없는 게시글인 경우 (재시도 스킵)
+//   - provider.ErrArticleNotFound: 원문 게시글이 삭제된 경우 (재시도 스킵)
+//   - provider.ErrArticleAccessDenied: 로그인 없이 접근할 수 없는 게시글인 경우 (재시도 스킵)
 //   - error: 네트워크 오류 등 일시적 장애가 발생한 경우 (경고 로그 후 오류 전파)
 func (c *crawler) crawlContentViaAPI(ctx context.Context, article *feed.Article) error {
 	// -------------------------------------------------------------------------
@@ -404,8 +428,11 @@ func (c *crawler) crawlContentViaAPI(ctx context.Context, article *feed.Article)
 
 	var apiResp articleAPIResponse
 	if err := c.Scraper().FetchJSON(ctx, "GET", apiURL, nil, nil, &apiResp); err != nil {
+		if apperrors.Is(err, apperrors.NotFound) {
+			return provider.ErrArticleNotFound
+		}
 		if apperrors.Is(err, apperrors.Forbidden) || apperrors.Is(err, apperrors.Unauthorized) {
-			return provider.ErrContentUnavailable
+			return provider.ErrArticleAccessDenied
 		}
 
 		c.Logger().WithFields(applog.Fields{
@@ -497,13 +524,15 @@ func (c *crawler) crawlContentViaAPI(ctx context.Context, article *feed.Article)
 // 상세 페이지의 "#tbody" 요소에서 전체 텍스트를 NormalizeMultiline으로 정규화하여 수집합니다.
 // 이후 동일 영역 내 <img> 태그를 순회하여 이미지를 본문 하단에 추가합니다.
 //
-// [로그인 필요 페이지 감지]
-// "#tbody" 요소가 존재하지 않으면 로그인 없이는 접근할 수 없는 페이지로 간주합니다.
-// 이 경우 로그를 남기지 않고 provider.ErrContentUnavailable을 조용히 반환합니다.
+// [삭제 안내 페이지 및 로그인 필요 페이지 감지]
+// 네이버 카페는 삭제된 게시글에 접근하면 HTTP 200 응답과 함께 '삭제되었거나 없는 게시글입니다'와 같은 안내 페이지를 보여줍니다.
+// "#tbody" 요소가 존재하지 않는 페이지에서 이 안내 문구(deletedNoticePhrases)가 발견되면 provider.ErrArticleNotFound를 반환합니다.
+// 안내 문구도 없다면 로그인 없이는 접근할 수 없는 페이지로 간주하여, 로그를 남기지 않고 provider.ErrContentUnavailable을 조용히 반환합니다.
 //
 // [오류 처리 정책]
+//   - apperrors.NotFound: 원문 게시글이 삭제된 경우입니다. provider.ErrArticleNotFound를 반환합니다.
 //   - apperrors.Forbidden 또는 apperrors.Unauthorized: 접근이 거부된 경우입니다.
-//     재시도해도 결과가 동일하므로 provider.ErrContentUnavailable을 반환합니다.
+//     재시도해도 결과가 동일하므로 provider.ErrArticleAccessDenied를 반환합니다.
 //   - 그 외 오류(네트워크 에러, 타임아웃 등): 경고 로그(Warn)를 남긴 뒤 오류를 전파합니다.
 //
 // 매개변수:
@@ -512,7 +541,9 @@ func (c *crawler) crawlContentViaAPI(ctx context.Context, article *feed.Article)
 //
 // 반환값:
 //   - nil: 성공적으로 본문을 채운 경우 (본문이 비어있어도 nil을 반환할 수 있습니다)
-//   - provider.ErrContentUnavailable: 접근이 거부되거나 로그인이 필요한 경우 (상위 루프가 조용히 건너뜁니다)
+//   - provider.ErrArticleNotFound: 원문 게시글이 삭제되었거나 삭제 안내 페이지가 표시된 경우 (상위 루프가 조용히 건너뜁니다)
+//   - provider.ErrArticleAccessDenied: 접근이 거부된 경우 (상위 루프가 조용히 건너뜁니다)
+//   - provider.ErrContentUnavailable: 본문 영역이 없는 로그인 필요 페이지인 경우 (상위 루프가 조용히 건너뜁니다)
 //   - error: 일시적 장애가 발생한 경우 (경고 로그 후 오류 전파)
 func (c *crawler) crawlContentViaPage(ctx context.Context, article *feed.Article) error {
 	// -------------------------------------------------------------------------
@@ -520,13 +551,16 @@ func (c *crawler) crawlContentViaPage(ctx context.Context, article *feed.Article
 	//
 	// 게시글 상세 페이지 URL(article.Link)에 접속하여 전체 HTML 문서를 파싱합니다.
 	// 비공개·회원 전용 게시글 접근 시에는 HTTP 401/403 에러가 반환되며, 이 경우 더 이상의 재시도 요청이 무의미하므로
-	// 즉시 ErrContentUnavailable을 반환하여 수집을 스킵합니다.
+	// 즉시 ErrArticleAccessDenied를 반환하여 수집을 스킵합니다.
 	// 그 외 타임아웃, 문서 파싱 예외 등 일시적인 네트워크 장애는 Warn 로그를 기록하고 에러를 전파합니다.
 	// -------------------------------------------------------------------------
 	doc, err := c.Scraper().FetchHTMLDocument(ctx, article.Link, nil)
 	if err != nil {
+		if apperrors.Is(err, apperrors.NotFound) {
+			return provider.ErrArticleNotFound
+		}
 		if apperrors.Is(err, apperrors.Forbidden) || apperrors.Is(err, apperrors.Unauthorized) {
-			return provider.ErrContentUnavailable
+			return provider.ErrArticleAccessDenied
 		}
 
 		c.Logger().WithFields(applog.Fields{
@@ -546,12 +580,16 @@ func (c *crawler) crawlContentViaPage(ctx context.Context, article *feed.Article
 	// [Step 2] 본문 컨테이너(#tbody) 검증 및 순수 텍스트 추출
 	//
 	// 네이버 카페 게시글의 실제 본문만 포함되어 있는 최상위 노드는 "#tbody"입니다.
-	// 만약 해당 마크업 구조를 찾을 수 없다면 로그인 세션이나 권한 부족으로 인해 우회된 다른 형태의
+	// 만약 해당 마크업 구조를 찾을 수 없다면 먼저 삭제 안내 문구가 있는지 확인하여 원문 삭제(NotFound)로 탈출하고,
+	// 안내 문구가 없다면 로그인 세션이나 권한 부족으로 인해 우회된 다른 형태의
 	// 차단 안내 페이지인 것으로 판단하고, 조용히 시스템적인 오류 처리 없이(Unavailable) 탈출합니다.
 	// 정상적인 경우 #tbody 내부의 텍스트 노드만을 축출해 다중 개행(\n\n\n 등)을 한 줄로 압축 정규화시킵니다.
 	// -------------------------------------------------------------------------
 	contentNode := doc.Find("#tbody")
 	if contentNode.Length() == 0 {
+		if isDeletedNoticePage(doc) {
+			return provider.ErrArticleNotFound
+		}
 		return provider.ErrContentUnavailable
 	}
 
@@ -580,6 +618,26 @@ func (c *crawler) crawlContentViaPage(ctx context.Context, article *feed.Article
 	return nil
 }
 
+// deletedNoticePhrases 네이버 카페가 삭제된 게시글에 접근할 때 본문 대신 표시하는 안내 문구 목록입니다.
+var deletedNoticePhrases = []string{
+	"삭제되었거나 없는 게시글입니다",
+	"삭제되었거나 존재하지 않는 게시글입니다",
+	"삭제된 게시글입니다",
+}
+
+// isDeletedNoticePage 본문 영역(#tbody)이 없는 상세 페이지가 네이버 카페의 삭제 안내 페이지인지 확인합니다.
+//
+// 로그인 안내나 권한 부족 안내 페이지와 구별하기 위해 삭제를 명시하는 문구(deletedNoticePhrases)가 있는 경우에만 true를 반환합니다.
+func isDeletedNoticePage(doc *goquery.Document) bool {
+	text := strutil.NormalizeSpace(doc.Find("body").Text())
+	for _, phrase := range deletedNoticePhrases {
+		if strings.Contains(text, phrase) {
+			return true
+		}
+	}
+	return false
+}
+
 // crawlContentViaSearch 네이버 검색 결과 페이지에서 게시글 요약을 추출하여 본문(Content)을 article에 직접 채웁니다.
 //
 // [검색 URL 구성]
@@ -176,6 +176,37 @@ func TestCrawlContentViaPage_FallbackSearchNoDOM(t *testing.T) {
 	assert.ErrorIs(t, err, provider.ErrContentUnavailable)
 }
 
+func TestCrawlContentViaPage_DeletedNotice(t *testing.T) {
+	f := fetchermocks.NewMockFetcher()
+	c := setupTestCrawler(t, f, nil, nil)
+	article := &feed.Article{ArticleID: "123", Link: "https://cafe.naver.com/ArticleRead.nhn?articleid=123&clubid=12345678"}
+
+	// 삭제된 게시글은 200 응답과 함께 본문 영역(#tbody) 없이 삭제 안내 문구만 표시됩니다.
+	htmlDeletedPage := `<html><body>
+		<div class="error_content">
+			<p class="error_text">삭제되었거나   없는 게시글입니다.</p>
+		</div>
+	</body></html>`
+
+	f.On("Do", mock.Anything).Return(fetchermocks.NewMockResponse(htmlDeletedPage, http.StatusOK), nil)
+
+	err := c.crawlContentViaPage(context.Background(), article)
+	assert.ErrorIs(t, err, provider.ErrArticleNotFound)
+}
+
+func TestCrawlContentViaPage_AccessDenied(t *testing.T) {
+	f := fetchermocks.NewMockFetcher()
+	c := setupTestCrawler(t, f, nil, nil)
+	article := &feed.Article{ArticleID: "123", Link: "https://cafe.naver.com/ArticleRead.nhn?articleid=123&clubid=12345678"}
+
+	f.On("Do", mock.Anything).Return(fetchermocks.NewMockResponse("Forbidden", http.StatusForbidden), nil)
+
+	err := c.crawlContentViaPage(context.Background(), article)
+	assert.ErrorIs(t, err, provider.ErrArticleAccessDenied)
+	assert.ErrorIs(t, err, provider.ErrContentUnavailable, "재시도 중단 로직이 유지되도록 ErrContentUnavailable도 만족해야 합니다")
+	assert.NotErrorIs(t, err, provider.ErrArticleNotFound, "접근 거부를 원문 삭제로 판정하지 않아야 합니다")
+}
+
 // ─────────────────────────────────────────────────────────────────────────────
 // TestCrawlContentViaSearch (폴백 검색 로직 파싱 검증)
 // ─────────────────────────────────────────────────────────────────────────────
@@ -238,3 +269,40 @@ func TestCrawlArticleContent_FullFallback(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "검색결과본문", article.Content)
 }
+
+func TestRevalidateArticleContent_SkipsSearchFallback(t *testing.T) {
+	f := fetchermocks.NewMockFetcher()
+	r := new(mockFeedRepo)
+	b := &config.BoardConfig{ID: "100", Name: "자유게시판"}
+	c := setupTestCrawler(t, f, r, []*config.BoardConfig{b})
+	article := &feed.Article{ArticleID: "123", Title: "제목", Link: "https://cafe.naver.com/ArticleRead.nhn?articleid=123&clubid=12345678"}
+
+	// 1. API: 내부 파싱 에러 발생
+	f.On("Do", mock.Anything).Return(fetchermocks.NewMockResponse("INVALID JSON {", http.StatusOK), nil).Once()
+
+	// 2. Page: 본문 태그 누락 발생
+	f.On("Do", mock.Anything).Return(fetchermocks.NewMockResponse(`<html><body><div>다른 레이아웃</div></body></html>`, http.StatusOK), nil).Once()
+
+	err := c.revalidateArticleContent(context.Background(), article)
+
+	assert.Error(t, err)
+	assert.Empty(t, article.Content, "검색 결과 요약으로 본문을 대신 채우지 않아야 합니다")
+	f.AssertNumberOfCalls(t, "Do", 2)
+}
+
+func TestCrawlArticleContent_StopsOnArticleNotFound(t *testing.T) {
+	f := fetchermocks.NewMockFetcher()
+	r := new(mockFeedRepo)
+	b := &config.BoardConfig{ID: "100", Name: "자유게시판"}
+	c := setupTestCrawler(t, f, r, []*config.BoardConfig{b})
+	article := &feed.Article{ArticleID: "123", Title: "제목", Link: "https://cafe.naver.com/ArticleRead.nhn?articleid=123&clubid=12345678"}
+
+	// API: 삭제된 게시글 (404)
+	f.On("Do", mock.Anything).Return(fetchermocks.NewMockResponse("Not Found", http.StatusNotFound), nil).Once()
+
+	err := c.crawlArticleContent(context.Background(), article)
+
+	assert.ErrorIs(t, err, provider.ErrArticleNotFound)
+	assert.Empty(t, article.Content, "삭제된 게시글을 검색 결과 요약으로 채우지 않아야 합니다")
+	f.AssertNumberOfCalls(t, "Do", 1)
+}
@@ -0,0 +1,16 @@
+package navercafe
+
+import (
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+	"github.com/darkkaiser/rss-feed-server/internal/service/crawl/provider"
+)
+
+// ReplayParseSnapshot 저장된 전체글보기 목록 스냅샷을 현재의 목록 파서(extractArticle)에 다시 통과시킨 결과를 반환합니다.
+func (c *crawler) ReplayParseSnapshot(snapshot *feed.ParseSnapshot) (*feed.ParseReplayResult, error) {
+	doc, err := provider.ParseSnapshotDocument(snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	return provider.ReplayRows(doc.Find(articleRowSelector), c.extractArticle), nil
+}
@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	applog "github.com/darkkaiser/notify-server/pkg/log"
+	apperrors "github.com/darkkaiser/rss-feed-server/internal/errors"
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+)
+
+const (
+	// parseSnapshotMaxBytes 스냅샷 한 건에 보관하는 응답 본문의 최대 크기입니다. 초과분은 잘라내고 Truncated로 표시합니다.
+	parseSnapshotMaxBytes = 512 * 1024
+
+	// parseSnapshotKeep 전체 공급자를 통틀어 보관하는 스냅샷의 최대 개수입니다.
+	parseSnapshotKeep = 200
+
+	// parseSnapshotTTL 스냅샷의 보관 기한입니다. 기한이 지난 스냅샷은 다음 스냅샷을 기록할 때 정리됩니다.
+	parseSnapshotTTL = 14 * 24 * time.Hour
+)
+
+// NewHTMLParseSnapshot 파싱에 실패한 HTML 목록 페이지로부터 스냅샷을 생성합니다.
+//
+// 매개변수:
+//   - boardID: 파싱에 실패한 게시판 ID (게시판 구분 없이 전체 목록을 수집하는 크롤러는 빈 문자열)
+//   - page: 파싱에 실패한 목록 페이지 번호
+//   - pageURL: 페이지를 요청한 주소
+//   - header: 페이지를 요청할 때 전송한 요청 헤더 (없으면 nil)
+//   - doc: 파싱에 실패한 페이지 문서
+//   - cause: 파싱 실패 사유
+func NewHTMLParseSnapshot(boardID string, page int, pageURL string, header http.Header, doc *goquery.Document, cause error) *feed.ParseSnapshot {
+	snapshot := &feed.ParseSnapshot{
+		BoardID:     boardID,
+		Page:        page,
+		URL:         pageURL,
+		Header:      header.Clone(),
+		ContentType: "text/html; charset=utf-8",
+		CreatedAt:   time.Now(),
+	}
+	if cause != nil {
+		snapshot.Error = cause.Error()
+	}
+	if doc != nil {
+		if html, err := doc.Html(); err == nil {
+			snapshot.Body = []byte(html)
+		}
+	}
+
+	return snapshot
+}
+
+// SaveParseSnapshot 파싱 실패 스냅샷을 저장소에 기록합니다.
+//
+// 공급자 ID는 이 크롤러의 값으로 채워지며, 본문이 parseSnapshotMaxBytes를 넘으면 잘라서 보관합니다.
+// 스냅샷은 디버깅을 위한 부가 기록이므로, 저장소가 스냅샷 기록을 지원하지 않거나 기록에 실패해도 크롤링은 그대로 진행됩니다.
+func (b *Base) SaveParseSnapshot(ctx context.Context, snapshot *feed.ParseSnapshot) {
+	if snapshot == nil {
+		return
+	}
+
+	repo, ok := b.feedRepo.(feed.ParseSnapshotRepository)
+	if !ok {
+		return
+	}
+
+	snapshot.ProviderID = b.providerID
+	if len(snapshot.Body) > parseSnapshotMaxBytes {
+		snapshot.Body = snapshot.Body[:parseSnapshotMaxBytes]
+		snapshot.Truncated = true
+	}
+
+	id, err := repo.SaveParseSnapshot(ctx, snapshot, parseSnapshotKeep, parseSnapshotTTL)
+	if err != nil {
+		b.logger.Warnf("%s: %v", b.Messagef("파싱 실패 스냅샷 기록 실패 (게시판: '%s', 페이지: %d)", snapshot.BoardID, snapshot.Page), err)
+		return
+	}
+
+	b.logger.WithFields(applog.Fields{
+		"snapshot_id": id,
+		"board_id":    snapshot.BoardID,
+		"page":        snapshot.Page,
+	}).Info(b.Messagef("파싱 실패 스냅샷 기록 완료: 관리자 API(/admin/snapshots)에서 원본을 확인할 수 있습니다"))
+}
+
+// ParseSnapshotDocument 스냅샷 본문을 파서에 다시 통과시킬 수 있도록 HTML 문서로 변환합니다.
+// HTML이 아닌 스냅샷(예: JSON 응답)은 에러를 반환합니다.
+func ParseSnapshotDocument(snapshot *feed.ParseSnapshot) (*goquery.Document, error) {
+	if !strings.Contains(strings.ToLower(snapshot.ContentType), "html") {
+		return nil, apperrors.Newf(apperrors.InvalidInput, "HTML이 아닌 스냅샷(Content-Type: '%s')은 목록 파서로 재생할 수 없습니다", snapshot.ContentType)
+	}
+
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(snapshot.Body))
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ParsingFailed, "스냅샷 본문을 HTML 문서로 해석할 수 없습니다")
+	}
+
+	return doc, nil
+}
+
+// ReplayRows 스냅샷에서 찾은 게시글 행을 파서(extract)에 하나씩 통과시킨 결과를 반환합니다.
+// 파서가 (nil, nil)을 반환한 행(예: 답글 토글 행)은 결과에서 제외됩니다.
+func ReplayRows(rows *goquery.Selection, extract func(s *goquery.Selection) (*feed.Article, error)) *feed.ParseReplayResult {
+	result := &feed.ParseReplayResult{
+		Articles: make([]*feed.Article, 0, rows.Length()),
+		Errors:   make([]string, 0),
+	}
+
+	rows.Each(func(i int, s *goquery.Selection) {
+		article, err := extract(s)
+		if err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("%d번째 행: %v", i+1, err))
+			return
+		}
+		if article != nil {
+			result.Articles = append(result.Articles, article)
+		}
+	})
+
+	return result
+}
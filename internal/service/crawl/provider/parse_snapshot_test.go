@@ -0,0 +1,126 @@
+package provider_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apperrors "github.com/darkkaiser/rss-feed-server/internal/errors"
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+	"github.com/darkkaiser/rss-feed-server/internal/service/crawl/provider"
+)
+
+// mockParseSnapshotRepository는 feed.Repository와 feed.ParseSnapshotRepository를 함께 만족하는 테스트 전용 객체입니다.
+type mockParseSnapshotRepository struct {
+	mockRepository
+
+	saved []*feed.ParseSnapshot
+	keep  int
+	ttl   time.Duration
+}
+
+func (m *mockParseSnapshotRepository) SaveParseSnapshot(ctx context.Context, snapshot *feed.ParseSnapshot, keep int, ttl time.Duration) (int64, error) {
+	m.saved = append(m.saved, snapshot)
+	m.keep = keep
+	m.ttl = ttl
+	return int64(len(m.saved)), nil
+}
+
+func (m *mockParseSnapshotRepository) ListParseSnapshots(ctx context.Context, providerID string, limit int) ([]*feed.ParseSnapshot, error) {
+	return m.saved, nil
+}
+
+func (m *mockParseSnapshotRepository) GetParseSnapshot(ctx context.Context, id int64) (*feed.ParseSnapshot, error) {
+	return nil, nil
+}
+
+func TestNewHTMLParseSnapshot(t *testing.T) {
+	t.Parallel()
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(`<table><tr><td>깨진 행</td></tr></table>`))
+	require.NoError(t, err)
+
+	header := http.Header{"Content-Type": {"application/x-www-form-urlencoded"}}
+	snapshot := provider.NewHTMLParseSnapshot("b1", 2, "https://example.com/list", header, doc, errors.New("작성일 파싱 실패"))
+
+	assert.Equal(t, "b1", snapshot.BoardID)
+	assert.Equal(t, 2, snapshot.Page)
+	assert.Equal(t, "https://example.com/list", snapshot.URL)
+	assert.Contains(t, string(snapshot.Body), "깨진 행")
+	assert.Contains(t, snapshot.ContentType, "text/html")
+	assert.Equal(t, "작성일 파싱 실패", snapshot.Error)
+
+	header.Set("Content-Type", "changed")
+	assert.Equal(t, "application/x-www-form-urlencoded", snapshot.Header["Content-Type"][0], "요청 헤더는 복사본으로 보관되어야 합니다")
+}
+
+func TestBase_SaveParseSnapshot(t *testing.T) {
+	t.Parallel()
+
+	t.Run("공급자 ID를 채우고 최대 크기를 넘는 본문은 잘라서 기록한다", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &mockParseSnapshotRepository{}
+		base := newRevalidateTestBase(repo)
+
+		base.SaveParseSnapshot(context.Background(), &feed.ParseSnapshot{
+			ContentType: "text/html",
+			Body:        []byte(strings.Repeat("a", 600*1024)),
+		})
+
+		require.Len(t, repo.saved, 1)
+		assert.Equal(t, "test-provider", repo.saved[0].ProviderID)
+		assert.True(t, repo.saved[0].Truncated)
+		assert.Len(t, repo.saved[0].Body, 512*1024)
+		assert.Positive(t, repo.keep)
+		assert.Positive(t, repo.ttl)
+	})
+
+	t.Run("저장소가 스냅샷 기록을 지원하지 않으면 아무 작업도 하지 않는다", func(t *testing.T) {
+		t.Parallel()
+
+		base := newRevalidateTestBase(&mockRepository{})
+		assert.NotPanics(t, func() {
+			base.SaveParseSnapshot(context.Background(), &feed.ParseSnapshot{ContentType: "text/html"})
+		})
+	})
+}
+
+func TestParseSnapshotDocument(t *testing.T) {
+	t.Parallel()
+
+	doc, err := provider.ParseSnapshotDocument(&feed.ParseSnapshot{ContentType: "text/html; charset=utf-8", Body: []byte(`<p>본문</p>`)})
+	require.NoError(t, err)
+	assert.Equal(t, "본문", doc.Find("p").Text())
+
+	_, err = provider.ParseSnapshotDocument(&feed.ParseSnapshot{ContentType: "application/json", Body: []byte(`{}`)})
+	assert.True(t, apperrors.Is(err, apperrors.InvalidInput))
+}
+
+func TestReplayRows(t *testing.T) {
+	t.Parallel()
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(`<ul><li>ok</li><li class="reply"></li><li>bad</li></ul>`))
+	require.NoError(t, err)
+
+	result := provider.ReplayRows(doc.Find("li"), func(s *goquery.Selection) (*feed.Article, error) {
+		switch {
+		case s.HasClass("reply"):
+			return nil, nil
+		case s.Text() == "bad":
+			return nil, errors.New("제목 없음")
+		}
+		return &feed.Article{Title: s.Text()}, nil
+	})
+
+	require.Len(t, result.Articles, 1)
+	assert.Equal(t, "ok", result.Articles[0].Title)
+	assert.Equal(t, []string{"3번째 행: 제목 없음"}, result.Errors)
+}
@@ -6,12 +6,17 @@ import (
 	applog "github.com/darkkaiser/notify-server/pkg/log"
 	"github.com/darkkaiser/rss-feed-server/internal/config"
 	apperrors "github.com/darkkaiser/rss-feed-server/internal/errors"
+	"github.com/darkkaiser/rss-feed-server/internal/service/crawl/fetcher"
 )
 
 // CrawlerConfig 크롤러 생성 및 실행 구성을 위한 메타데이터를 정의하는 구조체입니다.
 type CrawlerConfig struct {
 	// NewCrawler 새로운 크롤러 인스턴스를 생성하는 팩토리 함수입니다.
 	NewCrawler NewCrawlerFunc
+
+	// SessionMarkers 공급자에 로그인 세션(session)을 설정했을 때 세션 만료를 판단하는 사이트별 기본 표시 문자열입니다.
+	// (로그인 세션을 지원하지 않는 사이트는 생략)
+	SessionMarkers fetcher.SessionMarkers
 }
 
 // Validate 크롤러 설정의 유효성을 검증합니다.
@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+)
+
+// revalidateTimeout 한 번의 재검증 사이클(최근 게시글 조회 → 본문 재수집 → 수정 이력 기록)에 허용되는 최대 실행 시간입니다.
+const revalidateTimeout = 10 * time.Minute
+
+// revalidateConcurrency 재검증 시 동시에 본문을 재수집하는 최대 고루틴 수입니다.
+// 신규 게시글 수집과 달리 대상 게시글 수가 많을 수 있으므로, 대상 서버에 부담을 주지 않도록 보수적으로 제한합니다.
+const revalidateConcurrency = 2
+
+// Revalidate 작성된 지 days일 이내의 게시글 본문을 원문 사이트에서 다시 수집하여, 저장된 본문과 달라진 게시글을 수정 이력으로 기록합니다.
+//
+// 크롤링 커서는 이미 수집한 게시글을 다시 방문하지 않으므로, 게시 이후 작성자가 본문을 수정하더라도 피드에는 반영되지 않습니다.
+// 이 메서드는 별도의 재검증 스케줄에서 호출되어 그 공백을 메웁니다.
+//
+// 실행 흐름:
+//  1. 저장소(feed.RevisionRepository)에서 재검증 대상 게시글 목록을 조회합니다.
+//  2. 본문을 비운 복사본을 만들어 주입된 본문 수집 함수로 원문을 병렬 재수집합니다.
+//     SetRevalidateArticleContent로 재검증 전용 함수가 주입되어 있으면 그 함수를, 아니면 crawlArticleContent를 사용합니다.
+//  3. 저장된 본문과 새 본문의 해시를 비교하여, 달라진 게시글만 수정 이력으로 기록하고 UpdatedAt을 갱신합니다.
+//
+// 판단 유보 정책:
+//   - 본문 재수집에 실패했거나 빈 본문이 반환된 게시글(삭제, 권한 제한, 일시 장애 등)은 수정으로 간주하지 않고 건너뜁니다.
+//   - 본문 수집 함수가 주입되지 않았거나 저장소가 수정 이력 기록을 지원하지 않으면 아무 작업도 수행하지 않습니다.
+func (b *Base) Revalidate(ctx context.Context, days uint) {
+	// 재검증 중 발생한 런타임 패닉이 스케줄러 고루틴으로 전파되지 않도록 방어합니다.
+	defer func() {
+		if r := recover(); r != nil {
+			msg := b.Messagef("재검증 작업 중단: 런타임 패닉 발생 (상세: %v)", r)
+
+			b.logger.Error(msg)
+			b.ReportError(msg, nil)
+		}
+	}()
+
+	if days == 0 {
+		return
+	}
+
+	if b.crawlArticleContent == nil {
+		b.logger.Debug(b.Messagef("재검증 생략: 본문 수집 함수 미주입 (SetCrawlArticleContent 호출 필요)"))
+		return
+	}
+
+	repo, ok := b.feedRepo.(feed.RevisionRepository)
+	if !ok {
+		b.logger.Debug(b.Messagef("재검증 생략: 저장소가 게시글 수정 이력 기록을 지원하지 않음"))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, revalidateTimeout)
+	defer cancel()
+
+	// [1단계] 재검증 대상 게시글 조회
+	since := time.Now().AddDate(0, 0, -int(days))
+	stored, err := repo.GetRecentArticles(ctx, b.providerID, since)
+	if err != nil {
+		b.ReportError(b.Messagef("재검증 작업 실패: 최근 %d일 이내 게시글 조회 중 오류 발생", days), err)
+		return
+	}
+	if len(stored) == 0 {
+		b.logger.Debug(b.Messagef("재검증 종료: 최근 %d일 이내 게시글 없음", days))
+		return
+	}
+
+	// [2단계] 원문 본문 재수집
+	// 본문 수집 함수는 Content가 이미 채워져 있으면 수집을 생략하므로, 본문을 비운 복사본을 대상으로 수집합니다.
+	refetched := make([]*feed.Article, len(stored))
+	for i, article := range stored {
+		clone := *article
+		clone.Content = ""
+		refetched[i] = &clone
+	}
+
+	fetchContent := b.crawlArticleContent
+	if b.revalidateArticleContent != nil {
+		fetchContent = b.revalidateArticleContent
+	}
+
+	if err := b.CrawlArticleContentsConcurrently(ctx, refetched, revalidateConcurrency, fetchContent); err != nil {
+		b.logger.Warnf("%s: %v", b.Messagef("재검증 작업 중단: 본문 재수집 중 실행 컨텍스트 취소 또는 타임아웃 발생"), err)
+		return
+	}
+
+	// [3단계] 해시 비교 및 수정 이력 기록
+	revisedAt := time.Now()
+	var revisedCount int
+
+	for i, article := range refetched {
+		if article.Content == "" {
+			continue
+		}
+		// 최초 수집 당시 본문 수집에 실패하여 빈 본문으로 저장된 게시글은 '수정'이 아니라 '누락'이므로 수정으로 간주하지 않습니다.
+		if stored[i].Content == "" {
+			continue
+		}
+		if feed.ContentHash(article.Content) == feed.ContentHash(stored[i].Content) {
+			continue
+		}
+
+		article.UpdatedAt = revisedAt
+		if err := repo.SaveArticleRevision(ctx, b.providerID, article); err != nil {
+			b.ReportError(b.Messagef("재검증 작업 실패: 게시글(ID: %s)의 수정 이력 저장 중 오류 발생", article.ArticleID), err)
+			continue
+		}
+
+		revisedCount++
+	}
+
+	b.logger.Debug(b.Messagef("재검증 종료: 대상 게시글 %d건 중 %d건 수정 감지", len(stored), revisedCount))
+}
@@ -0,0 +1,168 @@
+package provider_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/darkkaiser/rss-feed-server/internal/config"
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+	"github.com/darkkaiser/rss-feed-server/internal/service/crawl/provider"
+)
+
+// mockRevisionRepository는 feed.Repository와 feed.RevisionRepository를 함께 만족하는 테스트 전용 객체입니다.
+type mockRevisionRepository struct {
+	mockRepository
+
+	mu sync.Mutex
+
+	recent    []*feed.Article
+	since     time.Time
+	revisions []*feed.Article
+}
+
+func (m *mockRevisionRepository) GetRecentArticles(ctx context.Context, providerID string, since time.Time) ([]*feed.Article, error) {
+	m.since = since
+	return m.recent, nil
+}
+
+func (m *mockRevisionRepository) SaveArticleRevision(ctx context.Context, providerID string, article *feed.Article) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.revisions = append(m.revisions, article)
+	return nil
+}
+
+func newRevalidateTestBase(repo feed.Repository) *provider.Base {
+	return provider.NewBase(provider.NewCrawlerParams{
+		ProviderID: "test-provider",
+		Config:     &config.ProviderDetailConfig{ID: "test", Name: "테스트사이트"},
+		Fetcher:    &dummyFetcher{},
+		FeedRepo:   repo,
+	}, 1)
+}
+
+func TestRevalidate_RecordsOnlyChangedArticles(t *testing.T) {
+	t.Parallel()
+
+	repo := &mockRevisionRepository{
+		recent: []*feed.Article{
+			{BoardID: "b1", ArticleID: "unchanged", Content: "그대로인 본문"},
+			{BoardID: "b1", ArticleID: "edited", Content: "마감일: 3월 5일"},
+			{BoardID: "b1", ArticleID: "deleted", Content: "삭제된 게시글"},
+			{BoardID: "b1", ArticleID: "backfill", Content: ""},
+		},
+	}
+	base := newRevalidateTestBase(repo)
+
+	base.SetCrawlArticleContent(func(ctx context.Context, article *feed.Article) error {
+		switch article.ArticleID {
+		case "unchanged":
+			article.Content = "그대로인 본문"
+		case "edited":
+			article.Content = "마감일: 3월 7일"
+		case "deleted":
+			return provider.ErrContentUnavailable
+		case "backfill":
+			article.Content = "뒤늦게 수집된 본문"
+		}
+		return nil
+	})
+
+	before := time.Now()
+	base.Revalidate(context.Background(), 7)
+
+	require.Len(t, repo.revisions, 1, "본문이 실제로 달라진 게시글만 수정 이력으로 기록되어야 합니다")
+	assert.Equal(t, "edited", repo.revisions[0].ArticleID)
+	assert.Equal(t, "마감일: 3월 7일", repo.revisions[0].Content)
+	assert.False(t, repo.revisions[0].UpdatedAt.Before(before), "수정 감지 시 UpdatedAt이 현재 시각으로 설정되어야 합니다")
+
+	// 원본 목록의 본문은 변경되지 않아야 합니다. (복사본으로 재수집)
+	assert.Equal(t, "마감일: 3월 5일", repo.recent[1].Content)
+
+	// 조회 기준 시각은 days일 전이어야 합니다.
+	assert.WithinDuration(t, before.AddDate(0, 0, -7), repo.since, time.Minute)
+}
+
+func TestRevalidate_PrefersRevalidateArticleContent(t *testing.T) {
+	t.Parallel()
+
+	repo := &mockRevisionRepository{
+		recent: []*feed.Article{
+			{BoardID: "b1", ArticleID: "full", Content: "전체 본문입니다. 끝까지 읽어 주세요."},
+		},
+	}
+	base := newRevalidateTestBase(repo)
+
+	// 신규 수집용 함수는 원문을 가져오지 못하면 검색 결과 요약으로 대신 채웁니다.
+	base.SetCrawlArticleContent(func(ctx context.Context, article *feed.Article) error {
+		article.Content = "전체 본문입니다. 끝까..."
+		return nil
+	})
+	// 재검증 전용 함수는 원문을 가져오지 못하면 본문을 비워 둡니다.
+	base.SetRevalidateArticleContent(func(ctx context.Context, article *feed.Article) error {
+		return provider.ErrContentUnavailable
+	})
+
+	base.Revalidate(context.Background(), 7)
+
+	assert.Empty(t, repo.revisions, "요약으로 대체된 본문을 수정으로 오판하지 않아야 합니다")
+}
+
+func TestRevalidate_SkipsWithoutPrerequisites(t *testing.T) {
+	t.Parallel()
+
+	t.Run("본문 수집 함수 미주입", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &mockRevisionRepository{recent: []*feed.Article{{ArticleID: "1", Content: "본문"}}}
+		base := newRevalidateTestBase(repo)
+
+		assert.NotPanics(t, func() { base.Revalidate(context.Background(), 7) })
+		assert.True(t, repo.since.IsZero(), "본문 수집 함수가 없으면 저장소를 조회하지 않아야 합니다")
+	})
+
+	t.Run("수정 이력을 지원하지 않는 저장소", func(t *testing.T) {
+		t.Parallel()
+
+		called := false
+		base := newRevalidateTestBase(&mockRepository{})
+		base.SetCrawlArticleContent(func(ctx context.Context, article *feed.Article) error {
+			called = true
+			return nil
+		})
+
+		assert.NotPanics(t, func() { base.Revalidate(context.Background(), 7) })
+		assert.False(t, called)
+	})
+
+	t.Run("재검증 기간 0일", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &mockRevisionRepository{}
+		base := newRevalidateTestBase(repo)
+		base.SetCrawlArticleContent(func(ctx context.Context, article *feed.Article) error { return nil })
+
+		base.Revalidate(context.Background(), 0)
+		assert.True(t, repo.since.IsZero())
+	})
+}
+
+func TestRevalidate_PanicRecovery(t *testing.T) {
+	t.Parallel()
+
+	repo := &mockRevisionRepository{recent: []*feed.Article{{ArticleID: "1", Content: "본문"}}}
+	base := newRevalidateTestBase(repo)
+	base.SetCrawlArticleContent(func(ctx context.Context, article *feed.Article) error {
+		return nil
+	})
+
+	// 저장소가 nil 본문 포인터를 반환하는 등 예기치 못한 상황에서도 스케줄러로 패닉이 전파되지 않아야 합니다.
+	repo.recent = []*feed.Article{nil}
+	assert.NotPanics(t, func() { base.Revalidate(context.Background(), 7) })
+}
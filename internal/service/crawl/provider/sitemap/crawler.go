@@ -0,0 +1,285 @@
+package sitemap
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"time"
+
+	applog "github.com/darkkaiser/notify-server/pkg/log"
+	"github.com/darkkaiser/rss-feed-server/internal/config"
+	apperrors "github.com/darkkaiser/rss-feed-server/internal/errors"
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+	"github.com/darkkaiser/rss-feed-server/internal/service/crawl/provider"
+)
+
+// component 크롤링 서비스의 사이트맵 Provider 로깅용 컴포넌트 이름
+const component = "crawl.provider.sitemap"
+
+func init() {
+	provider.MustRegister(config.ProviderSiteSitemap, &provider.CrawlerConfig{
+		NewCrawler: newCrawler,
+	})
+}
+
+func newCrawler(params provider.NewCrawlerParams) (provider.Crawler, error) {
+	settings, err := provider.ParseSettings[crawlerSettings](params.Config.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	// sitemap_url이 상대 경로이면 공급자 설정의 url을 기준으로 절대 주소를 만듭니다.
+	sitemapURL, err := resolveSitemapURL(params.Config.URL, settings.SitemapURL)
+	if err != nil {
+		return nil, err
+	}
+
+	// 사이트맵 크롤러에는 "페이지"가 없으므로, 한 사이클에서 내려받을 사이트맵 파일 수를 MaxPageCount로 사용합니다.
+	c := &crawler{
+		Base: provider.NewBase(params, settings.MaxSitemapCount),
+
+		settings:   settings,
+		sitemapURL: sitemapURL,
+	}
+
+	c.SetCrawlArticles(c.crawlArticles)
+	c.SetCrawlArticleContent(c.crawlArticleContent)
+
+	c.Logger().WithFields(applog.Fields{
+		"component":         component,
+		"sitemap_url":       c.sitemapURL,
+		"include_count":     len(settings.Include),
+		"exclude_count":     len(settings.Exclude),
+		"max_article_count": settings.MaxArticleCount,
+	}).Debug(c.Messagef("크롤러 생성 완료: Provider 초기화 수행"))
+
+	return c, nil
+}
+
+type crawler struct {
+	*provider.Base
+
+	// settings 설정 파일의 "data" 항목에서 읽어 들인 사이트맵 전용 설정입니다. (정규식, 셀렉터 검증 완료)
+	settings *crawlerSettings
+
+	// sitemapURL 수집을 시작할 사이트맵 파일의 절대 주소입니다.
+	sitemapURL string
+}
+
+// 컴파일 타임에 인터페이스 구현 여부를 검증합니다.
+var _ provider.Crawler = (*crawler)(nil)
+
+// resolveSitemapURL 설정된 사이트맵 주소를 공급자 URL 기준의 절대 주소로 변환합니다.
+func resolveSitemapURL(baseURL, sitemapURL string) (string, error) {
+	u, err := url.Parse(sitemapURL)
+	if err != nil {
+		return "", apperrors.Wrapf(err, apperrors.InvalidInput, "사이트맵 주소(sitemap_url) '%s'의 형식이 올바르지 않습니다", sitemapURL)
+	}
+	if u.IsAbs() {
+		return u.String(), nil
+	}
+
+	base, err := url.Parse(baseURL)
+	if err != nil || !base.IsAbs() {
+		return "", apperrors.Newf(apperrors.InvalidInput, "사이트맵 주소(sitemap_url) '%s'가 상대 경로이지만, 기준이 되는 공급자 URL('%s')이 절대 주소가 아닙니다", sitemapURL, baseURL)
+	}
+	return base.ResolveReference(u).String(), nil
+}
+
+// crawlArticles 사이트맵을 읽어 마지막 수집 이후 새로 등록되거나 수정된 게시글을 찾고, 각 게시글의 본문을 수집합니다.
+//
+// 다른 Provider가 게시판 목록 페이지의 게시글 ID를 커서로 사용하는 것과 달리, 이 크롤러는 사이트맵의 <lastmod>를 커서로 사용합니다.
+// 사이트맵의 주소는 숫자 ID처럼 대소 비교가 불가능하지만, <lastmod>는 시간 순서를 가지므로 "어디까지 읽었는지"를 표현할 수 있습니다.
+// 커서는 UTC 기준 RFC 3339 문자열로 저장합니다.
+//
+// <lastmod>는 커서로만 사용합니다. 게시글의 작성일시는 처음 발견했을 때 정한 값을 유지하며,
+// 커서 이후에 다시 나타난 기존 게시글은 신규 게시글로 반환하지 않고 본문이 바뀐 경우에만 수정 이력으로 기록합니다.
+//
+// 실행 흐름 (5단계):
+//  1. DB에서 마지막 수집 시각(커서)을 읽어옵니다.
+//  2. 사이트맵을 탐색하여 수집 조건에 맞는 게시글 후보를 모읍니다.
+//  3. 커서 이후의 후보 중 이번 사이클에서 수집할 게시글을 골라 목록을 만듭니다.
+//  4. 이미 저장된 게시글을 신규 게시글과 분리합니다.
+//  5. 게시글들의 상세 본문을 최대 2개씩 병렬로 가져오고, 이미 저장된 게시글은 본문이 바뀐 경우 수정 이력으로 기록합니다.
+//
+// 사이트맵 탐색 실패와 기존 게시글 조회 실패는 게시글 누락이나 작성일시 훼손으로 이어지므로 error를 반환하여 전체를 롤백합니다.
+// 반면 본문 수집이 중단되더라도 이미 확보한 목록과 커서는 그대로 반환합니다. (게시판형 Provider와 동일한 정책)
+//
+// 반환값:
+//   - []*feed.Article: 처음 발견한 게시글 목록 (오래된 글 → 최신 글 순서)
+//   - map[string]string: 게시판별 최신 커서 맵 (key: boardID, value: 마지막으로 수집한 게시글의 <lastmod>). 신규 게시글이 없으면 비어 있습니다.
+//   - string: 오류 메시지 접두사. 오류 발생 시 알림에 사용될 문맥 정보, 정상 시 빈 문자열("").
+//   - error: 커서 조회 실패, 사이트맵 탐색 실패, 기존 게시글 조회 실패 시 non-nil. 정상 시 nil.
+func (c *crawler) crawlArticles(ctx context.Context) ([]*feed.Article, map[string]string, string, error) {
+	// 설정 검증(config.ProviderSiteSitemap)에서 게시판이 정확히 1개임을 보장합니다.
+	b := c.Config().Boards[0]
+
+	// ========================================
+	// 1단계: 최근 수집 이력 조회
+	// ========================================
+	lastCursor, _, err := c.FeedRepo().GetCrawlingCursor(ctx, c.ProviderID(), b.ID)
+	if err != nil {
+		return nil, nil, c.Messagef("%s 대상 게시판의 최근 수집 이력(Cursor)을 데이터베이스에서 조회하는 과정에서 예외가 발생하였습니다.", b.Name), err
+	}
+
+	var cursor time.Time
+	if lastCursor != "" {
+		if cursor, err = time.Parse(time.RFC3339Nano, lastCursor); err != nil {
+			// 다른 형식의 커서(예: 크롤러 유형을 바꾸기 전의 게시글 ID)가 남아 있는 경우입니다.
+			// 첫 수집과 동일하게 최근 게시글부터 다시 수집하며, 이미 저장된 게시글은 4단계에서 신규 게시글과 분리됩니다.
+			c.Logger().WithFields(applog.Fields{
+				"component": component,
+				"board_id":  b.ID,
+				"cursor":    lastCursor,
+			}).Warn(c.Messagef("저장된 커서를 시각으로 해석할 수 없어 첫 수집과 동일하게 처리합니다"))
+
+			cursor = time.Time{}
+		}
+	}
+
+	// ========================================
+	// 2단계: 사이트맵 탐색
+	// ========================================
+	entries, skipped, message, err := c.collectEntries(ctx, b.ID, cursor)
+	if err != nil {
+		return nil, nil, message, err
+	}
+
+	if len(entries) == 0 && skipped > 0 {
+		// 수집 조건에 맞는 주소는 있지만 하나도 <lastmod>가 없으면, 새 글을 판별할 기준이 없어 영원히 아무것도 수집하지 못합니다.
+		// 조용히 넘어가면 관리자가 알아차릴 수 없으므로 에러로 보고합니다.
+		return nil, nil, c.Messagef("사이트맵에서 수집 조건에 맞는 주소 %d건을 찾았지만, 모두 수정일(<lastmod>)이 없거나 해석할 수 없어 신규 게시글을 판별할 수 없습니다.", skipped), apperrors.New(apperrors.ParsingFailed, "사이트맵 항목에 수정일(<lastmod>)이 없습니다")
+	}
+
+	if skipped > 0 {
+		c.Logger().WithFields(applog.Fields{
+			"component":     component,
+			"board_id":      b.ID,
+			"skipped_count": skipped,
+		}).Warn(c.Messagef("수정일(<lastmod>)이 없거나 해석할 수 없는 사이트맵 항목 제외"))
+	}
+
+	// ========================================
+	// 3단계: 수집 대상 선택 및 게시글 생성
+	// ========================================
+	selected := selectEntries(entries, cursor, c.settings.MaxArticleCount)
+
+	var articles = make([]*feed.Article, 0, len(selected))
+	var newCursors = make(map[string]string)
+
+	for _, e := range selected {
+		// 작성일시는 처음 발견했을 때의 <lastmod>로 정하며, 이후 <lastmod>가 바뀌어도 변경하지 않습니다. (5단계 참고)
+		articles = append(articles, &feed.Article{
+			BoardID:   b.ID,
+			BoardName: b.Name,
+			BoardType: b.Type,
+			ArticleID: articleIDFromLoc(e.loc),
+			Link:      e.loc,
+			CreatedAt: e.lastMod,
+		})
+	}
+	if len(selected) > 0 {
+		newCursors[b.ID] = selected[len(selected)-1].lastMod.UTC().Format(time.RFC3339Nano)
+	}
+
+	// ========================================
+	// 4단계: 이미 저장된 게시글 분리
+	// ========================================
+	// <lastmod>는 게시글이 수정될 때도 갱신되므로, 커서 이후의 항목에는 이전 사이클에서 이미 수집한 게시글이 다시 포함될 수 있습니다.
+	fresh, stored, err := c.splitStoredArticles(ctx, articles)
+	if err != nil {
+		return nil, nil, c.Messagef("%s 대상 게시판의 기존 게시글을 데이터베이스에서 조회하는 과정에서 예외가 발생하였습니다.", b.Name), err
+	}
+
+	// ========================================
+	// 5단계: 본문 수집 및 수정 이력 기록
+	// ========================================
+	// 이미 저장된 게시글의 본문은 수정 이력을 기록할 수 있는 저장소인 경우에만 다시 가져옵니다.
+	revisionRepo, canRevise := c.FeedRepo().(feed.RevisionRepository)
+	targets := fresh
+	if canRevise {
+		targets = articles
+	}
+
+	if err := c.CrawlArticleContentsConcurrently(ctx, targets, 2, c.crawlArticleContent); err != nil {
+		c.ReportError(c.Messagef("게시글 본문 파싱 프로세스 중 응답 타임아웃 또는 시스템 종료 시그널(Interrupt)이 감지되어 해당 크롤링 세션이 중단되었습니다."), err)
+	}
+
+	// 이미 저장된 게시글을 SaveArticles로 다시 저장하면 처음 수집한 작성일시가 새 <lastmod>로 덮어써져
+	// 오래된 글이 피드 최상단으로 다시 올라오므로, 본문이 바뀐 경우에만 수정 이력으로 기록하고 반환 목록에서는 제외합니다.
+	if canRevise {
+		c.saveRevisions(ctx, revisionRepo, stored, selected)
+	}
+
+	// 사이트맵에는 제목이 없으므로 본문 수집에 실패한 게시글은 제목이 비어 있습니다.
+	// 피드에서 빈 항목으로 보이지 않도록 주소를 제목으로 대신 사용합니다.
+	for _, article := range fresh {
+		if article.Title == "" {
+			article.Title = article.Link
+		}
+	}
+
+	return fresh, newCursors, "", nil
+}
+
+// splitStoredArticles 게시글 목록을 처음 발견한 게시글(fresh)과 이미 저장된 게시글(stored)로 나눕니다.
+//
+// stored는 수집한 게시글(key)과 저장소에 저장되어 있던 게시글(value)의 쌍입니다.
+// 저장소가 feed.HistoryRepository를 구현하지 않아 기존 게시글을 조회할 수 없으면 모든 게시글을 fresh로 반환합니다.
+func (c *crawler) splitStoredArticles(ctx context.Context, articles []*feed.Article) ([]*feed.Article, map[*feed.Article]*feed.Article, error) {
+	repo, ok := c.FeedRepo().(feed.HistoryRepository)
+	if !ok {
+		return articles, nil, nil
+	}
+
+	fresh := make([]*feed.Article, 0, len(articles))
+	stored := make(map[*feed.Article]*feed.Article)
+
+	for _, article := range articles {
+		existing, err := repo.GetArticle(ctx, c.ProviderID(), article.BoardID, article.ArticleID)
+		if err != nil {
+			return nil, nil, err
+		}
+		if existing == nil {
+			fresh = append(fresh, article)
+			continue
+		}
+		stored[article] = existing
+	}
+
+	return fresh, stored, nil
+}
+
+// saveRevisions 이미 저장된 게시글 중 본문이 바뀐 게시글을 수정 이력으로 기록합니다.
+// 수정일시(UpdatedAt)로는 사이트맵의 <lastmod>를 사용하며, 작성일시는 변경하지 않습니다.
+//
+// 재검증 작업과 마찬가지로 본문을 가져오지 못했거나 저장된 본문이 비어 있는 게시글은 수정으로 간주하지 않으며,
+// 기록에 실패한 게시글은 관리자에게 알린 뒤 다음 게시글을 계속 처리합니다.
+func (c *crawler) saveRevisions(ctx context.Context, repo feed.RevisionRepository, stored map[*feed.Article]*feed.Article, selected []pageEntry) {
+	lastModByLoc := make(map[string]time.Time, len(selected))
+	for _, e := range selected {
+		lastModByLoc[e.loc] = e.lastMod
+	}
+
+	for article, existing := range stored {
+		if article.Content == "" || existing.Content == "" {
+			continue
+		}
+		if feed.ContentHash(article.Content) == feed.ContentHash(existing.Content) {
+			continue
+		}
+
+		article.UpdatedAt = lastModByLoc[article.Link]
+		if err := repo.SaveArticleRevision(ctx, c.ProviderID(), article); err != nil {
+			c.ReportError(c.Messagef("게시글(ID: %s)의 수정 이력 저장 중 오류가 발생하였습니다.", article.ArticleID), err)
+		}
+	}
+}
+
+// articleIDFromLoc 게시글 주소로부터 고유 ID를 만듭니다.
+// 사이트맵의 주소는 길이 제한이 없어 DB 컬럼에 그대로 담을 수 없으므로, SHA-256 해시의 앞 20자리(80비트)를 사용합니다.
+func articleIDFromLoc(loc string) string {
+	sum := sha256.Sum256([]byte(loc))
+	return hex.EncodeToString(sum[:])[:20]
+}
@@ -0,0 +1,423 @@
+package sitemap
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/darkkaiser/rss-feed-server/internal/config"
+	apperrors "github.com/darkkaiser/rss-feed-server/internal/errors"
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+	"github.com/darkkaiser/rss-feed-server/internal/service/crawl/fetcher"
+	fetchermocks "github.com/darkkaiser/rss-feed-server/internal/service/crawl/fetcher/mocks"
+	"github.com/darkkaiser/rss-feed-server/internal/service/crawl/provider"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// ─────────────────────────────────────────────────────────────────────────────
+// 공통 헬퍼
+// ─────────────────────────────────────────────────────────────────────────────
+
+// mockFeedRepo feed.Repository 인터페이스의 Mock 구현체
+type mockFeedRepo struct {
+	mock.Mock
+}
+
+func (m *mockFeedRepo) SaveArticles(ctx context.Context, providerID string, articles []*feed.Article) (int, error) {
+	args := m.Called(ctx, providerID, articles)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *mockFeedRepo) GetArticles(ctx context.Context, providerID string, boardIDs []string, limit uint) ([]*feed.Article, error) {
+	args := m.Called(ctx, providerID, boardIDs, limit)
+	return args.Get(0).([]*feed.Article), args.Error(1)
+}
+
+func (m *mockFeedRepo) GetCrawlingCursor(ctx context.Context, providerID, boardID string) (string, time.Time, error) {
+	args := m.Called(ctx, providerID, boardID)
+	return args.String(0), args.Get(1).(time.Time), args.Error(2)
+}
+
+func (m *mockFeedRepo) UpsertLatestCrawledArticleID(ctx context.Context, providerID, boardID, articleID string) error {
+	args := m.Called(ctx, providerID, boardID, articleID)
+	return args.Error(0)
+}
+
+// mockHistoryFeedRepo feed.HistoryRepository를 함께 구현하는 저장소 Mock입니다.
+type mockHistoryFeedRepo struct {
+	mockFeedRepo
+}
+
+func (m *mockHistoryFeedRepo) ListArticles(ctx context.Context, providerID string, query feed.ArticlePageQuery) ([]*feed.Article, error) {
+	args := m.Called(ctx, providerID, query)
+	return args.Get(0).([]*feed.Article), args.Error(1)
+}
+
+func (m *mockHistoryFeedRepo) GetArticle(ctx context.Context, providerID, boardID, articleID string) (*feed.Article, error) {
+	args := m.Called(ctx, providerID, boardID, articleID)
+	article, _ := args.Get(0).(*feed.Article)
+	return article, args.Error(1)
+}
+
+// mockRevisionFeedRepo feed.HistoryRepository와 feed.RevisionRepository를 함께 구현하는 저장소 Mock입니다.
+type mockRevisionFeedRepo struct {
+	mockHistoryFeedRepo
+}
+
+func (m *mockRevisionFeedRepo) GetRecentArticles(ctx context.Context, providerID string, since time.Time) ([]*feed.Article, error) {
+	args := m.Called(ctx, providerID, since)
+	return args.Get(0).([]*feed.Article), args.Error(1)
+}
+
+func (m *mockRevisionFeedRepo) SaveArticleRevision(ctx context.Context, providerID string, article *feed.Article) error {
+	args := m.Called(ctx, providerID, article)
+	return args.Error(0)
+}
+
+const (
+	testProviderID = "example-sitemap"
+	testBoardID    = "news"
+)
+
+// setupTestCrawler newCrawler를 통해 테스트용 crawler를 생성합니다.
+func setupTestCrawler(t *testing.T, f fetcher.Fetcher, r feed.Repository, data map[string]any) *crawler {
+	t.Helper()
+
+	p := provider.NewCrawlerParams{
+		ProviderID: testProviderID,
+		Config: &config.ProviderDetailConfig{
+			ID:     testProviderID,
+			Name:   "예제 사이트",
+			URL:    "https://example.com",
+			Boards: []*config.BoardConfig{{ID: testBoardID, Name: "보도자료"}},
+			Data:   data,
+		},
+		Fetcher:  f,
+		FeedRepo: r,
+	}
+
+	c, err := newCrawler(p)
+	require.NoError(t, err)
+	return c.(*crawler)
+}
+
+func gzipBytes(t *testing.T, s string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	_, err := zw.Write([]byte(s))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func setHTMLPage(f *fetchermocks.MockHTTPFetcher, url, body string) {
+	f.SetResponse(url, []byte(body))
+	f.SetHeader(url, "Content-Type", "text/html; charset=utf-8")
+}
+
+const (
+	testSitemapIndex = `<?xml version="1.0" encoding="UTF-8"?>
+<sitemapindex xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">
+  <sitemap><loc>https://example.com/sitemap-news.xml.gz</loc><lastmod>2026-10-19T10:00:00+09:00</lastmod></sitemap>
+  <sitemap><loc>https://example.com/sitemap-2025.xml</loc><lastmod>2025-12-31T00:00:00+09:00</lastmod></sitemap>
+  <sitemap><loc>https://example.com/sitemap-index.xml</loc></sitemap>
+</sitemapindex>`
+
+	testNewsSitemap = `<?xml version="1.0" encoding="UTF-8"?>
+<urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">
+  <url><loc>https://example.com/news/3</loc><lastmod>2026-10-19T09:00:00+09:00</lastmod></url>
+  <url><loc>https://example.com/news/2</loc><lastmod>2026-10-18T09:00:00+09:00</lastmod></url>
+  <url><loc>https://example.com/news/1</loc><lastmod>2026-10-01T09:00:00+09:00</lastmod></url>
+  <url><loc>https://example.com/tag/seoul</loc><lastmod>2026-10-19T09:00:00+09:00</lastmod></url>
+</urlset>`
+
+	testOldSitemap = `<?xml version="1.0" encoding="UTF-8"?>
+<urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">
+  <url><loc>https://example.com/news/0</loc><lastmod>2025-12-01</lastmod></url>
+</urlset>`
+)
+
+// ─────────────────────────────────────────────────────────────────────────────
+// TestNewCrawler
+// ─────────────────────────────────────────────────────────────────────────────
+
+func TestNewCrawler(t *testing.T) {
+	t.Run("상대 경로 사이트맵 주소를 공급자 URL 기준으로 변환한다", func(t *testing.T) {
+		c := setupTestCrawler(t, fetchermocks.NewMockFetcher(), new(mockFeedRepo), map[string]any{"sitemap_url": "/sitemap.xml"})
+
+		assert.Equal(t, "https://example.com/sitemap.xml", c.sitemapURL)
+		assert.Equal(t, 20, c.MaxPageCount())
+	})
+
+	t.Run("잘못된 설정은 생성 단계에서 거부한다", func(t *testing.T) {
+		_, err := newCrawler(provider.NewCrawlerParams{
+			ProviderID: testProviderID,
+			Config:     &config.ProviderDetailConfig{ID: testProviderID, URL: "https://example.com", Data: map[string]any{"sitemap_url": "/sitemap.xml", "include": []any{"("}}},
+			Fetcher:    fetchermocks.NewMockFetcher(),
+		})
+
+		require.Error(t, err)
+		assert.True(t, apperrors.Is(err, apperrors.InvalidInput))
+	})
+}
+
+// ─────────────────────────────────────────────────────────────────────────────
+// TestCrawlArticles
+// ─────────────────────────────────────────────────────────────────────────────
+
+func TestCrawlArticles_SitemapIndex(t *testing.T) {
+	f := fetchermocks.NewMockHTTPFetcher()
+	r := new(mockFeedRepo)
+	c := setupTestCrawler(t, f, r, map[string]any{
+		"sitemap_url": "https://example.com/sitemap-index.xml",
+		"include":     []any{`/news/\d+$`},
+	})
+
+	f.SetResponse("https://example.com/sitemap-index.xml", []byte(testSitemapIndex))
+	f.SetResponse("https://example.com/sitemap-news.xml.gz", gzipBytes(t, testNewsSitemap))
+	f.SetResponse("https://example.com/sitemap-2025.xml", []byte(testOldSitemap))
+	setHTMLPage(f, "https://example.com/news/2", `<html><head><title>두 번째 소식</title></head><body><article>`+longText+`</article></body></html>`)
+	setHTMLPage(f, "https://example.com/news/3", `<html><head><meta property="og:title" content="세 번째 소식"></head><body><article>`+longText+`</article></body></html>`)
+
+	// 2026-10-10 이후 수정된 게시글만 신규 게시글입니다. (news/1은 이미 수집, sitemap-2025.xml은 내려받지 않음)
+	r.On("GetCrawlingCursor", mock.Anything, testProviderID, testBoardID).Return("2026-10-10T00:00:00Z", time.Time{}, nil)
+
+	articles, cursors, msg, err := c.crawlArticles(context.Background())
+
+	require.NoError(t, err)
+	assert.Empty(t, msg)
+	require.Len(t, articles, 2)
+
+	assert.Equal(t, "https://example.com/news/2", articles[0].Link)
+	assert.Equal(t, "두 번째 소식", articles[0].Title)
+	assert.Equal(t, testBoardID, articles[0].BoardID)
+	assert.Equal(t, articleIDFromLoc("https://example.com/news/2"), articles[0].ArticleID)
+	assert.NotEmpty(t, articles[0].Content)
+
+	assert.Equal(t, "세 번째 소식", articles[1].Title)
+	assert.Equal(t, map[string]string{testBoardID: "2026-10-19T00:00:00Z"}, cursors)
+
+	assert.Zero(t, f.GetCallCount("https://example.com/sitemap-2025.xml"), "커서 이전에 수정된 사이트맵은 내려받지 않아야 합니다")
+	assert.Equal(t, 1, f.GetCallCount("https://example.com/sitemap-index.xml"), "이미 방문한 사이트맵은 다시 내려받지 않아야 합니다")
+}
+
+func TestCrawlArticles_FirstRunLimitsArticleCount(t *testing.T) {
+	f := fetchermocks.NewMockHTTPFetcher()
+	r := new(mockFeedRepo)
+	c := setupTestCrawler(t, f, r, map[string]any{
+		"sitemap_url":       "https://example.com/sitemap.xml",
+		"exclude":           []any{"/tag/"},
+		"max_article_count": 1,
+	})
+
+	f.SetResponse("https://example.com/sitemap.xml", []byte(testNewsSitemap))
+	f.SetResponseWithStatus("https://example.com/news/3", []byte("forbidden"), http.StatusForbidden)
+	r.On("GetCrawlingCursor", mock.Anything, testProviderID, testBoardID).Return("", time.Time{}, nil)
+
+	articles, cursors, _, err := c.crawlArticles(context.Background())
+
+	require.NoError(t, err)
+	require.Len(t, articles, 1)
+	assert.Equal(t, "https://example.com/news/3", articles[0].Link)
+	assert.Equal(t, articles[0].Link, articles[0].Title, "본문 수집에 실패하면 주소를 제목으로 사용해야 합니다")
+	assert.Equal(t, "2026-10-19T00:00:00Z", cursors[testBoardID])
+}
+
+func TestCrawlArticles_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		sitemap string
+		setup   func(f *fetchermocks.MockHTTPFetcher, r *mockFeedRepo)
+		errType apperrors.ErrorType
+	}{
+		{
+			name: "커서 조회 실패",
+			setup: func(f *fetchermocks.MockHTTPFetcher, r *mockFeedRepo) {
+				r.On("GetCrawlingCursor", mock.Anything, testProviderID, testBoardID).Return("", time.Time{}, apperrors.New(apperrors.Unavailable, "db down"))
+			},
+			errType: apperrors.Unavailable,
+		},
+		{
+			name: "사이트맵 다운로드 실패",
+			setup: func(f *fetchermocks.MockHTTPFetcher, r *mockFeedRepo) {
+				f.SetError("https://example.com/sitemap.xml", errors.New("network timeout"))
+				r.On("GetCrawlingCursor", mock.Anything, testProviderID, testBoardID).Return("", time.Time{}, nil)
+			},
+		},
+		{
+			name: "사이트맵 형식이 아닌 XML",
+			setup: func(f *fetchermocks.MockHTTPFetcher, r *mockFeedRepo) {
+				f.SetResponse("https://example.com/sitemap.xml", []byte(`<rss><channel></channel></rss>`))
+				r.On("GetCrawlingCursor", mock.Anything, testProviderID, testBoardID).Return("", time.Time{}, nil)
+			},
+			errType: apperrors.ParsingFailed,
+		},
+		{
+			name: "모든 항목에 lastmod 없음",
+			setup: func(f *fetchermocks.MockHTTPFetcher, r *mockFeedRepo) {
+				f.SetResponse("https://example.com/sitemap.xml", []byte(`<urlset><url><loc>https://example.com/news/1</loc></url></urlset>`))
+				r.On("GetCrawlingCursor", mock.Anything, testProviderID, testBoardID).Return("", time.Time{}, nil)
+			},
+			errType: apperrors.ParsingFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := fetchermocks.NewMockHTTPFetcher()
+			r := new(mockFeedRepo)
+			c := setupTestCrawler(t, f, r, map[string]any{"sitemap_url": "https://example.com/sitemap.xml"})
+			tt.setup(f, r)
+
+			articles, cursors, msg, err := c.crawlArticles(context.Background())
+
+			require.Error(t, err)
+			if tt.errType != apperrors.Unknown {
+				assert.True(t, apperrors.Is(err, tt.errType), "Expected error type %s, got %v", tt.errType, err)
+			}
+			assert.NotEmpty(t, msg)
+			assert.Nil(t, articles)
+			assert.Nil(t, cursors)
+		})
+	}
+}
+
+func TestCrawlArticles_MaxSitemapCount(t *testing.T) {
+	setup := func(t *testing.T, maxSitemapCount int) (*fetchermocks.MockHTTPFetcher, *crawler) {
+		f := fetchermocks.NewMockHTTPFetcher()
+		r := new(mockFeedRepo)
+		c := setupTestCrawler(t, f, r, map[string]any{
+			"sitemap_url":       "https://example.com/sitemap-index.xml",
+			"include":           []any{`/news/3$`},
+			"max_sitemap_count": maxSitemapCount,
+		})
+
+		f.SetResponse("https://example.com/sitemap-index.xml", []byte(testSitemapIndex))
+		f.SetResponse("https://example.com/sitemap-news.xml.gz", gzipBytes(t, testNewsSitemap))
+		f.SetResponse("https://example.com/sitemap-2025.xml", []byte(testOldSitemap))
+		f.SetResponseWithStatus("https://example.com/news/3", []byte("forbidden"), http.StatusForbidden)
+		r.On("GetCrawlingCursor", mock.Anything, testProviderID, testBoardID).Return("", time.Time{}, nil)
+
+		return f, c
+	}
+
+	t.Run("내려받지 못한 사이트맵이 남으면 커서를 전진시키지 않도록 에러를 반환한다", func(t *testing.T) {
+		f, c := setup(t, 2)
+
+		articles, cursors, msg, err := c.crawlArticles(context.Background())
+
+		require.Error(t, err)
+		assert.True(t, apperrors.Is(err, apperrors.ExecutionFailed))
+		assert.Contains(t, msg, "max_sitemap_count")
+		assert.Nil(t, articles)
+		assert.Nil(t, cursors)
+		assert.Zero(t, f.GetCallCount("https://example.com/sitemap-2025.xml"), "최대 사이트맵 파일 수를 넘어 내려받지 않아야 합니다")
+	})
+
+	t.Run("모든 사이트맵을 한도 안에서 내려받으면 정상 처리한다", func(t *testing.T) {
+		f, c := setup(t, 3)
+
+		articles, _, _, err := c.crawlArticles(context.Background())
+
+		require.NoError(t, err)
+		assert.Len(t, articles, 1)
+		assert.Equal(t, 1, f.GetCallCount("https://example.com/sitemap-2025.xml"))
+	})
+}
+
+func TestCrawlArticles_StoredArticles(t *testing.T) {
+	data := map[string]any{
+		"sitemap_url": "https://example.com/sitemap.xml",
+		"include":     []any{`/news/\d+$`},
+	}
+	body := `<html><head><title>소식</title></head><body><article>` + longText + `</article></body></html>`
+	storedCreatedAt := time.Date(2026, 10, 2, 9, 0, 0, 0, time.UTC)
+
+	// news/2는 처음 발견한 게시글이고, news/3은 이전 사이클에서 storedContent 본문으로 수집한 뒤 <lastmod>가 갱신된 게시글입니다.
+	setup := func(f *fetchermocks.MockHTTPFetcher, r *mock.Mock, storedContent string) {
+		f.SetResponse("https://example.com/sitemap.xml", []byte(testNewsSitemap))
+		setHTMLPage(f, "https://example.com/news/2", body)
+		setHTMLPage(f, "https://example.com/news/3", body)
+
+		r.On("GetCrawlingCursor", mock.Anything, testProviderID, testBoardID).Return("2026-10-10T00:00:00Z", time.Time{}, nil)
+		r.On("GetArticle", mock.Anything, testProviderID, testBoardID, articleIDFromLoc("https://example.com/news/2")).Return(nil, nil)
+		r.On("GetArticle", mock.Anything, testProviderID, testBoardID, articleIDFromLoc("https://example.com/news/3")).
+			Return(&feed.Article{BoardID: testBoardID, ArticleID: articleIDFromLoc("https://example.com/news/3"), Content: storedContent, CreatedAt: storedCreatedAt}, nil)
+	}
+
+	t.Run("이미 저장된 게시글은 신규 게시글로 반환하지 않고, 본문이 바뀌었으면 수정 이력으로 기록한다", func(t *testing.T) {
+		f := fetchermocks.NewMockHTTPFetcher()
+		r := new(mockRevisionFeedRepo)
+		c := setupTestCrawler(t, f, r, data)
+		setup(f, &r.Mock, "수정 전 본문")
+
+		r.On("SaveArticleRevision", mock.Anything, testProviderID, mock.MatchedBy(func(a *feed.Article) bool {
+			return a.Link == "https://example.com/news/3" && a.UpdatedAt.Equal(time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)) && a.Content != ""
+		})).Return(nil).Once()
+
+		articles, cursors, _, err := c.crawlArticles(context.Background())
+
+		require.NoError(t, err)
+		require.Len(t, articles, 1)
+		assert.Equal(t, "https://example.com/news/2", articles[0].Link)
+		assert.Equal(t, map[string]string{testBoardID: "2026-10-19T00:00:00Z"}, cursors, "커서는 이미 저장된 게시글의 <lastmod>까지 전진해야 합니다")
+		r.AssertExpectations(t)
+	})
+
+	t.Run("본문이 같으면 수정 이력을 기록하지 않는다", func(t *testing.T) {
+		f := fetchermocks.NewMockHTTPFetcher()
+		r := new(mockRevisionFeedRepo)
+		c := setupTestCrawler(t, f, r, data)
+
+		// 크롤러가 추출하는 본문과 같은 본문이 저장되어 있는 상태를 만듭니다.
+		probe := &feed.Article{Link: "https://example.com/news/3"}
+		setHTMLPage(f, probe.Link, body)
+		require.NoError(t, c.crawlArticleContent(context.Background(), probe))
+		setup(f, &r.Mock, probe.Content)
+
+		articles, _, _, err := c.crawlArticles(context.Background())
+
+		require.NoError(t, err)
+		assert.Len(t, articles, 1)
+		r.AssertNotCalled(t, "SaveArticleRevision", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("수정 이력을 기록할 수 없는 저장소는 이미 저장된 게시글의 본문을 가져오지 않는다", func(t *testing.T) {
+		f := fetchermocks.NewMockHTTPFetcher()
+		r := new(mockHistoryFeedRepo)
+		c := setupTestCrawler(t, f, r, data)
+		setup(f, &r.Mock, "수정 전 본문")
+
+		articles, _, _, err := c.crawlArticles(context.Background())
+
+		require.NoError(t, err)
+		require.Len(t, articles, 1)
+		assert.Equal(t, "https://example.com/news/2", articles[0].Link)
+		assert.Zero(t, f.GetCallCount("https://example.com/news/3"))
+	})
+
+	t.Run("기존 게시글 조회에 실패하면 에러를 반환한다", func(t *testing.T) {
+		f := fetchermocks.NewMockHTTPFetcher()
+		r := new(mockHistoryFeedRepo)
+		c := setupTestCrawler(t, f, r, data)
+
+		f.SetResponse("https://example.com/sitemap.xml", []byte(testNewsSitemap))
+		r.On("GetCrawlingCursor", mock.Anything, testProviderID, testBoardID).Return("2026-10-10T00:00:00Z", time.Time{}, nil)
+		r.On("GetArticle", mock.Anything, testProviderID, testBoardID, mock.Anything).Return(nil, apperrors.New(apperrors.Unavailable, "db down"))
+
+		articles, cursors, msg, err := c.crawlArticles(context.Background())
+
+		require.Error(t, err)
+		assert.NotEmpty(t, msg)
+		assert.Nil(t, articles)
+		assert.Nil(t, cursors)
+	})
+}
@@ -0,0 +1,223 @@
+package sitemap
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/PuerkitoBio/goquery"
+	applog "github.com/darkkaiser/notify-server/pkg/log"
+	"github.com/darkkaiser/notify-server/pkg/strutil"
+	apperrors "github.com/darkkaiser/rss-feed-server/internal/errors"
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+	"github.com/darkkaiser/rss-feed-server/internal/service/crawl/provider"
+)
+
+// boilerplateSelector 범용 본문 추출기가 본문 후보를 찾기 전에 문서에서 제거하는 요소들입니다.
+// 메뉴, 머리말/꼬리말, 사이드바처럼 모든 페이지에 반복되는 영역이 본문으로 잘못 선택되는 것을 막습니다.
+//
+// <form>은 제거하지 않습니다. ASP.NET 기반 사이트는 페이지 전체를 하나의 <form>으로 감싸기 때문입니다.
+const boilerplateSelector = "script, style, noscript, iframe, nav, header, footer, aside"
+
+// contentCandidateSelectors 범용 본문 추출기가 순서대로 시도하는, 본문 영역에 흔히 쓰이는 셀렉터 목록입니다.
+var contentCandidateSelectors = []string{
+	`[itemprop="articleBody"]`,
+	"article",
+	"main",
+	"#content",
+	".content",
+}
+
+// minCandidateTextLength 본문 후보 영역으로 인정하기 위한 최소 글자 수입니다.
+// 빈 <main>이나 제목만 담긴 <article>처럼 셀렉터는 일치하지만 실제 본문이 아닌 영역을 걸러냅니다.
+const minCandidateTextLength = 100
+
+// crawlArticleContent 게시글 상세 페이지를 방문하여 제목, 작성자, 본문을 article에 직접 채웁니다.
+//
+// [제목]
+// 사이트맵에는 제목 정보가 없으므로 상세 페이지에서 찾습니다. title_selector, og:title, <title>, <h1> 순서로 시도합니다.
+//
+// [본문]
+// content_selector가 설정되어 있으면 해당 영역을 본문으로 사용하며, 일치하는 요소가 없으면 본문을 수집할 수 없는 페이지로 간주합니다.
+// 설정되어 있지 않으면 extractMainContent의 범용 추출 규칙으로 본문 영역을 추정합니다.
+// 본문 텍스트가 비어 있으면 og:description을 대신 사용합니다.
+//
+// [오류 처리 정책]
+//   - 404로 응답한 페이지는 원문이 삭제된 것으로 보고 provider.ErrArticleNotFound를 반환합니다.
+//   - 접근이 거부된 페이지(Forbidden, Unauthorized)는 provider.ErrArticleAccessDenied를, 본문이 없는 페이지는
+//     provider.ErrContentUnavailable을 반환하여 상위 루프(CrawlArticleContentsConcurrently)가 조용히 건너뛰도록 합니다.
+//   - 그 외 오류(네트워크 에러, 타임아웃 등)는 경고 로그(Warn)를 남긴 뒤 그대로 전파합니다.
+//
+// 매개변수:
+//   - ctx: 요청 타임아웃이나 시스템 종료 시그널에 의해 작업을 취소할 수 있는 컨텍스트
+//   - article: 내용을 채워 넣을 대상 게시글 포인터 (Title, Author, Content 필드가 직접 수정됩니다)
+func (c *crawler) crawlArticleContent(ctx context.Context, article *feed.Article) error {
+	// -------------------------------------------------------------------------
+	// [Step 1] 상세 페이지 HTML 로드
+	// -------------------------------------------------------------------------
+	doc, err := c.Scraper().FetchHTMLDocument(ctx, article.Link, nil)
+	if err != nil {
+		if apperrors.Is(err, apperrors.NotFound) {
+			return provider.ErrArticleNotFound
+		}
+		if apperrors.Is(err, apperrors.Forbidden) || apperrors.Is(err, apperrors.Unauthorized) {
+			return provider.ErrArticleAccessDenied
+		}
+
+		c.Logger().WithFields(applog.Fields{
+			"component":  component,
+			"board_id":   article.BoardID,
+			"article_id": article.ArticleID,
+			"link":       article.Link,
+			"error":      err.Error(),
+		}).Warn(c.Messagef("상세 페이지 수집 실패: 데이터 추출 중 예외 발생"))
+
+		return err
+	}
+
+	// -------------------------------------------------------------------------
+	// [Step 2] 제목, 작성자 추출
+	//
+	// 범용 추출기가 <header> 등을 문서에서 제거하기 전에 먼저 추출해야 합니다.
+	// -------------------------------------------------------------------------
+	if article.Title == "" {
+		article.Title = extractTitle(doc, c.settings.TitleSelector)
+	}
+	if article.Author == "" {
+		article.Author = metaContent(doc, `meta[name="author"]`)
+	}
+
+	// -------------------------------------------------------------------------
+	// [Step 3] 본문 영역 선택 및 텍스트 추출
+	// -------------------------------------------------------------------------
+	var contentNode *goquery.Selection
+	if c.settings.ContentSelector != "" {
+		contentNode = doc.Find(c.settings.ContentSelector).First()
+		if contentNode.Length() == 0 {
+			c.Logger().WithFields(applog.Fields{
+				"component":        component,
+				"board_id":         article.BoardID,
+				"article_id":       article.ArticleID,
+				"link":             article.Link,
+				"content_selector": c.settings.ContentSelector,
+			}).Warn("본문 수집 실패: content_selector와 일치하는 요소 없음")
+
+			return provider.ErrContentUnavailable
+		}
+	} else {
+		contentNode = extractMainContent(doc)
+	}
+
+	article.Content = strings.TrimSpace(strutil.NormalizeMultiline(contentNode.Text()))
+	if article.Content == "" {
+		article.Content = metaContent(doc, `meta[property="og:description"]`)
+	}
+
+	// -------------------------------------------------------------------------
+	// [Step 4] 본문 이미지 추출
+	//
+	// Base64 인라인 이미지("data:image/")는 크기가 과도하여 제외하고, 상대 경로는 상세 페이지 주소 기준 절대 URL로 변환합니다.
+	// -------------------------------------------------------------------------
+	contentNode.Find("img").Each(func(i int, s *goquery.Selection) {
+		src, _ := s.Attr("src")
+		if src == "" || strings.HasPrefix(src, "data:image/") {
+			return
+		}
+
+		parsedSrc, errParseSrc := url.Parse(src)
+		parsedLink, errParseLink := url.Parse(article.Link)
+		if errParseSrc != nil || errParseLink != nil {
+			return
+		}
+
+		if article.Content != "" {
+			article.Content += "\r\n"
+		}
+
+		alt, _ := s.Attr("alt")
+		resolvedURL := parsedLink.ResolveReference(parsedSrc).String()
+		article.Content += fmt.Sprintf(`<img src="%s" alt="%s">`, html.EscapeString(resolvedURL), html.EscapeString(alt))
+	})
+
+	if article.Content == "" {
+		return provider.ErrContentUnavailable
+	}
+
+	return nil
+}
+
+// extractTitle 상세 페이지에서 게시글 제목을 찾습니다.
+// titleSelector가 지정되어 있으면 가장 먼저 시도하고, 이후 og:title, <title>, 첫 번째 <h1> 순서로 비어 있지 않은 값을 사용합니다.
+func extractTitle(doc *goquery.Document, titleSelector string) string {
+	if titleSelector != "" {
+		if title := normalizeText(doc.Find(titleSelector).First().Text()); title != "" {
+			return title
+		}
+	}
+
+	if title := metaContent(doc, `meta[property="og:title"]`); title != "" {
+		return title
+	}
+	if title := normalizeText(doc.Find("title").First().Text()); title != "" {
+		return title
+	}
+	return normalizeText(doc.Find("h1").First().Text())
+}
+
+// metaContent 셀렉터와 일치하는 첫 번째 <meta> 태그의 content 값을 반환합니다.
+func metaContent(doc *goquery.Document, selector string) string {
+	content, _ := doc.Find(selector).First().Attr("content")
+	return normalizeText(content)
+}
+
+// normalizeText 한 줄짜리 텍스트(제목, 작성자 등)의 연속된 공백과 줄바꿈을 공백 하나로 합칩니다.
+func normalizeText(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// extractMainContent content_selector가 설정되지 않았을 때, 상세 페이지에서 본문으로 보이는 영역을 추정합니다.
+//
+// 추정 규칙:
+//  1. 스크립트, 메뉴, 머리말/꼬리말 등 본문이 아닌 요소를 문서에서 제거합니다.
+//  2. 본문 영역에 흔히 쓰이는 셀렉터(contentCandidateSelectors)를 순서대로 시도하여, 충분한 텍스트를 가진 첫 번째 영역을 사용합니다.
+//  3. 일치하는 영역이 없으면, 직계 자식 <p> 요소의 텍스트가 가장 많은 컨테이너(div, section, td)를 본문으로 선택합니다.
+//     문단이 모여 있는 곳이 본문이라는 가정으로, 목록이나 메뉴처럼 짧은 텍스트가 흩어진 영역보다 높은 점수를 받습니다.
+//  4. 그래도 찾지 못하면 <body> 전체를 사용합니다.
+//
+// 이 함수는 문서(doc)에서 요소를 제거하므로, 제목 등 다른 정보를 먼저 추출한 뒤 호출해야 합니다.
+func extractMainContent(doc *goquery.Document) *goquery.Selection {
+	doc.Find(boilerplateSelector).Remove()
+
+	for _, selector := range contentCandidateSelectors {
+		s := doc.Find(selector).First()
+		if s.Length() > 0 && textLength(s) >= minCandidateTextLength {
+			return s
+		}
+	}
+
+	var best *goquery.Selection
+	var bestScore int
+	doc.Find("div, section, td").Each(func(i int, s *goquery.Selection) {
+		score := 0
+		s.ChildrenFiltered("p").Each(func(i int, p *goquery.Selection) {
+			score += textLength(p)
+		})
+
+		if score > bestScore {
+			best, bestScore = s, score
+		}
+	})
+	if best != nil {
+		return best
+	}
+
+	return doc.Find("body")
+}
+
+// textLength 선택 영역의 공백을 제외한 텍스트 글자 수를 반환합니다.
+func textLength(s *goquery.Selection) int {
+	return utf8.RuneCountInString(strings.Join(strings.Fields(s.Text()), ""))
+}
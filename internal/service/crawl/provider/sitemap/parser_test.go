@@ -0,0 +1,177 @@
+package sitemap
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+	fetchermocks "github.com/darkkaiser/rss-feed-server/internal/service/crawl/fetcher/mocks"
+	"github.com/darkkaiser/rss-feed-server/internal/service/crawl/provider"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// longText 범용 추출기가 본문 후보로 인정할 만큼 충분히 긴 본문 텍스트입니다.
+var longText = strings.Repeat("지역 축제가 다음 달 개최됩니다. ", 10)
+
+func TestExtractMainContent(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		html string
+		want string // 추출된 본문에 포함되어야 하는 텍스트
+		omit string // 추출된 본문에 포함되지 않아야 하는 텍스트
+	}{
+		{
+			name: "article 요소를 본문으로 사용한다",
+			html: `<body><nav>메뉴</nav><article>` + longText + `</article><footer>저작권</footer></body>`,
+			want: "지역 축제",
+			omit: "메뉴",
+		},
+		{
+			name: "텍스트가 부족한 후보는 건너뛴다",
+			html: `<body><main>짧은 글</main><div id="content">` + longText + `</div></body>`,
+			want: "지역 축제",
+			omit: "짧은 글",
+		},
+		{
+			name: "후보가 없으면 문단이 가장 많은 컨테이너를 선택한다",
+			html: `<body><div class="menu"><p>홈</p><p>소개</p></div><div class="view"><p>` + longText + `</p><p>문의: 관광과</p></div></body>`,
+			want: "문의: 관광과",
+			omit: "소개",
+		},
+		{
+			name: "문단도 없으면 body 전체를 사용한다",
+			html: `<body><script>var a = 1;</script>줄바꿈으로만<br>작성된 본문</body>`,
+			want: "작성된 본문",
+			omit: "var a",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			doc, err := goquery.NewDocumentFromReader(strings.NewReader(tt.html))
+			require.NoError(t, err)
+
+			text := extractMainContent(doc).Text()
+
+			assert.Contains(t, text, tt.want)
+			assert.NotContains(t, text, tt.omit)
+		})
+	}
+}
+
+func TestCrawlArticleContent(t *testing.T) {
+	const link = "https://example.com/news/1"
+
+	tests := []struct {
+		name  string
+		data  map[string]any
+		setup func(f *fetchermocks.MockHTTPFetcher)
+
+		wantErr     error
+		wantAnyErr  bool
+		wantTitle   string
+		wantAuthor  string
+		wantContent []string
+	}{
+		{
+			name: "설정된 셀렉터로 제목과 본문을 추출한다",
+			data: map[string]any{"content_selector": "div.view", "title_selector": "h2.subject"},
+			setup: func(f *fetchermocks.MockHTTPFetcher) {
+				setHTMLPage(f, link, `<html><head><title>사이트 | 보도자료</title><meta name="author" content="홍보팀"></head>
+<body><h2 class="subject"> 축제 안내 </h2><div class="view">본문입니다.<img src="/img/a.jpg" alt="포스터"><img src="data:image/png;base64,AAAA"></div></body></html>`)
+			},
+			wantTitle:   "축제 안내",
+			wantAuthor:  "홍보팀",
+			wantContent: []string{"본문입니다.", `<img src="https://example.com/img/a.jpg" alt="포스터">`},
+		},
+		{
+			name: "셀렉터가 없으면 og:title과 범용 추출기를 사용한다",
+			setup: func(f *fetchermocks.MockHTTPFetcher) {
+				setHTMLPage(f, link, `<html><head><meta property="og:title" content="오픈그래프 제목"></head><body><header><h1>사이트 이름</h1></header><article>`+longText+`</article></body></html>`)
+			},
+			wantTitle:   "오픈그래프 제목",
+			wantContent: []string{"지역 축제"},
+		},
+		{
+			name: "본문 텍스트가 없으면 og:description을 사용한다",
+			data: map[string]any{"content_selector": "div.view"},
+			setup: func(f *fetchermocks.MockHTTPFetcher) {
+				setHTMLPage(f, link, `<html><head><title>제목</title><meta property="og:description" content="요약 설명"></head><body><div class="view"> </div></body></html>`)
+			},
+			wantTitle:   "제목",
+			wantContent: []string{"요약 설명"},
+		},
+		{
+			name: "content_selector와 일치하는 요소가 없으면 건너뛴다",
+			data: map[string]any{"content_selector": "div.view"},
+			setup: func(f *fetchermocks.MockHTTPFetcher) {
+				setHTMLPage(f, link, `<html><body><p>권한이 없습니다.</p></body></html>`)
+			},
+			wantErr: provider.ErrContentUnavailable,
+		},
+		{
+			name: "접근이 거부된 페이지는 건너뛴다",
+			setup: func(f *fetchermocks.MockHTTPFetcher) {
+				f.SetResponseWithStatus(link, []byte("forbidden"), http.StatusForbidden)
+			},
+			wantErr: provider.ErrContentUnavailable,
+		},
+		{
+			name: "404로 응답한 페이지는 삭제된 것으로 판정한다",
+			setup: func(f *fetchermocks.MockHTTPFetcher) {
+				f.SetResponseWithStatus(link, []byte("not found"), http.StatusNotFound)
+			},
+			wantErr: provider.ErrArticleNotFound,
+		},
+		{
+			name: "네트워크 오류는 그대로 전파한다",
+			setup: func(f *fetchermocks.MockHTTPFetcher) {
+				f.SetError(link, errors.New("connection reset"))
+			},
+			wantAnyErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := map[string]any{"sitemap_url": "/sitemap.xml"}
+			for k, v := range tt.data {
+				data[k] = v
+			}
+
+			f := fetchermocks.NewMockHTTPFetcher()
+			tt.setup(f)
+			c := setupTestCrawler(t, f, new(mockFeedRepo), data)
+
+			article := &feed.Article{BoardID: testBoardID, ArticleID: articleIDFromLoc(link), Link: link}
+			err := c.crawlArticleContent(context.Background(), article)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			if tt.wantAnyErr {
+				require.Error(t, err)
+				assert.NotErrorIs(t, err, provider.ErrContentUnavailable)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantTitle, article.Title)
+			assert.Equal(t, tt.wantAuthor, article.Author)
+			for _, s := range tt.wantContent {
+				assert.Contains(t, article.Content, s)
+			}
+			assert.NotContains(t, article.Content, "data:image/")
+		})
+	}
+}
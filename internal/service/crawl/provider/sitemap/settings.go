@@ -0,0 +1,136 @@
+package sitemap
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/andybalholm/cascadia"
+	apperrors "github.com/darkkaiser/rss-feed-server/internal/errors"
+	"github.com/darkkaiser/rss-feed-server/internal/service/crawl/provider"
+)
+
+// crawlerSettings 사이트맵 크롤러 구동을 위해 설정 파일의 "data" 항목에서 추가로 주입받는 전용 설정 정보를 담는 구조체입니다.
+// ParseSettings 함수에 의해 설정 파일의 map 데이터로부터 자동으로 역직렬화됩니다.
+type crawlerSettings struct {
+	// SitemapURL 수집을 시작할 사이트맵(sitemap.xml) 또는 사이트맵 인덱스 파일의 주소입니다. (필수)
+	// 상대 경로("/sitemap.xml")를 지정하면 공급자 설정의 url을 기준으로 절대 주소로 변환합니다.
+	// gzip으로 압축된 파일(sitemap.xml.gz)도 지정할 수 있습니다.
+	SitemapURL string `json:"sitemap_url"`
+
+	// Include 수집 대상으로 삼을 게시글 URL의 정규식 목록입니다.
+	// 하나 이상 지정하면 이 중 하나와 일치하는 URL만 수집하며, 비어 있으면 모든 URL이 대상입니다.
+	// (예: ["/news/\\d+$"] → 보도자료 상세 페이지만 수집)
+	Include []string `json:"include"`
+
+	// Exclude 수집 대상에서 제외할 URL의 정규식 목록입니다. Include보다 우선합니다.
+	// (예: ["/tag/", "/page/\\d+"] → 태그 목록, 페이지 목록 제외)
+	Exclude []string `json:"exclude"`
+
+	// ContentSelector 상세 페이지에서 본문 영역을 선택하는 CSS 셀렉터입니다.
+	// 비어 있으면 본문으로 보이는 영역을 자동으로 추정하는 범용 추출기를 사용합니다.
+	ContentSelector string `json:"content_selector"`
+
+	// TitleSelector 상세 페이지에서 제목을 선택하는 CSS 셀렉터입니다.
+	// 비어 있으면 og:title 메타 태그, <title>, 첫 번째 <h1> 순서로 제목을 찾습니다.
+	TitleSelector string `json:"title_selector"`
+
+	// MaxArticleCount 한 번의 크롤링 사이클에서 본문을 수집할 최대 게시글 수입니다.
+	// 값이 0 이하이거나 생략된 경우 ApplyDefaults()에서 기본값(20)이 자동으로 적용됩니다.
+	MaxArticleCount int `json:"max_article_count"`
+
+	// MaxSitemapCount 사이트맵 인덱스를 따라가며 내려받을 사이트맵 파일의 최대 개수입니다. (인덱스 파일 포함)
+	// 내려받아야 할 사이트맵이 이 개수를 넘으면 게시글 누락을 막기 위해 해당 사이클의 수집을 실패로 처리합니다.
+	// 값이 0 이하이거나 생략된 경우 ApplyDefaults()에서 기본값(20)이 자동으로 적용됩니다.
+	MaxSitemapCount int `json:"max_sitemap_count"`
+
+	// includePatterns, excludePatterns Validate()에서 Include, Exclude를 컴파일한 결과입니다.
+	includePatterns []*regexp.Regexp
+	excludePatterns []*regexp.Regexp
+}
+
+// 컴파일 타임에 인터페이스 구현 여부를 검증합니다.
+var _ provider.Defaulter = (*crawlerSettings)(nil)
+var _ provider.Validator = (*crawlerSettings)(nil)
+
+// ApplyDefaults 설정 파일에서 값이 제공되지 않았거나 유효하지 않은 선택적 필드에 기본값을 자동으로 주입합니다.
+//
+// 기본값:
+//   - MaxArticleCount: 20건 (첫 수집 시 과거 게시글 전체를 한꺼번에 가져오지 않도록 제한)
+//   - MaxSitemapCount: 20개 (대형 사이트의 인덱스가 수백 개의 사이트맵을 가리키는 경우를 대비한 상한)
+func (s *crawlerSettings) ApplyDefaults() {
+	if s.MaxArticleCount <= 0 {
+		s.MaxArticleCount = 20
+	}
+	if s.MaxSitemapCount <= 0 {
+		s.MaxSitemapCount = 20
+	}
+}
+
+// Validate 설정값의 유효성을 검증하고, 정규식과 CSS 셀렉터를 미리 컴파일하여 오류를 조기에 드러냅니다.
+//
+// 이 메서드는 ApplyDefaults() 호출 이후 자동으로 실행됩니다.
+func (s *crawlerSettings) Validate() error {
+	s.SitemapURL = strings.TrimSpace(s.SitemapURL)
+	if s.SitemapURL == "" {
+		return apperrors.New(apperrors.InvalidInput, "사이트맵 주소(sitemap_url)는 필수 입력값입니다")
+	}
+	if _, err := url.Parse(s.SitemapURL); err != nil {
+		return apperrors.Wrapf(err, apperrors.InvalidInput, "사이트맵 주소(sitemap_url) '%s'의 형식이 올바르지 않습니다", s.SitemapURL)
+	}
+
+	var err error
+	if s.includePatterns, err = compilePatterns("include", s.Include); err != nil {
+		return err
+	}
+	if s.excludePatterns, err = compilePatterns("exclude", s.Exclude); err != nil {
+		return err
+	}
+
+	for name, selector := range map[string]string{"content_selector": s.ContentSelector, "title_selector": s.TitleSelector} {
+		if strings.TrimSpace(selector) == "" {
+			continue
+		}
+		if _, err := cascadia.Compile(selector); err != nil {
+			return apperrors.Wrapf(err, apperrors.InvalidInput, "%s('%s')는 올바른 CSS 셀렉터가 아닙니다", name, selector)
+		}
+	}
+
+	return nil
+}
+
+// compilePatterns 정규식 문자열 목록을 컴파일합니다. 빈 문자열은 모든 URL과 일치하므로 설정 실수로 보고 거부합니다.
+func compilePatterns(name string, patterns []string) ([]*regexp.Regexp, error) {
+	compiled := make([]*regexp.Regexp, 0, len(patterns))
+	for _, p := range patterns {
+		if strings.TrimSpace(p) == "" {
+			return nil, apperrors.Newf(apperrors.InvalidInput, "%s에 빈 정규식이 포함되어 있습니다", name)
+		}
+
+		re, err := regexp.Compile(p)
+		if err != nil {
+			return nil, apperrors.Wrapf(err, apperrors.InvalidInput, "%s의 정규식('%s')을 해석할 수 없습니다", name, p)
+		}
+		compiled = append(compiled, re)
+	}
+	return compiled, nil
+}
+
+// matchURL 게시글 URL이 include/exclude 조건을 만족하여 수집 대상인지 판단합니다.
+func (s *crawlerSettings) matchURL(loc string) bool {
+	for _, re := range s.excludePatterns {
+		if re.MatchString(loc) {
+			return false
+		}
+	}
+
+	if len(s.includePatterns) == 0 {
+		return true
+	}
+	for _, re := range s.includePatterns {
+		if re.MatchString(loc) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,141 @@
+package sitemap
+
+import (
+	"testing"
+
+	apperrors "github.com/darkkaiser/rss-feed-server/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCrawlerSettings_ApplyDefaults(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                 string
+		settings             crawlerSettings
+		expectedArticleCount int
+		expectedSitemapCount int
+	}{
+		{
+			name:                 "값이 없으면 기본값이 적용된다",
+			settings:             crawlerSettings{},
+			expectedArticleCount: 20,
+			expectedSitemapCount: 20,
+		},
+		{
+			name:                 "음수이면 기본값이 적용된다",
+			settings:             crawlerSettings{MaxArticleCount: -1, MaxSitemapCount: -5},
+			expectedArticleCount: 20,
+			expectedSitemapCount: 20,
+		},
+		{
+			name:                 "명시적으로 설정된 값은 유지한다",
+			settings:             crawlerSettings{MaxArticleCount: 5, MaxSitemapCount: 100},
+			expectedArticleCount: 5,
+			expectedSitemapCount: 100,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			settings := tt.settings
+			settings.ApplyDefaults()
+
+			assert.Equal(t, tt.expectedArticleCount, settings.MaxArticleCount)
+			assert.Equal(t, tt.expectedSitemapCount, settings.MaxSitemapCount)
+		})
+	}
+}
+
+func TestCrawlerSettings_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		settings    crawlerSettings
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name: "정상적인 설정은 검증을 통과한다",
+			settings: crawlerSettings{
+				SitemapURL:      "https://example.com/sitemap.xml",
+				Include:         []string{`/news/\d+$`},
+				Exclude:         []string{`/tag/`},
+				ContentSelector: "div.article-body",
+				TitleSelector:   "h2.subject",
+			},
+		},
+		{
+			name:     "상대 경로 사이트맵 주소도 허용한다",
+			settings: crawlerSettings{SitemapURL: "/sitemap.xml"},
+		},
+		{
+			name:        "사이트맵 주소가 공백이면 에러를 반환한다",
+			settings:    crawlerSettings{SitemapURL: "   "},
+			wantErr:     true,
+			errContains: "sitemap_url",
+		},
+		{
+			name:        "include 정규식이 잘못되면 에러를 반환한다",
+			settings:    crawlerSettings{SitemapURL: "/sitemap.xml", Include: []string{`/news/(\d+`}},
+			wantErr:     true,
+			errContains: "include",
+		},
+		{
+			name:        "exclude에 빈 정규식이 있으면 에러를 반환한다",
+			settings:    crawlerSettings{SitemapURL: "/sitemap.xml", Exclude: []string{""}},
+			wantErr:     true,
+			errContains: "exclude",
+		},
+		{
+			name:        "content_selector가 잘못되면 에러를 반환한다",
+			settings:    crawlerSettings{SitemapURL: "/sitemap.xml", ContentSelector: "div[class="},
+			wantErr:     true,
+			errContains: "content_selector",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			settings := tt.settings
+			err := settings.Validate()
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.True(t, apperrors.Is(err, apperrors.InvalidInput))
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Len(t, settings.includePatterns, len(settings.Include))
+			assert.Len(t, settings.excludePatterns, len(settings.Exclude))
+		})
+	}
+}
+
+func TestCrawlerSettings_MatchURL(t *testing.T) {
+	t.Parallel()
+
+	settings := crawlerSettings{
+		SitemapURL: "/sitemap.xml",
+		Include:    []string{`/news/\d+$`, `/notice/\d+$`},
+		Exclude:    []string{`/news/0+$`},
+	}
+	require.NoError(t, settings.Validate())
+
+	assert.True(t, settings.matchURL("https://example.com/news/123"))
+	assert.True(t, settings.matchURL("https://example.com/notice/7"))
+	assert.False(t, settings.matchURL("https://example.com/news/"), "include와 일치하지 않는 주소")
+	assert.False(t, settings.matchURL("https://example.com/news/000"), "exclude가 include보다 우선")
+
+	all := crawlerSettings{SitemapURL: "/sitemap.xml"}
+	require.NoError(t, all.Validate())
+	assert.True(t, all.matchURL("https://example.com/anything"), "include가 없으면 모든 주소가 대상")
+}
@@ -0,0 +1,228 @@
+package sitemap
+
+import (
+	"context"
+	"encoding/xml"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	applog "github.com/darkkaiser/notify-server/pkg/log"
+	apperrors "github.com/darkkaiser/rss-feed-server/internal/errors"
+	"github.com/darkkaiser/rss-feed-server/internal/tracing"
+)
+
+// sitemapEntry 사이트맵 문서의 <url> 또는 <sitemap> 항목 하나를 나타냅니다.
+// 두 항목 모두 <loc>과 <lastmod>를 공통으로 가지므로 하나의 구조체로 디코딩합니다.
+type sitemapEntry struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod"`
+}
+
+// sitemapDocument 사이트맵 파일 하나의 디코딩 결과입니다.
+//
+// 사이트맵 프로토콜(https://www.sitemaps.org/protocol.html)의 파일은 아래 두 형식 중 하나입니다.
+//   - <urlset>: 게시글(페이지) 주소 목록을 담은 일반 사이트맵
+//   - <sitemapindex>: 다른 사이트맵 파일들의 주소 목록을 담은 인덱스
+//
+// 내려받기 전에는 어떤 형식인지 알 수 없으므로 두 형식의 항목을 모두 받을 수 있는 구조체로 디코딩한 뒤,
+// 루트 요소 이름(XMLName)으로 형식을 구분합니다.
+type sitemapDocument struct {
+	XMLName  xml.Name
+	URLs     []sitemapEntry `xml:"url"`
+	Sitemaps []sitemapEntry `xml:"sitemap"`
+}
+
+// pageEntry 사이트맵에서 찾아낸 수집 후보 게시글입니다.
+type pageEntry struct {
+	loc     string
+	lastMod time.Time
+}
+
+// lastModLayouts <lastmod> 값을 해석할 때 시도하는 날짜 형식 목록입니다.
+//
+// 사이트맵 프로토콜은 W3C Datetime 형식을 요구하므로 연도만 있는 형식부터 나노초까지 포함한 형식까지 모두 허용됩니다.
+// 여기에 더해, 규격을 지키지 않고 "2006-01-02 15:04:05" 형태로 출력하는 국내 CMS가 많아 이 형식도 함께 허용합니다.
+var lastModLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04Z07:00",
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+	"2006-01",
+	"2006",
+}
+
+// parseLastMod <lastmod> 문자열을 시각으로 변환합니다.
+//
+// 시간대 정보가 없는 값(날짜만 있는 값 포함)은 공급자의 시간대(loc) 기준 시각으로 해석합니다.
+// 값이 비어 있거나 어떤 형식과도 일치하지 않으면 false를 반환합니다.
+func parseLastMod(s string, loc *time.Location) (time.Time, bool) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return time.Time{}, false
+	}
+
+	for _, layout := range lastModLayouts {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// collectEntries 시작 사이트맵에서 출발하여 사이트맵 인덱스를 따라 내려가며, 수집 조건에 맞는 게시글 후보를 모읍니다.
+//
+// 동작 흐름:
+//  1. 시작 사이트맵 주소를 대기열에 넣고, 너비 우선(BFS)으로 사이트맵 파일을 하나씩 내려받습니다.
+//     - 내려받는 파일 수는 MaxPageCount(설정의 max_sitemap_count)로 제한하며, 한도에 도달했는데 내려받을 사이트맵이 남아 있으면 에러를 반환합니다.
+//     - 인덱스가 서로를 가리키는 순환 구조에 빠지지 않도록 이미 방문한 주소는 다시 내려받지 않습니다.
+//  2. <sitemapindex>이면 하위 사이트맵 주소를 대기열에 추가합니다.
+//     - 하위 사이트맵의 <lastmod>가 마지막 수집 시각(cursor) 이전이면, 그 안의 게시글도 모두 이미 수집한 것이므로 내려받지 않습니다.
+//  3. <urlset>이면 include/exclude 조건에 맞는 주소 중 <lastmod>를 해석할 수 있는 항목만 후보로 추가합니다.
+//
+// 사이트맵 파일 하나라도 내려받지 못하면(한도 초과로 내려받지 않은 경우 포함) 에러를 반환합니다.
+// 일부 사이트맵만으로 커서를 전진시키면, 내려받지 못한 사이트맵의 게시글이 커서 뒤로 밀려나 영구적으로 누락되기 때문입니다.
+//
+// 반환값:
+//   - []pageEntry: 수집 조건에 맞는 게시글 후보 목록 (정렬되지 않은 상태)
+//   - int: 수집 조건에는 맞지만 <lastmod>가 없거나 해석할 수 없어 제외된 항목 수
+//   - string: 오류 메시지 접두사. 오류 발생 시 알림에 사용될 문맥 정보, 정상 시 빈 문자열("").
+//   - error: 사이트맵을 내려받지 못했거나, 최대 사이트맵 파일 수를 넘었거나, 사이트맵 형식이 아닌 경우 non-nil
+func (c *crawler) collectEntries(ctx context.Context, boardID string, cursor time.Time) ([]pageEntry, int, string, error) {
+	var entries []pageEntry
+	var skipped int
+
+	queue := []string{c.sitemapURL}
+	visited := map[string]bool{c.sitemapURL: true}
+
+	for fetched := 0; len(queue) > 0; {
+		if fetched >= c.MaxPageCount() {
+			c.Logger().WithFields(applog.Fields{
+				"component":     component,
+				"board_id":      boardID,
+				"fetched_count": fetched,
+				"pending_count": len(queue),
+			}).Warn(c.Messagef("사이트맵 탐색 중단: 최대 사이트맵 파일 수(max_sitemap_count) 도달"))
+
+			return nil, 0, c.Messagef("사이트맵 파일 %d개를 내려받았지만 아직 내려받지 못한 하위 사이트맵이 %d개 남아 있어 수집을 중단합니다. 설정의 max_sitemap_count를 늘려 주세요.", fetched, len(queue)), apperrors.Newf(apperrors.ExecutionFailed, "최대 사이트맵 파일 수(max_sitemap_count: %d)를 초과하였습니다 (pending:%d)", c.MaxPageCount(), len(queue))
+		}
+
+		sitemapURL := queue[0]
+		queue = queue[1:]
+		fetched++
+
+		var doc sitemapDocument
+		pageCtx, pageSpan := c.StartPageSpan(ctx, boardID, fetched)
+		err := c.Scraper().FetchXML(pageCtx, sitemapURL, nil, &doc)
+		tracing.EndSpan(pageSpan, err)
+		if err != nil {
+			return nil, 0, c.Messagef("사이트맵 파일(%s)을 불러오지 못했습니다.", sitemapURL), err
+		}
+
+		switch doc.XMLName.Local {
+		case "sitemapindex":
+			for _, s := range doc.Sitemaps {
+				loc := resolveLoc(sitemapURL, s.Loc)
+				if loc == "" || visited[loc] {
+					continue
+				}
+
+				if lastMod, ok := parseLastMod(s.LastMod, c.DateParser().Location()); ok && !cursor.IsZero() && !lastMod.After(cursor) {
+					continue
+				}
+
+				visited[loc] = true
+				queue = append(queue, loc)
+			}
+
+		case "urlset":
+			for _, u := range doc.URLs {
+				loc := resolveLoc(sitemapURL, u.Loc)
+				if loc == "" || !c.settings.matchURL(loc) {
+					continue
+				}
+
+				lastMod, ok := parseLastMod(u.LastMod, c.DateParser().Location())
+				if !ok {
+					skipped++
+					continue
+				}
+
+				entries = append(entries, pageEntry{loc: loc, lastMod: lastMod})
+			}
+
+		default:
+			return nil, 0, c.Messagef("사이트맵 파일(%s)의 형식을 해석할 수 없습니다.", sitemapURL), apperrors.Newf(apperrors.ParsingFailed, "사이트맵 문서의 루트 요소가 <urlset> 또는 <sitemapindex>가 아닙니다 (root:%s)", doc.XMLName.Local)
+		}
+	}
+
+	return entries, skipped, "", nil
+}
+
+// resolveLoc <loc> 값을 앞뒤 공백을 제거한 절대 URL로 변환합니다.
+// 규격상 <loc>은 절대 URL이어야 하지만 상대 경로로 출력하는 사이트가 있어, 사이트맵 파일 주소를 기준으로 변환합니다.
+// 해석할 수 없는 값이면 빈 문자열을 반환합니다.
+func resolveLoc(base, loc string) string {
+	loc = strings.TrimSpace(loc)
+	if loc == "" {
+		return ""
+	}
+
+	baseURL, err := url.Parse(base)
+	if err != nil {
+		return ""
+	}
+	locURL, err := url.Parse(loc)
+	if err != nil {
+		return ""
+	}
+	return baseURL.ResolveReference(locURL).String()
+}
+
+// selectEntries 게시글 후보 중 이번 사이클에서 수집할 항목을 골라 오래된 글 → 최신 글 순서로 반환합니다.
+//
+// 선택 규칙:
+//   - 같은 주소가 여러 사이트맵에 중복으로 등장하면 <lastmod>가 가장 최근인 항목 하나만 남깁니다.
+//   - 마지막 수집 시각(cursor)보다 나중에 수정된 항목만 대상으로 합니다.
+//   - 첫 수집(cursor 없음)이면 가장 최근 항목 limit건만 가져옵니다. 과거 게시글 전체가 한꺼번에 피드에 쏟아지는 것을 막기 위함입니다.
+//   - 이후 수집에서 대상이 limit건을 넘으면 오래된 항목부터 limit건을 가져오고, 나머지는 다음 사이클로 미룹니다.
+//     이때 마지막으로 고른 항목과 <lastmod>가 같은 항목은 limit을 넘더라도 함께 가져옵니다.
+//     커서는 시각 단위로 저장되므로, 같은 시각의 항목을 나눠 가져오면 남은 항목이 다음 사이클에서 커서에 가려 누락되기 때문입니다.
+func selectEntries(entries []pageEntry, cursor time.Time, limit int) []pageEntry {
+	latest := make(map[string]pageEntry, len(entries))
+	for _, e := range entries {
+		if prev, exists := latest[e.loc]; !exists || e.lastMod.After(prev.lastMod) {
+			latest[e.loc] = e
+		}
+	}
+
+	selected := make([]pageEntry, 0, len(latest))
+	for _, e := range latest {
+		if cursor.IsZero() || e.lastMod.After(cursor) {
+			selected = append(selected, e)
+		}
+	}
+
+	sort.Slice(selected, func(i, j int) bool {
+		if !selected[i].lastMod.Equal(selected[j].lastMod) {
+			return selected[i].lastMod.Before(selected[j].lastMod)
+		}
+		return selected[i].loc < selected[j].loc
+	})
+
+	if len(selected) <= limit {
+		return selected
+	}
+
+	if cursor.IsZero() {
+		return selected[len(selected)-limit:]
+	}
+
+	n := limit
+	for n < len(selected) && selected[n].lastMod.Equal(selected[limit-1].lastMod) {
+		n++
+	}
+	return selected[:n]
+}
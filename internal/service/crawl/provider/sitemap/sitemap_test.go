@@ -0,0 +1,135 @@
+package sitemap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLastMod(t *testing.T) {
+	t.Parallel()
+
+	kst := time.FixedZone("KST", 9*60*60)
+
+	tests := []struct {
+		name   string
+		input  string
+		want   time.Time
+		wantOK bool
+	}{
+		{name: "RFC 3339 (시간대 포함)", input: "2026-10-19T09:30:00+09:00", want: time.Date(2026, 10, 19, 9, 30, 0, 0, kst), wantOK: true},
+		{name: "RFC 3339 (UTC, 소수점 초)", input: "2026-10-19T00:30:00.5Z", want: time.Date(2026, 10, 19, 0, 30, 0, 500000000, time.UTC), wantOK: true},
+		{name: "초 없는 W3C 형식", input: "2026-10-19T09:30+09:00", want: time.Date(2026, 10, 19, 9, 30, 0, 0, kst), wantOK: true},
+		{name: "날짜만 (공급자 시간대 적용)", input: " 2026-10-19 ", want: time.Date(2026, 10, 19, 0, 0, 0, 0, kst), wantOK: true},
+		{name: "시간대 없는 일시 (공급자 시간대 적용)", input: "2026-10-19 14:05:00", want: time.Date(2026, 10, 19, 14, 5, 0, 0, kst), wantOK: true},
+		{name: "연월만", input: "2026-10", want: time.Date(2026, 10, 1, 0, 0, 0, 0, kst), wantOK: true},
+		{name: "빈 값", input: "", wantOK: false},
+		{name: "해석할 수 없는 값", input: "어제", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := parseLastMod(tt.input, kst)
+
+			assert.Equal(t, tt.wantOK, ok)
+			if tt.wantOK {
+				assert.True(t, tt.want.Equal(got), "want %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestResolveLoc(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "https://example.com/news/1", resolveLoc("https://example.com/sitemap.xml", " https://example.com/news/1\n"))
+	assert.Equal(t, "https://example.com/news/1", resolveLoc("https://example.com/sitemaps/a.xml", "/news/1"))
+	assert.Empty(t, resolveLoc("https://example.com/sitemap.xml", "  "))
+	assert.Empty(t, resolveLoc("https://example.com/sitemap.xml", "http://[::1"))
+}
+
+func TestSelectEntries(t *testing.T) {
+	t.Parallel()
+
+	at := func(day int) time.Time { return time.Date(2026, 10, day, 0, 0, 0, 0, time.UTC) }
+	locs := func(entries []pageEntry) []string {
+		var result []string
+		for _, e := range entries {
+			result = append(result, e.loc)
+		}
+		return result
+	}
+
+	entries := []pageEntry{
+		{loc: "/c", lastMod: at(3)},
+		{loc: "/a", lastMod: at(1)},
+		{loc: "/d", lastMod: at(4)},
+		{loc: "/b", lastMod: at(2)},
+		{loc: "/a", lastMod: at(5)}, // 중복 주소: 최근 수정일만 남아야 함
+	}
+
+	tests := []struct {
+		name    string
+		entries []pageEntry
+		cursor  time.Time
+		limit   int
+		want    []string
+	}{
+		{
+			name:    "첫 수집: 오래된 순으로 정렬하고 중복을 제거한다",
+			entries: entries,
+			limit:   10,
+			want:    []string{"/b", "/c", "/d", "/a"},
+		},
+		{
+			name:    "첫 수집에서 제한을 넘으면 최근 항목만 가져온다",
+			entries: entries,
+			limit:   2,
+			want:    []string{"/d", "/a"},
+		},
+		{
+			name:    "커서 이후 항목만 가져온다",
+			entries: entries,
+			cursor:  at(3),
+			limit:   10,
+			want:    []string{"/d", "/a"},
+		},
+		{
+			name:    "커서가 있으면 오래된 항목부터 제한 수만큼 가져온다",
+			entries: entries,
+			cursor:  at(1),
+			limit:   2,
+			want:    []string{"/b", "/c"},
+		},
+		{
+			name: "마지막 항목과 수정일이 같은 항목은 제한을 넘어도 함께 가져온다",
+			entries: []pageEntry{
+				{loc: "/x", lastMod: at(2)},
+				{loc: "/y", lastMod: at(3)},
+				{loc: "/z", lastMod: at(3)},
+				{loc: "/w", lastMod: at(4)},
+			},
+			cursor: at(1),
+			limit:  2,
+			want:   []string{"/x", "/y", "/z"},
+		},
+		{
+			name:    "신규 항목이 없으면 빈 목록을 반환한다",
+			entries: entries,
+			cursor:  at(5),
+			limit:   10,
+			want:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, locs(selectEntries(tt.entries, tt.cursor, tt.limit)))
+		})
+	}
+}
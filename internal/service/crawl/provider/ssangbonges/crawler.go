@@ -14,6 +14,7 @@ import (
 	apperrors "github.com/darkkaiser/rss-feed-server/internal/errors"
 	"github.com/darkkaiser/rss-feed-server/internal/feed"
 	"github.com/darkkaiser/rss-feed-server/internal/service/crawl/provider"
+	"github.com/darkkaiser/rss-feed-server/internal/tracing"
 )
 
 // component 크롤링 서비스의 쌍봉초등학교 Provider 로깅용 컴포넌트 이름
@@ -89,6 +90,7 @@ func newCrawler(params provider.NewCrawlerParams) (provider.Crawler, error) {
 	}
 
 	c.SetCrawlArticles(c.crawlArticles)
+	c.SetCrawlArticleContent(c.crawlArticleContent)
 
 	c.Logger().WithFields(applog.Fields{
 		"component":   component,
@@ -103,7 +105,10 @@ type crawler struct {
 }
 
 // 컴파일 타임에 인터페이스 구현 여부를 검증합니다.
-var _ provider.Crawler = (*crawler)(nil)
+var (
+	_ provider.Crawler               = (*crawler)(nil)
+	_ provider.ParseSnapshotReplayer = (*crawler)(nil)
+)
 
 // crawlArticles 설정에 등록된 쌍봉초등학교의 모든 게시판을 순회하여 신규 게시글의 목록과 본문을 수집합니다.
 //
@@ -244,7 +249,9 @@ func (c *crawler) crawlSingleBoard(ctx context.Context, b *config.BoardConfig) (
 		// 실제 대상 게시판의 ID 값(b.ID)으로 교체하여 접속할 최종 웹사이트 주소를 만듭니다.
 		pageURL := strings.ReplaceAll(fmt.Sprintf("%s%s&currPage=%d", c.Config().URL, boardTypeCfg.listURLTemplate, page), boardIDPlaceholder, b.ID)
 
-		doc, err := c.fetchHTMLViaPostForm(ctx, pageURL, c.Messagef("'%s' 게시판의 %d번 페이지 목록을 불러오지 못했습니다.", b.Name, page))
+		pageCtx, pageSpan := c.StartPageSpan(ctx, b.ID, page)
+		doc, err := c.fetchHTMLViaPostForm(pageCtx, pageURL, c.Messagef("'%s' 게시판의 %d번 페이지 목록을 불러오지 못했습니다.", b.Name, page))
+		tracing.EndSpan(pageSpan, err)
 		if err != nil {
 			// [전체 롤백 정책] 에러 발생 시, 이전 페이지들에서 성공적으로 모아둔 데이터도 미련 없이 버리고 즉시 중단합니다.
 			// 1. 에러를 무시하고 커서를 전진시키면: 해당 페이지의 게시물들이 영구적으로 수집 누락됩니다.
@@ -286,6 +293,15 @@ func (c *crawler) crawlSingleBoard(ctx context.Context, b *config.BoardConfig) (
 			return nil, "", msg, errExtract
 		}
 
+		// [레이아웃 변경 감지]
+		// 행 셀렉터가 적중하더라도 컬럼 배치가 바뀌면 개별 행의 파싱 경고로만 남고 묻히기 쉽습니다.
+		// 1페이지의 모든 행을 기준으로 구조 지표를 측정하여 최근 기준선과 비교합니다.
+		if page == 1 {
+			c.CheckLayoutDrift(ctx, provider.ObserveLayout(b.ID, doc, articleRows, func(s *goquery.Selection) (*feed.Article, error) {
+				return c.extractArticle(b.ID, b.Type, boardTypeCfg.detailURLTemplate, s)
+			}))
+		}
+
 		// ----------------------------------------
 		// 4-3단계: 게시글 행 순회 (중복 판별 & 커서 갱신)
 		// ----------------------------------------
@@ -301,6 +317,9 @@ func (c *crawler) crawlSingleBoard(ctx context.Context, b *config.BoardConfig) (
 		// 외부 루프 하단에서 이 상태값을 확인하여 불필요한 다음 페이지 호출을 완전히 종료(break)하기 위함입니다.
 		var reachedLastCursor = false
 
+		// 파싱 실패 스냅샷은 페이지당 한 건만 기록합니다. (같은 페이지의 여러 행이 실패해도 원본 HTML은 동일합니다)
+		var snapshotSaved = false
+
 		// 수집된 웹페이지의 게시글 행(Row)을 위에서 아래로 순서대로 순회합니다.
 		// 중간에 중단 조건(예: 예전 글 발견)이 발생하면 false를 반환하여 행 순회를 즉시 중단할 수 있습니다.
 		articleRows.EachWithBreak(func(i int, s *goquery.Selection) bool {
@@ -339,6 +358,11 @@ func (c *crawler) crawlSingleBoard(ctx context.Context, b *config.BoardConfig) (
 					"error":      err.Error(),
 				}).Warn(c.Messagef("개별 게시글 처리 스킵: 데이터 추출 실패"))
 
+				if !snapshotSaved {
+					snapshotSaved = true
+					c.SaveParseSnapshot(ctx, provider.NewHTMLParseSnapshot(b.ID, page, pageURL, newPostFormHeader(), doc, fmt.Errorf("%d번째 행: %w", i+1, err)))
+				}
+
 				return true
 			}
 
@@ -397,7 +421,7 @@ func (c *crawler) crawlSingleBoard(ctx context.Context, b *config.BoardConfig) (
 			// 이미 수집이 완료된 날짜 구간이므로 탐색을 즉시 중단합니다.
 			//
 			// [왜 시각(Time)이 아닌 날짜(Date) 단위로만 비교하는가?]
-			// provider.ParseCreatedAt은 파싱 포맷에 따라 CreatedAt이 다르게 생성됩니다.
+			// ParseCreatedAt은 파싱 포맷에 따라 CreatedAt이 다르게 생성됩니다.
 			//   - 당일 게시글 (예: "14:30"): 정확한 시각(HH:MM:SS)으로 파싱됩니다.
 			//   - 과거 날짜 게시글 (예: "2024-03-15"): 시각 정보가 없어 항상 00:00:00으로 고정됩니다.
 			// 따라서 두 값을 time.Time으로 직접 비교하면, 같은 날에 등록된 글이라도 시각 차이로 인해
@@ -498,9 +522,7 @@ func (c *crawler) fetchHTMLViaPostForm(ctx context.Context, reqURL, errMsgPrefix
 	}
 
 	// [2단계: 요청 헤더 구성]
-	header := make(http.Header)
-	header.Set("Accept", "text/html,application/xhtml+xml,application/xml;q=0.9,image/avif,image/webp,image/apng,*/*;q=0.8,application/signed-exchange;v=b3;q=0.7")
-	header.Set("Content-Type", "application/x-www-form-urlencoded")
+	header := newPostFormHeader()
 
 	// [3단계: 요청 바디 구성] 분리한 queryString을 그대로 POST Body로 변환합니다.
 	// 일반적으로 GET 요청의 쿼리 파라미터로 쓰이는 문자열(예: "mi=123&bbsId=456")을 그대로 Body에 담는 것이 이 사이트의 요청 방식입니다.
@@ -520,3 +542,12 @@ func (c *crawler) fetchHTMLViaPostForm(ctx context.Context, reqURL, errMsgPrefix
 
 	return doc, nil
 }
+
+// newPostFormHeader 쌍봉초등학교 웹사이트에 폼 데이터를 POST로 전송할 때 사용하는 요청 헤더를 생성합니다.
+// 파싱 실패 스냅샷에도 같은 헤더를 기록하여, 실패 당시의 요청을 그대로 재현할 수 있도록 합니다.
+func newPostFormHeader() http.Header {
+	header := make(http.Header)
+	header.Set("Accept", "text/html,application/xhtml+xml,application/xml;q=0.9,image/avif,image/webp,image/apng,*/*;q=0.8,application/signed-exchange;v=b3;q=0.7")
+	header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return header
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/darkkaiser/rss-feed-server/internal/service/crawl/provider"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 // mockFeedRepo is a mock for feed.Repository
@@ -199,3 +200,36 @@ func TestCrawlArticles_Routing(t *testing.T) {
 
 	r.AssertExpectations(t)
 }
+
+// TestCrawlArticles_ReplayFixtures testdata/fixtures에 녹화된 POST 목록/상세 요청을 ReplayFetcher로 재생하여,
+// 요청 본문(currPage, nttSn)까지 일치해야 응답이 재생되는 조건에서 전체 수집 흐름이 동작하는지 검증합니다.
+func TestCrawlArticles_ReplayFixtures(t *testing.T) {
+	f, err := fetcher.NewReplayFetcher("testdata/fixtures")
+	require.NoError(t, err)
+	require.Equal(t, 3, f.Len())
+
+	r := new(mockFeedRepo)
+	b := &config.BoardConfig{ID: "1001", Name: "학교소식", Type: boardTypeList1}
+	c := setupTestCrawler(t, f, r, []*config.BoardConfig{b})
+
+	// 마지막 커서: 3044 → 3051, 3058만 신규 수집 (고정 공지 2990은 제외)
+	r.On("GetCrawlingCursor", mock.Anything, "ssangbonges", "1001").Return("3044", time.Time{}, nil)
+
+	articles, cursors, msg, err := c.crawlArticles(context.Background())
+
+	require.NoError(t, err)
+	assert.Empty(t, msg)
+	assert.Equal(t, map[string]string{"1001": "3058"}, cursors)
+
+	require.Len(t, articles, 2)
+	assert.Equal(t, "3051", articles[0].ArticleID)
+	assert.Equal(t, "2학기 학부모 상담 주간 운영 안내", articles[0].Title)
+	assert.Equal(t, "행정실", articles[0].Author)
+	assert.Equal(t, "http://test.local/ys-ssangbong_es/na/ntt/selectNttInfo.do?mi=1001&bbsId=1001&nttSn=3051", articles[0].Link)
+	assert.Contains(t, articles[0].Content, "학부모 상담 주간을 다음과 같이 운영합니다")
+
+	assert.Equal(t, "3058", articles[1].ArticleID)
+	assert.Equal(t, "현장체험학습 안전 교육 안내", articles[1].Title)
+	assert.Contains(t, articles[1].Content, `src="http://test.local/upload/ssangbong/trip_safety.png"`)
+	r.AssertExpectations(t)
+}
@@ -144,7 +144,7 @@ func (c *crawler) extractList1Article(boardID, detailURLTemplate string, s *goqu
 	createdAtStr = strings.TrimRight(strings.TrimSpace(strings.ReplaceAll(createdAtStr, ".", "-")), "-")
 
 	var err error
-	if article.CreatedAt, err = provider.ParseCreatedAt(createdAtStr); err != nil {
+	if article.CreatedAt, err = c.ParseCreatedAt(createdAtStr); err != nil {
 		return nil, err
 	}
 
@@ -340,7 +340,7 @@ func (c *crawler) extractPhoto1Article(boardID, detailURLTemplate string, s *goq
 	createdAtStr = strings.TrimRight(strings.TrimSpace(strings.ReplaceAll(createdAtStr, ".", "-")), "-")
 
 	var err error
-	if article.CreatedAt, err = provider.ParseCreatedAt(createdAtStr); err != nil {
+	if article.CreatedAt, err = c.ParseCreatedAt(createdAtStr); err != nil {
 		return nil, err
 	}
 
@@ -358,8 +358,10 @@ func (c *crawler) extractPhoto1Article(boardID, detailURLTemplate string, s *goq
 // [오류 처리 정책 — 상세 페이지 접근 실패]
 // 상세 페이지 HTTP 요청이 실패한 경우 오류 유형에 따라 다르게 처리합니다.
 //
+//   - apperrors.NotFound: 상세 페이지가 404로 응답한 경우로, 원문 게시글이 삭제된 것이 확실합니다.
+//     provider.ErrArticleNotFound를 반환하여 재시도 없이 건너뛰고, 원문 삭제 감지(CheckDeleted)가 삭제로 기록할 수 있도록 합니다.
 //   - apperrors.Forbidden 또는 apperrors.Unauthorized: 서버가 정상 응답했으나 접근 자체가 거부된 경우(예: 비공개 또는 권한 없음)입니다.
-//     이 경우 재시도해도 결과는 동일하므로, provider.ErrContentUnavailable을 반환하여
+//     이 경우 재시도해도 결과는 동일하므로, provider.ErrArticleAccessDenied를 반환하여
 //     상위 루프(CrawlArticleContentsConcurrently)가 해당 게시글을 조용히 건너뛰도록 합니다.
 //   - 그 외 오류(네트워크 에러, 타임아웃 등): 일시적인 장애일 수 있으므로 경고 로그(Warn)를 남긴 뒤 오류를 그대로 전파합니다.
 //
@@ -380,7 +382,8 @@ func (c *crawler) extractPhoto1Article(boardID, detailURLTemplate string, s *goq
 //
 // 반환값:
 //   - nil: 성공적으로 본문을 채웠거나, 이미 본문이 설정되어 있어 조기 반환한 경우
-//   - provider.ErrContentUnavailable: 상세 페이지 접근이 거부된 경우 (상위 루프가 조용히 건너뜁니다)
+//   - provider.ErrArticleNotFound: 상세 페이지가 404로 응답하여 원문 삭제가 확인된 경우 (상위 루프가 조용히 건너뜁니다)
+//   - provider.ErrArticleAccessDenied: 상세 페이지 접근이 거부된 경우 (상위 루프가 조용히 건너뜁니다)
 //   - error: 네트워크 오류 등 일시적 장애가 발생한 경우 (경고 로그 후 오류 전파)
 func (c *crawler) crawlArticleContent(ctx context.Context, article *feed.Article) error {
 	// [조기 반환 — 본문이 이미 채워진 경우]
@@ -397,16 +400,21 @@ func (c *crawler) crawlArticleContent(ctx context.Context, article *feed.Article
 	// fetchHTMLViaPostForm을 통해 게시글 상세 페이지를 POST 방식으로 요청합니다.
 	// 오류 발생 시 유형에 따라 처리 방식을 아래와 같이 분기합니다.
 	//
+	//   - apperrors.NotFound:
+	//     원문 게시글이 삭제된 경우로 ErrArticleNotFound를 반환합니다.
 	//   - apperrors.Forbidden 또는 apperrors.Unauthorized:
 	//     서버가 정상 응답했으나 접근 자체가 거부된 경우(예: 비공개 게시글, 로그인 필요)로 재시도해도 결과가
-	//     동일하므로 ErrContentUnavailable을 반환하여 상위 루프가 조용히 건너뛰도록 합니다.
+	//     동일하므로 ErrArticleAccessDenied를 반환하여 상위 루프가 조용히 건너뛰도록 합니다.
 	//   - 그 외 오류(네트워크 에러, 타임아웃 등):
 	//     경고 로그(Warn)를 남긴 뒤 오류를 전파합니다.
 	// -------------------------------------------------------------------------
 	doc, err := c.fetchHTMLViaPostForm(ctx, article.Link, c.Messagef("대상 게시판('%s')의 지정된 게시글(ID: %s) 상세 페이지 접근 및 데이터 수신에 실패했습니다", article.BoardName, article.ArticleID))
 	if err != nil {
+		if apperrors.Is(err, apperrors.NotFound) {
+			return provider.ErrArticleNotFound
+		}
 		if apperrors.Is(err, apperrors.Forbidden) || apperrors.Is(err, apperrors.Unauthorized) {
-			return provider.ErrContentUnavailable
+			return provider.ErrArticleAccessDenied
 		}
 
 		c.Logger().WithFields(applog.Fields{
@@ -501,6 +501,17 @@ func TestCrawlArticleContent_UnauthorizedResponse_ReturnsErrContentUnavailable(t
 	assert.ErrorIs(t, err, provider.ErrContentUnavailable)
 }
 
+func TestCrawlArticleContent_NotFoundResponse_ReturnsErrArticleNotFound(t *testing.T) {
+	// HTTP 404 Not Found 응답 → ErrArticleNotFound 반환 (ErrContentUnavailable로도 판정되어 재시도 중단)
+	c, f, article := makeCrawlerForContent(t)
+	f.On("Do", mock.Anything).Return(fetchermocks.NewMockResponse("", http.StatusNotFound), nil)
+
+	err := c.crawlArticleContent(context.Background(), article)
+
+	assert.ErrorIs(t, err, provider.ErrArticleNotFound)
+	assert.ErrorIs(t, err, provider.ErrContentUnavailable)
+}
+
 func TestCrawlArticleContent_NetworkError_PropagatesError(t *testing.T) {
 	// 네트워크 에러 → 그대로 전파 (재시도 대상)
 	c, f, article := makeCrawlerForContent(t)
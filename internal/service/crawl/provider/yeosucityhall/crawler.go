@@ -12,6 +12,7 @@ import (
 	apperrors "github.com/darkkaiser/rss-feed-server/internal/errors"
 	"github.com/darkkaiser/rss-feed-server/internal/feed"
 	"github.com/darkkaiser/rss-feed-server/internal/service/crawl/provider"
+	"github.com/darkkaiser/rss-feed-server/internal/tracing"
 )
 
 // component 크롤링 서비스의 여수시청 Provider 로깅용 컴포넌트 이름
@@ -125,6 +126,7 @@ func newCrawler(params provider.NewCrawlerParams) (provider.Crawler, error) {
 	}
 
 	c.SetCrawlArticles(c.crawlArticles)
+	c.SetCrawlArticleContent(c.crawlArticleContent)
 
 	c.Logger().WithFields(applog.Fields{
 		"component":   component,
@@ -139,7 +141,10 @@ type crawler struct {
 }
 
 // 컴파일 타임에 인터페이스 구현 여부를 검증합니다.
-var _ provider.Crawler = (*crawler)(nil)
+var (
+	_ provider.Crawler               = (*crawler)(nil)
+	_ provider.ParseSnapshotReplayer = (*crawler)(nil)
+)
 
 // inspectPageStatus 응답받은 HTML 페이지의 파싱 상태를 게시판 타입별 기준으로 검증합니다.
 //
@@ -374,7 +379,9 @@ PageLoop:
 		// 실제 대상 게시판의 ID 값(b.ID)으로 교체하여 접속할 최종 웹사이트 주소를 만듭니다.
 		pageURL := strings.ReplaceAll(fmt.Sprintf("%s%s?page=%d", c.Config().URL, boardTypeCfg.listURLTemplate, page), boardIDPlaceholder, b.ID)
 
-		doc, err := c.Scraper().FetchHTMLDocument(ctx, pageURL, nil)
+		pageCtx, pageSpan := c.StartPageSpan(ctx, b.ID, page)
+		doc, err := c.Scraper().FetchHTMLDocument(pageCtx, pageURL, nil)
+		tracing.EndSpan(pageSpan, err)
 		if err != nil {
 			// [전체 롤백 정책] 에러 발생 시, 이전 페이지들에서 성공적으로 모아둔 데이터도 미련 없이 버리고 즉시 중단합니다.
 			// 1. 에러를 무시하고 커서를 전진시키면: 해당 페이지의 게시물들이 영구적으로 수집 누락됩니다.
@@ -402,7 +409,18 @@ PageLoop:
 
 		case pageStatusTypeError:
 			msg := c.Messagef("'%s' 게시판에 할당된 타입('%s')에 대한 데이터 파싱 로직이 시스템 내부에 구현되어 있지 않아 크롤링 프로세스가 중단되었습니다. 설정 파일의 무결성 점검이 요구됩니다.", b.Name, b.Type)
-			return nil, "", msg, apperrors.Newf(apperrors.System, "시스템에 지원되지 않거나 구현이 누락된 게시판 처리 유형('%s')이 감지되었습니다", b.Type)
+			err := apperrors.Newf(apperrors.System, "시스템에 지원되지 않거나 구현이 누락된 게시판 처리 유형('%s')이 감지되었습니다", b.Type)
+			c.SaveParseSnapshot(ctx, provider.NewHTMLParseSnapshot(b.ID, page, pageURL, nil, doc, err))
+			return nil, "", msg, err
+		}
+
+		// [레이아웃 변경 감지]
+		// 셀렉터는 적중하지만 컬럼 배치가 바뀌는 등의 미묘한 구조 변경은 위의 상태 검증으로 드러나지 않습니다.
+		// 1페이지의 모든 행을 기준으로 구조 지표를 측정하여 최근 기준선과 비교합니다.
+		if page == 1 {
+			c.CheckLayoutDrift(ctx, provider.ObserveLayout(b.ID, doc, articleRows, func(s *goquery.Selection) (*feed.Article, error) {
+				return c.extractArticle(b.Type, s)
+			}))
 		}
 
 		// ----------------------------------------
@@ -420,6 +438,9 @@ PageLoop:
 		// 외부 루프 하단에서 이 상태값을 확인하여 불필요한 다음 페이지 호출을 완전히 종료(break)하기 위함입니다.
 		var reachedLastCursor = false
 
+		// 파싱 실패 스냅샷은 페이지당 한 건만 기록합니다. (같은 페이지의 여러 행이 실패해도 원본 HTML은 동일합니다)
+		var snapshotSaved = false
+
 		// 수집된 웹페이지의 게시글 행(Row)을 위에서 아래로 순서대로 순회합니다.
 		// 중간에 중단 조건(예: 예전 글 발견)이 발생하면 false를 반환하여 행 순회를 즉시 중단할 수 있습니다.
 		articleRows.EachWithBreak(func(i int, s *goquery.Selection) bool {
@@ -444,6 +465,11 @@ PageLoop:
 					"error":      err.Error(),
 				}).Warn(c.Messagef("개별 게시글 처리 스킵: 데이터 추출 실패"))
 
+				if !snapshotSaved {
+					snapshotSaved = true
+					c.SaveParseSnapshot(ctx, provider.NewHTMLParseSnapshot(b.ID, page, pageURL, nil, doc, fmt.Errorf("%d번째 행: %w", i+1, err)))
+				}
+
 				return true
 			}
 
@@ -502,7 +528,7 @@ PageLoop:
 			// 이미 수집이 완료된 날짜 구간이므로 탐색을 즉시 중단합니다.
 			//
 			// [왜 시각(Time)이 아닌 날짜(Date) 단위로만 비교하는가?]
-			// provider.ParseCreatedAt은 파싱 포맷에 따라 CreatedAt이 다르게 생성됩니다.
+			// ParseCreatedAt은 파싱 포맷에 따라 CreatedAt이 다르게 생성됩니다.
 			//   - 당일 게시글 (예: "14:30"): 정확한 시각(HH:MM:SS)으로 파싱됩니다.
 			//   - 과거 날짜 게시글 (예: "2024-03-15"): 시각 정보가 없어 항상 00:00:00으로 고정됩니다.
 			// 따라서 두 값을 time.Time으로 직접 비교하면, 같은 날에 등록된 글이라도 시각 차이로 인해
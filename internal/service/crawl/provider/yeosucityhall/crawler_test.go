@@ -15,6 +15,7 @@ import (
 	"github.com/darkkaiser/rss-feed-server/internal/service/crawl/provider"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 // ─────────────────────────────────────────────────────────────────────────────
@@ -427,3 +428,41 @@ func TestCrawlArticles_MultiBoard_PartialError(t *testing.T) {
 	assert.Empty(t, cursors)
 	r.AssertExpectations(t)
 }
+
+// ─────────────────────────────────────────────────────────────────────────────
+// TestCrawlArticles_ReplayFixtures
+// ─────────────────────────────────────────────────────────────────────────────
+
+// TestCrawlArticles_ReplayFixtures testdata/fixtures에 녹화된 목록/상세 페이지를 ReplayFetcher로 재생하여,
+// 네트워크 없이 목록 파싱부터 본문 수집까지 전체 수집 흐름이 동작하는지 검증합니다.
+func TestCrawlArticles_ReplayFixtures(t *testing.T) {
+	f, err := fetcher.NewReplayFetcher("testdata/fixtures")
+	require.NoError(t, err)
+	require.Equal(t, 3, f.Len())
+
+	r := new(mockFeedRepo)
+	b := &config.BoardConfig{ID: "notice", Name: "공지사항", Type: boardTypeList1}
+	c := setupTestCrawler(t, f, r, []*config.BoardConfig{b})
+
+	// 마지막 커서: 471002 → 471150, 471203만 신규 수집
+	r.On("GetCrawlingCursor", mock.Anything, "yeosu-cityhall-news", "notice").Return("471002", time.Time{}, nil)
+
+	articles, cursors, msg, err := c.crawlArticles(context.Background())
+
+	require.NoError(t, err)
+	assert.Empty(t, msg)
+	assert.Equal(t, map[string]string{"notice": "471203"}, cursors)
+
+	require.Len(t, articles, 2)
+	assert.Equal(t, "471150", articles[0].ArticleID)
+	assert.Equal(t, "여수 밤바다 불꽃축제 교통 통제 안내", articles[0].Title)
+	assert.Equal(t, "관광과", articles[0].Author)
+	assert.Equal(t, "https://www.yeosu.go.kr/www/govt/news/notice?idx=471150&mode=view", articles[0].Link)
+	assert.Contains(t, articles[0].Content, "이순신광장 일대 차량 통행이 제한됩니다")
+
+	assert.Equal(t, "471203", articles[1].ArticleID)
+	assert.Equal(t, "2026년 하반기 시민 안전 교육 참가자 모집 안내", articles[1].Title)
+	assert.Contains(t, articles[1].Content, "시민 안전 교육 참가자를 다음과 같이 모집합니다")
+	assert.Contains(t, articles[1].Content, `src="https://www.yeosu.go.kr/upload/safety_2026.jpg"`)
+	r.AssertExpectations(t)
+}
@@ -166,7 +166,7 @@ func (c *crawler) extractListArticle(boardType string, s *goquery.Selection) (*f
 	// [등록일 파싱]
 	// 셀 텍스트 형식: "2024.03.15" 또는 오늘 등록된 경우 "14:30" 형태로 제공됩니다.
 	var createdAtStr = strings.TrimSpace(rowCells.Eq(rowCells.Length() - 2).Text())
-	if article.CreatedAt, err = provider.ParseCreatedAt(createdAtStr); err != nil {
+	if article.CreatedAt, err = c.ParseCreatedAt(createdAtStr); err != nil {
 		return nil, err
 	}
 
@@ -290,7 +290,7 @@ func (c *crawler) extractPhotoNewsArticle(s *goquery.Selection) (*feed.Article,
 	// [등록일 파싱]
 	// 셀 텍스트 형식: "2024.03.15" 또는 오늘 등록된 경우 "14:30" 형태로 제공됩니다.
 	var createdAtStr = strings.TrimSpace(metaItems.Eq(1).Text())
-	if article.CreatedAt, err = provider.ParseCreatedAt(createdAtStr); err != nil {
+	if article.CreatedAt, err = c.ParseCreatedAt(createdAtStr); err != nil {
 		return nil, err
 	}
 
@@ -416,7 +416,7 @@ func (c *crawler) extractCardNewsArticle(s *goquery.Selection) (*feed.Article, e
 	// [등록일 파싱]
 	// 셀 텍스트 형식: "2024.03.15" 또는 오늘 등록된 경우 "14:30" 형태로 제공됩니다.
 	var createdAtStr = strings.TrimSpace(metaItems.Eq(0).Text())
-	if article.CreatedAt, err = provider.ParseCreatedAt(createdAtStr); err != nil {
+	if article.CreatedAt, err = c.ParseCreatedAt(createdAtStr); err != nil {
 		return nil, err
 	}
 
@@ -428,8 +428,10 @@ func (c *crawler) extractCardNewsArticle(s *goquery.Selection) (*feed.Article, e
 // [오류 처리 정책 — 상세 페이지 접근 실패]
 // 상세 페이지 HTTP 요청이 실패한 경우 오류 유형에 따라 다르게 처리합니다.
 //
+//   - apperrors.NotFound: 상세 페이지가 404로 응답한 경우로, 원문 게시글이 삭제된 것이 확실합니다.
+//     provider.ErrArticleNotFound를 반환하여 재시도 없이 건너뛰고, 원문 삭제 감지(CheckDeleted)가 삭제로 기록할 수 있도록 합니다.
 //   - apperrors.Forbidden 또는 apperrors.Unauthorized: 서버가 정상 응답했으나 접근 자체가 거부된 경우(예: 비공개 또는 권한 없음)입니다.
-//     이 경우 재시도해도 결과는 동일하므로, provider.ErrContentUnavailable을 반환하여
+//     이 경우 재시도해도 결과는 동일하므로, provider.ErrArticleAccessDenied를 반환하여
 //     상위 루프(CrawlArticleContentsConcurrently)가 해당 게시글을 조용히 건너뛰도록 합니다.
 //   - 그 외 오류(네트워크 에러, 타임아웃 등): 일시적인 장애일 수 있으므로 경고 로그(Warn)를 남긴 뒤 오류를 그대로 전파합니다.
 //
@@ -445,7 +447,8 @@ func (c *crawler) extractCardNewsArticle(s *goquery.Selection) (*feed.Article, e
 //
 // 반환값:
 //   - nil: 성공적으로 본문을 채운 경우
-//   - provider.ErrContentUnavailable: 상세 페이지 접근이 거부된 경우 (상위 루프가 조용히 건너뜁니다)
+//   - provider.ErrArticleNotFound: 상세 페이지가 404로 응답하여 원문 삭제가 확인된 경우 (상위 루프가 조용히 건너뜁니다)
+//   - provider.ErrArticleAccessDenied: 상세 페이지 접근이 거부된 경우 (상위 루프가 조용히 건너뜁니다)
 //   - error: 네트워크 오류 등 일시적 장애가 발생한 경우 (경고 로그 후 오류 전파)
 func (c *crawler) crawlArticleContent(ctx context.Context, article *feed.Article) error {
 	// -------------------------------------------------------------------------
@@ -454,16 +457,21 @@ func (c *crawler) crawlArticleContent(ctx context.Context, article *feed.Article
 	// FetchHTMLDocument를 통해 게시글 상세 페이지를 GET 방식으로 요청합니다.
 	// 오류 발생 시 유형에 따라 처리 방식을 아래와 같이 분기합니다.
 	//
+	//   - apperrors.NotFound:
+	//     원문 게시글이 삭제된 경우로 ErrArticleNotFound를 반환합니다.
 	//   - apperrors.Forbidden 또는 apperrors.Unauthorized:
 	//     서버가 정상 응답했으나 접근 자체가 거부된 경우(예: 비공개 게시글, 로그인 필요)로 재시도해도 결과가
-	//     동일하므로 ErrContentUnavailable을 반환하여 상위 루프가 조용히 건너뛰도록 합니다.
+	//     동일하므로 ErrArticleAccessDenied를 반환하여 상위 루프가 조용히 건너뛰도록 합니다.
 	//   - 그 외 오류(네트워크 에러, 타임아웃 등):
 	//     경고 로그(Warn)를 남긴 뒤 오류를 전파합니다.
 	// -------------------------------------------------------------------------
 	doc, err := c.Scraper().FetchHTMLDocument(ctx, article.Link, nil)
 	if err != nil {
+		if apperrors.Is(err, apperrors.NotFound) {
+			return provider.ErrArticleNotFound
+		}
 		if apperrors.Is(err, apperrors.Forbidden) || apperrors.Is(err, apperrors.Unauthorized) {
-			return provider.ErrContentUnavailable
+			return provider.ErrArticleAccessDenied
 		}
 
 		c.Logger().WithFields(applog.Fields{
@@ -681,6 +681,16 @@ func TestCrawlArticleContent_UnauthorizedResponse_ReturnsErrContentUnavailable(t
 	assert.ErrorIs(t, err, provider.ErrContentUnavailable)
 }
 
+func TestCrawlArticleContent_NotFoundResponse_ReturnsErrArticleNotFound(t *testing.T) {
+	c, f, article := makeCrawlerAndArticle(t)
+	f.On("Do", mock.Anything).Return(fetchermocks.NewMockResponse("", http.StatusNotFound), nil)
+
+	err := c.crawlArticleContent(context.Background(), article)
+
+	assert.ErrorIs(t, err, provider.ErrArticleNotFound)
+	assert.ErrorIs(t, err, provider.ErrContentUnavailable, "재시도 중단 판정은 기존과 동일해야 합니다")
+}
+
 func TestCrawlArticleContent_NetworkError_Propagated(t *testing.T) {
 	c, f, article := makeCrawlerAndArticle(t)
 	f.On("Do", mock.Anything).Return((*http.Response)(nil), apperrors.New(apperrors.ExecutionFailed, "일시적 네트워크 에러"))
@@ -701,6 +711,7 @@ func TestCrawlArticleContent_ContentNodeMissing_ReturnsErrContentUnavailable(t *
 	err := c.crawlArticleContent(context.Background(), article)
 
 	assert.ErrorIs(t, err, provider.ErrContentUnavailable)
+	assert.NotErrorIs(t, err, provider.ErrArticleNotFound, "레이아웃 변경 가능성이 있으므로 삭제로 판정하지 않아야 합니다")
 }
 
 func TestCrawlArticleContent_TextExtracted(t *testing.T) {
@@ -0,0 +1,40 @@
+package yeosucityhall
+
+import (
+	"github.com/PuerkitoBio/goquery"
+	apperrors "github.com/darkkaiser/rss-feed-server/internal/errors"
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+	"github.com/darkkaiser/rss-feed-server/internal/service/crawl/provider"
+)
+
+// ReplayParseSnapshot 저장된 게시판 목록 스냅샷을 현재의 목록 파서(extractArticle)에 다시 통과시킨 결과를 반환합니다.
+// 게시글 행은 스냅샷이 기록된 게시판의 유형(Type)에 해당하는 셀렉터로 찾습니다.
+func (c *crawler) ReplayParseSnapshot(snapshot *feed.ParseSnapshot) (*feed.ParseReplayResult, error) {
+	b := c.Config().Board(snapshot.BoardID)
+	if b == nil {
+		return nil, apperrors.Newf(apperrors.NotFound, "스냅샷의 게시판('%s')이 현재 설정에 존재하지 않아 재생할 수 없습니다", snapshot.BoardID)
+	}
+
+	boardTypeCfg, exists := boardTypes[b.Type]
+	if !exists {
+		return nil, apperrors.Newf(apperrors.System, "시스템에 지원되지 않는 게시판 유형('%s')이 감지되었습니다", b.Type)
+	}
+
+	doc, err := provider.ParseSnapshotDocument(snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	return provider.ReplayRows(doc.Find(boardTypeCfg.articleSelector), func(s *goquery.Selection) (*feed.Article, error) {
+		article, err := c.extractArticle(b.Type, s)
+		if err != nil {
+			return nil, err
+		}
+
+		article.BoardID = b.ID
+		article.BoardName = b.Name
+		article.BoardType = b.Type
+
+		return article, nil
+	}), nil
+}
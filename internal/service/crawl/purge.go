@@ -0,0 +1,128 @@
+package crawl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	applog "github.com/darkkaiser/notify-server/pkg/log"
+	"github.com/darkkaiser/notify-server/pkg/notify"
+	"github.com/darkkaiser/rss-feed-server/internal/config"
+	apperrors "github.com/darkkaiser/rss-feed-server/internal/errors"
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+)
+
+// ArticlePurger 게시판별 보관 정책(보관 기간, 보관 개수)을 벗어난 게시글을 삭제하는 저장소 인터페이스입니다.
+//
+// 서버 기동 시에 사용하는 저장소(store.Store)가 이미 구현하고 있으므로, 크롤링 서비스는 feed.Repository가
+// 이 인터페이스를 함께 구현한 경우에만 주기적인 삭제 작업을 등록합니다.
+type ArticlePurger interface {
+	PurgeOldArticles(ctx context.Context, providers []*config.ProviderConfig) ([]*feed.PurgeResult, error)
+}
+
+// registerPurgeJob 게시글 삭제 주기가 설정되어 있고 저장소가 ArticlePurger를 구현한 경우,
+// 보관 정책을 벗어난 게시글을 삭제하는 작업을 Cron 스케줄러에 등록합니다.
+func (s *Service) registerPurgeJob(ctx context.Context) error {
+	if !s.cfg.Purge.Enabled() {
+		return nil
+	}
+
+	purger, ok := s.feedRepo.(ArticlePurger)
+	if !ok {
+		return nil
+	}
+
+	if _, err := s.cron.AddFunc(s.cfg.Purge.TimeSpec, func() {
+		s.runExclusive(ctx, "purge", func() { s.purge(ctx, purger) })
+	}); err != nil {
+		s.logAndNotifyError("게시글 삭제 작업의 Cron 표현식 구문에 오류가 있어 스케줄 등록에 실패했습니다.", err)
+		return apperrors.Wrapf(err, apperrors.Internal, "게시글 삭제 스케줄 등록 실패: Cron 표현식 구문이 잘못되었습니다 (TimeSpec: '%s')", s.cfg.Purge.TimeSpec)
+	}
+
+	return nil
+}
+
+// purgeNotifyTimeout 게시글 삭제 결과 알림 전송에 허용하는 최대 시간입니다.
+const purgeNotifyTimeout = 5 * time.Second
+
+// purgeNotifyMaxBoards 게시글 삭제 결과 알림에 게시판별 내역을 나열할 최대 게시판 수입니다.
+// 이를 넘는 게시판은 개수만 표시하여 알림 메시지가 지나치게 길어지지 않도록 합니다.
+const purgeNotifyMaxBoards = 10
+
+// purge 보관 정책을 벗어난 게시글을 삭제하고, 게시판별 삭제 내역을 로그와 알림으로 보고합니다.
+// 일부 공급자의 삭제가 실패하더라도 나머지 공급자의 삭제 내역은 그대로 보고합니다.
+func (s *Service) purge(ctx context.Context, purger ArticlePurger) {
+	results, err := purger.PurgeOldArticles(ctx, s.cfg.Providers)
+
+	LogPurgeResults(ctx, s.notifyClient, results, err)
+}
+
+// LogPurgeResults 게시글 삭제 작업의 게시판별 삭제 내역과 전체 합계를 로그로 남기고,
+// 게시글이 한 건 이상 삭제되었거나 삭제 작업이 실패(purgeErr)한 경우 notifyClient로 요약을 전송합니다.
+// 삭제된 게시글이 없는 정상 완료는 매 주기 반복되므로 알리지 않으며, notifyClient가 nil이면 로그만 남깁니다.
+// 서버 기동 시 수행하는 삭제 작업과 주기적인 삭제 작업이 같은 형식으로 결과를 보고하도록 공용으로 사용합니다.
+func LogPurgeResults(ctx context.Context, notifyClient *notify.Client, results []*feed.PurgeResult, purgeErr error) {
+	var expired, overflow int64
+	for _, r := range results {
+		expired += r.Expired
+		overflow += r.Overflow
+
+		applog.WithComponentAndFields(component, applog.Fields{
+			"provider_id": r.ProviderID,
+			"board_id":    purgeBoardLabel(r),
+			"expired":     r.Expired,
+			"overflow":    r.Overflow,
+		}).Info(fmt.Sprintf("게시글 삭제: 보관 기간 경과 %d건, 보관 개수 초과 %d건", r.Expired, r.Overflow))
+	}
+
+	applog.WithComponentAndFields(component, applog.Fields{
+		"boards":   len(results),
+		"expired":  expired,
+		"overflow": overflow,
+	}).Info(fmt.Sprintf("게시글 삭제 작업 완료: 총 %d건 삭제", expired+overflow))
+
+	if purgeErr != nil {
+		applog.WithComponentAndFields(component, applog.Fields{
+			"error": purgeErr,
+		}).Error("보관 정책에 따른 게시글 삭제 작업 중 오류가 발생했습니다")
+	}
+
+	if notifyClient == nil || (purgeErr == nil && expired+overflow == 0) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, purgeNotifyTimeout)
+	defer cancel()
+
+	if purgeErr != nil {
+		notifyClient.NotifyError(ctx, fmt.Sprintf("보관 정책에 따른 게시글 삭제 작업 중 오류가 발생했습니다.\r\n\r\n%s\r\n\r\n%s", purgeSummary(results, expired, overflow), purgeErr))
+		return
+	}
+
+	notifyClient.Notify(ctx, purgeSummary(results, expired, overflow))
+}
+
+// purgeSummary 알림으로 전송할 게시글 삭제 결과 요약 메시지를 생성합니다.
+func purgeSummary(results []*feed.PurgeResult, expired, overflow int64) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "게시글 삭제 결과: 총 %d건 삭제 (보관 기간 경과 %d건, 보관 개수 초과 %d건)", expired+overflow, expired, overflow)
+
+	for i, r := range results {
+		if i == purgeNotifyMaxBoards {
+			fmt.Fprintf(&sb, "\r\n  - 외 %d개 게시판", len(results)-purgeNotifyMaxBoards)
+			break
+		}
+		fmt.Fprintf(&sb, "\r\n  - %s/%s: %d건", r.ProviderID, purgeBoardLabel(r), r.Total())
+	}
+
+	return sb.String()
+}
+
+// purgeBoardLabel 삭제 내역을 보고할 때 표시할 게시판 이름을 반환합니다.
+func purgeBoardLabel(r *feed.PurgeResult) string {
+	if r.BoardID == "" {
+		return "(설정에 없는 게시판)"
+	}
+	return r.BoardID
+}
@@ -0,0 +1,185 @@
+package crawl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/darkkaiser/notify-server/pkg/cronx"
+	"github.com/darkkaiser/notify-server/pkg/notify"
+	"github.com/darkkaiser/rss-feed-server/internal/config"
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+	"github.com/robfig/cron/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockPurgingFeedRepo는 ArticlePurger를 함께 구현하는 테스트용 저장소입니다.
+type mockPurgingFeedRepo struct {
+	mockFeedRepo
+
+	providers []*config.ProviderConfig
+	results   []*feed.PurgeResult
+	err       error
+}
+
+func (m *mockPurgingFeedRepo) PurgeOldArticles(ctx context.Context, providers []*config.ProviderConfig) ([]*feed.PurgeResult, error) {
+	m.providers = providers
+	return m.results, m.err
+}
+
+func TestService_registerPurgeJob(t *testing.T) {
+	newCfg := func(purge config.PurgeConfig) *config.RSSFeedConfig {
+		return &config.RSSFeedConfig{
+			Providers: []*config.ProviderConfig{
+				{Site: "test_site_success", ID: "p-1", Scheduler: config.SchedulerConfig{TimeSpec: "0 */5 * * * *"}},
+			},
+			Purge: purge,
+		}
+	}
+
+	t.Run("성공: 삭제 주기가 설정되고 저장소가 ArticlePurger를 구현하면 삭제 작업 추가 등록", func(t *testing.T) {
+		s := NewService(newCfg(config.PurgeConfig{TimeSpec: config.DefaultPurgeTimeSpec}), &mockPurgingFeedRepo{}, nil)
+		s.cron = cron.New(cron.WithParser(cronx.StandardParser()))
+
+		require.NoError(t, s.registerJobs(context.Background()))
+		assert.Len(t, s.cron.Entries(), 2)
+	})
+
+	t.Run("성공: 삭제 주기가 비어 있으면 삭제 작업을 등록하지 않음", func(t *testing.T) {
+		s := NewService(newCfg(config.PurgeConfig{}), &mockPurgingFeedRepo{}, nil)
+		s.cron = cron.New(cron.WithParser(cronx.StandardParser()))
+
+		require.NoError(t, s.registerJobs(context.Background()))
+		assert.Len(t, s.cron.Entries(), 1)
+	})
+
+	t.Run("성공: ArticlePurger 미구현 저장소는 삭제 작업을 등록하지 않음", func(t *testing.T) {
+		s := NewService(newCfg(config.PurgeConfig{TimeSpec: config.DefaultPurgeTimeSpec}), &mockFeedRepo{}, nil)
+		s.cron = cron.New(cron.WithParser(cronx.StandardParser()))
+
+		require.NoError(t, s.registerJobs(context.Background()))
+		assert.Len(t, s.cron.Entries(), 1)
+	})
+
+	t.Run("실패: 잘못된 삭제 Cron 표현식 지정 시 에러", func(t *testing.T) {
+		s := NewService(newCfg(config.PurgeConfig{TimeSpec: "invalid_%_string"}), &mockPurgingFeedRepo{}, nil)
+		s.cron = cron.New(cron.WithParser(cronx.StandardParser()))
+
+		err := s.registerJobs(context.Background())
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "게시글 삭제 스케줄 등록 실패")
+	})
+}
+
+// newPurgeNotifyServer 알림 요청 본문을 기록하는 가짜 알림 서버와 그 서버를 바라보는 알림 클라이언트를 생성합니다.
+func newPurgeNotifyServer(t *testing.T) (*notify.Client, *[]string) {
+	t.Helper()
+
+	var bodies []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(ts.Close)
+
+	client, err := notify.NewClient(&notify.Config{URL: ts.URL, AppKey: "test", ApplicationID: "test"})
+	require.NoError(t, err)
+
+	return client, &bodies
+}
+
+func TestLogPurgeResults_Notify(t *testing.T) {
+	t.Run("게시글이 삭제되면 요약을 알림으로 전송", func(t *testing.T) {
+		client, bodies := newPurgeNotifyServer(t)
+
+		LogPurgeResults(context.Background(), client, []*feed.PurgeResult{
+			{ProviderID: "p-1", BoardID: "b-1", Expired: 3, Overflow: 2},
+			{ProviderID: "p-1", Expired: 1},
+		}, nil)
+
+		require.Len(t, *bodies, 1)
+		assert.Contains(t, (*bodies)[0], "총 6건 삭제")
+		assert.Contains(t, (*bodies)[0], "p-1/b-1: 5건")
+		assert.Contains(t, (*bodies)[0], "p-1/(설정에 없는 게시판): 1건")
+	})
+
+	t.Run("삭제된 게시글이 없으면 알리지 않음", func(t *testing.T) {
+		client, bodies := newPurgeNotifyServer(t)
+
+		LogPurgeResults(context.Background(), client, nil, nil)
+
+		assert.Empty(t, *bodies)
+	})
+
+	t.Run("삭제 작업이 실패하면 삭제 내역과 함께 오류를 알림", func(t *testing.T) {
+		client, bodies := newPurgeNotifyServer(t)
+
+		LogPurgeResults(context.Background(), client, nil, errors.New("mock purge error"))
+
+		require.Len(t, *bodies, 1)
+		assert.Contains(t, (*bodies)[0], "총 0건 삭제")
+		assert.Contains(t, (*bodies)[0], "mock purge error")
+	})
+
+	t.Run("알림 클라이언트가 없으면 로그만 남김", func(t *testing.T) {
+		assert.NotPanics(t, func() {
+			LogPurgeResults(context.Background(), nil, []*feed.PurgeResult{{ProviderID: "p-1", Expired: 1}}, errors.New("mock purge error"))
+		})
+	})
+}
+
+func TestPurgeSummary_LimitsBoards(t *testing.T) {
+	results := make([]*feed.PurgeResult, 0, purgeNotifyMaxBoards+3)
+	for i := range purgeNotifyMaxBoards + 3 {
+		results = append(results, &feed.PurgeResult{ProviderID: "p-1", BoardID: fmt.Sprintf("b-%d", i), Expired: 1})
+	}
+
+	summary := purgeSummary(results, int64(len(results)), 0)
+
+	assert.Contains(t, summary, fmt.Sprintf("b-%d: 1건", purgeNotifyMaxBoards-1))
+	assert.NotContains(t, summary, fmt.Sprintf("b-%d: 1건", purgeNotifyMaxBoards))
+	assert.Contains(t, summary, "외 3개 게시판")
+}
+
+func TestService_purge(t *testing.T) {
+	t.Run("설정된 공급자 목록으로 삭제를 수행", func(t *testing.T) {
+		repo := &mockPurgingFeedRepo{
+			results: []*feed.PurgeResult{
+				{ProviderID: "p-1", BoardID: "b-1", Expired: 3, Overflow: 2},
+				{ProviderID: "p-1", Expired: 1},
+			},
+		}
+		cfg := &config.RSSFeedConfig{Providers: []*config.ProviderConfig{{ID: "p-1"}}}
+		s := NewService(cfg, repo, nil)
+
+		assert.NotPanics(t, func() { s.purge(context.Background(), repo) })
+		assert.Equal(t, cfg.Providers, repo.providers)
+	})
+
+	t.Run("일부 공급자의 삭제가 실패해도 패닉 없이 결과를 보고", func(t *testing.T) {
+		repo := &mockPurgingFeedRepo{
+			results: []*feed.PurgeResult{{ProviderID: "p-1", BoardID: "b-1", Expired: 1}},
+			err:     errors.New("mock purge error"),
+		}
+		s := NewService(&config.RSSFeedConfig{}, repo, nil)
+
+		assert.NotPanics(t, func() { s.purge(context.Background(), repo) })
+	})
+
+	t.Run("삭제 결과를 서비스의 알림 클라이언트로 전송", func(t *testing.T) {
+		client, bodies := newPurgeNotifyServer(t)
+		repo := &mockPurgingFeedRepo{results: []*feed.PurgeResult{{ProviderID: "p-1", BoardID: "b-1", Overflow: 4}}}
+		s := NewService(&config.RSSFeedConfig{}, repo, client)
+
+		s.purge(context.Background(), repo)
+
+		require.Len(t, *bodies, 1)
+		assert.Contains(t, (*bodies)[0], "보관 개수 초과 4건")
+	})
+}
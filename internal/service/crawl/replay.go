@@ -0,0 +1,52 @@
+package crawl
+
+import (
+	"github.com/darkkaiser/rss-feed-server/internal/config"
+	apperrors "github.com/darkkaiser/rss-feed-server/internal/errors"
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+	"github.com/darkkaiser/rss-feed-server/internal/service/crawl/provider"
+)
+
+// ReplayParseSnapshot 저장된 파싱 실패 스냅샷을 해당 공급자 크롤러의 현재 목록 파서에 다시 통과시킨 결과를 반환합니다.
+//
+// 재생은 네트워크 요청 없이 스냅샷 본문만으로 수행되므로, 스케줄러의 실행 여부(Start 호출 여부)와 관계없이
+// 설정 정보로 크롤러 인스턴스를 새로 생성하여 사용합니다.
+func (s *Service) ReplayParseSnapshot(snapshot *feed.ParseSnapshot) (*feed.ParseReplayResult, error) {
+	if snapshot == nil {
+		return nil, apperrors.New(apperrors.InvalidInput, "재생할 스냅샷이 지정되지 않았습니다")
+	}
+
+	var p *config.ProviderConfig
+	for _, candidate := range s.cfg.Providers {
+		if candidate.ID == snapshot.ProviderID {
+			p = candidate
+			break
+		}
+	}
+	if p == nil {
+		return nil, apperrors.Newf(apperrors.NotFound, "스냅샷의 공급자('%s')가 현재 설정에 존재하지 않아 재생할 수 없습니다", snapshot.ProviderID)
+	}
+
+	cfg, err := provider.Lookup(config.ProviderSite(p.Site))
+	if err != nil {
+		return nil, err
+	}
+
+	crawler, err := cfg.NewCrawler(provider.NewCrawlerParams{
+		ProviderID:   p.ID,
+		Config:       p.Config,
+		Fetcher:      s.fetcherFor(p),
+		FeedRepo:     s.feedRepo,
+		NotifyClient: s.notifyClient,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, apperrors.Internal, "스냅샷 재생을 위한 크롤러 인스턴스 생성 실패 (대상 Site: %s, 식별자: %s)", p.Site, p.ID)
+	}
+
+	replayer, ok := crawler.(provider.ParseSnapshotReplayer)
+	if !ok {
+		return nil, apperrors.Newf(apperrors.Unavailable, "공급자('%s')의 크롤러는 스냅샷 재생을 지원하지 않습니다", p.ID)
+	}
+
+	return replayer.ReplayParseSnapshot(snapshot)
+}
@@ -0,0 +1,55 @@
+package crawl
+
+import (
+	"testing"
+
+	"github.com/darkkaiser/rss-feed-server/internal/config"
+	apperrors "github.com/darkkaiser/rss-feed-server/internal/errors"
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockReplayingCrawler는 provider.ParseSnapshotReplayer를 함께 구현하는 테스트용 크롤러입니다.
+type mockReplayingCrawler struct {
+	mockCrawler
+}
+
+func (m *mockReplayingCrawler) ReplayParseSnapshot(snapshot *feed.ParseSnapshot) (*feed.ParseReplayResult, error) {
+	return &feed.ParseReplayResult{
+		Articles: []*feed.Article{{BoardID: snapshot.BoardID, ArticleID: "1", Title: string(snapshot.Body)}},
+	}, nil
+}
+
+func TestService_ReplayParseSnapshot(t *testing.T) {
+	s := NewService(&config.RSSFeedConfig{
+		Providers: []*config.ProviderConfig{
+			{Site: "replaying_site", ID: "p-replay", Config: &config.ProviderDetailConfig{}},
+			{Site: "test_site_success", ID: "p-plain", Config: &config.ProviderDetailConfig{}},
+		},
+	}, &mockFeedRepo{}, nil)
+
+	t.Run("성공: 공급자 크롤러의 파서로 스냅샷을 재생", func(t *testing.T) {
+		result, err := s.ReplayParseSnapshot(&feed.ParseSnapshot{ProviderID: "p-replay", BoardID: "b1", Body: []byte("본문")})
+		require.NoError(t, err)
+		require.Len(t, result.Articles, 1)
+		assert.Equal(t, "본문", result.Articles[0].Title)
+	})
+
+	t.Run("실패: 설정에 없는 공급자", func(t *testing.T) {
+		_, err := s.ReplayParseSnapshot(&feed.ParseSnapshot{ProviderID: "unknown"})
+		require.Error(t, err)
+		assert.True(t, apperrors.Is(err, apperrors.NotFound))
+	})
+
+	t.Run("실패: 재생을 지원하지 않는 크롤러", func(t *testing.T) {
+		_, err := s.ReplayParseSnapshot(&feed.ParseSnapshot{ProviderID: "p-plain"})
+		require.Error(t, err)
+		assert.True(t, apperrors.Is(err, apperrors.Unavailable))
+	})
+
+	t.Run("실패: 스냅샷 누락", func(t *testing.T) {
+		_, err := s.ReplayParseSnapshot(nil)
+		assert.True(t, apperrors.Is(err, apperrors.InvalidInput))
+	})
+}
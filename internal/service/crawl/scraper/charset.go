@@ -0,0 +1,254 @@
+package scraper
+
+import (
+	"bytes"
+	"mime"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	applog "github.com/darkkaiser/notify-server/pkg/log"
+	"golang.org/x/net/html/charset"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/korean"
+	"golang.org/x/text/encoding/unicode"
+)
+
+// charsetSource 문자 인코딩을 어떤 근거로 결정했는지 나타냅니다.
+type charsetSource string
+
+const (
+	// charsetSourceOverride 공급자 설정(charset)으로 지정된 인코딩
+	charsetSourceOverride charsetSource = "override"
+
+	// charsetSourceBOM 본문 맨 앞의 바이트 순서 표시(BOM)
+	charsetSourceBOM charsetSource = "bom"
+
+	// charsetSourceHeader Content-Type 헤더의 charset 파라미터
+	charsetSourceHeader charsetSource = "header"
+
+	// charsetSourceMeta HTML <meta charset>, <meta http-equiv="Content-Type"> 또는 XML 선언의 encoding 속성
+	charsetSourceMeta charsetSource = "meta"
+
+	// charsetSourceSniff 선언된 인코딩이 없거나 실제 바이트와 맞지 않아 본문 바이트를 분석하여 추정한 인코딩
+	charsetSourceSniff charsetSource = "sniff"
+)
+
+// charsetSniffSize 바이트 분석과 <meta> 탐색에 사용할 본문 앞부분의 최대 크기입니다.
+//
+// <meta> 태그는 HTML 명세상 문서 앞 1024바이트 안에 있어야 하지만, 실제 국내 사이트는 긴 스크립트 뒤에 두는 경우가 많아 넉넉하게 잡습니다.
+const charsetSniffSize = 64 * 1024
+
+var (
+	// metaCharsetPattern <meta charset="euc-kr">와 <meta http-equiv="Content-Type" content="text/html; charset=euc-kr">를 모두 찾습니다.
+	metaCharsetPattern = regexp.MustCompile(`(?i)<meta[^>]*?charset\s*=\s*["']?\s*([a-z0-9_:.\-]+)`)
+
+	// xmlEncodingPattern <?xml version="1.0" encoding="euc-kr"?> 선언의 encoding 속성을 찾습니다.
+	xmlEncodingPattern = regexp.MustCompile(`(?i)^\s*<\?xml[^>]*?encoding\s*=\s*["']([a-z0-9_:.\-]+)["']`)
+)
+
+// charsetDetection 응답 본문의 문자 인코딩 감지 결과입니다.
+type charsetDetection struct {
+	// Encoding 본문을 UTF-8로 변환할 때 사용할 인코딩입니다.
+	Encoding encoding.Encoding
+
+	// Name 감지된 인코딩의 표준 이름입니다. (예: "utf-8", "euc-kr")
+	Name string
+
+	// Source 인코딩을 결정한 근거입니다.
+	Source charsetSource
+
+	// Declared 헤더나 <meta>에 선언되었지만 실제 바이트와 맞지 않거나 알 수 없는 이름이어서 무시한 인코딩 이름입니다.
+	// 비어 있지 않으면 사이트가 잘못된 charset을 선언하고 있다는 뜻이므로, 호출자는 경고 로그를 남깁니다.
+	Declared string
+}
+
+// Mislabeled 선언된 인코딩을 무시하고 다른 인코딩을 사용했는지 여부를 반환합니다.
+func (d charsetDetection) Mislabeled() bool {
+	return d.Declared != ""
+}
+
+// detectCharset 응답 본문의 문자 인코딩을 감지합니다.
+//
+// 국내 공공기관·학교 사이트는 CP949(EUC-KR 확장)로 작성된 페이지를 charset 없이, 또는 "utf-8"이나 "iso-8859-1"로
+// 잘못 선언하여 제공하는 경우가 많습니다. 선언을 그대로 믿으면 한글이 모두 깨지므로, 아래 순서로 인코딩을 결정하되
+// 헤더와 <meta>의 선언은 실제 바이트와 모순되지 않는 경우에만 받아들입니다.
+//
+//  1. 공급자 설정(override): 운영자가 지정한 인코딩을 무조건 사용합니다.
+//  2. BOM: UTF-8/UTF-16 BOM이 있으면 그 인코딩을 사용합니다. (BOM은 변환 과정에서 제거됩니다)
+//  3. Content-Type 헤더의 charset 파라미터
+//  4. <meta> 태그 또는 XML 선언 (헤더에 charset이 없을 때만)
+//  5. 바이트 분석: 유효한 UTF-8이면 UTF-8, 한글 CP949로 해석되면 EUC-KR, 둘 다 아니면 windows-1252
+//
+// 매개변수:
+//   - body: 응답 본문 (앞부분 charsetSniffSize 바이트만 분석합니다)
+//   - contentType: HTTP 응답의 Content-Type 헤더 (빈 문자열 가능)
+//   - override: 공급자 설정으로 지정된 WHATWG 인코딩 이름 또는 별칭 (빈 문자열이면 자동 감지, CP949는 "euc-kr" 또는 "windows-949")
+func detectCharset(body []byte, contentType, override string) charsetDetection {
+	// 1. 공급자 설정
+	if override != "" {
+		if enc, name := charset.Lookup(override); enc != nil {
+			return charsetDetection{Encoding: enc, Name: name, Source: charsetSourceOverride}
+		}
+	}
+
+	// 2. BOM
+	switch {
+	case bytes.HasPrefix(body, []byte{0xEF, 0xBB, 0xBF}):
+		return charsetDetection{Encoding: unicode.UTF8BOM, Name: "utf-8", Source: charsetSourceBOM}
+	case bytes.HasPrefix(body, []byte{0xFE, 0xFF}):
+		return charsetDetection{Encoding: unicode.UTF16(unicode.BigEndian, unicode.ExpectBOM), Name: "utf-16be", Source: charsetSourceBOM}
+	case bytes.HasPrefix(body, []byte{0xFF, 0xFE}):
+		return charsetDetection{Encoding: unicode.UTF16(unicode.LittleEndian, unicode.ExpectBOM), Name: "utf-16le", Source: charsetSourceBOM}
+	}
+
+	// 분석 범위를 넘는 본문은 앞부분만 분석하며, 이때 범위 끝에서 잘린 멀티바이트 문자는 판별에서 제외합니다.
+	sample, truncated := body, false
+	if len(sample) > charsetSniffSize {
+		sample, truncated = sample[:charsetSniffSize], true
+	}
+
+	// 3~4. 헤더 또는 <meta>에 선언된 인코딩
+	var declared string
+	source := charsetSourceHeader
+	if _, params, err := mime.ParseMediaType(contentType); err == nil {
+		declared = strings.TrimSpace(params["charset"])
+	}
+	if declared == "" {
+		declared, source = declaredMarkupCharset(sample), charsetSourceMeta
+	}
+
+	if declared != "" {
+		if enc, name := charset.Lookup(declared); enc != nil && charsetMatches(name, sample, truncated) {
+			return charsetDetection{Encoding: enc, Name: name, Source: source}
+		}
+	}
+
+	// 5. 바이트 분석
+	detection := charsetDetection{Source: charsetSourceSniff, Declared: declared}
+	switch {
+	case validUTF8(sample, truncated):
+		detection.Encoding, detection.Name = encoding.Nop, "utf-8"
+	case looksLikeCP949(sample, truncated):
+		detection.Encoding, detection.Name = korean.EUCKR, "euc-kr"
+	default:
+		detection.Encoding, detection.Name = charset.Lookup("windows-1252")
+	}
+
+	// 선언된 이름이 분석 결과와 같은 인코딩이면(예: "ks_c_5601-1987"을 선언했는데 판별 결과도 euc-kr) 잘못된 선언이 아닙니다.
+	if _, name := charset.Lookup(declared); name == detection.Name {
+		detection.Declared = ""
+	}
+
+	return detection
+}
+
+// declaredMarkupCharset 본문의 XML 선언 또는 <meta> 태그에 선언된 인코딩 이름을 반환합니다. 없으면 빈 문자열을 반환합니다.
+func declaredMarkupCharset(sample []byte) string {
+	if m := xmlEncodingPattern.FindSubmatch(sample); m != nil {
+		return string(m[1])
+	}
+	if m := metaCharsetPattern.FindSubmatch(sample); m != nil {
+		return string(m[1])
+	}
+	return ""
+}
+
+// charsetMatches 선언된 인코딩(name)이 실제 바이트(sample)와 모순되지 않는지 확인합니다.
+//
+//   - utf-8 선언: 유효한 UTF-8이 아니면서 CP949 한글로 해석될 때만 잘못된 선언입니다.
+//     UTF-8 페이지에 깨진 바이트가 몇 개 섞인 것만으로 선언을 버리면 windows-1252로 해석되어 한글 전체가 깨지기 때문입니다.
+//   - euc-kr 선언: 한글이 포함된 유효한 UTF-8이면 사이트를 UTF-8로 전환하면서 선언을 고치지 않은 것입니다.
+//   - 서유럽 단일 바이트 인코딩 선언: 실제로는 CP949 한글이면 잘못된 선언입니다. (서버 기본값 iso-8859-1이 그대로 나가는 경우)
+//
+// 그 밖의 인코딩은 바이트만으로 판별하기 어려우므로 선언을 그대로 믿습니다.
+func charsetMatches(name string, sample []byte, truncated bool) bool {
+	switch name {
+	case "utf-8":
+		return validUTF8(sample, truncated) || !looksLikeCP949(sample, truncated)
+	case "euc-kr":
+		return !hasHighBit(sample) || !validUTF8(sample, truncated)
+	case "windows-1252", "iso-8859-1", "iso-8859-15":
+		return !looksLikeCP949(sample, truncated)
+	default:
+		return true
+	}
+}
+
+// validUTF8 본문 앞부분이 유효한 UTF-8인지 확인합니다. truncated가 true이면 분석 범위의 끝에서 잘린 멀티바이트 문자는 무시합니다.
+func validUTF8(sample []byte, truncated bool) bool {
+	for i := len(sample) - 1; truncated && i >= 0 && i > len(sample)-utf8.UTFMax; i-- {
+		if sample[i] < utf8.RuneSelf {
+			break
+		}
+		if utf8.RuneStart(sample[i]) {
+			if !utf8.FullRune(sample[i:]) {
+				sample = sample[:i]
+			}
+			break
+		}
+	}
+
+	return utf8.Valid(sample)
+}
+
+// looksLikeCP949 본문 앞부분이 CP949로 인코딩된 한글 텍스트로 보이는지 확인합니다.
+//
+// CP949로 변환했을 때 잘못된 바이트 시퀀스가 없고, ASCII가 아닌 문자의 절반 이상이 한글 음절이면 한글 텍스트로 판단합니다.
+// 한자나 특수 기호만으로 이루어진 본문은 드물고, 라틴 문자 인코딩의 바이트열은 이 조건을 만족하기 어렵습니다.
+func looksLikeCP949(sample []byte, truncated bool) bool {
+	if !hasHighBit(sample) {
+		return false
+	}
+
+	decoded, err := korean.EUCKR.NewDecoder().Bytes(sample)
+	if err != nil {
+		return false
+	}
+
+	// 분석 범위의 끝에서 2바이트 문자가 잘리면 마지막 한 글자만 대체 문자가 되므로 제외합니다.
+	if truncated {
+		decoded = bytes.TrimSuffix(decoded, []byte(string(utf8.RuneError)))
+	}
+
+	var nonASCII, hangul int
+	for _, r := range string(decoded) {
+		switch {
+		case r == utf8.RuneError:
+			return false
+		case r >= 0xAC00 && r <= 0xD7A3:
+			hangul++
+			nonASCII++
+		case r >= utf8.RuneSelf:
+			nonASCII++
+		}
+	}
+
+	return hangul > 0 && hangul*2 >= nonASCII
+}
+
+func hasHighBit(sample []byte) bool {
+	for _, b := range sample {
+		if b >= utf8.RuneSelf {
+			return true
+		}
+	}
+	return false
+}
+
+// detectCharset 스크래퍼의 인코딩 설정(charsetOverride)을 반영하여 본문의 문자 인코딩을 감지하고,
+// 선언된 charset이 실제 바이트와 맞지 않으면 경고 로그를 남깁니다.
+func (s *scraper) detectCharset(body []byte, contentType string, logger *applog.Entry) charsetDetection {
+	cs := detectCharset(body, contentType, s.charsetOverride)
+
+	if cs.Mislabeled() {
+		logger.WithFields(applog.Fields{
+			"content_type":     contentType,
+			"declared_charset": cs.Declared,
+			"detected_charset": cs.Name,
+			"charset_source":   cs.Source,
+		}).Warn("[주의]: 선언된 문자 인코딩이 실제 본문과 일치하지 않아 본문 분석 결과로 변환합니다")
+	}
+
+	return cs
+}
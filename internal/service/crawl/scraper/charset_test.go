@@ -0,0 +1,189 @@
+package scraper
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/darkkaiser/rss-feed-server/internal/service/crawl/fetcher/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// readCharsetFixture testdata/charset 디렉터리의 픽스처 파일을 읽습니다.
+//
+// 픽스처는 CP949 전용 음절("똠")을 포함한 실제 국내 사이트 형태의 문서이며,
+// 헤더나 <meta>에 charset을 잘못 선언하거나 아예 선언하지 않은 경우를 재현합니다.
+func readCharsetFixture(t *testing.T, name string) []byte {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Join("testdata", "charset", name))
+	require.NoError(t, err)
+	return data
+}
+
+func TestDetectCharset(t *testing.T) {
+	mislabeledHTML := readCharsetFixture(t, "mislabeled_cp949.html")
+	unlabeledHTML := readCharsetFixture(t, "unlabeled_cp949.html")
+	mislabeledJSON := readCharsetFixture(t, "mislabeled_cp949.json")
+	invalidByteHTML := readCharsetFixture(t, "utf8_invalid_byte.html")
+	utf8HTML := []byte(`<html><head><meta charset="euc-kr"></head><body>한글</body></html>`)
+
+	tests := []struct {
+		name         string
+		body         []byte
+		contentType  string
+		override     string
+		wantName     string
+		wantSource   charsetSource
+		wantDeclared string
+	}{
+		{"공급자 설정 우선", []byte("abc"), "text/html; charset=utf-8", "windows-949", "euc-kr", charsetSourceOverride, ""},
+		{"알 수 없는 공급자 설정은 무시", []byte("abc"), "text/html; charset=utf-8", "unknown-xyz", "utf-8", charsetSourceHeader, ""},
+		{"UTF-8 BOM", []byte("\xEF\xBB\xBF<html></html>"), "text/html; charset=euc-kr", "", "utf-8", charsetSourceBOM, ""},
+		{"UTF-16LE BOM", []byte{0xFF, 0xFE, '<', 0}, "", "", "utf-16le", charsetSourceBOM, ""},
+		{"헤더 선언", []byte("<html></html>"), "text/html; charset=EUC-KR", "", "euc-kr", charsetSourceHeader, ""},
+		{"헤더 별칭 선언", []byte("<html></html>"), "text/html; charset=ks_c_5601-1987", "", "euc-kr", charsetSourceHeader, ""},
+		{"헤더가 없으면 meta 선언", []byte(`<meta http-equiv="Content-Type" content="text/html; charset=euc-kr">`), "text/html", "", "euc-kr", charsetSourceMeta, ""},
+		{"XML 선언", []byte(`<?xml version="1.0" encoding="EUC-KR"?><rss></rss>`), "application/xml", "", "euc-kr", charsetSourceMeta, ""},
+
+		// 잘못 선언된 CP949 문서
+		{"헤더와 meta 모두 utf-8로 잘못 선언한 HTML", mislabeledHTML, "text/html; charset=utf-8", "", "euc-kr", charsetSourceSniff, "utf-8"},
+		{"헤더 없이 meta만 utf-8로 잘못 선언한 HTML", mislabeledHTML, "text/html", "", "euc-kr", charsetSourceSniff, "utf-8"},
+		{"iso-8859-1로 잘못 선언한 HTML", unlabeledHTML, "text/html; charset=iso-8859-1", "", "euc-kr", charsetSourceSniff, "iso-8859-1"},
+		{"charset 선언이 전혀 없는 HTML", unlabeledHTML, "text/html", "", "euc-kr", charsetSourceSniff, ""},
+		{"utf-8로 잘못 선언한 JSON", mislabeledJSON, "application/json; charset=utf-8", "", "euc-kr", charsetSourceSniff, "utf-8"},
+		{"charset 선언이 없는 JSON", mislabeledJSON, "application/json", "", "euc-kr", charsetSourceSniff, ""},
+
+		// 깨진 바이트가 섞인 UTF-8 문서는 CP949 한글로 해석되지 않으면 선언을 그대로 따릅니다.
+		{"깨진 바이트가 하나 섞인 UTF-8 한글 HTML", invalidByteHTML, "text/html; charset=utf-8", "", "utf-8", charsetSourceHeader, ""},
+		{"깨진 바이트가 하나 섞인 UTF-8 한글 HTML (meta 선언)", invalidByteHTML, "text/html", "", "utf-8", charsetSourceMeta, ""},
+
+		// UTF-8로 전환하면서 선언을 고치지 않은 문서
+		{"euc-kr로 잘못 선언한 UTF-8 문서", utf8HTML, "text/html", "", "utf-8", charsetSourceSniff, "euc-kr"},
+		{"알 수 없는 선언", []byte("<html>한글</html>"), "text/html; charset=unknown-xyz", "", "utf-8", charsetSourceSniff, "unknown-xyz"},
+		{"한글이 아닌 단일 바이트 문서", []byte("caf\xE9"), "", "", "windows-1252", charsetSourceSniff, ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := detectCharset(tc.body, tc.contentType, tc.override)
+
+			require.NotNil(t, got.Encoding)
+			assert.Equal(t, tc.wantName, got.Name)
+			assert.Equal(t, tc.wantSource, got.Source)
+			assert.Equal(t, tc.wantDeclared, got.Declared)
+			assert.Equal(t, tc.wantDeclared != "", got.Mislabeled())
+		})
+	}
+}
+
+// TestDetectCharset_TruncatedSample 분석 범위 끝에서 멀티바이트 문자가 잘려도 올바르게 판별하는지 검증합니다.
+func TestDetectCharset_TruncatedSample(t *testing.T) {
+	utf8Body := []byte(strings.Repeat("가", charsetSniffSize)) // 3바이트 문자이므로 분석 범위 끝에서 잘립니다.
+	assert.Equal(t, "utf-8", detectCharset(utf8Body, "", "").Name)
+
+	cp949Body := append([]byte("a"), []byte(eucKrContent(strings.Repeat("가", charsetSniffSize)))...) // 2바이트 문자가 홀수 위치에서 잘립니다.
+	assert.Equal(t, "euc-kr", detectCharset(cp949Body, "", "").Name)
+}
+
+// TestFetchHTML_MislabeledCP949 잘못 선언된 CP949 페이지를 FetchHTML이 한글 깨짐 없이 파싱하고, 감지 결과를 기록하는지 검증합니다.
+func TestFetchHTML_MislabeledCP949(t *testing.T) {
+	for _, fixture := range []string{"mislabeled_cp949.html", "unlabeled_cp949.html"} {
+		t.Run(fixture, func(t *testing.T) {
+			m := mocks.NewMockFetcher()
+			resp := mocks.NewMockResponse(string(readCharsetFixture(t, fixture)), http.StatusOK)
+			resp.Header.Set("Content-Type", "text/html; charset=utf-8")
+			m.On("Do", mock.Anything).Return(resp, nil)
+
+			s := New(m).(*scraper)
+			doc, err := s.FetchHTMLDocument(context.Background(), "http://example.com/board", nil)
+			require.NoError(t, err)
+
+			assertCP949Board(t, doc)
+		})
+	}
+}
+
+// TestFetchHTML_UTF8WithInvalidByte 깨진 바이트가 하나 섞인 UTF-8 페이지를 windows-1252로 오인하지 않고 한글 그대로 파싱하는지 검증합니다.
+func TestFetchHTML_UTF8WithInvalidByte(t *testing.T) {
+	m := mocks.NewMockFetcher()
+	resp := mocks.NewMockResponse(string(readCharsetFixture(t, "utf8_invalid_byte.html")), http.StatusOK)
+	resp.Header.Set("Content-Type", "text/html; charset=utf-8")
+	m.On("Do", mock.Anything).Return(resp, nil)
+
+	s := New(m).(*scraper)
+	doc, err := s.FetchHTMLDocument(context.Background(), "http://example.com/board", nil)
+	require.NoError(t, err)
+
+	assertCP949Board(t, doc)
+}
+
+// TestParseHTML_CharsetOverride 공급자 설정으로 지정한 인코딩이 선언과 바이트 분석보다 우선하는지 검증합니다.
+func TestParseHTML_CharsetOverride(t *testing.T) {
+	data := readCharsetFixture(t, "mislabeled_cp949.html")
+
+	doc, err := New(mocks.NewMockFetcher(), WithCharset("euc-kr")).ParseHTML(context.Background(), strings.NewReader(string(data)), "", "text/html; charset=utf-8")
+	require.NoError(t, err)
+	assertCP949Board(t, doc)
+
+	// 반대로 잘못된 인코딩을 강제하면 그대로 적용되어 한글이 깨집니다.
+	doc, err = New(mocks.NewMockFetcher(), WithCharset("windows-1252")).ParseHTML(context.Background(), strings.NewReader(string(data)), "", "")
+	require.NoError(t, err)
+	assert.NotEqual(t, "여수시청 공지사항", doc.Find("title").Text())
+}
+
+// TestExecuteRequest_RecordsCharset executeRequest가 감지한 인코딩을 fetchResult에 기록하는지 검증합니다.
+func TestExecuteRequest_RecordsCharset(t *testing.T) {
+	m := mocks.NewMockFetcher()
+	resp := mocks.NewMockResponse(string(readCharsetFixture(t, "mislabeled_cp949.json")), http.StatusOK)
+	resp.Header.Set("Content-Type", "application/json; charset=utf-8")
+	m.On("Do", mock.Anything).Return(resp, nil)
+
+	s := New(m).(*scraper)
+	result, _, err := s.executeRequest(context.Background(), requestParams{Method: http.MethodGet, URL: "http://example.com/api"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "euc-kr", result.Charset.Name)
+	assert.Equal(t, charsetSourceSniff, result.Charset.Source)
+	assert.Equal(t, "utf-8", result.Charset.Declared)
+}
+
+// TestFetchJSON_MislabeledCP949 utf-8로 잘못 선언된 CP949 JSON 응답을 FetchJSON이 올바르게 디코딩하는지 검증합니다.
+func TestFetchJSON_MislabeledCP949(t *testing.T) {
+	m := mocks.NewMockFetcher()
+	resp := mocks.NewMockResponse(string(readCharsetFixture(t, "mislabeled_cp949.json")), http.StatusOK)
+	resp.Header.Set("Content-Type", "application/json; charset=utf-8")
+	m.On("Do", mock.Anything).Return(resp, nil)
+
+	var target struct {
+		Result struct {
+			Articles []struct {
+				ID      int    `json:"id"`
+				Subject string `json:"subject"`
+				Writer  string `json:"writer"`
+			} `json:"articles"`
+		} `json:"result"`
+	}
+
+	err := New(m).FetchJSON(context.Background(), http.MethodGet, "http://example.com/api", nil, nil, &target)
+	require.NoError(t, err)
+
+	require.Len(t, target.Result.Articles, 2)
+	assert.Equal(t, "똠방각하 민원 처리 안내", target.Result.Articles[0].Subject)
+	assert.Equal(t, "교무실", target.Result.Articles[1].Writer)
+}
+
+func assertCP949Board(t *testing.T, doc *goquery.Document) {
+	t.Helper()
+
+	assert.Equal(t, "여수시청 공지사항", doc.Find("title").Text())
+
+	subjects := doc.Find("td.subject").Map(func(_ int, s *goquery.Selection) string { return s.Text() })
+	assert.Equal(t, []string{"똠방각하 민원 처리 안내", "쌍봉초등학교 학부모 공개수업"}, subjects)
+}
@@ -58,6 +58,41 @@ func newErrUnexpectedHTMLResponse(url, contentType string) error {
 	return apperrors.New(apperrors.InvalidInput, fmt.Sprintf("응답 형식 오류: JSON 대신 HTML이 반환되었습니다 (URL: %s, Content-Type: %s)", url, contentType))
 }
 
+// ━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━
+// XML 응답 처리 에러 (XML Response Handling)
+// ━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━
+
+// newErrXMLParseFailed XML 바이트 스트림을 Go 구조체로 역직렬화하는 과정에서 구문 오류가 발생했을 때 에러를 생성합니다.
+//
+// 매개변수:
+//   - cause: xml.Decoder.Decode()가 반환한 원본 에러 (xml.SyntaxError 등)
+//   - url: 요청을 보낸 대상 URL (에러 발생 위치 추적용)
+//   - line: XML 구문 오류가 발생한 줄 번호 (xml.SyntaxError.Line, 0이면 위치 정보 없음)
+//
+// 반환값: apperrors.ParsingFailed 타입의 에러
+func newErrXMLParseFailed(cause error, url string, line int) error {
+	m := fmt.Sprintf("XML 파싱 실패: 구문 오류로 인해 응답 데이터를 변환할 수 없습니다 (URL: %s)", url)
+
+	if line > 0 {
+		m += fmt.Sprintf(" - 오류 위치: %d번째 줄", line)
+	}
+
+	return apperrors.Wrap(cause, apperrors.ParsingFailed, m)
+}
+
+// newErrGzipDecompressFailed gzip 매직 넘버로 시작하는 응답 본문의 압축을 해제하지 못했을 때 에러를 생성합니다.
+//
+// 전송 중 파일이 잘렸거나, 확장자만 .gz이고 실제로는 손상된 파일을 제공하는 경우에 발생합니다.
+//
+// 매개변수:
+//   - cause: gzip.Reader가 반환한 원본 에러
+//   - url: 요청을 보낸 대상 URL (에러 발생 위치 추적용)
+//
+// 반환값: apperrors.ParsingFailed 타입의 에러
+func newErrGzipDecompressFailed(cause error, url string) error {
+	return apperrors.Wrap(cause, apperrors.ParsingFailed, fmt.Sprintf("압축 해제 실패: gzip 형식의 응답 본문을 해제할 수 없습니다 (URL: %s)", url))
+}
+
 // ━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━
 // HTML 파싱 에러 (HTML Parsing)
 // ━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━
@@ -103,6 +138,28 @@ func newErrReadHTMLInput(cause error) error {
 	return apperrors.Wrap(cause, apperrors.Unavailable, "HTML 입력 데이터 읽기 실패: 데이터 스트림을 읽는 중 I/O 오류가 발생했습니다")
 }
 
+// ━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━
+// 스크립트 데이터 추출 에러 (Script Data Extraction)
+// ━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━
+
+// newErrScriptDataNotFound 조건(ScriptQuery)에 맞는 <script> 태그나 그 안의 데이터를 찾을 수 없을 때 발생하는 에러를 생성합니다.
+//
+// NewErrHTMLStructureChanged와 마찬가지로 네트워크 장애가 아닌 사이트 개편(데이터 변수 이름 변경, 렌더링 방식 변경)을 의미하므로,
+// 재시도하지 않고 ScriptQuery 설정을 점검해야 합니다.
+//
+// 매개변수:
+//   - url: 데이터를 찾지 못한 페이지 URL (빈 문자열 가능)
+//   - query: 탐색에 사용한 조건의 요약 문자열 (ScriptQuery.String)
+//
+// 반환값: apperrors.ExecutionFailed 타입의 에러
+func newErrScriptDataNotFound(url, query string) error {
+	m := fmt.Sprintf("스크립트 데이터 없음: 조건(%s)에 맞는 <script> 데이터를 찾을 수 없습니다", query)
+	if url != "" {
+		m += fmt.Sprintf(" (URL: %s)", url)
+	}
+	return apperrors.New(apperrors.ExecutionFailed, m)
+}
+
 // ━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━
 // HTTP 요청 및 네트워크 에러 (HTTP Request & Network)
 // ━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━
@@ -318,6 +375,10 @@ var ErrInputReaderNil = apperrors.New(apperrors.Internal, "HTML 파싱 실패: 
 // ParseHTML 함수 호출 시 io.Reader 파라미터 r이 포인터 타입이면서 nil 값을 가진 경우 입력 검증 단계에서 즉시 반환되며, 런타임 패닉을 방지합니다.
 var ErrInputReaderTypedNil = apperrors.New(apperrors.Internal, "HTML 파싱 실패: 입력 데이터 스트림이 Typed Nil입니다")
 
+// ErrDocumentNil 스크립트 데이터를 추출할 HTML 문서가 제공되지 않았을 때 반환되는 에러입니다.
+// ParseScriptData 함수 호출 시 doc 파라미터가 nil인 경우 입력 검증 단계에서 즉시 반환됩니다.
+var ErrDocumentNil = apperrors.New(apperrors.Internal, "스크립트 데이터 추출 실패: HTML 문서가 nil입니다")
+
 // ━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━
 // 응답 검증 에러 (Response Validation)
 // ━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━
@@ -1,7 +1,6 @@
 package scraper
 
 import (
-	"bufio"
 	"bytes"
 	"context"
 	"errors"
@@ -14,7 +13,6 @@ import (
 
 	"github.com/PuerkitoBio/goquery"
 	applog "github.com/darkkaiser/notify-server/pkg/log"
-	"golang.org/x/net/html/charset"
 )
 
 // FetchHTML 지정된 URL로 HTTP 요청을 보내 HTML 문서를 가져오고, 파싱된 goquery.Document를 반환합니다.
@@ -93,7 +91,7 @@ func (s *scraper) FetchHTML(ctx context.Context, method, rawURL string, body io.
 		"body_size":   len(result.Body),
 	}).Debug("[성공]: HTML 요청 완료, 파싱 단계 진입")
 
-	// 6단계: Content-Type 추출 (8단계의 HTML 파싱 시 Charset 변환을 위한 힌트)
+	// 6단계: Content-Type 추출 (로깅용, 인코딩은 executeRequest에서 이미 감지되어 result.Charset에 기록되어 있습니다)
 	contentType := result.Response.Header.Get("Content-Type")
 
 	// 7단계: 문서 URL 결정 (상대 경로 해석용)
@@ -117,8 +115,8 @@ func (s *scraper) FetchHTML(ctx context.Context, method, rawURL string, body io.
 	//  - result.Response.Body: executeRequest에서 이미 메모리로 읽어들인 응답 본문 (NopCloser로 래핑된 bytes.Reader)
 	//  - contextAwareReader 래핑: 파싱 도중 Context가 취소되면 작업을 즉시 중단합니다.
 	//  - baseURL: HTML 내의 상대 경로(href="/...")를 절대 경로로 변환하기 위한 기준 URL입니다.
-	//  - contentType: 응답 헤더의 Charset 정보를 기반으로 인코딩을 자동 변환(예: EUC-KR → UTF-8)합니다.
-	doc, err := s.parseHTML(ctx, &contextAwareReader{ctx: ctx, r: result.Response.Body}, baseURL, contentType, logger)
+	//  - result.Charset: 헤더, <meta> 선언과 본문 바이트 분석으로 감지한 인코딩을 UTF-8로 자동 변환(예: EUC-KR → UTF-8)합니다.
+	doc, err := s.parseHTML(ctx, &contextAwareReader{ctx: ctx, r: result.Response.Body}, baseURL, result.Charset)
 	if err != nil {
 		// 컨텍스트 취소/타임아웃 에러는 래핑하지 않고 그대로 반환
 		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
@@ -138,9 +136,11 @@ func (s *scraper) FetchHTML(ctx context.Context, method, rawURL string, body io.
 	}
 
 	logger.WithFields(applog.Fields{
-		"status_code":  result.Response.StatusCode,
-		"content_type": contentType,
-		"body_size":    len(result.Body),
+		"status_code":    result.Response.StatusCode,
+		"content_type":   contentType,
+		"charset":        result.Charset.Name,
+		"charset_source": result.Charset.Source,
+		"body_size":      len(result.Body),
 	}).Debug("[성공]: HTML 요청 및 파싱 완료")
 
 	return doc, nil
@@ -270,11 +270,14 @@ func (s *scraper) ParseHTML(ctx context.Context, r io.Reader, rawURL string, con
 	// 이미 메모리에 로드된 데이터를 파싱 로직에서 재사용하기 위해 bytes.Reader로 래핑합니다.
 	parsingReader := bytes.NewReader(data)
 
+	// 메모리에 로드된 데이터로 문자 인코딩을 감지합니다. (contentType은 힌트로만 사용됩니다)
+	cs := s.detectCharset(data, contentType, logger)
+
 	// ━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━
 	// [5단계] HTML 파싱
 	// ━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━
 	// contextAwareReader로 래핑하여 파싱 중 컨텍스트 취소를 감지할 수 있도록 합니다.
-	doc, err := s.parseHTML(ctx, &contextAwareReader{ctx: ctx, r: parsingReader}, baseURL, contentType, logger)
+	doc, err := s.parseHTML(ctx, &contextAwareReader{ctx: ctx, r: parsingReader}, baseURL, cs)
 	if err != nil {
 		// 컨텍스트 취소/타임아웃 에러는 래핑하지 않고 그대로 반환
 		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
@@ -289,9 +292,11 @@ func (s *scraper) ParseHTML(ctx context.Context, r io.Reader, rawURL string, con
 	}
 
 	logger.WithFields(applog.Fields{
-		"title":        strings.TrimSpace(doc.Find("title").Text()),
-		"node_count":   doc.Find("*").Length(),
-		"has_base_url": baseURL != nil,
+		"title":          strings.TrimSpace(doc.Find("title").Text()),
+		"node_count":     doc.Find("*").Length(),
+		"has_base_url":   baseURL != nil,
+		"charset":        cs.Name,
+		"charset_source": cs.Source,
 	}).Debug("[성공]: HTML 파싱 완료, DOM 트리 구성됨")
 
 	return doc, nil
@@ -366,80 +371,30 @@ func (s *scraper) verifyHTMLContentType(resp *http.Response, url string, logger
 // parseHTML HTML 데이터를 파싱하여 goquery.Document 객체를 생성하는 내부 공통 메서드입니다.
 //
 // 이 함수는 다양한 문자 인코딩(EUC-KR, UTF-8 등)으로 작성된 HTML 문서를 안전하게 처리하기 위해
-// 인코딩 자동 감지 및 UTF-8 변환 로직을 포함하고 있습니다.
+// 호출자가 감지한 인코딩(detectCharset)으로 본문을 UTF-8로 변환한 뒤 파싱합니다.
 //
 // 매개변수:
 //   - _: 컨텍스트 (현재 사용되지 않음)
 //   - r: HTML 데이터를 읽을 io.Reader
 //   - baseURL: 파싱할 HTML 페이지의 원본 URL (상대 경로 링크를 절대 경로로 변환할 때 사용, nil일 경우 변환 건너뜀)
-//   - contentType: HTTP 응답의 Content-Type 헤더 (인코딩 감지를 위한 힌트로 사용됨, 예: "text/html; charset=euc-kr")
-//   - logger: 파싱 및 인코딩 변환 과정의 진행 상황을 기록할 로거 객체
+//   - cs: 본문의 문자 인코딩 감지 결과 (Encoding이 nil이면 UTF-8로 가정)
 //
 // 반환값:
 //   - *goquery.Document: 파싱된 HTML 문서 객체
 //   - error: 입출력 오류 또는 파싱 실패 시 에러 반환
-func (s *scraper) parseHTML(_ context.Context, r io.Reader, baseURL *url.URL, contentType string, logger *applog.Entry) (*goquery.Document, error) {
-	// ━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━
-	// [1단계] bufio.Reader로 래핑하여 Peek 기능 확보
-	// ━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━
-	// bufio.Reader는 내부적으로 4KB 버퍼를 유지하며, Peek 메서드를 통해 버퍼의 데이터를
-	// 소비하지 않고 미리 읽을 수 있습니다.
-	// 이를 통해 인코딩 감지를 위한 데이터 샘플링과 실제 파싱을 위한 전체 데이터 읽기를
-	// 동일한 Reader에서 순차적으로 수행할 수 있습니다.
-	br := bufio.NewReader(r)
-
+func (s *scraper) parseHTML(_ context.Context, r io.Reader, baseURL *url.URL, cs charsetDetection) (*goquery.Document, error) {
 	// ━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━
-	// [2단계] 인코딩 감지를 위한 데이터 샘플링
-	// ━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━
-	// HTML 문서의 인코딩 정보는 보통 문서 앞부분에 위치하므로(Content-Type 헤더 또는 <meta> 태그),
-	// 1KB 정도만 읽어도 충분히 감지할 수 있습니다.
-	// Peek은 에러가 발생해도(예: EOF로 1KB 미만만 읽힌 경우) 읽은 만큼의 데이터를 반환하므로
-	// 작은 HTML 문서도 안전하게 처리할 수 있습니다.
-	peekBytes, _ := br.Peek(1024)
-
-	// ━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━
-	// [3단계] 문자 인코딩 감지
-	// ━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━
-	// charset.DetermineEncoding은 다음 순서로 인코딩을 감지합니다:
-	//   1. 바이트 순서 표시(BOM) 감지
-	//   2. Content-Type 헤더의 charset 파라미터
-	//   3. HTML 문서 내 <meta> 태그 또는 XML 선언의 encoding 속성
-	//
-	// 반환값:
-	//   - enc: 감지된 인코딩의 encoding.Encoding 객체 (디코더 생성에 사용)
-	//   - name: 인코딩 이름 (예: "euc-kr", "utf-8")
-	//   - 에러는 무시 (감지 실패 시에도 기본 인코딩을 반환하므로 항상 처리 가능)
-	enc, name, _ := charset.DetermineEncoding(peekBytes, contentType)
-
-	// ━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━
-	// [4단계] UTF-8 변환 리더 생성
+	// [1단계] UTF-8 변환 리더 생성
 	// ━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━
 	// goquery는 UTF-8 인코딩만 지원하므로, 감지된 인코딩을 UTF-8로 변환하는 리더(transform.Reader)를 생성합니다.
-	var utf8Reader io.Reader
-	if enc != nil && name != "" {
-		// 인코딩 감지 성공: 디코더를 통해 UTF-8로 변환
-		// enc.NewDecoder().Reader(br)는 br에서 읽은 바이트를 감지된 인코딩으로 해석하여 UTF-8로 변환한 스트림을 제공합니다.
-		utf8Reader = enc.NewDecoder().Reader(br)
-	} else {
-		// DetermineEncoding은 감지에 실패하더라도 보통 기본 인코딩(windows-1252 등)을 반환합니다.
-		// 그러므로, enc가 nil이 아니라면 해당 인코딩을 사용하는 것이 안전합니다.
-		if enc != nil {
-			// 기본 인코딩 사용 (보통 windows-1252)
-			utf8Reader = enc.NewDecoder().Reader(br)
-		} else {
-			// 정말 인코딩을 알 수 없는 경우라면, UTF-8로 가정하고 원본 데이터를 그대로 사용합니다.
-			utf8Reader = br
-
-			// 만약 실제로 다른 인코딩이었다면 파싱은 성공하지만 텍스트가 깨질 수 있으므로, 경고 로그를 남겨 운영자가 문제를 인지할 수 있도록 합니다.
-			logger.WithFields(applog.Fields{
-				"content_type":      contentType,
-				"detected_encoding": name,
-			}).Warn("[경고]: 인코딩 감지 실패, UTF-8로 가정하고 진행합니다.")
-		}
+	// enc.NewDecoder().Reader(r)는 r에서 읽은 바이트를 감지된 인코딩으로 해석하여 UTF-8로 변환한 스트림을 제공합니다.
+	utf8Reader := r
+	if cs.Encoding != nil {
+		utf8Reader = cs.Encoding.NewDecoder().Reader(r)
 	}
 
 	// ━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━
-	// [5단계] HTML 파싱
+	// [2단계] HTML 파싱
 	// ━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━
 	// UTF-8로 변환된 스트림을 goquery에 전달하여 DOM 트리를 구성합니다.
 	doc, err := goquery.NewDocumentFromReader(utf8Reader)
@@ -448,7 +403,7 @@ func (s *scraper) parseHTML(_ context.Context, r io.Reader, baseURL *url.URL, co
 	}
 
 	// ━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━
-	// [6단계] 상대 경로 링크 해석을 위한 URL 정보 주입
+	// [3단계] 상대 경로 링크 해석을 위한 URL 정보 주입
 	// ━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━
 	// HTML 문서 내의 상대 경로 링크(예: <a href="/path/to/page">)를 절대 경로로 변환하려면 원본 페이지의 URL이 필요합니다.
 	// goquery.Document의 Url 필드에 원본 URL을 설정하면,
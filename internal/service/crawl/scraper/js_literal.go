@@ -0,0 +1,230 @@
+package scraper
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// jsLiteralError JavaScript 값 리터럴을 JSON으로 변환하지 못했을 때의 원인과 위치를 담는 에러입니다.
+type jsLiteralError struct {
+	// Offset 문제가 발견된 위치 (변환 대상 문자열 기준 바이트 위치)
+	Offset int
+
+	msg string
+}
+
+func (e *jsLiteralError) Error() string {
+	return fmt.Sprintf("%s (위치: %d)", e.msg, e.Offset)
+}
+
+// errJSLiteralNotFound 값의 시작 위치에 객체나 배열이 없을 때 반환되는 에러입니다.
+var errJSLiteralNotFound = errors.New("JavaScript 객체 또는 배열 리터럴이 아닙니다")
+
+// jsLiteralToJSON src[start:]에서 시작하는 JavaScript 객체/배열 리터럴 하나를 읽어 JSON 문자열로 변환합니다.
+//
+// 인라인 <script>에 담긴 데이터는 JSON처럼 보이지만 JavaScript 문법으로 작성된 경우가 많아 encoding/json으로 바로 읽을 수 없습니다.
+// 이 함수는 데이터 선언에 흔히 쓰이는 아래 문법만 JSON으로 바꾸며, 함수 호출이나 연산식처럼 값이 아닌 코드는 그대로 남겨 JSON 파싱 단계에서 에러가 나도록 합니다.
+//
+//   - 따옴표 없는 키: {id: 1}           → {"id": 1}
+//   - 작은따옴표/백틱 문자열: 'a'        → "a" (${...} 치환식이 있는 템플릿 문자열은 지원하지 않음)
+//   - 후행 쉼표: [1, 2,]                → [1, 2]
+//   - 주석: // ..., /* ... */           → 제거
+//   - undefined, NaN, Infinity          → null
+//   - 압축된 불리언: !0, !1              → true, false
+//   - \xHH 이스케이프                    → \u00HH
+//
+// 반환값:
+//   - string: 변환된 JSON 문자열
+//   - int: 리터럴이 끝난 바로 다음 위치 (src 기준)
+//   - error: 시작 위치에 객체/배열이 없거나 괄호가 닫히지 않은 경우
+func jsLiteralToJSON(src string, start int) (string, int, error) {
+	i := skipJSSpace(src, start)
+	if i >= len(src) || (src[i] != '{' && src[i] != '[') {
+		return "", i, errJSLiteralNotFound
+	}
+
+	var out strings.Builder
+	out.Grow(len(src) - i)
+
+	depth := 0
+	for i < len(src) {
+		c := src[i]
+
+		switch {
+		case c == '/' && i+1 < len(src) && (src[i+1] == '/' || src[i+1] == '*'):
+			next, err := skipJSComment(src, i)
+			if err != nil {
+				return "", i, err
+			}
+			i = next
+
+		case c == '"' || c == '\'' || c == '`':
+			s, next, err := readJSString(src, i)
+			if err != nil {
+				return "", i, err
+			}
+			i = next
+
+			// 문자열 키({'id': 1})도 큰따옴표로 바뀌므로 별도 처리가 필요 없습니다.
+			out.WriteString(s)
+
+		case c == '{' || c == '[':
+			depth++
+			out.WriteByte(c)
+			i++
+
+		case c == '}' || c == ']':
+			trimTrailingComma(&out)
+			depth--
+			out.WriteByte(c)
+			i++
+
+			if depth == 0 {
+				return out.String(), i, nil
+			}
+
+		case c == '!' && i+1 < len(src) && (src[i+1] == '0' || src[i+1] == '1'):
+			if src[i+1] == '0' {
+				out.WriteString("true")
+			} else {
+				out.WriteString("false")
+			}
+			i += 2
+
+		case isJSIdentStart(c) || isDigit(c):
+			j := i + 1
+			for j < len(src) && (isJSIdentPart(src[j]) || (isDigit(c) && src[j] == '.')) {
+				j++
+			}
+			word := src[i:j]
+			i = j
+
+			// 콜론이 뒤따르면 객체의 키이므로 큰따옴표로 감쌉니다.
+			if k := skipJSSpace(src, i); k < len(src) && src[k] == ':' {
+				out.WriteString(`"` + word + `"`)
+				continue
+			}
+
+			switch word {
+			case "undefined", "NaN", "Infinity":
+				out.WriteString("null")
+			default:
+				out.WriteString(word)
+			}
+
+		default:
+			out.WriteByte(c)
+			i++
+		}
+	}
+
+	return "", i, &jsLiteralError{Offset: len(src), msg: "JavaScript 리터럴의 괄호가 닫히지 않았습니다"}
+}
+
+// readJSString src[start]의 따옴표로 시작하는 문자열 리터럴을 읽어 JSON 문자열(큰따옴표)로 변환합니다.
+func readJSString(src string, start int) (string, int, error) {
+	quote := src[start]
+
+	var out strings.Builder
+	out.WriteByte('"')
+
+	i := start + 1
+	for i < len(src) {
+		c := src[i]
+
+		switch {
+		case c == quote:
+			out.WriteByte('"')
+			return out.String(), i + 1, nil
+
+		case c == '\\' && i+1 < len(src):
+			esc := src[i+1]
+			switch {
+			case esc == '\'' || esc == '`':
+				// JSON에는 없는 이스케이프이므로 문자 그대로 씁니다.
+				out.WriteByte(esc)
+				i += 2
+			case esc == 'x' && i+3 < len(src):
+				out.WriteString(`\u00` + src[i+2:i+4])
+				i += 4
+			case esc == '\n':
+				// 줄 이어쓰기(Line Continuation)는 아무 문자도 만들지 않습니다.
+				i += 2
+			default:
+				out.WriteByte('\\')
+				out.WriteByte(esc)
+				i += 2
+			}
+
+		case c == '"':
+			out.WriteString(`\"`)
+			i++
+
+		case c == '\n' || c == '\r' || c == '\t':
+			if quote != '`' {
+				return "", i, &jsLiteralError{Offset: i, msg: "문자열 리터럴이 줄바꿈 전에 닫히지 않았습니다"}
+			}
+			out.WriteString(map[byte]string{'\n': `\n`, '\r': `\r`, '\t': `\t`}[c])
+			i++
+
+		case quote == '`' && c == '$' && i+1 < len(src) && src[i+1] == '{':
+			return "", i, &jsLiteralError{Offset: i, msg: "치환식(${...})이 있는 템플릿 문자열은 지원하지 않습니다"}
+
+		default:
+			out.WriteByte(c)
+			i++
+		}
+	}
+
+	return "", i, &jsLiteralError{Offset: start, msg: "문자열 리터럴이 닫히지 않았습니다"}
+}
+
+// skipJSComment src[start]에서 시작하는 주석을 건너뛴 다음 위치를 반환합니다.
+func skipJSComment(src string, start int) (int, error) {
+	if src[start+1] == '/' {
+		if end := strings.IndexByte(src[start:], '\n'); end >= 0 {
+			return start + end, nil
+		}
+		return len(src), nil
+	}
+
+	if end := strings.Index(src[start+2:], "*/"); end >= 0 {
+		return start + 2 + end + 2, nil
+	}
+	return start, &jsLiteralError{Offset: start, msg: "블록 주석이 닫히지 않았습니다"}
+}
+
+// trimTrailingComma 닫는 괄호 직전의 후행 쉼표(공백 포함)를 제거합니다.
+func trimTrailingComma(out *strings.Builder) {
+	s := out.String()
+	trimmed := strings.TrimRight(s, " \t\r\n")
+	if !strings.HasSuffix(trimmed, ",") {
+		return
+	}
+
+	out.Reset()
+	out.WriteString(trimmed[:len(trimmed)-1])
+}
+
+// skipJSSpace src[i:]의 공백 문자를 건너뛴 위치를 반환합니다.
+func skipJSSpace(src string, i int) int {
+	for i < len(src) && strings.IndexByte(" \t\r\n", src[i]) >= 0 {
+		i++
+	}
+	return i
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// isJSIdentStart 식별자의 첫 글자가 될 수 있는 바이트인지 확인합니다.
+// 한글 등 비ASCII 식별자도 키로 쓰일 수 있으므로 UTF-8 멀티바이트 문자의 바이트(0x80 이상)도 포함합니다.
+func isJSIdentStart(c byte) bool {
+	return c == '_' || c == '$' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c >= 0x80
+}
+
+func isJSIdentPart(c byte) bool {
+	return isJSIdentStart(c) || isDigit(c)
+}
@@ -0,0 +1,123 @@
+package scraper
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSLiteralToJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string // 변환 결과를 JSON으로 디코딩한 값과 비교할 JSON
+	}{
+		{
+			name: "표준 JSON은 그대로 유지",
+			src:  `{"id": 1, "title": "공지", "tags": ["a", "b"], "pinned": false, "author": null}`,
+			want: `{"id": 1, "title": "공지", "tags": ["a", "b"], "pinned": false, "author": null}`,
+		},
+		{
+			name: "따옴표 없는 키",
+			src:  `{id: 1, $meta: {page_no: 2}, 제목: "가"}`,
+			want: `{"id": 1, "$meta": {"page_no": 2}, "제목": "가"}`,
+		},
+		{
+			name: "숫자 키",
+			src:  `{1: "a", 2: "b"}`,
+			want: `{"1": "a", "2": "b"}`,
+		},
+		{
+			name: "작은따옴표 문자열과 이스케이프",
+			src:  `{'title': 'He said "hi" & it\'s ok', path: 'a\\b'}`,
+			want: `{"title": "He said \"hi\" & it's ok", "path": "a\\b"}`,
+		},
+		{
+			name: "백틱 문자열의 줄바꿈",
+			src:  "{body: `첫 줄\n둘째 줄`}",
+			want: `{"body": "첫 줄\n둘째 줄"}`,
+		},
+		{
+			name: "후행 쉼표",
+			src:  "{list: [1, 2, 3,\n], last: {a: 1,},}",
+			want: `{"list": [1, 2, 3], "last": {"a": 1}}`,
+		},
+		{
+			name: "주석 제거",
+			src:  "{\n// 게시글 목록\nitems: [/* 비어 있음 */],\nurl: 'http://example.com/a'\n}",
+			want: `{"items": [], "url": "http://example.com/a"}`,
+		},
+		{
+			name: "undefined, NaN, 압축된 불리언",
+			src:  `{a: undefined, b: NaN, c: !0, d: !1, e: -1.5e3}`,
+			want: `{"a": null, "b": null, "c": true, "d": false, "e": -1500}`,
+		},
+		{
+			name: "\\x 이스케이프",
+			src:  `{s: '\x41\x42'}`,
+			want: `{"s": "AB"}`,
+		},
+		{
+			name: "배열 최상위 값",
+			src:  `  [{id: 1}, {id: 2}]`,
+			want: `[{"id": 1}, {"id": 2}]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, end, err := jsLiteralToJSON(tt.src, 0)
+			require.NoError(t, err)
+			assert.Equal(t, len(tt.src), end)
+
+			var gotValue, wantValue any
+			require.NoError(t, json.Unmarshal([]byte(got), &gotValue), "변환 결과가 유효한 JSON이어야 합니다: %s", got)
+			require.NoError(t, json.Unmarshal([]byte(tt.want), &wantValue))
+			assert.Equal(t, wantValue, gotValue)
+		})
+	}
+}
+
+func TestJSLiteralToJSON_StopsAtEndOfLiteral(t *testing.T) {
+	src := `window.__STATE__ = {a: [1, {b: "}"}]}; window.__OTHER__ = {c: 1};`
+
+	got, end, err := jsLiteralToJSON(src, 18)
+	require.NoError(t, err)
+	assert.Equal(t, `{"a": [1, {"b": "}"}]}`, got)
+	assert.Equal(t, ";", src[end:end+1])
+}
+
+func TestJSLiteralToJSON_Errors(t *testing.T) {
+	tests := []struct {
+		name        string
+		src         string
+		notFound    bool
+		errContains string
+	}{
+		{name: "객체/배열이 아닌 값", src: `"문자열"`, notFound: true},
+		{name: "빈 입력", src: ``, notFound: true},
+		{name: "닫히지 않은 괄호", src: `{a: [1, 2`, errContains: "괄호가 닫히지 않았습니다"},
+		{name: "닫히지 않은 문자열", src: `{a: 'abc}`, errContains: "문자열 리터럴이 닫히지 않았습니다"},
+		{name: "줄바꿈이 포함된 문자열", src: "{a: 'abc\n'}", errContains: "줄바꿈 전에 닫히지 않았습니다"},
+		{name: "닫히지 않은 블록 주석", src: `{a: 1 /* 주석`, errContains: "블록 주석이 닫히지 않았습니다"},
+		{name: "치환식이 있는 템플릿 문자열", src: "{a: `${b}`}", errContains: "템플릿 문자열은 지원하지 않습니다"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := jsLiteralToJSON(tt.src, 0)
+			require.Error(t, err)
+
+			if tt.notFound {
+				assert.ErrorIs(t, err, errJSLiteralNotFound)
+				return
+			}
+
+			var literalErr *jsLiteralError
+			require.ErrorAs(t, err, &literalErr)
+			assert.Contains(t, err.Error(), tt.errContains)
+		})
+	}
+}
@@ -8,11 +8,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
-	"reflect"
 	"strings"
 
 	applog "github.com/darkkaiser/notify-server/pkg/log"
-	"golang.org/x/net/html/charset"
 )
 
 // FetchJSON 지정된 URL로 HTTP 요청을 보내 JSON 응답을 가져오고, 지정된 구조체로 디코딩합니다.
@@ -38,11 +36,8 @@ func (s *scraper) FetchJSON(ctx context.Context, method, rawURL string, body any
 	// JSON 디코딩을 위해서는 결과를 담을 '구조체의 포인터'가 필요합니다.
 	// 만약 v가 nil이거나 포인터가 아니라면, 디코딩된 데이터를 저장할 수 없으므로
 	// 네트워크 요청 전에 즉시 에러를 반환하여 개발자의 실수를 조기에 알립니다.
-	if v == nil {
-		return ErrDecodeTargetNil
-	}
-	if rv := reflect.ValueOf(v); rv.Kind() != reflect.Ptr || rv.IsNil() {
-		return newErrDecodeTargetInvalidType(v)
+	if err := validateDecodeTarget(v); err != nil {
+		return err
 	}
 
 	// 1단계: 요청 본문(Body) 처리
@@ -223,26 +218,21 @@ func (s *scraper) decodeJSONResponse(ctx context.Context, result fetchResult, v
 	// ============================================================
 	// 4. 문자 인코딩 감지 및 UTF-8 변환
 	// ============================================================
-	utf8Reader, err := charset.NewReader(result.Response.Body, contentType)
-	if err != nil {
-		logger.WithError(err).
-			WithFields(applog.Fields{
-				"content_type": contentType,
-				"body_preview": s.previewBody(result.Body, contentType),
-			}).Warn("[경고]: 문자 인코딩 변환 실패, 인코딩 변환 없이 JSON 파싱을 계속합니다")
-
-		// 인코딩 감지 실패 시, 이미 일부 읽혀진 result.Response.Body 대신
-		// 원본 바이트(result.Body)를 사용하여 새 Reader를 생성합니다.
-		// 이를 통해 데이터 소실 없이 파싱을 시도할 수 있습니다.
-		utf8Reader = bytes.NewReader(result.Body)
+	// executeRequest가 본문 바이트를 분석하여 감지한 인코딩(result.Charset)을 사용합니다.
+	// 헤더의 charset이 누락되었거나 잘못 선언된 EUC-KR 응답도 한글이 깨지지 않고 변환됩니다.
+	// (감지 결과가 없는 경우, 즉 executeRequest를 거치지 않은 결과는 여기서 직접 감지합니다)
+	cs := result.Charset
+	if cs.Encoding == nil {
+		cs = s.detectCharset(result.Body, contentType, logger)
 	}
+	utf8Reader := cs.Encoding.NewDecoder().Reader(result.Response.Body)
 
 	// ============================================================
 	// 5. JSON 디코딩 (스트림 방식)
 	// ============================================================
 	reader := &contextAwareReader{ctx: ctx, r: utf8Reader}
 	decoder := json.NewDecoder(reader)
-	if err = decoder.Decode(v); err != nil {
+	if err := decoder.Decode(v); err != nil {
 		// 컨텍스트 취소/타임아웃 에러는 래핑하지 않고 그대로 반환
 		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
 			return err
@@ -280,27 +270,19 @@ func (s *scraper) decodeJSONResponse(ctx context.Context, result fetchResult, v
 			snippetLen := int64(contextBytes * 2)
 
 			// [스니펫 추출] 올바른 인코딩(UTF-8)으로 변환된 데이터에서 문맥 데이터를 추출합니다.
-			if r, err := charset.NewReader(bytes.NewReader(result.Body), contentType); err == nil {
-				// [위치 이동] 스니펫 추출 시작 지점까지 데이터를 읽어서 건너뜁니다.
-				// (charset.Reader는 io.Seeker를 구현하지 않을 수 있으므로 io.CopyN으로 대체)
-				if contextStart > 0 {
-					_, _ = io.CopyN(io.Discard, r, contextStart)
-				}
-
-				// [데이터 추출] 설정된 길이(snippetLen)만큼 에러 주변 문맥 데이터를 읽어 들입니다.
-				snippetBuf := make([]byte, snippetLen)
-				n, _ := io.ReadFull(r, snippetBuf)
-				snippet = string(snippetBuf[:n])
-			} else {
-				// [차선책] 인코딩 변환 실패 시, 원본 바이트에서 에러 주변 데이터를 직접 추출합니다.
-				// 인코딩 차이로 인해 정확한 문자의 위치는 다를 수 있으나, 대략적인 문맥 파악에는 충분합니다.
-				fallbackStart := min(max(offset-contextBytes, 0), len(result.Body))
-				fallbackEnd := min(max(offset+contextBytes, 0), len(result.Body))
-
-				// 바이트 슬라이스를 문자열로 변환
-				snippet = string(result.Body[fallbackStart:fallbackEnd])
+			r := cs.Encoding.NewDecoder().Reader(bytes.NewReader(result.Body))
+
+			// [위치 이동] 스니펫 추출 시작 지점까지 데이터를 읽어서 건너뜁니다.
+			// (transform.Reader는 io.Seeker를 구현하지 않으므로 io.CopyN으로 대체)
+			if contextStart > 0 {
+				_, _ = io.CopyN(io.Discard, r, contextStart)
 			}
 
+			// [데이터 추출] 설정된 길이(snippetLen)만큼 에러 주변 문맥 데이터를 읽어 들입니다.
+			snippetBuf := make([]byte, snippetLen)
+			n, _ := io.ReadFull(r, snippetBuf)
+			snippet = string(snippetBuf[:n])
+
 			logger = logger.WithFields(applog.Fields{
 				"syntax_error_offset":  offset,
 				"syntax_error_context": snippet,
@@ -357,9 +339,11 @@ func (s *scraper) decodeJSONResponse(ctx context.Context, result fetchResult, v
 	}
 
 	logger.WithFields(applog.Fields{
-		"status_code":  result.Response.StatusCode,
-		"content_type": contentType,
-		"body_size":    len(result.Body),
+		"status_code":    result.Response.StatusCode,
+		"content_type":   contentType,
+		"charset":        cs.Name,
+		"charset_source": cs.Source,
+		"body_size":      len(result.Body),
 	}).Debug("[성공]: JSON 파싱 완료")
 
 	return nil
@@ -2,6 +2,7 @@ package scraper
 
 import (
 	"net/http"
+	"strings"
 )
 
 // Option Scraper 구성을 위한 옵션 함수 타입입니다.
@@ -39,6 +40,18 @@ func WithMaxResponseBodySize(size int64) Option {
 	}
 }
 
+// WithCharset 응답 본문의 문자 인코딩 자동 감지를 생략하고, 항상 지정한 인코딩(예: "euc-kr", "windows-949")으로 해석하도록 설정합니다.
+//
+// 알 수 없는 인코딩 이름이면 이 옵션은 무시되고 자동 감지를 사용합니다.
+//
+// 매개변수:
+//   - name: WHATWG Encoding 표준의 인코딩 이름 또는 별칭. 빈 문자열이면 자동 감지를 사용합니다.
+func WithCharset(name string) Option {
+	return func(s *scraper) {
+		s.charsetOverride = strings.TrimSpace(name)
+	}
+}
+
 // WithResponseCallback HTTP 응답을 수신한 직후, 응답 본문을 읽기 전에 실행될 콜백 함수를 설정합니다.
 //
 // 이 콜백은 응답 본문이 닫히기 전에 호출되므로, 응답 헤더, 상태 코드, 쿠키 등의 메타데이터를
@@ -46,6 +46,16 @@ func TestWithMaxRequestBodySize(t *testing.T) {
 	}
 }
 
+func TestWithCharset(t *testing.T) {
+	mockFetcher := &mocks.MockFetcher{}
+
+	s := New(mockFetcher, WithCharset(" euc-kr ")).(*scraper)
+	assert.Equal(t, "euc-kr", s.charsetOverride)
+
+	s = New(mockFetcher).(*scraper)
+	assert.Empty(t, s.charsetOverride, "기본값은 자동 감지여야 합니다")
+}
+
 func TestWithMaxResponseBodySize(t *testing.T) {
 	mockFetcher := &mocks.MockFetcher{}
 	defaultSize := int64(defaultMaxBodySize)
@@ -51,6 +51,12 @@ type fetchResult struct {
 	// 호출자는 이 바이트 슬라이스를 직접 사용하여 파싱(HTML/JSON)을 수행합니다.
 	Body []byte
 
+	// Charset 응답 본문의 문자 인코딩 감지 결과입니다.
+	//
+	// 헤더, BOM, <meta> 선언과 바이트 분석을 종합하여 결정하며, HTML 파싱과 JSON 디코딩 모두 이 결과로 본문을 UTF-8로 변환합니다.
+	// 본문이 gzip으로 압축되어 있으면 감지하지 않습니다. (Encoding이 nil)
+	Charset charsetDetection
+
 	// IsTruncated 응답 본문이 크기 제한으로 인해 잘렸는지 여부를 나타냅니다.
 	//
 	// true인 경우:
@@ -163,10 +169,21 @@ func (s *scraper) executeRequest(ctx context.Context, params requestParams) (res
 	//   - bytes.Reader는 Close 메서드가 없으므로 NopCloser로 감싸서 규격 준수
 	httpResp.Body = io.NopCloser(bytes.NewReader(bodyBytes))
 
+	// 문자 인코딩 감지
+	//
+	// 국내 사이트는 Content-Type의 charset을 누락하거나 잘못 선언하는 경우가 많으므로,
+	// 메모리에 확보한 본문 바이트를 직접 분석하여 실제 인코딩을 결정합니다.
+	// gzip으로 압축된 본문은 압축된 바이트로는 인코딩을 판별할 수 없으므로, 압축을 해제하는 호출자(FetchXML)가 직접 감지합니다.
+	var cs charsetDetection
+	if !bytes.HasPrefix(bodyBytes, gzipMagic) {
+		cs = s.detectCharset(bodyBytes, httpResp.Header.Get("Content-Type"), logger)
+	}
+
 	// 최종 결과 객체 생성
 	result = fetchResult{
 		Response:    httpResp,
 		Body:        bodyBytes,
+		Charset:     cs,
 		IsTruncated: isTruncated,
 	}
 
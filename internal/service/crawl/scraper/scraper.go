@@ -98,16 +98,70 @@ type JSONScraper interface {
 	FetchJSON(ctx context.Context, method, rawURL string, body any, header http.Header, v any) error
 }
 
+// XMLScraper XML 문서 스크래핑을 위한 인터페이스입니다.
+//
+// 이 인터페이스는 사이트맵(sitemap.xml)처럼 XML 형식으로 제공되는 문서를 가져와 Go 구조체로 변환하는 기능을 제공합니다.
+type XMLScraper interface {
+	// FetchXML 지정된 URL로 GET 요청을 보내 XML 문서를 가져오고, 지정된 구조체로 디코딩합니다.
+	//
+	// gzip으로 압축된 파일(예: sitemap.xml.gz)은 Content-Type과 무관하게 본문의 매직 넘버로 감지하여 압축을 해제합니다.
+	// 압축 해제 후의 크기에도 maxResponseBodySize 제한이 동일하게 적용됩니다.
+	//
+	// 매개변수:
+	//   - ctx: 요청의 생명주기를 제어하는 컨텍스트 (취소, 타임아웃 등)
+	//   - rawURL: 요청할 URL
+	//   - header: 추가 HTTP 헤더 (nil 가능, 예: User-Agent, Cookie 등)
+	//   - v: XML 응답을 디코딩할 대상 구조체의 포인터 (반드시 nil이 아닌 포인터여야 함)
+	//
+	// 반환값:
+	//   - error: 네트워크 오류, 압축 해제 오류, XML 파싱 오류, 또는 응답 크기 초과 시 에러 반환
+	FetchXML(ctx context.Context, rawURL string, header http.Header, v any) error
+}
+
+// ScriptDataScraper HTML 페이지의 인라인 <script>에 담긴 데이터를 추출하기 위한 인터페이스입니다.
+//
+// 목록을 HTML 표 대신 자바스크립트로 그리는 SPA 게시판은 goquery 선택자로 게시글을 찾을 수 없지만,
+// 화면에 필요한 데이터를 <script> 안의 JSON이나 window.__INITIAL_STATE__ 같은 변수로 미리 심어 두는 경우가 많습니다.
+// 이 인터페이스는 헤드리스 브라우저 없이 그 데이터를 읽어 Go 구조체로 변환하는 기능을 제공합니다.
+type ScriptDataScraper interface {
+	// FetchScriptData 지정된 URL의 HTML 페이지를 GET 요청으로 가져와, 인라인 <script>에 담긴 데이터를 지정된 구조체로 디코딩합니다.
+	//
+	// 매개변수:
+	//   - ctx: 요청의 생명주기를 제어하는 컨텍스트 (취소, 타임아웃 등)
+	//   - rawURL: 요청할 URL
+	//   - header: 추가 HTTP 헤더 (nil 가능, 예: User-Agent, Cookie 등)
+	//   - query: 데이터가 담긴 <script> 태그와 데이터 위치를 지정하는 조건
+	//   - v: 추출한 데이터를 디코딩할 대상 구조체의 포인터 (반드시 nil이 아닌 포인터여야 함)
+	//
+	// 반환값:
+	//   - error: 네트워크 오류, 응답 크기 초과, 데이터를 찾지 못한 경우, 또는 JSON 파싱 오류 시 에러 반환
+	FetchScriptData(ctx context.Context, rawURL string, header http.Header, query ScriptQuery, v any) error
+
+	// ParseScriptData 이미 파싱된 HTML 문서에서 인라인 <script>에 담긴 데이터를 찾아 지정된 구조체로 디코딩합니다.
+	//
+	// 매개변수:
+	//   - ctx: 컨텍스트 (로깅 연동 및 취소 신호 감지)
+	//   - doc: 데이터를 찾을 HTML 문서 (nil 불가)
+	//   - query: 데이터가 담긴 <script> 태그와 데이터 위치를 지정하는 조건
+	//   - v: 추출한 데이터를 디코딩할 대상 구조체의 포인터 (반드시 nil이 아닌 포인터여야 함)
+	//
+	// 반환값:
+	//   - error: 데이터를 찾지 못한 경우, 데이터 크기 초과, 또는 JSON 파싱 오류 시 에러 반환
+	ParseScriptData(ctx context.Context, doc *goquery.Document, query ScriptQuery, v any) error
+}
+
 // Scraper 웹 페이지 스크래핑을 위한 통합 인터페이스입니다.
 type Scraper interface {
 	HTMLScraper
 	JSONScraper
+	XMLScraper
+	ScriptDataScraper
 }
 
 // scraper Scraper 인터페이스의 구현체입니다.
 //
 // 이 구조체는 웹 페이지 스크래핑을 수행하는 실제 구현을 담당합니다.
-// Fetcher를 통해 HTTP 요청을 수행하고, HTML 파싱 및 JSON 디코딩 기능을 제공합니다.
+// Fetcher를 통해 HTTP 요청을 수행하고, HTML 파싱 및 JSON/XML 디코딩 기능을 제공합니다.
 //
 // 주요 기능:
 //   - 자동 인코딩 감지 및 변환 (EUC-KR, UTF-8 등)
@@ -126,6 +180,10 @@ type scraper struct {
 	// 이 값을 초과하는 응답 본문은 에러를 발생시킵니다.
 	maxResponseBodySize int64
 
+	// charsetOverride 응답 본문의 문자 인코딩을 자동 감지하지 않고 항상 이 인코딩으로 해석합니다. (빈 문자열: 자동 감지)
+	// 헤더와 <meta>에 잘못된 charset을 선언하면서 바이트 분석으로도 판별하기 어려운 사이트를 위한 설정입니다.
+	charsetOverride string
+
 	// responseCallback HTTP 응답 수신 직후 실행될 콜백 함수입니다.
 	// 응답 헤더나 상태 코드를 검사할 때 사용할 수 있습니다.
 	responseCallback func(*http.Response)
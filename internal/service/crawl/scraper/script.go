@@ -0,0 +1,296 @@
+package scraper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	applog "github.com/darkkaiser/notify-server/pkg/log"
+)
+
+// ScriptQuery HTML 문서에서 데이터가 담긴 <script> 태그와 그 안의 데이터 위치를 지정하는 조건입니다.
+//
+// 목록을 HTML 표 대신 자바스크립트로 그리는 SPA 게시판은 화면에 필요한 데이터를 아래와 같이 페이지에 미리 심어 두므로,
+// 브라우저 없이도 이 값을 읽어 게시글 목록을 만들 수 있습니다.
+//
+//	<script id="__NEXT_DATA__" type="application/json">{"props": {...}}</script>   → Selector만 지정
+//	<script>window.__INITIAL_STATE__ = {articles: [...]};</script>                 → Variable 지정
+//	<script>var boardData = JSON_START{...}</script>                               → Pattern 지정
+//
+// Variable과 Pattern을 모두 지정하지 않으면 <script> 본문 전체를 하나의 값으로 해석합니다.
+type ScriptQuery struct {
+	// Selector 후보 <script> 태그를 고르는 CSS 선택자입니다. (빈 문자열: 문서의 모든 <script>)
+	// 예: `script#__NEXT_DATA__`, `script[type="application/ld+json"]`
+	Selector string
+
+	// Variable 데이터가 대입되는 변수 이름입니다. "{Variable} = " 바로 뒤의 값을 추출합니다.
+	// 예: "window.__INITIAL_STATE__", "__APOLLO_STATE__"
+	Variable string
+
+	// Pattern 스크립트 본문에서 데이터의 시작 위치를 찾는 정규식입니다. 일치한 부분 바로 뒤의 값을 추출합니다.
+	// 변수 대입 형태가 아닌 경우(예: `store.init(`)에 사용하며, Variable이 지정되어 있으면 무시됩니다.
+	Pattern *regexp.Regexp
+}
+
+// String 로그와 에러 메시지에 사용할 조건 요약 문자열을 반환합니다.
+func (q ScriptQuery) String() string {
+	var parts []string
+	if q.Selector != "" {
+		parts = append(parts, fmt.Sprintf("selector=%q", q.Selector))
+	}
+	if q.Variable != "" {
+		parts = append(parts, fmt.Sprintf("variable=%q", q.Variable))
+	} else if q.Pattern != nil {
+		parts = append(parts, fmt.Sprintf("pattern=%q", q.Pattern.String()))
+	}
+	if len(parts) == 0 {
+		return "모든 script"
+	}
+	return strings.Join(parts, ", ")
+}
+
+// FetchScriptData 지정된 URL의 HTML 페이지를 GET 요청으로 가져와, 인라인 <script>에 담긴 데이터를 지정된 구조체로 디코딩합니다.
+//
+// 페이지 요청과 응답 처리(크기 제한, 문자 인코딩 변환, 에러 분류)는 FetchHTMLDocument와 동일하며,
+// 데이터 추출과 디코딩은 ParseScriptData와 동일합니다.
+//
+// 매개변수:
+//   - ctx: 요청의 생명주기를 제어하는 컨텍스트 (취소, 타임아웃 등)
+//   - rawURL: 요청할 URL
+//   - header: 추가 HTTP 헤더 (nil 가능, 예: User-Agent, Cookie 등)
+//   - query: 데이터가 담긴 <script> 태그와 데이터 위치를 지정하는 조건
+//   - v: 추출한 데이터를 디코딩할 대상 구조체의 포인터 (반드시 nil이 아닌 포인터여야 함)
+//
+// 반환값:
+//   - error: 네트워크 오류, 응답 크기 초과, 데이터를 찾지 못한 경우, 또는 JSON 파싱 오류 시 에러 반환
+func (s *scraper) FetchScriptData(ctx context.Context, rawURL string, header http.Header, query ScriptQuery, v any) error {
+	// 네트워크 요청 전에 디코딩 대상을 먼저 검증하여, 잘못된 호출로 불필요한 요청이 나가지 않도록 합니다.
+	if err := validateDecodeTarget(v); err != nil {
+		return err
+	}
+
+	doc, err := s.FetchHTMLDocument(ctx, rawURL, header)
+	if err != nil {
+		return err
+	}
+
+	return s.ParseScriptData(ctx, doc, query, v)
+}
+
+// ParseScriptData 이미 파싱된 HTML 문서에서 인라인 <script>에 담긴 데이터를 찾아 지정된 구조체로 디코딩합니다.
+//
+// 처리 순서:
+//  1. query.Selector에 해당하는 <script> 태그를 문서 순서대로 검사합니다.
+//  2. Variable/Pattern으로 데이터의 시작 위치를 찾고, 그 위치의 객체/배열 리터럴 하나를 추출합니다.
+//     (따옴표 없는 키, 작은따옴표 문자열, 후행 쉼표 등 JavaScript 문법은 JSON으로 변환됩니다)
+//  3. 데이터가 발견된 첫 번째 태그의 값을 JSON으로 디코딩합니다.
+//
+// 매개변수:
+//   - ctx: 컨텍스트 (로깅 연동 및 취소 신호 감지)
+//   - doc: 데이터를 찾을 HTML 문서 (nil 불가)
+//   - query: 데이터가 담긴 <script> 태그와 데이터 위치를 지정하는 조건
+//   - v: 추출한 데이터를 디코딩할 대상 구조체의 포인터 (반드시 nil이 아닌 포인터여야 함)
+//
+// 반환값:
+//   - error: 조건에 맞는 데이터가 없거나(ExecutionFailed), 데이터 크기 초과(InvalidInput), 또는 JSON 파싱 오류(ParsingFailed) 시 에러 반환
+//
+// 보안 고려사항:
+//   - 추출한 스크립트 본문이 maxResponseBodySize를 초과하면 에러를 반환합니다. (FetchJSON과 동일한 제한)
+//   - 스크립트를 실행하지 않고 문자열로만 해석하므로, 페이지의 코드가 서버에서 실행될 위험이 없습니다.
+func (s *scraper) ParseScriptData(ctx context.Context, doc *goquery.Document, query ScriptQuery, v any) error {
+	// ━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━
+	// [1단계] 입력 검증
+	// ━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━
+	if doc == nil {
+		return ErrDocumentNil
+	}
+	if err := validateDecodeTarget(v); err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return ErrContextCanceled
+	}
+
+	var docURL string
+	if doc.Url != nil {
+		docURL = doc.Url.String()
+	}
+
+	logger := applog.WithComponent(component).
+		WithContext(ctx).
+		WithFields(applog.Fields{
+			"url":          docURL,
+			"script_query": query.String(),
+			"target_type":  fmt.Sprintf("%T", v),
+		})
+
+	// ━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━
+	// [2단계] 데이터가 담긴 <script> 탐색 및 리터럴 추출
+	// ━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━
+	selector := query.Selector
+	if selector == "" {
+		selector = "script"
+	}
+
+	var (
+		data       string
+		scanErr    error
+		scriptText string
+	)
+	scripts := doc.Find(selector)
+	scripts.EachWithBreak(func(_ int, sel *goquery.Selection) bool {
+		text := sel.Text()
+
+		// 스크립트 본문은 이미 메모리에 있지만, 비정상적으로 큰 데이터를 그대로 변환·디코딩하지 않도록 FetchJSON과 같은 상한을 적용합니다.
+		if int64(len(text)) > s.maxResponseBodySize {
+			scanErr = newErrInputDataSizeLimitExceeded(s.maxResponseBodySize, "JSON")
+			return false
+		}
+
+		start, ok := locateScriptData(text, query)
+		if !ok {
+			return true
+		}
+
+		converted, end, err := jsLiteralToJSON(text, start)
+		if err != nil {
+			// 위치는 찾았지만 값이 객체/배열이 아니면(예: 다른 용도의 동명 변수) 다음 태그를 계속 찾습니다.
+			if errors.Is(err, errJSLiteralNotFound) {
+				return true
+			}
+			scanErr, scriptText = err, text
+			return false
+		}
+
+		// 본문 전체가 데이터인 경우에는 FetchJSON의 Strict Mode처럼 값 뒤에 다른 내용이 없어야 합니다.
+		if query.Variable == "" && query.Pattern == nil {
+			if rest := strings.TrimSpace(text[end:]); rest != "" && rest != ";" {
+				scanErr = newErrJSONUnexpectedToken(docURL)
+				return false
+			}
+		}
+
+		data = converted
+		return false
+	})
+
+	if scanErr != nil {
+		var literalErr *jsLiteralError
+		if errors.As(scanErr, &literalErr) {
+			snippet := snippetAround(scriptText, literalErr.Offset, 50)
+
+			logger.WithError(scanErr).
+				WithFields(applog.Fields{
+					"syntax_error_offset":  literalErr.Offset,
+					"syntax_error_context": snippet,
+				}).Error("[실패]: 스크립트 데이터 추출 실패, 유효하지 않은 JavaScript 리터럴")
+
+			return newErrJSONParseFailed(scanErr, docURL, literalErr.Offset, snippet)
+		}
+
+		logger.WithError(scanErr).Error("[실패]: 스크립트 데이터 추출 중단")
+
+		return scanErr
+	}
+
+	if data == "" {
+		logger.WithField("script_count", scripts.Length()).
+			Error("[실패]: 조건에 맞는 스크립트 데이터를 찾을 수 없습니다")
+
+		return newErrScriptDataNotFound(docURL, query.String())
+	}
+
+	// ━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━
+	// [3단계] JSON 디코딩
+	// ━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━
+	// 문서는 이미 UTF-8로 변환되어 있으므로 별도의 문자 인코딩 처리 없이 바로 디코딩합니다.
+	reader := &contextAwareReader{ctx: ctx, r: strings.NewReader(data)}
+	if err := json.NewDecoder(reader).Decode(v); err != nil {
+		// 컨텍스트 취소/타임아웃 에러는 래핑하지 않고 그대로 반환
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return err
+		}
+
+		// 변환된 JSON 기준의 위치와 주변 문맥을 기록합니다. (원본 스크립트와는 따옴표 추가 등으로 위치가 다를 수 있음)
+		var offset int
+		var snippet string
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			offset = int(syntaxErr.Offset)
+			snippet = snippetAround(data, offset, 50)
+		}
+
+		logger.WithError(err).
+			WithFields(applog.Fields{
+				"data_size":            len(data),
+				"syntax_error_offset":  offset,
+				"syntax_error_context": snippet,
+			}).Error("[실패]: 스크립트 데이터 변환 실패, 유효하지 않은 형식")
+
+		return newErrJSONParseFailed(err, docURL, offset, snippet)
+	}
+
+	logger.WithField("data_size", len(data)).Debug("[성공]: 스크립트 데이터 추출 및 파싱 완료")
+
+	return nil
+}
+
+// locateScriptData 스크립트 본문에서 조건에 맞는 데이터의 시작 위치를 찾습니다.
+func locateScriptData(text string, query ScriptQuery) (int, bool) {
+	switch {
+	case query.Variable != "":
+		// 같은 이름이 조건식(`window.__STATE__ || {}`) 등에 먼저 나올 수 있으므로, 대입(=) 형태가 나올 때까지 계속 찾습니다.
+		for offset := 0; ; {
+			idx := strings.Index(text[offset:], query.Variable)
+			if idx < 0 {
+				return 0, false
+			}
+			pos := offset + idx
+			offset = pos + len(query.Variable)
+
+			// 더 긴 이름의 일부(예: "my__STATE__")는 제외합니다. ("window." 같은 객체 접두사는 허용)
+			if pos > 0 && isJSIdentPart(text[pos-1]) {
+				continue
+			}
+
+			i := skipJSSpace(text, offset)
+			if i < len(text) && text[i] == '=' && (i+1 >= len(text) || text[i+1] != '=') {
+				return i + 1, true
+			}
+		}
+
+	case query.Pattern != nil:
+		loc := query.Pattern.FindStringIndex(text)
+		if loc == nil {
+			return 0, false
+		}
+		return loc[1], true
+
+	default:
+		return 0, strings.TrimSpace(text) != ""
+	}
+}
+
+// validateDecodeTarget 디코딩 대상(v)이 nil이 아닌 포인터인지 검증합니다.
+func validateDecodeTarget(v any) error {
+	if v == nil {
+		return ErrDecodeTargetNil
+	}
+	if rv := reflect.ValueOf(v); rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return newErrDecodeTargetInvalidType(v)
+	}
+	return nil
+}
+
+// snippetAround 디버깅용으로 offset 전후 width 바이트의 문자열을 잘라 반환합니다.
+func snippetAround(s string, offset, width int) string {
+	start := min(max(offset-width, 0), len(s))
+	end := min(max(offset+width, 0), len(s))
+	return strings.ToValidUTF8(s[start:end], "")
+}
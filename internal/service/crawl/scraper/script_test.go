@@ -0,0 +1,247 @@
+package scraper
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+	apperrors "github.com/darkkaiser/rss-feed-server/internal/errors"
+	"github.com/darkkaiser/rss-feed-server/internal/service/crawl/fetcher/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// spaBoard 테스트용 SPA 게시판의 초기 상태 데이터 구조입니다.
+type spaBoard struct {
+	Board struct {
+		Articles []struct {
+			ID    int    `json:"id"`
+			Title string `json:"title"`
+		} `json:"articles"`
+	} `json:"board"`
+}
+
+func newTestDocument(t *testing.T, html string) *goquery.Document {
+	t.Helper()
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+	require.NoError(t, err)
+	return doc
+}
+
+func TestParseScriptData(t *testing.T) {
+	const initialStatePage = `<html><head>
+<script src="/app.js"></script>
+<script>
+  // 상태가 이미 있으면 재사용합니다.
+  var state = window.__INITIAL_STATE__ || {};
+  window.__INITIAL_STATE__ = {
+    board: {
+      articles: [
+        {id: 101, title: '첫 번째 "공지"'},
+        {id: 100, title: '두 번째 글',},
+      ],
+    },
+    loaded: !0,
+  };
+  window.__CONFIG__ = {debug: false};
+</script>
+</head><body><div id="app"></div></body></html>`
+
+	tests := []struct {
+		name        string
+		html        string
+		query       ScriptQuery
+		maxBodySize int64
+
+		wantErr     bool
+		errType     apperrors.ErrorType
+		errContains []string
+		assertVal   func(*testing.T, *spaBoard)
+	}{
+		{
+			name:  "Success: window.__INITIAL_STATE__ 변수 (JS 객체 리터럴)",
+			html:  initialStatePage,
+			query: ScriptQuery{Variable: "window.__INITIAL_STATE__"},
+			assertVal: func(t *testing.T, v *spaBoard) {
+				require.Len(t, v.Board.Articles, 2)
+				assert.Equal(t, 101, v.Board.Articles[0].ID)
+				assert.Equal(t, `첫 번째 "공지"`, v.Board.Articles[0].Title)
+				assert.Equal(t, "두 번째 글", v.Board.Articles[1].Title)
+			},
+		},
+		{
+			name:  "Success: 객체 접두사를 생략한 변수 이름",
+			html:  initialStatePage,
+			query: ScriptQuery{Variable: "__INITIAL_STATE__"},
+			assertVal: func(t *testing.T, v *spaBoard) {
+				assert.Len(t, v.Board.Articles, 2)
+			},
+		},
+		{
+			name: "Success: 선택자로 지정한 JSON 스크립트 본문 전체",
+			html: `<script>var a = 1;</script>
+<script id="__NEXT_DATA__" type="application/json">{"board": {"articles": [{"id": 7, "title": "넥스트"}]}}</script>`,
+			query: ScriptQuery{Selector: "script#__NEXT_DATA__"},
+			assertVal: func(t *testing.T, v *spaBoard) {
+				require.Len(t, v.Board.Articles, 1)
+				assert.Equal(t, "넥스트", v.Board.Articles[0].Title)
+			},
+		},
+		{
+			name:  "Success: 정규식으로 지정한 함수 호출 인자",
+			html:  `<script>store.init({board: {articles: [{id: 3, title: 'init'}]}}, "ko");</script>`,
+			query: ScriptQuery{Pattern: regexp.MustCompile(`store\.init\(`)},
+			assertVal: func(t *testing.T, v *spaBoard) {
+				require.Len(t, v.Board.Articles, 1)
+				assert.Equal(t, 3, v.Board.Articles[0].ID)
+			},
+		},
+		{
+			name: "Success: 조건과 일치하지만 객체가 아닌 스크립트는 건너뜀",
+			html: `<script>window.__INITIAL_STATE__ = null;</script>
+<script>window.__INITIAL_STATE__ = {board: {articles: [{id: 1}]}};</script>`,
+			query: ScriptQuery{Variable: "window.__INITIAL_STATE__"},
+			assertVal: func(t *testing.T, v *spaBoard) {
+				assert.Len(t, v.Board.Articles, 1)
+			},
+		},
+		{
+			name:        "Error: 조건에 맞는 데이터 없음",
+			html:        initialStatePage,
+			query:       ScriptQuery{Variable: "window.__NUXT__"},
+			wantErr:     true,
+			errType:     apperrors.ExecutionFailed,
+			errContains: []string{"스크립트 데이터 없음", `variable="window.__NUXT__"`},
+		},
+		{
+			name:        "Error: 선택자에 해당하는 태그 없음",
+			html:        initialStatePage,
+			query:       ScriptQuery{Selector: "script#__NEXT_DATA__"},
+			wantErr:     true,
+			errType:     apperrors.ExecutionFailed,
+			errContains: []string{"스크립트 데이터 없음"},
+		},
+		{
+			name:        "Error: 닫히지 않은 리터럴",
+			html:        `<script>window.__INITIAL_STATE__ = {board: {articles: [</script>`,
+			query:       ScriptQuery{Variable: "window.__INITIAL_STATE__"},
+			wantErr:     true,
+			errType:     apperrors.ParsingFailed,
+			errContains: []string{"JSON 파싱 실패", "괄호가 닫히지 않았습니다"},
+		},
+		{
+			name:        "Error: JSON으로 변환할 수 없는 코드",
+			html:        `<script>window.__INITIAL_STATE__ = {board: loadBoard()};</script>`,
+			query:       ScriptQuery{Variable: "window.__INITIAL_STATE__"},
+			wantErr:     true,
+			errType:     apperrors.ParsingFailed,
+			errContains: []string{"구문 오류"},
+		},
+		{
+			name:        "Error: 대상 구조체와 타입 불일치",
+			html:        `<script>window.__INITIAL_STATE__ = {board: {articles: [{id: "abc"}]}};</script>`,
+			query:       ScriptQuery{Variable: "window.__INITIAL_STATE__"},
+			wantErr:     true,
+			errType:     apperrors.ParsingFailed,
+			errContains: []string{"JSON 파싱 실패"},
+		},
+		{
+			name:        "Error: 본문 전체 모드에서 값 뒤의 잔여 데이터 (Strict Mode)",
+			html:        `<script type="application/json" id="data">{"board": {}} GARBAGE</script>`,
+			query:       ScriptQuery{Selector: "script#data"},
+			wantErr:     true,
+			errType:     apperrors.ParsingFailed,
+			errContains: []string{"불필요한 토큰"},
+		},
+		{
+			name:        "Error: 스크립트 크기 제한 초과",
+			html:        initialStatePage,
+			query:       ScriptQuery{Variable: "window.__INITIAL_STATE__"},
+			maxBodySize: 32,
+			wantErr:     true,
+			errType:     apperrors.InvalidInput,
+			errContains: []string{"입력 데이터 크기 초과"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var opts []Option
+			if tt.maxBodySize > 0 {
+				opts = append(opts, WithMaxResponseBodySize(tt.maxBodySize))
+			}
+			s := New(&mocks.MockFetcher{}, opts...)
+
+			var v spaBoard
+			err := s.ParseScriptData(context.Background(), newTestDocument(t, tt.html), tt.query, &v)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.True(t, apperrors.Is(err, tt.errType), "Expected error type %s, got %v", tt.errType, err)
+				for _, msg := range tt.errContains {
+					assert.Contains(t, err.Error(), msg)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			tt.assertVal(t, &v)
+		})
+	}
+}
+
+func TestParseScriptData_InvalidInput(t *testing.T) {
+	s := New(&mocks.MockFetcher{})
+	doc := newTestDocument(t, `<script>{"a": 1}</script>`)
+
+	var v map[string]any
+	assert.ErrorIs(t, s.ParseScriptData(context.Background(), nil, ScriptQuery{}, &v), ErrDocumentNil)
+	assert.ErrorIs(t, s.ParseScriptData(context.Background(), doc, ScriptQuery{}, nil), ErrDecodeTargetNil)
+	assert.True(t, apperrors.Is(s.ParseScriptData(context.Background(), doc, ScriptQuery{}, v), apperrors.Internal), "포인터가 아닌 대상은 거부해야 합니다")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, s.ParseScriptData(ctx, doc, ScriptQuery{}, &v), ErrContextCanceled)
+}
+
+func TestFetchScriptData(t *testing.T) {
+	t.Run("페이지를 가져와 스크립트 데이터를 디코딩", func(t *testing.T) {
+		m := &mocks.MockFetcher{}
+		resp := mocks.NewMockResponse(`<html><script>window.__INITIAL_STATE__={board:{articles:[{id:5,title:'SPA'}]}}</script></html>`, http.StatusOK)
+		resp.Header.Set("Content-Type", "text/html; charset=utf-8")
+		m.On("Do", mock.Anything).Return(resp, nil)
+
+		var v spaBoard
+		err := New(m).FetchScriptData(context.Background(), "https://spa.example.com/board", nil, ScriptQuery{Variable: "window.__INITIAL_STATE__"}, &v)
+		require.NoError(t, err)
+		require.Len(t, v.Board.Articles, 1)
+		assert.Equal(t, "SPA", v.Board.Articles[0].Title)
+		m.AssertExpectations(t)
+	})
+
+	t.Run("디코딩 대상이 잘못되면 요청하지 않음", func(t *testing.T) {
+		m := &mocks.MockFetcher{}
+
+		err := New(m).FetchScriptData(context.Background(), "https://spa.example.com/board", nil, ScriptQuery{}, nil)
+		assert.ErrorIs(t, err, ErrDecodeTargetNil)
+		m.AssertNotCalled(t, "Do", mock.Anything)
+	})
+
+	t.Run("응답 크기 제한 초과", func(t *testing.T) {
+		m := &mocks.MockFetcher{}
+		resp := mocks.NewMockResponse(`<html><script>window.__INITIAL_STATE__={}</script>`+strings.Repeat(" ", 128)+`</html>`, http.StatusOK)
+		resp.Header.Set("Content-Type", "text/html")
+		m.On("Do", mock.Anything).Return(resp, nil)
+
+		var v spaBoard
+		err := New(m, WithMaxResponseBodySize(64)).FetchScriptData(context.Background(), "https://spa.example.com/board", nil, ScriptQuery{Variable: "window.__INITIAL_STATE__"}, &v)
+		require.Error(t, err)
+		assert.True(t, apperrors.Is(err, apperrors.InvalidInput))
+		assert.Contains(t, err.Error(), "응답 본문 크기 초과")
+	})
+}
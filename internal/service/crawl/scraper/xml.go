@@ -0,0 +1,152 @@
+package scraper
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	applog "github.com/darkkaiser/notify-server/pkg/log"
+)
+
+// gzipMagic gzip 파일의 시작을 나타내는 매직 넘버입니다.
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// FetchXML 지정된 URL로 GET 요청을 보내 XML 문서를 가져오고, 지정된 구조체로 디코딩합니다.
+//
+// 사이트맵 파일은 sitemap.xml.gz처럼 gzip으로 압축된 채 제공되는 경우가 많은데, 이때 서버는 Content-Encoding 대신
+// "application/gzip"이나 "application/octet-stream" 같은 Content-Type으로 응답하므로 HTTP 클라이언트가 압축을 자동으로 해제하지 않습니다.
+// 따라서 Content-Type을 신뢰하지 않고 본문 앞부분의 매직 넘버로 gzip 여부를 판별합니다.
+//
+// 매개변수:
+//   - ctx: 요청의 생명주기를 제어하는 컨텍스트 (취소, 타임아웃 등)
+//   - rawURL: 요청할 URL
+//   - header: 추가 HTTP 헤더 (nil 가능, 예: User-Agent, Cookie 등)
+//   - v: XML 응답을 디코딩할 대상 구조체의 포인터 (반드시 nil이 아닌 포인터여야 함)
+//
+// 반환값:
+//   - error: 네트워크 오류, 압축 해제 오류, XML 파싱 오류, 또는 응답 크기 초과 시 에러 반환
+func (s *scraper) FetchXML(ctx context.Context, rawURL string, header http.Header, v any) error {
+	// 0단계: 디코딩 대상(v) 검증
+	// 네트워크 요청 전에 잘못된 대상을 걸러내어 불필요한 요청을 방지합니다.
+	if err := validateDecodeTarget(v); err != nil {
+		return err
+	}
+
+	// 1단계: HTTP 요청 실행 및 응답 수신
+	// 사이트맵은 text/xml, application/xml, application/gzip 등 다양한 Content-Type으로 제공되므로 별도의 Content-Type 검증은 하지 않고,
+	// 실제 형식 오류는 디코딩 단계에서 판별합니다.
+	result, logger, err := s.executeRequest(ctx, requestParams{
+		Method:        http.MethodGet,
+		URL:           rawURL,
+		Header:        header,
+		DefaultAccept: "application/xml,text/xml;q=0.9,*/*;q=0.8",
+	})
+	if err != nil {
+		return err
+	}
+	defer result.Response.Body.Close()
+
+	contentType := result.Response.Header.Get("Content-Type")
+
+	// 2단계: 응답 본문 크기 제한 검증
+	// XML 역시 JSON과 마찬가지로 중간에 잘리면 닫는 태그가 누락되어 전체 문서를 사용할 수 없습니다.
+	if result.IsTruncated {
+		logger.WithFields(applog.Fields{
+			"content_type": contentType,
+			"body_size":    len(result.Body),
+			"limit_bytes":  s.maxResponseBodySize,
+			"truncated":    true,
+		}).Error("[실패]: XML 파싱 중단, 응답 본문 크기 초과(Truncated)")
+
+		return newErrResponseBodySizeLimitExceeded(s.maxResponseBodySize, rawURL, contentType)
+	}
+
+	// 3단계: gzip 압축 해제
+	body := result.Body
+	compressed := bytes.HasPrefix(body, gzipMagic)
+	if compressed {
+		body, err = s.gunzip(ctx, body, rawURL)
+		if err != nil {
+			logger.WithError(err).Error("[실패]: gzip 응답 본문 압축 해제 실패")
+			return err
+		}
+	}
+
+	// 4단계: 문자 인코딩 감지 및 UTF-8 변환
+	// 압축을 해제한 본문으로 인코딩을 감지하여, 공급자 설정(charset)과 잘못 선언된 XML 선언(<?xml encoding="..."?>)도 HTML, JSON과 같은 규칙으로 처리합니다.
+	// 압축된 응답의 Content-Type(application/gzip 등)은 압축 해제된 문서의 charset을 나타내지 않으므로 감지에 사용하지 않습니다.
+	// (executeRequest는 압축된 본문의 인코딩을 감지하지 않으므로, 이 경우 result.Charset.Encoding은 nil입니다)
+	cs := result.Charset
+	if cs.Encoding == nil {
+		detectContentType := contentType
+		if compressed {
+			detectContentType = ""
+		}
+		cs = s.detectCharset(body, detectContentType, logger)
+	}
+
+	// 5단계: XML 디코딩
+	// 본문은 이미 UTF-8로 변환되었으므로, XML 선언부의 encoding 속성은 무시하고 그대로 읽습니다.
+	decoder := xml.NewDecoder(&contextAwareReader{ctx: ctx, r: cs.Encoding.NewDecoder().Reader(bytes.NewReader(body))})
+	decoder.CharsetReader = func(_ string, input io.Reader) (io.Reader, error) { return input, nil }
+	if err := decoder.Decode(v); err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return err
+		}
+
+		var line int
+		var syntaxErr *xml.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			line = syntaxErr.Line
+		}
+
+		logger.WithError(err).WithFields(applog.Fields{
+			"content_type": contentType,
+			"body_size":    len(body),
+			"compressed":   compressed,
+			"body_preview": s.previewBody(body, "text/xml"),
+			"target_type":  fmt.Sprintf("%T", v),
+		}).Error("[실패]: XML 데이터 변환 실패, 유효하지 않은 형식")
+
+		return newErrXMLParseFailed(err, rawURL, line)
+	}
+
+	logger.WithFields(applog.Fields{
+		"content_type":   contentType,
+		"body_size":      len(body),
+		"compressed":     compressed,
+		"charset":        cs.Name,
+		"charset_source": cs.Source,
+	}).Debug("[성공]: XML 파싱 완료")
+
+	return nil
+}
+
+// gunzip gzip으로 압축된 본문을 해제합니다.
+//
+// 압축률이 매우 높은 악의적인 파일(Zip Bomb)로 메모리가 고갈되지 않도록, 압축 해제 후의 크기에도 maxResponseBodySize 제한을 적용합니다.
+func (s *scraper) gunzip(ctx context.Context, body []byte, rawURL string) ([]byte, error) {
+	zr, err := gzip.NewReader(bytes.NewReader(body))
+	if err != nil {
+		return nil, newErrGzipDecompressFailed(err, rawURL)
+	}
+	defer zr.Close()
+
+	data, err := io.ReadAll(&contextAwareReader{ctx: ctx, r: io.LimitReader(zr, s.maxResponseBodySize+1)})
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return nil, err
+		}
+		return nil, newErrGzipDecompressFailed(err, rawURL)
+	}
+	if int64(len(data)) > s.maxResponseBodySize {
+		return nil, newErrResponseBodySizeLimitExceeded(s.maxResponseBodySize, rawURL, "application/gzip")
+	}
+
+	return data, nil
+}
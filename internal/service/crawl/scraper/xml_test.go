@@ -0,0 +1,226 @@
+package scraper
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/xml"
+	"net/http"
+	"strings"
+	"testing"
+
+	apperrors "github.com/darkkaiser/rss-feed-server/internal/errors"
+	"github.com/darkkaiser/rss-feed-server/internal/service/crawl/fetcher/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/encoding/charmap"
+)
+
+// testURLSet 테스트용 사이트맵 문서 구조입니다.
+type testURLSet struct {
+	XMLName xml.Name `xml:"urlset"`
+	URLs    []struct {
+		Loc     string `xml:"loc"`
+		LastMod string `xml:"lastmod"`
+	} `xml:"url"`
+}
+
+const testSitemap = `<?xml version="1.0" encoding="UTF-8"?>
+<urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">
+  <url><loc>https://example.com/news/1</loc><lastmod>2026-10-18</lastmod></url>
+  <url><loc>https://example.com/news/2</loc><lastmod>2026-10-19T09:00:00+09:00</lastmod></url>
+</urlset>`
+
+func gzipString(t *testing.T, s string) string {
+	t.Helper()
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	_, err := zw.Write([]byte(s))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+	return buf.String()
+}
+
+func TestFetchXML(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		contentType string
+		statusCode  int
+		maxBodySize int64
+
+		wantErr     bool
+		errType     apperrors.ErrorType
+		errContains []string
+	}{
+		{
+			name:        "Success: 일반 XML 문서",
+			body:        testSitemap,
+			contentType: "application/xml",
+		},
+		{
+			name:        "Success: Content-Encoding 없이 전달된 gzip 파일",
+			body:        gzipString(t, testSitemap),
+			contentType: "application/x-gzip",
+		},
+		{
+			name:        "Success: 선언된 문자 인코딩으로 변환",
+			body:        strings.Replace(testSitemap, `encoding="UTF-8"`, `encoding="ISO-8859-1"`, 1),
+			contentType: "text/xml",
+		},
+		{
+			name:        "Error: 닫히지 않은 태그",
+			body:        "<urlset>\n<url><loc>https://example.com/news/1</loc>\n",
+			contentType: "application/xml",
+			wantErr:     true,
+			errType:     apperrors.ParsingFailed,
+			errContains: []string{"XML 파싱 실패"},
+		},
+		{
+			name:        "Error: 손상된 gzip 파일",
+			body:        gzipString(t, testSitemap)[:20],
+			contentType: "application/gzip",
+			wantErr:     true,
+			errType:     apperrors.ParsingFailed,
+			errContains: []string{"압축 해제 실패"},
+		},
+		{
+			name:        "Error: 응답 본문 크기 제한 초과",
+			body:        testSitemap,
+			contentType: "application/xml",
+			maxBodySize: 64,
+			wantErr:     true,
+			errType:     apperrors.InvalidInput,
+			errContains: []string{"응답 본문 크기 초과"},
+		},
+		{
+			name:        "Error: 압축 해제 후 크기 제한 초과",
+			body:        gzipString(t, testSitemap+strings.Repeat(" ", 4096)),
+			contentType: "application/gzip",
+			maxBodySize: 1024,
+			wantErr:     true,
+			errType:     apperrors.InvalidInput,
+			errContains: []string{"응답 본문 크기 초과", "application/gzip"},
+		},
+		{
+			name:        "Error: HTTP 404",
+			body:        "not found",
+			contentType: "text/plain",
+			statusCode:  http.StatusNotFound,
+			wantErr:     true,
+			errType:     apperrors.ExecutionFailed,
+			errContains: []string{"HTTP 요청 실패"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statusCode := tt.statusCode
+			if statusCode == 0 {
+				statusCode = http.StatusOK
+			}
+
+			m := &mocks.MockFetcher{}
+			resp := mocks.NewMockResponse(tt.body, statusCode)
+			resp.Header.Set("Content-Type", tt.contentType)
+			m.On("Do", mock.Anything).Return(resp, nil)
+
+			var opts []Option
+			if tt.maxBodySize > 0 {
+				opts = append(opts, WithMaxResponseBodySize(tt.maxBodySize))
+			}
+
+			var v testURLSet
+			err := New(m, opts...).FetchXML(context.Background(), "https://example.com/sitemap.xml", nil, &v)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.True(t, apperrors.Is(err, tt.errType), "Expected error type %s, got %v", tt.errType, err)
+				for _, msg := range tt.errContains {
+					assert.Contains(t, err.Error(), msg)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, v.URLs, 2)
+			assert.Equal(t, "https://example.com/news/1", v.URLs[0].Loc)
+			assert.Equal(t, "2026-10-19T09:00:00+09:00", v.URLs[1].LastMod)
+		})
+	}
+}
+
+// TestFetchXML_Charset 압축을 해제한 본문으로 문자 인코딩을 감지하고, 공급자 설정(charset)을 따르는지 검증합니다.
+func TestFetchXML_Charset(t *testing.T) {
+	koreanSitemap := strings.Replace(testSitemap, "https://example.com/news/1", "https://example.com/공지사항/1", 1)
+
+	tests := []struct {
+		name        string
+		body        string
+		contentType string
+		opts        []Option
+		wantLoc     string
+	}{
+		{
+			name:        "gzip으로 압축된 CP949 문서를 utf-8로 잘못 선언",
+			body:        gzipString(t, eucKrContent(koreanSitemap)),
+			contentType: "application/gzip",
+			wantLoc:     "https://example.com/공지사항/1",
+		},
+		{
+			name:        "헤더에 utf-8로 잘못 선언한 CP949 문서",
+			body:        eucKrContent(koreanSitemap),
+			contentType: "text/xml; charset=utf-8",
+			wantLoc:     "https://example.com/공지사항/1",
+		},
+		{
+			name:        "gzip으로 압축된 문서에도 공급자 설정을 적용",
+			body:        gzipString(t, eucKrContent(koreanSitemap)),
+			contentType: "application/gzip",
+			opts:        []Option{WithCharset("euc-kr")},
+			wantLoc:     "https://example.com/공지사항/1",
+		},
+		{
+			name:        "공급자 설정이 XML 선언보다 우선",
+			body:        koreanSitemap,
+			contentType: "text/xml",
+			opts:        []Option{WithCharset("windows-1252")},
+			wantLoc:     mustDecodeWindows1252(t, "https://example.com/공지사항/1"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &mocks.MockFetcher{}
+			resp := mocks.NewMockResponse(tt.body, http.StatusOK)
+			resp.Header.Set("Content-Type", tt.contentType)
+			m.On("Do", mock.Anything).Return(resp, nil)
+
+			var v testURLSet
+			err := New(m, tt.opts...).FetchXML(context.Background(), "https://example.com/sitemap.xml.gz", nil, &v)
+			require.NoError(t, err)
+
+			require.Len(t, v.URLs, 2)
+			assert.Equal(t, tt.wantLoc, v.URLs[0].Loc)
+		})
+	}
+}
+
+// mustDecodeWindows1252 UTF-8 문자열의 바이트를 windows-1252로 해석한 결과(깨진 문자열)를 반환합니다.
+func mustDecodeWindows1252(t *testing.T, s string) string {
+	t.Helper()
+
+	decoded, err := charmap.Windows1252.NewDecoder().String(s)
+	require.NoError(t, err)
+	return decoded
+}
+
+func TestFetchXML_InvalidTarget(t *testing.T) {
+	m := &mocks.MockFetcher{}
+
+	err := New(m).FetchXML(context.Background(), "https://example.com/sitemap.xml", nil, nil)
+	assert.ErrorIs(t, err, ErrDecodeTargetNil)
+	m.AssertNotCalled(t, "Do", mock.Anything)
+}
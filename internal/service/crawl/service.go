@@ -16,6 +16,7 @@ import (
 	"github.com/darkkaiser/rss-feed-server/internal/service/crawl/fetcher"
 	"github.com/darkkaiser/rss-feed-server/internal/service/crawl/provider"
 	_ "github.com/darkkaiser/rss-feed-server/internal/service/crawl/provider/navercafe"
+	_ "github.com/darkkaiser/rss-feed-server/internal/service/crawl/provider/sitemap"
 	_ "github.com/darkkaiser/rss-feed-server/internal/service/crawl/provider/ssangbonges"
 	_ "github.com/darkkaiser/rss-feed-server/internal/service/crawl/provider/yeosucityhall"
 	"github.com/robfig/cron/v3"
@@ -30,9 +31,15 @@ type Service struct {
 
 	cron *cron.Cron
 
-	// fetcher 모든 크롤러가 공유하는 HTTP 클라이언트입니다.
+	// fetcher 공급자별 HTTP 설정이 없는 크롤러들이 공유하는 HTTP 클라이언트입니다. (공통 HTTP 설정으로 구성)
 	fetcher fetcher.Fetcher
 
+	// providerFetchers 공급자별 HTTP 설정(http)이 지정된 공급자에 한해 별도로 구성한 HTTP 클라이언트입니다. (키: 공급자 ID)
+	providerFetchers map[string]fetcher.Fetcher
+
+	// rateLimiter 모든 Fetcher 체인이 공유하는 호스트별 요청 속도 제한 상태입니다.
+	rateLimiter *fetcher.HostRateLimiter
+
 	feedRepo feed.Repository
 
 	notifyClient *notify.Client
@@ -53,11 +60,40 @@ func NewService(cfg *config.RSSFeedConfig, feedRepo feed.Repository, notifyClien
 		panic("feed.Repository는 필수입니다")
 	}
 
+	// 공통 HTTP 설정으로 공유 Fetcher를 구성하고, HTTP 설정을 따로 지정한 공급자에는 설정을 덮어쓴 별도의 Fetcher 체인을 구성합니다.
+	// 덕분에 응답이 느린 사이트에 긴 타임아웃이나 프록시를 지정해도 다른 공급자의 요청에는 영향을 주지 않습니다.
+	// 호스트별 요청 속도 제한은 Fetcher 체인이 달라도 같은 호스트라면 함께 적용되도록 하나의 상태를 공유합니다.
+	rateLimiter := newHostRateLimiter(cfg.RateLimit)
+	robotsPolicy := newRobotsPolicy(cfg, rateLimiter)
+	responseCache := newResponseCache(cfg.HTTPCache)
+
+	providerFetchers := make(map[string]fetcher.Fetcher)
+	for _, p := range cfg.Providers {
+		// 로그인 세션은 설정한 공급자의 Fetcher 체인에만 연결하여 다른 공급자의 요청에 쿠키가 포함되지 않도록 합니다.
+		session := newProviderSession(p, notifyClient)
+
+		// fixture 기록을 켜면 공급자별 디렉터리에 나누어 기록할 수 있도록 모든 공급자에 별도의 Fetcher 체인을 구성합니다.
+		recordingDir := cfg.FixtureRecording.ProviderDir(p.ID)
+		if p.HTTP == nil && session == nil && recordingDir == "" {
+			continue
+		}
+
+		fetcherConfig := newFetcherConfig(cfg.HTTP.Merge(p.HTTP), rateLimiter, robotsPolicy, responseCache)
+		fetcherConfig.RecordingDir = recordingDir
+		if session != nil {
+			// 로그인한 상태의 응답이 공유 응답 캐시를 통해 다른 공급자에게 전달되지 않도록 응답 캐시를 사용하지 않습니다.
+			fetcherConfig.Session = session
+			fetcherConfig.ResponseCache = nil
+		}
+		providerFetchers[p.ID] = fetcher.NewFromConfig(fetcherConfig)
+	}
+
 	return &Service{
 		cfg: cfg,
 
-		// 모든 크롤러가 공유하는 HTTP 클라이언트(Fetcher)를 초기화합니다.
-		fetcher: fetcher.New(3, 5*time.Second, 10*1024*1024),
+		fetcher:          fetcher.NewFromConfig(newFetcherConfig(cfg.HTTP, rateLimiter, robotsPolicy, responseCache)),
+		providerFetchers: providerFetchers,
+		rateLimiter:      rateLimiter,
 
 		feedRepo: feedRepo,
 
@@ -158,7 +194,7 @@ func (s *Service) stop() {
 	s.cron = nil
 	s.running = false
 
-	// 소켓 누수(Resource Leak) 방지를 위해 공유 Fetcher의 HTTP 커넥션 풀을 안전하게 회수합니다.
+	// 소켓 누수(Resource Leak) 방지를 위해 공유 Fetcher와 공급자별 Fetcher의 HTTP 커넥션 풀을 안전하게 회수합니다.
 	if s.fetcher != nil {
 		if err := s.fetcher.Close(); err != nil {
 			applog.WithComponentAndFields(component, applog.Fields{
@@ -166,6 +202,14 @@ func (s *Service) stop() {
 			}).Errorf("Fetcher 리소스 정리 실패: 커넥션 풀 해제 과정에서 오류 발생")
 		}
 	}
+	for providerID, f := range s.providerFetchers {
+		if err := f.Close(); err != nil {
+			applog.WithComponentAndFields(component, applog.Fields{
+				"provider_id": providerID,
+				"error":       err,
+			}).Errorf("Fetcher 리소스 정리 실패: 공급자별 커넥션 풀 해제 과정에서 오류 발생")
+		}
+	}
 
 	applog.WithComponent(component).Info("크롤링 서비스 종료 완료: 모든 리소스가 정리되었습니다")
 }
@@ -182,7 +226,7 @@ func (s *Service) registerJobs(ctx context.Context) error {
 		crawler, err := cfg.NewCrawler(provider.NewCrawlerParams{
 			ProviderID:   p.ID,
 			Config:       p.Config,
-			Fetcher:      s.fetcher,
+			Fetcher:      s.fetcherFor(p),
 			FeedRepo:     s.feedRepo,
 			NotifyClient: s.notifyClient,
 		})
@@ -192,11 +236,104 @@ func (s *Service) registerJobs(ctx context.Context) error {
 		}
 
 		if _, err := s.cron.AddFunc(p.Scheduler.TimeSpec, func() {
-			crawler.Run(ctx)
+			s.runExclusive(ctx, "crawl:"+p.ID, func() { crawler.Run(ctx) })
 		}); err != nil {
 			s.logAndNotifyError(fmt.Sprintf("지정된 Provider Site(%s, 식별자: %s)의 Cron 표현식 구문에 오류가 있어 스케줄 등록에 실패했습니다.", p.Site, p.ID), err)
 			return apperrors.Wrapf(err, apperrors.Internal, "크롤러 스케줄 등록 실패: Cron 표현식 구문이 잘못되었습니다 (Site: %s, ID: %s, TimeSpec: '%s')", config.ProviderSite(p.Site), p.ID, p.Scheduler.TimeSpec)
 		}
+
+		if err := s.registerRevalidationJob(ctx, p, crawler); err != nil {
+			return err
+		}
+
+		if err := s.registerDeletionCheckJob(ctx, p, crawler); err != nil {
+			return err
+		}
+	}
+
+	return s.registerPurgeJob(ctx)
+}
+
+// fetcherFor 공급자 p의 크롤러에 주입할 Fetcher를 반환합니다. 공급자별 HTTP 설정이 없으면 공유 Fetcher를 반환합니다.
+func (s *Service) fetcherFor(p *config.ProviderConfig) fetcher.Fetcher {
+	if f, ok := s.providerFetchers[p.ID]; ok {
+		return f
+	}
+	return s.fetcher
+}
+
+// runExclusive 저장소가 feed.JobLockRepository를 구현한 경우 name으로 식별되는 작업의 잠금을 획득한 뒤 job을 실행합니다.
+//
+// 여러 서버 인스턴스가 하나의 데이터베이스(PostgreSQL)를 공유하면 모든 인스턴스의 Cron 스케줄러가 같은 시각에 같은 작업을 실행하므로,
+// 잠금을 획득한 인스턴스만 작업을 실행하고 나머지 인스턴스는 이번 주기를 건너뜁니다.
+// 잠금 확인에 실패한 경우에도 중복 실행을 피하기 위해 이번 주기를 건너뛰며, 저장소가 잠금을 지원하지 않으면 항상 job을 실행합니다.
+func (s *Service) runExclusive(ctx context.Context, name string, job func()) {
+	locker, ok := s.feedRepo.(feed.JobLockRepository)
+	if !ok {
+		job()
+		return
+	}
+
+	unlock, acquired, err := locker.TryLockJob(ctx, name)
+	if err != nil {
+		applog.WithComponentAndFields(component, applog.Fields{
+			"job":   name,
+			"error": err,
+		}).Warn("작업 잠금 확인 실패: 다른 인스턴스와의 중복 실행을 피하기 위해 이번 주기의 작업을 건너뜁니다")
+		return
+	}
+	if !acquired {
+		applog.WithComponentAndFields(component, applog.Fields{
+			"job": name,
+		}).Debug("작업 건너뜀: 다른 인스턴스가 같은 작업을 실행 중입니다")
+		return
+	}
+	defer unlock()
+
+	job()
+}
+
+// registerRevalidationJob 재검증 설정이 활성화되어 있고 크롤러가 provider.Revalidator를 구현한 경우,
+// 이미 수집한 게시글의 원문 수정 여부를 확인하는 재검증 작업을 Cron 스케줄러에 등록합니다.
+func (s *Service) registerRevalidationJob(ctx context.Context, p *config.ProviderConfig, crawler provider.Crawler) error {
+	if !s.cfg.Revalidation.Enabled() {
+		return nil
+	}
+
+	revalidator, ok := crawler.(provider.Revalidator)
+	if !ok {
+		return nil
+	}
+
+	days := s.cfg.Revalidation.Days
+	if _, err := s.cron.AddFunc(s.cfg.Revalidation.TimeSpec, func() {
+		s.runExclusive(ctx, "revalidate:"+p.ID, func() { revalidator.Revalidate(ctx, days) })
+	}); err != nil {
+		s.logAndNotifyError(fmt.Sprintf("지정된 Provider Site(%s, 식별자: %s)의 재검증 Cron 표현식 구문에 오류가 있어 스케줄 등록에 실패했습니다.", p.Site, p.ID), err)
+		return apperrors.Wrapf(err, apperrors.Internal, "재검증 스케줄 등록 실패: Cron 표현식 구문이 잘못되었습니다 (Site: %s, ID: %s, TimeSpec: '%s')", config.ProviderSite(p.Site), p.ID, s.cfg.Revalidation.TimeSpec)
+	}
+
+	return nil
+}
+
+// registerDeletionCheckJob 삭제 점검 설정이 활성화되어 있고 크롤러가 provider.DeletionChecker를 구현한 경우,
+// 이미 수집한 게시글이 원문 사이트에서 삭제되었는지 표본 점검하는 작업을 Cron 스케줄러에 등록합니다.
+func (s *Service) registerDeletionCheckJob(ctx context.Context, p *config.ProviderConfig, crawler provider.Crawler) error {
+	if !s.cfg.DeletionCheck.Enabled() {
+		return nil
+	}
+
+	checker, ok := crawler.(provider.DeletionChecker)
+	if !ok {
+		return nil
+	}
+
+	days, sampleSize := s.cfg.DeletionCheck.Days, s.cfg.DeletionCheck.SampleSize
+	if _, err := s.cron.AddFunc(s.cfg.DeletionCheck.TimeSpec, func() {
+		s.runExclusive(ctx, "deletion-check:"+p.ID, func() { checker.CheckDeleted(ctx, days, sampleSize) })
+	}); err != nil {
+		s.logAndNotifyError(fmt.Sprintf("지정된 Provider Site(%s, 식별자: %s)의 삭제 점검 Cron 표현식 구문에 오류가 있어 스케줄 등록에 실패했습니다.", p.Site, p.ID), err)
+		return apperrors.Wrapf(err, apperrors.Internal, "삭제 점검 스케줄 등록 실패: Cron 표현식 구문이 잘못되었습니다 (Site: %s, ID: %s, TimeSpec: '%s')", config.ProviderSite(p.Site), p.ID, s.cfg.DeletionCheck.TimeSpec)
 	}
 
 	return nil
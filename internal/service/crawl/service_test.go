@@ -5,6 +5,7 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
 	"sync"
 	"testing"
 	"time"
@@ -47,6 +48,20 @@ func (m *mockCrawler) Run(ctx context.Context) {
 	}
 }
 
+// mockRevalidatingCrawler는 provider.Revalidator를 함께 구현하는 테스트용 크롤러입니다.
+type mockRevalidatingCrawler struct {
+	mockCrawler
+}
+
+func (m *mockRevalidatingCrawler) Revalidate(ctx context.Context, days uint) {}
+
+// mockDeletionCheckingCrawler는 provider.DeletionChecker를 함께 구현하는 테스트용 크롤러입니다.
+type mockDeletionCheckingCrawler struct {
+	mockCrawler
+}
+
+func (m *mockDeletionCheckingCrawler) CheckDeleted(ctx context.Context, days, sampleSize uint) {}
+
 // mockFetcher는 Fetcher 리소스 반환 실패(Close Error) 시나리오 검증용 구조체입니다.
 type mockFetcher struct {
 	CloseError error
@@ -73,6 +88,27 @@ func init() {
 		},
 	})
 
+	// 재검증 작업 등록 테스트를 위한 Mock Provider 등록
+	provider.MustRegister("revalidating_site", &provider.CrawlerConfig{
+		NewCrawler: func(params provider.NewCrawlerParams) (provider.Crawler, error) {
+			return &mockRevalidatingCrawler{mockCrawler{config: params.Config, id: params.ProviderID}}, nil
+		},
+	})
+
+	// 삭제 점검 작업 등록 테스트를 위한 Mock Provider 등록
+	provider.MustRegister("deletion_checking_site", &provider.CrawlerConfig{
+		NewCrawler: func(params provider.NewCrawlerParams) (provider.Crawler, error) {
+			return &mockDeletionCheckingCrawler{mockCrawler{config: params.Config, id: params.ProviderID}}, nil
+		},
+	})
+
+	// 파싱 실패 스냅샷 재생 테스트를 위한 Mock Provider 등록
+	provider.MustRegister("replaying_site", &provider.CrawlerConfig{
+		NewCrawler: func(params provider.NewCrawlerParams) (provider.Crawler, error) {
+			return &mockReplayingCrawler{mockCrawler{config: params.Config, id: params.ProviderID}}, nil
+		},
+	})
+
 	// 팩토리 초기화 에러 반환을 위한 Mock Provider 등록
 	provider.MustRegister("new_crawler_fail_site", &provider.CrawlerConfig{
 		NewCrawler: func(params provider.NewCrawlerParams) (provider.Crawler, error) {
@@ -231,6 +267,43 @@ func TestService_StartAndStop(t *testing.T) {
 	})
 }
 
+func TestNewService_ProviderFetchers(t *testing.T) {
+	timeout := time.Minute
+	cfg := &config.RSSFeedConfig{
+		Providers: []*config.ProviderConfig{
+			{ID: "shared"},
+			{ID: "slow", HTTP: &config.HTTPConfig{Timeout: &timeout}},
+		},
+	}
+
+	s := NewService(cfg, &mockFeedRepo{}, nil)
+
+	assert.Same(t, s.fetcher, s.fetcherFor(cfg.Providers[0]), "HTTP 설정이 없는 공급자는 공유 Fetcher를 사용해야 합니다")
+	require.Contains(t, s.providerFetchers, "slow")
+	assert.Same(t, s.providerFetchers["slow"], s.fetcherFor(cfg.Providers[1]))
+	assert.NotSame(t, s.fetcher, s.fetcherFor(cfg.Providers[1]), "HTTP 설정이 있는 공급자는 별도의 Fetcher 체인을 사용해야 합니다")
+	assert.NotNil(t, s.rateLimiter, "호스트별 요청 속도 제한 상태는 모든 Fetcher 체인이 공유하도록 서비스에 하나만 생성되어야 합니다")
+}
+
+func TestNewService_FixtureRecording(t *testing.T) {
+	dir := t.TempDir()
+	cfg := &config.RSSFeedConfig{
+		Providers: []*config.ProviderConfig{
+			{ID: "first"},
+			{ID: "second"},
+		},
+		FixtureRecording: config.FixtureRecordingConfig{Dir: dir},
+	}
+
+	s := NewService(cfg, &mockFeedRepo{}, nil)
+
+	for _, p := range cfg.Providers {
+		require.Contains(t, s.providerFetchers, p.ID, "fixture 기록을 켜면 모든 공급자가 별도의 Fetcher 체인을 사용해야 합니다")
+		assert.DirExists(t, filepath.Join(dir, p.ID), "공급자별 fixture 디렉터리가 생성되어야 합니다")
+	}
+	assert.NotSame(t, s.providerFetchers["first"], s.providerFetchers["second"])
+}
+
 func TestService_stop_CloseError(t *testing.T) {
 	// fetcher.Close() 호출 시 에러가 발생하는 예외 상황을 처리하는 방어 로직 검증 (100% 커버리지 확보)
 	t.Run("성공: Fetcher.Close 에러 로깅 시 패닉 없이 안전한 서비스 종료", func(t *testing.T) {
@@ -290,6 +363,88 @@ func TestService_registerJobs_Exceptions(t *testing.T) {
 	})
 }
 
+func TestService_registerRevalidationJob(t *testing.T) {
+	repo := &mockFeedRepo{}
+	newCfg := func(site string, revalidation config.RevalidationConfig) *config.RSSFeedConfig {
+		return &config.RSSFeedConfig{
+			Providers: []*config.ProviderConfig{
+				{Site: site, ID: "p-1", Scheduler: config.SchedulerConfig{TimeSpec: "0 */5 * * * *"}},
+			},
+			Revalidation: revalidation,
+		}
+	}
+
+	t.Run("성공: 재검증 활성화 시 Revalidator 구현 크롤러에 재검증 작업 추가 등록", func(t *testing.T) {
+		s := NewService(newCfg("revalidating_site", config.RevalidationConfig{Days: 7, TimeSpec: "0 0 */6 * * *"}), repo, nil)
+		s.cron = cron.New(cron.WithParser(cronx.StandardParser()))
+
+		require.NoError(t, s.registerJobs(context.Background()))
+		assert.Len(t, s.cron.Entries(), 2)
+	})
+
+	t.Run("성공: Revalidator 미구현 크롤러는 재검증 작업을 등록하지 않음", func(t *testing.T) {
+		s := NewService(newCfg("test_site_success", config.RevalidationConfig{Days: 7, TimeSpec: "0 0 */6 * * *"}), repo, nil)
+		s.cron = cron.New(cron.WithParser(cronx.StandardParser()))
+
+		require.NoError(t, s.registerJobs(context.Background()))
+		assert.Len(t, s.cron.Entries(), 1)
+	})
+
+	t.Run("성공: 재검증 비활성화 시 등록하지 않음", func(t *testing.T) {
+		s := NewService(newCfg("revalidating_site", config.RevalidationConfig{}), repo, nil)
+		s.cron = cron.New(cron.WithParser(cronx.StandardParser()))
+
+		require.NoError(t, s.registerJobs(context.Background()))
+		assert.Len(t, s.cron.Entries(), 1)
+	})
+
+	t.Run("실패: 잘못된 재검증 Cron 표현식 지정 시 에러", func(t *testing.T) {
+		s := NewService(newCfg("revalidating_site", config.RevalidationConfig{Days: 7, TimeSpec: "invalid_%_string"}), repo, nil)
+		s.cron = cron.New(cron.WithParser(cronx.StandardParser()))
+
+		err := s.registerJobs(context.Background())
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "재검증 스케줄 등록 실패")
+	})
+}
+
+func TestService_registerDeletionCheckJob(t *testing.T) {
+	repo := &mockFeedRepo{}
+	newCfg := func(site string, deletionCheck config.DeletionCheckConfig) *config.RSSFeedConfig {
+		return &config.RSSFeedConfig{
+			Providers: []*config.ProviderConfig{
+				{Site: site, ID: "p-1", Scheduler: config.SchedulerConfig{TimeSpec: "0 */5 * * * *"}},
+			},
+			DeletionCheck: deletionCheck,
+		}
+	}
+
+	t.Run("성공: 삭제 점검 활성화 시 DeletionChecker 구현 크롤러에 점검 작업 추가 등록", func(t *testing.T) {
+		s := NewService(newCfg("deletion_checking_site", config.DeletionCheckConfig{Days: 14, SampleSize: 20, TimeSpec: "0 0 3 * * *"}), repo, nil)
+		s.cron = cron.New(cron.WithParser(cronx.StandardParser()))
+
+		require.NoError(t, s.registerJobs(context.Background()))
+		assert.Len(t, s.cron.Entries(), 2)
+	})
+
+	t.Run("성공: DeletionChecker 미구현 크롤러는 점검 작업을 등록하지 않음", func(t *testing.T) {
+		s := NewService(newCfg("test_site_success", config.DeletionCheckConfig{Days: 14, SampleSize: 20, TimeSpec: "0 0 3 * * *"}), repo, nil)
+		s.cron = cron.New(cron.WithParser(cronx.StandardParser()))
+
+		require.NoError(t, s.registerJobs(context.Background()))
+		assert.Len(t, s.cron.Entries(), 1)
+	})
+
+	t.Run("실패: 잘못된 삭제 점검 Cron 표현식 지정 시 에러", func(t *testing.T) {
+		s := NewService(newCfg("deletion_checking_site", config.DeletionCheckConfig{Days: 14, SampleSize: 20, TimeSpec: "invalid_%_string"}), repo, nil)
+		s.cron = cron.New(cron.WithParser(cronx.StandardParser()))
+
+		err := s.registerJobs(context.Background())
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "삭제 점검 스케줄 등록 실패")
+	})
+}
+
 func TestService_logAndNotifyError(t *testing.T) {
 	t.Run("성공: 알림 클라이언트가 nil일 때 패닉 없이 로그만 처리", func(t *testing.T) {
 		s := NewService(&config.RSSFeedConfig{}, &mockFeedRepo{}, nil)
@@ -327,3 +482,68 @@ func TestService_logAndNotifyError(t *testing.T) {
 		}
 	})
 }
+
+// mockJobLockingFeedRepo는 feed.JobLockRepository를 함께 구현하는 테스트용 저장소입니다.
+type mockJobLockingFeedRepo struct {
+	mockFeedRepo
+
+	acquired bool
+	err      error
+
+	lockedNames []string
+	unlocked    int
+}
+
+func (m *mockJobLockingFeedRepo) TryLockJob(ctx context.Context, name string) (func(), bool, error) {
+	m.lockedNames = append(m.lockedNames, name)
+	if m.err != nil || !m.acquired {
+		return nil, false, m.err
+	}
+	return func() { m.unlocked++ }, true, nil
+}
+
+func TestService_runExclusive(t *testing.T) {
+	t.Run("잠금을 지원하지 않는 저장소는 항상 작업을 실행한다", func(t *testing.T) {
+		s := NewService(&config.RSSFeedConfig{}, &mockFeedRepo{}, nil)
+
+		ran := false
+		s.runExclusive(context.Background(), "purge", func() { ran = true })
+
+		assert.True(t, ran)
+	})
+
+	t.Run("잠금을 획득하면 작업을 실행한 뒤 해제한다", func(t *testing.T) {
+		repo := &mockJobLockingFeedRepo{acquired: true}
+		s := NewService(&config.RSSFeedConfig{}, repo, nil)
+
+		ran := false
+		s.runExclusive(context.Background(), "crawl:p1", func() {
+			ran = true
+			assert.Zero(t, repo.unlocked, "작업이 끝나기 전에는 잠금을 해제하지 않아야 합니다")
+		})
+
+		assert.True(t, ran)
+		assert.Equal(t, []string{"crawl:p1"}, repo.lockedNames)
+		assert.Equal(t, 1, repo.unlocked)
+	})
+
+	t.Run("다른 인스턴스가 잠금을 보유하면 작업을 건너뛴다", func(t *testing.T) {
+		repo := &mockJobLockingFeedRepo{acquired: false}
+		s := NewService(&config.RSSFeedConfig{}, repo, nil)
+
+		ran := false
+		s.runExclusive(context.Background(), "crawl:p1", func() { ran = true })
+
+		assert.False(t, ran)
+	})
+
+	t.Run("잠금 확인에 실패하면 작업을 건너뛴다", func(t *testing.T) {
+		repo := &mockJobLockingFeedRepo{err: errors.New("connection refused")}
+		s := NewService(&config.RSSFeedConfig{}, repo, nil)
+
+		ran := false
+		s.runExclusive(context.Background(), "purge", func() { ran = true })
+
+		assert.False(t, ran)
+	})
+}
@@ -0,0 +1,72 @@
+package crawl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	applog "github.com/darkkaiser/notify-server/pkg/log"
+	"github.com/darkkaiser/notify-server/pkg/notify"
+	"github.com/darkkaiser/rss-feed-server/internal/config"
+	"github.com/darkkaiser/rss-feed-server/internal/service/crawl/fetcher"
+	"github.com/darkkaiser/rss-feed-server/internal/service/crawl/provider"
+)
+
+// newProviderSession 공급자 p에 로그인 세션 설정이 있으면 쿠키 파일을 읽어 해당 공급자 전용 Session을 생성합니다.
+//
+// 세션 만료 판단에는 사이트별 기본 표시 문자열(provider.CrawlerConfig.SessionMarkers)과 설정 파일에서 추가한 표시 문자열을 함께 사용합니다.
+// 쿠키 파일을 읽지 못하면 에러를 기록하고 nil을 반환하여, 해당 공급자는 이전과 같이 비로그인 상태로 크롤링합니다.
+func newProviderSession(p *config.ProviderConfig, notifyClient *notify.Client) *fetcher.Session {
+	if p.Session == nil {
+		return nil
+	}
+
+	var markers fetcher.SessionMarkers
+	if crawlerConfig, err := provider.Lookup(config.ProviderSite(p.Site)); err == nil {
+		markers.URL = append(markers.URL, crawlerConfig.SessionMarkers.URL...)
+		markers.Body = append(markers.Body, crawlerConfig.SessionMarkers.Body...)
+	}
+	markers.URL = append(markers.URL, p.Session.ExpiredURLMarkers...)
+	markers.Body = append(markers.Body, p.Session.ExpiredBodyMarkers...)
+
+	session, err := fetcher.NewSession(strings.TrimSpace(p.Session.CookiesFile), markers, func(ctx context.Context, reason string) {
+		notifySessionExpired(ctx, notifyClient, p, reason)
+	})
+	if err != nil {
+		applog.WithComponentAndFields(component, applog.Fields{
+			"provider_id":  p.ID,
+			"cookies_file": p.Session.CookiesFile,
+			"error":        err,
+		}).Error("로그인 세션 초기화 실패: 쿠키 파일을 사용할 수 없어 해당 공급자는 비로그인 상태로 크롤링합니다")
+
+		return nil
+	}
+
+	return session
+}
+
+// notifySessionExpired 로그인 세션이 만료되어 재인증이 필요하다는 사실을 관리자에게 알립니다.
+//
+// Session이 쿠키 파일을 다시 읽기 전까지 한 번만 호출하므로, 크롤링 주기마다 같은 알림이 반복되지 않습니다.
+func notifySessionExpired(ctx context.Context, notifyClient *notify.Client, p *config.ProviderConfig, reason string) {
+	message := fmt.Sprintf("[%s] 로그인 세션이 만료되어 회원 전용 게시판을 비로그인 상태로 수집합니다 (%s). 다시 로그인한 뒤 쿠키 파일(%s)을 갱신하세요", p.ID, reason, p.Session.CookiesFile)
+
+	applog.WithComponentAndFields(component, applog.Fields{
+		"provider_id":  p.ID,
+		"cookies_file": p.Session.CookiesFile,
+		"reason":       reason,
+	}).Warn("로그인 세션 만료: 관리자에게 재인증 필요 알림을 전송합니다")
+
+	if notifyClient == nil {
+		return
+	}
+
+	// 요청 컨텍스트가 곧 취소되더라도 알림은 전송되도록 별도의 컨텍스트를 사용합니다.
+	go func() {
+		notifyCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+		defer cancel()
+
+		notifyClient.NotifyError(notifyCtx, message)
+	}()
+}
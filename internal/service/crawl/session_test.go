@@ -0,0 +1,54 @@
+package crawl
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/darkkaiser/rss-feed-server/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTestCookiesFile(t *testing.T) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "navercafe.cookies.json")
+	require.NoError(t, os.WriteFile(path, []byte(`[{"name":"NID_AUT","value":"secret","domain":".naver.com"}]`), 0o600))
+	return path
+}
+
+func TestNewProviderSession(t *testing.T) {
+	t.Run("세션 설정이 없으면 nil", func(t *testing.T) {
+		assert.Nil(t, newProviderSession(&config.ProviderConfig{ID: "p1"}, nil))
+	})
+
+	t.Run("쿠키 파일을 읽지 못하면 nil (비로그인 상태로 크롤링)", func(t *testing.T) {
+		p := &config.ProviderConfig{ID: "p1", Session: &config.SessionConfig{CookiesFile: filepath.Join(t.TempDir(), "missing.json")}}
+		assert.Nil(t, newProviderSession(p, nil))
+	})
+
+	t.Run("쿠키 파일로 세션 생성", func(t *testing.T) {
+		p := &config.ProviderConfig{ID: "p1", Site: string(config.ProviderSiteNaverCafe), Session: &config.SessionConfig{CookiesFile: writeTestCookiesFile(t)}}
+
+		session := newProviderSession(p, nil)
+		require.NotNil(t, session)
+		assert.False(t, session.Expired())
+	})
+}
+
+func TestNewService_ProviderSession(t *testing.T) {
+	cfg := &config.RSSFeedConfig{
+		HTTPCache: config.HTTPCacheConfig{Dir: filepath.Join(t.TempDir(), "http-cache")},
+		Providers: []*config.ProviderConfig{
+			{ID: "guest"},
+			{ID: "member", Site: string(config.ProviderSiteNaverCafe), Session: &config.SessionConfig{CookiesFile: writeTestCookiesFile(t)}},
+		},
+	}
+
+	s := NewService(cfg, &mockFeedRepo{}, nil)
+
+	assert.Same(t, s.fetcher, s.fetcherFor(cfg.Providers[0]))
+	require.Contains(t, s.providerFetchers, "member", "로그인 세션을 설정한 공급자는 별도의 Fetcher 체인을 사용해야 합니다")
+	assert.NotSame(t, s.fetcher, s.fetcherFor(cfg.Providers[1]))
+}
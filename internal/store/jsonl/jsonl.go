@@ -0,0 +1,235 @@
+// Package jsonl 저장소의 게시글을 JSON Lines 형식으로 내보내고 다시 가져오는 기능을 제공합니다.
+//
+// 한 줄에 게시글 하나를 JSON 객체로 기록하므로, 호스트 이전 시 데이터베이스 종류(SQLite, PostgreSQL)와 무관하게 데이터를 옮기거나
+// 일부 공급자·기간의 게시글만 추려 테스트 환경의 초기 데이터로 사용할 수 있습니다.
+package jsonl
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+)
+
+// DefaultBatchSize 가져오기 시 SaveArticles 한 번에 전달하는 기본 게시글 수입니다.
+const DefaultBatchSize = 500
+
+// Record JSON Lines 파일의 한 줄에 해당하는 게시글 레코드입니다.
+type Record struct {
+	ProviderID    string             `json:"provider_id"`
+	BoardID       string             `json:"board_id"`
+	ArticleID     string             `json:"article_id"`
+	Title         string             `json:"title"`
+	Content       string             `json:"content,omitempty"`
+	Link          string             `json:"link"`
+	Author        string             `json:"author,omitempty"`
+	CreatedAt     time.Time          `json:"created_at"`
+	UpdatedAt     *time.Time         `json:"updated_at,omitempty"`
+	DeletedAt     *time.Time         `json:"deleted_at,omitempty"`
+	DeletedReason feed.DeletedReason `json:"deleted_reason,omitempty"`
+}
+
+// newRecord 저장소에서 읽은 게시글을 레코드로 변환합니다.
+func newRecord(providerID string, article *feed.Article) *Record {
+	r := &Record{
+		ProviderID: providerID,
+		BoardID:    article.BoardID,
+		ArticleID:  article.ArticleID,
+		Title:      article.Title,
+		Content:    article.Content,
+		Link:       article.Link,
+		Author:     article.Author,
+		CreatedAt:  article.CreatedAt,
+	}
+	if article.IsEdited() {
+		updatedAt := article.UpdatedAt
+		r.UpdatedAt = &updatedAt
+	}
+	if article.IsDeleted() {
+		deletedAt := article.DeletedAt
+		r.DeletedAt = &deletedAt
+		r.DeletedReason = article.DeletedReason
+	}
+
+	return r
+}
+
+// article 레코드를 SaveArticles에 전달할 게시글로 변환합니다.
+func (r *Record) article() *feed.Article {
+	return &feed.Article{
+		BoardID:   r.BoardID,
+		ArticleID: r.ArticleID,
+		Title:     r.Title,
+		Content:   r.Content,
+		Link:      r.Link,
+		Author:    r.Author,
+		CreatedAt: r.CreatedAt,
+	}
+}
+
+// validate 가져오기에 필요한 식별자가 모두 채워져 있는지 검사합니다.
+func (r *Record) validate() error {
+	switch {
+	case r.ProviderID == "":
+		return errors.New("provider_id가 비어 있습니다")
+	case r.BoardID == "":
+		return errors.New("board_id가 비어 있습니다")
+	case r.ArticleID == "":
+		return errors.New("article_id가 비어 있습니다")
+	}
+
+	return nil
+}
+
+// Export 조건에 맞는 게시글을 JSON Lines 형식으로 w에 기록하고, 기록한 게시글 수를 반환합니다.
+func Export(ctx context.Context, repo feed.ExportRepository, filter feed.ArticleFilter, w io.Writer) (int, error) {
+	bw := bufio.NewWriter(w)
+
+	enc := json.NewEncoder(bw)
+	enc.SetEscapeHTML(false) // 본문의 HTML 태그를 \u003c 형태로 바꾸지 않고 그대로 기록합니다.
+
+	count := 0
+	err := repo.ExportArticles(ctx, filter, func(providerID string, article *feed.Article) error {
+		if err := enc.Encode(newRecord(providerID, article)); err != nil {
+			return fmt.Errorf("게시글 레코드 기록 실패 (providerID: %s, articleID: %s): %w", providerID, article.ArticleID, err)
+		}
+		count++
+		return nil
+	})
+	if err != nil {
+		return count, err
+	}
+
+	if err := bw.Flush(); err != nil {
+		return count, fmt.Errorf("내보내기 파일 기록 실패: %w", err)
+	}
+
+	return count, nil
+}
+
+// ImportResult 가져오기 실행 결과입니다.
+type ImportResult struct {
+	// Read 파일에서 읽은 레코드 수입니다.
+	Read int
+
+	// Saved 저장(신규 추가 또는 덮어쓰기)에 성공한 게시글 수입니다.
+	Saved int
+
+	// Failed 검증 또는 저장에 실패한 게시글 수입니다.
+	Failed int
+
+	// MarkedDeleted 삭제 감지 일시를 함께 복원한 게시글 수입니다.
+	MarkedDeleted int
+}
+
+// Import JSON Lines 형식의 게시글을 읽어 repo.SaveArticles로 저장합니다.
+//
+// 충돌 처리는 SaveArticles의 동작을 그대로 따릅니다. 이미 있는 게시글(공급자, 게시판, 게시글 ID가 같은 경우)은 파일의 내용으로 덮어쓰고,
+// 저장소에 등록되지 않은 공급자나 게시판의 게시글처럼 저장에 실패한 레코드는 건너뛴 뒤 나머지를 계속 처리합니다.
+// 이렇게 개별 레코드가 실패한 경우 결과와 함께 실패 내역을 통합한 에러를 반환하며,
+// 파일 형식이 깨졌거나 컨텍스트가 취소된 경우에는 그 시점까지의 결과와 함께 즉시 중단합니다.
+//
+// 삭제 감지 일시(deleted_at)와 판정 근거(deleted_reason)는 repo가 feed.DeletionRepository를 구현하는 경우에만 복원하며,
+// 수정 감지 일시(updated_at)와 수정 이력은 SaveArticles가 다루지 않으므로 복원하지 않습니다.
+func Import(ctx context.Context, repo feed.Repository, r io.Reader, batchSize int) (*ImportResult, error) {
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+
+	deletion, _ := repo.(feed.DeletionRepository)
+
+	var (
+		result = &ImportResult{}
+		errs   []error
+		batch  []*Record
+		lineNo int
+		br     = bufio.NewReader(r)
+	)
+
+	// flush 같은 공급자의 레코드 묶음을 한 번의 SaveArticles 호출로 저장합니다.
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+
+		providerID := batch[0].ProviderID
+		articles := make([]*feed.Article, len(batch))
+		for i, record := range batch {
+			articles[i] = record.article()
+		}
+
+		saved, err := repo.SaveArticles(ctx, providerID, articles)
+		result.Saved += saved
+		result.Failed += len(batch) - saved
+		if err != nil {
+			errs = append(errs, err)
+		}
+
+		if deletion != nil && saved > 0 {
+			for _, record := range batch {
+				if record.DeletedAt == nil {
+					continue
+				}
+				if err := deletion.MarkArticleDeleted(ctx, providerID, record.BoardID, record.ArticleID, *record.DeletedAt, record.DeletedReason); err != nil {
+					errs = append(errs, err)
+					continue
+				}
+				result.MarkedDeleted++
+			}
+		}
+
+		batch = batch[:0]
+
+		return ctx.Err()
+	}
+
+	for {
+		line, readErr := br.ReadBytes('\n')
+		if len(line) > 0 {
+			lineNo++
+
+			if line = bytes.TrimSpace(line); len(line) > 0 {
+				var record Record
+				if err := json.Unmarshal(line, &record); err != nil {
+					return result, fmt.Errorf("%d번째 줄의 JSON 해석 실패: %w", lineNo, err)
+				}
+				result.Read++
+
+				if err := record.validate(); err != nil {
+					result.Failed++
+					errs = append(errs, fmt.Errorf("%d번째 줄: %w", lineNo, err))
+				} else {
+					if len(batch) > 0 && (batch[0].ProviderID != record.ProviderID || len(batch) >= batchSize) {
+						if err := flush(); err != nil {
+							return result, err
+						}
+					}
+					batch = append(batch, &record)
+				}
+			}
+		}
+
+		if readErr == io.EOF {
+			break
+		}
+		if readErr != nil {
+			return result, fmt.Errorf("가져오기 파일 읽기 실패: %w", readErr)
+		}
+	}
+
+	if err := flush(); err != nil {
+		return result, err
+	}
+
+	if len(errs) > 0 {
+		return result, fmt.Errorf("게시글 %d건 가져오기 실패: %w", result.Failed, errors.Join(errs...))
+	}
+
+	return result, nil
+}
@@ -0,0 +1,172 @@
+package jsonl_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/darkkaiser/rss-feed-server/internal/config"
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+	"github.com/darkkaiser/rss-feed-server/internal/store/jsonl"
+	"github.com/darkkaiser/rss-feed-server/internal/store/sqlite"
+)
+
+// newTestStore 공급자 p1(게시판 b1)이 동기화된 인메모리 SQLite 저장소를 생성합니다.
+func newTestStore(t *testing.T, name string) *sqlite.Store {
+	t.Helper()
+
+	ctx := context.Background()
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", strings.ReplaceAll(t.Name()+"_"+name, "/", "_"))
+	db, err := sqlite.Open(ctx, dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	s, err := sqlite.New(db)
+	require.NoError(t, err)
+	require.NoError(t, s.Initialize(ctx))
+	require.NoError(t, s.SyncProviders(ctx, []*config.ProviderConfig{{
+		ID:     "p1",
+		Config: &config.ProviderDetailConfig{Boards: []*config.BoardConfig{{ID: "b1", Name: "게시판"}}},
+	}}))
+
+	return s
+}
+
+// mockRepository SaveArticles 호출 내역을 기록하는 테스트용 저장소입니다.
+type mockRepository struct {
+	calls map[string][]int
+	order []string
+}
+
+func (m *mockRepository) SaveArticles(ctx context.Context, providerID string, articles []*feed.Article) (int, error) {
+	if m.calls == nil {
+		m.calls = make(map[string][]int)
+	}
+	m.calls[providerID] = append(m.calls[providerID], len(articles))
+	m.order = append(m.order, providerID)
+	return len(articles), nil
+}
+
+func (m *mockRepository) GetArticles(ctx context.Context, providerID string, boardIDs []string, limit uint) ([]*feed.Article, error) {
+	return nil, nil
+}
+
+func (m *mockRepository) GetCrawlingCursor(ctx context.Context, providerID, boardID string) (string, time.Time, error) {
+	return "", time.Time{}, nil
+}
+
+func (m *mockRepository) UpsertLatestCrawledArticleID(ctx context.Context, providerID, boardID, articleID string) error {
+	return nil
+}
+
+func TestExportImport_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	createdAt := time.Date(2024, 3, 15, 9, 30, 0, 0, time.Local)
+	deletedAt := createdAt.Add(24 * time.Hour)
+
+	src := newTestStore(t, "src")
+	_, err := src.SaveArticles(ctx, "p1", []*feed.Article{
+		{BoardID: "b1", ArticleID: "1", Title: "첫 글", Content: "<p>본문 & 이미지</p>", Link: "https://example.com/1", Author: "홍길동", CreatedAt: createdAt},
+		{BoardID: "b1", ArticleID: "2", Title: "삭제된 글", Link: "https://example.com/2", CreatedAt: createdAt.Add(time.Hour)},
+	})
+	require.NoError(t, err)
+	require.NoError(t, src.MarkArticleDeleted(ctx, "p1", "b1", "2", deletedAt, feed.DeletedReasonAccessDenied))
+
+	var buf bytes.Buffer
+	count, err := jsonl.Export(ctx, src, feed.ArticleFilter{}, &buf)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+	assert.Contains(t, lines[0], `"content":"<p>본문 & 이미지</p>"`, "HTML은 이스케이프하지 않고 기록되어야 합니다")
+
+	var record jsonl.Record
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &record))
+	require.NotNil(t, record.DeletedAt)
+	assert.True(t, deletedAt.Equal(*record.DeletedAt))
+	assert.Equal(t, feed.DeletedReasonAccessDenied, record.DeletedReason)
+	assert.Nil(t, record.UpdatedAt)
+
+	dst := newTestStore(t, "dst")
+	result, err := jsonl.Import(ctx, dst, &buf, 0)
+	require.NoError(t, err)
+	assert.Equal(t, &jsonl.ImportResult{Read: 2, Saved: 2, MarkedDeleted: 1}, result)
+
+	articles, err := dst.GetArticles(ctx, "p1", []string{"b1"}, 10)
+	require.NoError(t, err)
+	require.Len(t, articles, 2)
+	assert.Equal(t, "삭제된 글", articles[0].Title)
+	assert.True(t, deletedAt.Equal(articles[0].DeletedAt))
+	assert.Equal(t, feed.DeletedReasonAccessDenied, articles[0].DeletedReason)
+	assert.Equal(t, "<p>본문 & 이미지</p>", articles[1].Content)
+	assert.True(t, createdAt.Equal(articles[1].CreatedAt))
+}
+
+func TestImport(t *testing.T) {
+	t.Parallel()
+
+	t.Run("이미 있는 게시글은 덮어쓰고, 저장할 수 없는 레코드는 건너뛴 뒤 실패 내역을 반환한다", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		s := newTestStore(t, "db")
+		_, err := s.SaveArticles(ctx, "p1", []*feed.Article{{BoardID: "b1", ArticleID: "1", Title: "기존 제목", CreatedAt: time.Now()}})
+		require.NoError(t, err)
+
+		input := strings.Join([]string{
+			`{"provider_id":"p1","board_id":"b1","article_id":"1","title":"새 제목","link":"https://example.com/1","created_at":"2024-03-15T09:30:00+09:00"}`,
+			``,
+			`{"provider_id":"p1","board_id":"unknown","article_id":"2","title":"없는 게시판","link":"","created_at":"2024-03-15T09:30:00+09:00"}`,
+			`{"provider_id":"p1","board_id":"b1","title":"ID 없음"}`,
+		}, "\n")
+
+		result, err := jsonl.Import(ctx, s, strings.NewReader(input), 0)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "4번째 줄")
+		assert.Equal(t, &jsonl.ImportResult{Read: 3, Saved: 1, Failed: 2}, result)
+
+		articles, err := s.GetArticles(ctx, "p1", []string{"b1"}, 10)
+		require.NoError(t, err)
+		require.Len(t, articles, 1)
+		assert.Equal(t, "새 제목", articles[0].Title)
+	})
+
+	t.Run("JSON 형식이 깨진 줄을 만나면 줄 번호와 함께 중단한다", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &mockRepository{}
+		input := `{"provider_id":"p1","board_id":"b1","article_id":"1"}` + "\n" + `{"provider_id":`
+
+		result, err := jsonl.Import(context.Background(), repo, strings.NewReader(input), 0)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "2번째 줄")
+		assert.Equal(t, 1, result.Read)
+		assert.Empty(t, repo.calls, "중단된 경우 남은 묶음은 저장하지 않습니다")
+	})
+
+	t.Run("같은 공급자의 연속된 레코드를 batchSize 단위로 묶어 저장한다", func(t *testing.T) {
+		t.Parallel()
+
+		var lines []string
+		for i, p := range []string{"p1", "p1", "p1", "p2", "p1"} {
+			lines = append(lines, fmt.Sprintf(`{"provider_id":%q,"board_id":"b1","article_id":"%d"}`, p, i))
+		}
+
+		repo := &mockRepository{}
+		result, err := jsonl.Import(context.Background(), repo, strings.NewReader(strings.Join(lines, "\n")), 2)
+		require.NoError(t, err)
+		assert.Equal(t, 5, result.Saved)
+		assert.Equal(t, []string{"p1", "p1", "p2", "p1"}, repo.order)
+		assert.Equal(t, []int{2, 1, 1}, repo.calls["p1"])
+	})
+}
@@ -0,0 +1,307 @@
+// Package migrate 저장소 스키마를 번호가 매겨진 마이그레이션 스크립트로 관리하는 실행기를 제공합니다.
+//
+// 각 저장소 구현체(SQLite, PostgreSQL)는 'NNNN_설명.up.sql' 형식의 스크립트를 바이너리에 내장(embed)하고,
+// Migrator가 schema_migrations 테이블에 기록된 현재 버전을 기준으로 아직 적용되지 않은 스크립트만 순서대로 실행합니다.
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+// VersionTable 적용된 마이그레이션 버전을 기록하는 테이블의 이름입니다.
+const VersionTable = "schema_migrations"
+
+// ErrSchemaTooNew 데이터베이스의 스키마 버전이 현재 바이너리가 알고 있는 최신 버전보다 높을 때 반환되는 에러입니다.
+//
+// 더 새로운 버전의 서버가 이미 스키마를 올린 데이터베이스에 이전 버전의 서버가 접속한 경우로,
+// 알 수 없는 스키마 위에서 동작하면 데이터가 손상될 수 있으므로 기동을 중단해야 합니다.
+var ErrSchemaTooNew = errors.New("데이터베이스 스키마가 현재 실행 중인 서버보다 새로운 버전입니다")
+
+// fileNamePattern 마이그레이션 스크립트 파일명 형식입니다. (예: 0001_baseline.up.sql)
+var fileNamePattern = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.up\.sql$`)
+
+// Migration 하나의 스키마 변경 단위를 나타냅니다.
+type Migration struct {
+	// Version 1부터 시작하여 1씩 증가하는 마이그레이션 번호입니다.
+	Version int
+
+	// Name 파일명에서 추출한 마이그레이션 설명입니다.
+	Name string
+
+	// SQL 실행할 DDL/DML 스크립트입니다. 여러 문장을 포함할 수 있습니다.
+	SQL string
+}
+
+// Load 파일 시스템(주로 embed.FS)의 dir 디렉터리에서 마이그레이션 스크립트를 읽어 버전 순으로 정렬하여 반환합니다.
+//
+// 버전은 1부터 빠짐없이 연속되어야 하며, 형식에 맞지 않는 .sql 파일이나 중복된 버전이 있으면 에러를 반환합니다.
+// 스크립트 누락이나 오타로 인해 일부 마이그레이션을 건너뛴 채 기동되는 것을 방지하기 위함입니다.
+func Load(fsys fs.FS, dir string) ([]*Migration, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, fmt.Errorf("마이그레이션 디렉터리(%s) 읽기 실패: %w", dir, err)
+	}
+
+	migrations := make([]*Migration, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || path.Ext(entry.Name()) != ".sql" {
+			continue
+		}
+
+		matches := fileNamePattern.FindStringSubmatch(entry.Name())
+		if matches == nil {
+			return nil, fmt.Errorf("마이그레이션 파일명(%s)이 'NNNN_설명.up.sql' 형식이 아닙니다", entry.Name())
+		}
+
+		version, err := strconv.Atoi(matches[1])
+		if err != nil {
+			return nil, fmt.Errorf("마이그레이션 파일명(%s)의 버전 번호 해석 실패: %w", entry.Name(), err)
+		}
+
+		script, err := fs.ReadFile(fsys, path.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("마이그레이션 파일(%s) 읽기 실패: %w", entry.Name(), err)
+		}
+
+		migrations = append(migrations, &Migration{
+			Version: version,
+			Name:    matches[2],
+			SQL:     string(script),
+		})
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	for i, m := range migrations {
+		if m.Version != i+1 {
+			return nil, fmt.Errorf("마이그레이션 버전이 연속되지 않습니다: %d번 위치에 버전 %d가 있습니다", i+1, m.Version)
+		}
+	}
+
+	return migrations, nil
+}
+
+// Dialect 데이터베이스 종류마다 달라지는 동작을 Migrator에 제공합니다.
+type Dialect struct {
+	// Placeholder n번째(1부터 시작) 바인딩 인자의 자리표시자를 반환합니다. (예: SQLite는 "?", PostgreSQL은 "$1")
+	Placeholder func(n int) string
+
+	// TableExists 현재 트랜잭션에서 지정한 이름의 테이블이 존재하는지 확인합니다.
+	TableExists func(ctx context.Context, tx *sql.Tx, table string) (bool, error)
+
+	// Lock 여러 서버 인스턴스가 동시에 마이그레이션하지 않도록 트랜잭션 범위의 잠금을 획득합니다.
+	// 단일 프로세스만 데이터베이스 파일에 접근하는 경우처럼 잠금이 필요 없다면 nil로 둡니다.
+	Lock func(ctx context.Context, tx *sql.Tx) error
+}
+
+// Baseline 버전 관리가 도입되기 전에 'CREATE TABLE IF NOT EXISTS' 방식으로 만들어진 데이터베이스를 인식하는 규칙입니다.
+//
+// schema_migrations 테이블은 없지만 MarkerTable이 존재하면 버전 관리 이전의 데이터베이스로 판단하고,
+// Prepare로 스키마를 Version 시점의 구조로 맞춘 뒤 해당 버전이 적용된 것으로 기록합니다(Baselining).
+type Baseline struct {
+	// Version 버전 관리 이전 스키마에 대응하는 마이그레이션 버전입니다.
+	Version int
+
+	// MarkerTable 버전 관리 이전 데이터베이스에 반드시 존재하는 테이블의 이름입니다.
+	MarkerTable string
+
+	// Prepare 버전 관리 이전의 여러 배포 시점에 만들어진 스키마를 Version 시점의 구조로 맞춥니다.
+	// 누락된 테이블이나 컬럼만 추가하도록 멱등하게 작성해야 합니다.
+	Prepare func(ctx context.Context, tx *sql.Tx) error
+}
+
+// Result 마이그레이션 실행 결과입니다.
+type Result struct {
+	// From 실행 전 데이터베이스의 스키마 버전입니다. 빈 데이터베이스는 0이고, 기준 버전으로 기록된 데이터베이스는 기준 버전입니다.
+	From int
+
+	// To 실행 후 데이터베이스의 스키마 버전입니다.
+	To int
+
+	// Baselined 버전 관리 이전의 데이터베이스를 인식하여 기준 버전으로 기록했는지 여부입니다.
+	Baselined bool
+}
+
+// Migrator 내장된 마이그레이션 스크립트를 데이터베이스에 순서대로 적용합니다.
+type Migrator struct {
+	db         *sql.DB
+	dialect    Dialect
+	migrations []*Migration
+	baseline   *Baseline
+}
+
+// New 새로운 Migrator 인스턴스를 생성합니다.
+// migrations는 Load로 읽어 들인, 1부터 연속된 버전 순의 목록이어야 합니다. baseline이 nil이면 버전 관리 이전 데이터베이스를 인식하지 않습니다.
+func New(db *sql.DB, dialect Dialect, migrations []*Migration, baseline *Baseline) *Migrator {
+	if db == nil {
+		panic("migrate.New: DB 커넥션(*sql.DB)은 필수입니다")
+	}
+	if dialect.Placeholder == nil || dialect.TableExists == nil {
+		panic("migrate.New: Dialect의 Placeholder와 TableExists는 필수입니다")
+	}
+	if baseline != nil && (baseline.Version < 1 || baseline.Version > len(migrations)) {
+		panic(fmt.Sprintf("migrate.New: 기준 버전(%d)이 마이그레이션 범위(1~%d)를 벗어났습니다", baseline.Version, len(migrations)))
+	}
+
+	return &Migrator{
+		db:         db,
+		dialect:    dialect,
+		migrations: migrations,
+		baseline:   baseline,
+	}
+}
+
+// Latest 현재 바이너리에 내장된 마이그레이션의 최신 버전을 반환합니다.
+func (m *Migrator) Latest() int {
+	return len(m.migrations)
+}
+
+// Up 아직 적용되지 않은 마이그레이션을 버전 순으로 모두 적용합니다.
+//
+// 각 마이그레이션은 스크립트 실행과 버전 기록을 하나의 트랜잭션으로 묶어 처리하므로,
+// 도중에 실패하면 해당 마이그레이션은 전혀 적용되지 않은 상태로 롤백되고 이전까지 적용된 버전은 유지됩니다.
+// 데이터베이스의 버전이 Latest()보다 높으면 아무것도 변경하지 않고 ErrSchemaTooNew를 반환합니다.
+func (m *Migrator) Up(ctx context.Context) (*Result, error) {
+	result := &Result{From: -1}
+
+	for {
+		done, err := m.step(ctx, result)
+		if err != nil {
+			return nil, err
+		}
+		if done {
+			return result, nil
+		}
+	}
+}
+
+// step 하나의 트랜잭션 안에서 현재 버전을 확인하고, 다음 마이그레이션 하나를 적용합니다.
+// 더 이상 적용할 마이그레이션이 없으면 true를 반환합니다.
+//
+// 매 단계마다 잠금을 획득한 뒤 버전을 다시 읽으므로, 다른 인스턴스가 그 사이에 마이그레이션을 진행했더라도 중복 적용되지 않습니다.
+func (m *Migrator) step(ctx context.Context, result *Result) (bool, error) {
+	// [단계 1] 트랜잭션 시작 및 인스턴스 간 마이그레이션 직렬화
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("스키마 마이그레이션 트랜잭션 시작 실패: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	if m.dialect.Lock != nil {
+		if err := m.dialect.Lock(ctx, tx); err != nil {
+			return false, fmt.Errorf("스키마 마이그레이션 잠금 획득 실패: %w", err)
+		}
+	}
+
+	// [단계 2] 현재 스키마 버전 확인 (버전 관리 이전 데이터베이스는 기준 버전으로 기록)
+	current, baselined, err := m.currentVersion(ctx, tx)
+	if err != nil {
+		return false, err
+	}
+	if result.From < 0 {
+		result.From = current
+	}
+	result.Baselined = result.Baselined || baselined
+	result.To = current
+
+	if current > m.Latest() {
+		return false, fmt.Errorf("%w (데이터베이스: %d, 서버: %d)", ErrSchemaTooNew, current, m.Latest())
+	}
+
+	// [단계 3] 다음 마이그레이션 적용 및 버전 기록
+	if current < m.Latest() {
+		next := m.migrations[current]
+		if _, err := tx.ExecContext(ctx, next.SQL); err != nil {
+			return false, fmt.Errorf("스키마 마이그레이션(%04d_%s) 실행 실패: %w", next.Version, next.Name, err)
+		}
+		if err := m.record(ctx, tx, next, false); err != nil {
+			return false, err
+		}
+		result.To = next.Version
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("스키마 마이그레이션 트랜잭션 커밋 실패: %w", err)
+	}
+
+	return result.To == m.Latest(), nil
+}
+
+// currentVersion 데이터베이스에 기록된 최신 스키마 버전을 조회합니다.
+//
+// schema_migrations 테이블이 없으면 테이블을 만들고, 버전 관리 이전 데이터베이스라면 기준 버전을 기록한 뒤
+// 두 번째 반환값으로 true를 돌려줍니다. 완전히 빈 데이터베이스의 버전은 0입니다.
+func (m *Migrator) currentVersion(ctx context.Context, tx *sql.Tx) (int, bool, error) {
+	exists, err := m.dialect.TableExists(ctx, tx, VersionTable)
+	if err != nil {
+		return 0, false, fmt.Errorf("스키마 버전 테이블(%s) 존재 여부 확인 실패: %w", VersionTable, err)
+	}
+
+	if !exists {
+		legacy := false
+		if m.baseline != nil {
+			if legacy, err = m.dialect.TableExists(ctx, tx, m.baseline.MarkerTable); err != nil {
+				return 0, false, fmt.Errorf("기존 스키마 테이블(%s) 존재 여부 확인 실패: %w", m.baseline.MarkerTable, err)
+			}
+		}
+
+		if _, err := tx.ExecContext(ctx, `
+			CREATE TABLE `+VersionTable+` (
+				version      INTEGER      PRIMARY KEY NOT NULL,
+				name         VARCHAR(100) NOT NULL,
+				baseline     BOOLEAN      NOT NULL DEFAULT FALSE,
+				applied_date TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP
+			)
+		`); err != nil {
+			return 0, false, fmt.Errorf("스키마 버전 테이블(%s) 생성 실패: %w", VersionTable, err)
+		}
+
+		if !legacy {
+			return 0, false, nil
+		}
+
+		if m.baseline.Prepare != nil {
+			if err := m.baseline.Prepare(ctx, tx); err != nil {
+				return 0, false, fmt.Errorf("기존 스키마를 기준 버전(%d)으로 맞추는 작업 실패: %w", m.baseline.Version, err)
+			}
+		}
+		for _, migration := range m.migrations[:m.baseline.Version] {
+			if err := m.record(ctx, tx, migration, true); err != nil {
+				return 0, false, err
+			}
+		}
+
+		return m.baseline.Version, true, nil
+	}
+
+	var version sql.NullInt64
+	if err := tx.QueryRowContext(ctx, "SELECT MAX(version) FROM "+VersionTable).Scan(&version); err != nil {
+		return 0, false, fmt.Errorf("현재 스키마 버전 조회 실패: %w", err)
+	}
+
+	return int(version.Int64), false, nil
+}
+
+// record 마이그레이션이 적용되었음을 schema_migrations 테이블에 기록합니다.
+func (m *Migrator) record(ctx context.Context, tx *sql.Tx, migration *Migration, baseline bool) error {
+	query := fmt.Sprintf("INSERT INTO %s (version, name, baseline) VALUES (%s, %s, %s)",
+		VersionTable, m.dialect.Placeholder(1), m.dialect.Placeholder(2), m.dialect.Placeholder(3))
+
+	if _, err := tx.ExecContext(ctx, query, migration.Version, migration.Name, baseline); err != nil {
+		return fmt.Errorf("스키마 마이그레이션(%04d_%s) 적용 기록 실패: %w", migration.Version, migration.Name, err)
+	}
+
+	return nil
+}
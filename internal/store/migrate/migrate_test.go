@@ -0,0 +1,232 @@
+package migrate_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/darkkaiser/rss-feed-server/internal/store/migrate"
+)
+
+// sqliteDialect 테스트에서 사용하는 SQLite용 실행 규칙입니다.
+var sqliteDialect = migrate.Dialect{
+	Placeholder: func(int) string { return "?" },
+	TableExists: func(ctx context.Context, tx *sql.Tx, table string) (bool, error) {
+		var count int
+		err := tx.QueryRowContext(ctx, "SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = ?", table).Scan(&count)
+		return count > 0, err
+	},
+}
+
+// openTestDB 테스트마다 독립된 인메모리 SQLite 데이터베이스를 생성합니다.
+func openTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared", strings.ReplaceAll(t.Name(), "/", "_"))
+	db, err := sql.Open("sqlite3", dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	return db
+}
+
+func testMigrations() []*migrate.Migration {
+	return []*migrate.Migration{
+		{Version: 1, Name: "baseline", SQL: "CREATE TABLE IF NOT EXISTS item (id INTEGER PRIMARY KEY);"},
+		{Version: 2, Name: "item_title", SQL: "ALTER TABLE item ADD COLUMN title TEXT;"},
+		{Version: 3, Name: "tag", SQL: "CREATE TABLE tag (name TEXT); CREATE INDEX tag_index01 ON tag(name);"},
+	}
+}
+
+func schemaVersions(t *testing.T, db *sql.DB) map[int]bool {
+	t.Helper()
+
+	rows, err := db.Query("SELECT version, baseline FROM " + migrate.VersionTable + " ORDER BY version")
+	require.NoError(t, err)
+	defer rows.Close()
+
+	versions := make(map[int]bool)
+	for rows.Next() {
+		var (
+			version  int
+			baseline bool
+		)
+		require.NoError(t, rows.Scan(&version, &baseline))
+		versions[version] = baseline
+	}
+	require.NoError(t, rows.Err())
+
+	return versions
+}
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+
+	t.Run("버전 순으로 정렬하고 .sql 이외의 파일은 무시한다", func(t *testing.T) {
+		t.Parallel()
+
+		fsys := fstest.MapFS{
+			"m/0002_add_title.up.sql": {Data: []byte("ALTER TABLE item ADD COLUMN title TEXT;")},
+			"m/0001_baseline.up.sql":  {Data: []byte("CREATE TABLE item (id INTEGER);")},
+			"m/README.md":             {Data: []byte("설명")},
+		}
+
+		migrations, err := migrate.Load(fsys, "m")
+		require.NoError(t, err)
+		require.Len(t, migrations, 2)
+		assert.Equal(t, 1, migrations[0].Version)
+		assert.Equal(t, "baseline", migrations[0].Name)
+		assert.Equal(t, 2, migrations[1].Version)
+		assert.Equal(t, "add_title", migrations[1].Name)
+		assert.Contains(t, migrations[1].SQL, "ALTER TABLE")
+	})
+
+	tests := []struct {
+		name  string
+		files []string
+	}{
+		{"파일명 형식이 잘못되면 에러", []string{"0001_baseline.sql"}},
+		{"버전이 1부터 시작하지 않으면 에러", []string{"0002_second.up.sql"}},
+		{"버전이 빠지면 에러", []string{"0001_first.up.sql", "0003_third.up.sql"}},
+		{"버전이 중복되면 에러", []string{"0001_first.up.sql", "1_again.up.sql"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			fsys := fstest.MapFS{}
+			for _, name := range tt.files {
+				fsys["m/"+name] = &fstest.MapFile{Data: []byte("SELECT 1;")}
+			}
+
+			_, err := migrate.Load(fsys, "m")
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestMigrator_Up(t *testing.T) {
+	t.Parallel()
+
+	t.Run("빈 데이터베이스에 모든 마이그레이션을 적용하고, 다시 실행하면 아무것도 하지 않는다", func(t *testing.T) {
+		t.Parallel()
+
+		db := openTestDB(t)
+		m := migrate.New(db, sqliteDialect, testMigrations(), nil)
+
+		result, err := m.Up(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, &migrate.Result{From: 0, To: 3}, result)
+		assert.Equal(t, map[int]bool{1: false, 2: false, 3: false}, schemaVersions(t, db))
+
+		result, err = m.Up(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, &migrate.Result{From: 3, To: 3}, result)
+	})
+
+	t.Run("새로 추가된 마이그레이션만 적용한다", func(t *testing.T) {
+		t.Parallel()
+
+		db := openTestDB(t)
+		_, err := migrate.New(db, sqliteDialect, testMigrations()[:1], nil).Up(context.Background())
+		require.NoError(t, err)
+
+		result, err := migrate.New(db, sqliteDialect, testMigrations(), nil).Up(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, &migrate.Result{From: 1, To: 3}, result)
+
+		_, err = db.Exec("INSERT INTO item (id, title) VALUES (1, '제목')")
+		assert.NoError(t, err, "추가된 컬럼이 존재해야 합니다")
+	})
+
+	t.Run("실패한 마이그레이션은 롤백되고 이전 버전까지만 유지된다", func(t *testing.T) {
+		t.Parallel()
+
+		db := openTestDB(t)
+		migrations := testMigrations()
+		migrations[2].SQL = "CREATE TABLE tag (name TEXT); CREATE INDEX broken ON missing_table(name);"
+
+		_, err := migrate.New(db, sqliteDialect, migrations, nil).Up(context.Background())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "0003_tag")
+
+		assert.Equal(t, map[int]bool{1: false, 2: false}, schemaVersions(t, db))
+
+		var count int
+		require.NoError(t, db.QueryRow("SELECT COUNT(*) FROM sqlite_master WHERE name = 'tag'").Scan(&count))
+		assert.Zero(t, count, "실패한 마이그레이션에서 생성된 테이블은 남지 않아야 합니다")
+	})
+
+	t.Run("데이터베이스 스키마가 더 새로우면 아무것도 변경하지 않고 거부한다", func(t *testing.T) {
+		t.Parallel()
+
+		db := openTestDB(t)
+		_, err := migrate.New(db, sqliteDialect, testMigrations(), nil).Up(context.Background())
+		require.NoError(t, err)
+
+		_, err = migrate.New(db, sqliteDialect, testMigrations()[:2], nil).Up(context.Background())
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, migrate.ErrSchemaTooNew))
+		assert.Len(t, schemaVersions(t, db), 3)
+	})
+
+	t.Run("버전 관리 이전 데이터베이스는 기준 버전으로 기록한 뒤 이후 마이그레이션을 적용한다", func(t *testing.T) {
+		t.Parallel()
+
+		db := openTestDB(t)
+		_, err := db.Exec("CREATE TABLE item (id INTEGER PRIMARY KEY); INSERT INTO item (id) VALUES (7);")
+		require.NoError(t, err)
+
+		prepared := false
+		baseline := &migrate.Baseline{
+			Version:     1,
+			MarkerTable: "item",
+			Prepare: func(ctx context.Context, tx *sql.Tx) error {
+				prepared = true
+				return nil
+			},
+		}
+
+		result, err := migrate.New(db, sqliteDialect, testMigrations(), baseline).Up(context.Background())
+		require.NoError(t, err)
+		assert.True(t, prepared)
+		assert.Equal(t, &migrate.Result{From: 1, To: 3, Baselined: true}, result)
+		assert.Equal(t, map[int]bool{1: true, 2: false, 3: false}, schemaVersions(t, db))
+
+		var title sql.NullString
+		require.NoError(t, db.QueryRow("SELECT title FROM item WHERE id = 7").Scan(&title))
+		assert.False(t, title.Valid, "기존 데이터는 보존되어야 합니다")
+	})
+
+	t.Run("기준 테이블이 없는 빈 데이터베이스는 기준 버전으로 기록하지 않는다", func(t *testing.T) {
+		t.Parallel()
+
+		db := openTestDB(t)
+		baseline := &migrate.Baseline{Version: 1, MarkerTable: "item"}
+
+		result, err := migrate.New(db, sqliteDialect, testMigrations(), baseline).Up(context.Background())
+		require.NoError(t, err)
+		assert.False(t, result.Baselined)
+		assert.Equal(t, map[int]bool{1: false, 2: false, 3: false}, schemaVersions(t, db))
+	})
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	db := openTestDB(t)
+
+	assert.Panics(t, func() { migrate.New(nil, sqliteDialect, testMigrations(), nil) })
+	assert.Panics(t, func() { migrate.New(db, migrate.Dialect{}, testMigrations(), nil) })
+	assert.Panics(t, func() {
+		migrate.New(db, sqliteDialect, testMigrations(), &migrate.Baseline{Version: 4, MarkerTable: "item"})
+	})
+}
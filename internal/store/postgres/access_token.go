@@ -0,0 +1,244 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+)
+
+// 컴파일 타임에 인터페이스 구현 여부를 검증합니다.
+var _ feed.AccessTokenRepository = (*Store)(nil)
+
+// CreateAccessToken 접근 토큰을 저장하고 발급된 ID를 반환합니다.
+func (s *Store) CreateAccessToken(ctx context.Context, token *feed.AccessToken) (int64, error) {
+	scopes, err := json.Marshal(token.Scopes)
+	if err != nil {
+		return 0, fmt.Errorf("접근 토큰 범위 직렬화 실패: %w", err)
+	}
+
+	createdAt := token.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
+
+	// PostgreSQL 드라이버는 LastInsertId를 지원하지 않으므로 RETURNING 절로 발급된 ID를 돌려받습니다.
+	var id int64
+	if err := s.db.QueryRowContext(ctx, `
+		INSERT INTO
+			rss_access_token (name, token_hash, scopes, expires_date, created_date)
+		VALUES
+			($1, $2, $3, $4, $5)
+		RETURNING id
+	`, token.Name, token.Hash, string(scopes), nullTime(token.ExpiresAt), createdAt.UTC()).Scan(&id); err != nil {
+		return 0, fmt.Errorf("접근 토큰 저장(CreateAccessToken) 쿼리 실행 실패 (name: %s): %w", token.Name, err)
+	}
+
+	return id, nil
+}
+
+// GetAccessTokenByHash 지정한 해시의 접근 토큰을 반환합니다. 존재하지 않으면 nil, nil을 반환합니다.
+func (s *Store) GetAccessTokenByHash(ctx context.Context, hash string) (*feed.AccessToken, error) {
+	row := s.db.QueryRowContext(ctx, `
+		SELECT id
+		     , name
+		     , token_hash
+		     , scopes
+		     , expires_date
+		     , created_date
+		     , last_used_date
+		     , revoked_date
+		  FROM rss_access_token
+		 WHERE token_hash = $1
+	`, hash)
+
+	token, err := scanAccessToken(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("접근 토큰 조회(GetAccessTokenByHash) 실패: %w", err)
+	}
+
+	return token, nil
+}
+
+// ListAccessTokens 모든 접근 토큰을 발급 순으로 반환합니다.
+func (s *Store) ListAccessTokens(ctx context.Context) ([]*feed.AccessToken, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id
+		     , name
+		     , token_hash
+		     , scopes
+		     , expires_date
+		     , created_date
+		     , last_used_date
+		     , revoked_date
+		  FROM rss_access_token
+		 ORDER BY id
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("접근 토큰 목록 조회(ListAccessTokens) 쿼리 실행 실패: %w", err)
+	}
+	defer rows.Close()
+
+	tokens := make([]*feed.AccessToken, 0)
+
+	for rows.Next() {
+		token, err := scanAccessToken(rows)
+		if err != nil {
+			return nil, fmt.Errorf("접근 토큰 목록 조회(ListAccessTokens) 결과 행 스캔 실패: %w", err)
+		}
+
+		tokens = append(tokens, token)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("접근 토큰 목록 조회(ListAccessTokens) 결과 행 순회 중 오류 발생: %w", err)
+	}
+
+	return tokens, nil
+}
+
+// RevokeAccessToken 지정한 ID의 접근 토큰을 폐기합니다. 토큰이 없거나 이미 폐기되었으면 false를 반환합니다.
+func (s *Store) RevokeAccessToken(ctx context.Context, id int64) (bool, error) {
+	res, err := s.db.ExecContext(ctx, `
+		UPDATE rss_access_token
+		   SET revoked_date = $1
+		 WHERE id = $2
+		   AND revoked_date IS NULL
+	`, time.Now().UTC(), id)
+	if err != nil {
+		return false, fmt.Errorf("접근 토큰 폐기(RevokeAccessToken) 쿼리 실행 실패 (id: %d): %w", id, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("접근 토큰 폐기(RevokeAccessToken) 결과 확인 실패 (id: %d): %w", id, err)
+	}
+
+	return affected > 0, nil
+}
+
+// RecordAccessTokenUsage 접근 토큰 사용 기록을 남기고 토큰의 마지막 사용 일시를 갱신합니다.
+// 기록과 정리는 하나의 트랜잭션으로 처리되며, 해당 토큰의 기록이 keep개를 초과하면 오래된 기록부터 삭제합니다.
+func (s *Store) RecordAccessTokenUsage(ctx context.Context, usage *feed.AccessTokenUsage, keep int) error {
+	usedAt := usage.UsedAt
+	if usedAt.IsZero() {
+		usedAt = time.Now()
+	}
+	rawUsedAt := usedAt.UTC()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("접근 토큰 사용 기록(RecordAccessTokenUsage) 트랜잭션 시작(BeginTx) 실패: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO
+			rss_access_token_usage (token_id, path, remote_ip, user_agent, used_date)
+		VALUES
+			($1, $2, $3, $4, $5)
+	`, usage.TokenID, usage.Path, usage.RemoteIP, usage.UserAgent, rawUsedAt); err != nil {
+		return fmt.Errorf("접근 토큰 사용 기록(Insert) 쿼리 실행 실패 (id: %d): %w", usage.TokenID, err)
+	}
+
+	if _, err := tx.ExecContext(ctx, "UPDATE rss_access_token SET last_used_date = $1 WHERE id = $2", rawUsedAt, usage.TokenID); err != nil {
+		return fmt.Errorf("접근 토큰 마지막 사용 일시 갱신(Update) 쿼리 실행 실패 (id: %d): %w", usage.TokenID, err)
+	}
+
+	if keep > 0 {
+		if _, err := tx.ExecContext(ctx, `
+			DELETE FROM rss_access_token_usage
+			 WHERE token_id = $1
+			   AND seq NOT IN ( SELECT seq
+			                      FROM rss_access_token_usage
+			                     WHERE token_id = $2
+			                     ORDER BY seq DESC
+			                     LIMIT $3 )
+		`, usage.TokenID, usage.TokenID, keep); err != nil {
+			return fmt.Errorf("오래된 접근 토큰 사용 기록 정리(Delete) 쿼리 실행 실패 (id: %d): %w", usage.TokenID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("접근 토큰 사용 기록(RecordAccessTokenUsage) 트랜잭션 Commit 실패: %w", err)
+	}
+
+	return nil
+}
+
+// ListAccessTokenUsage 지정한 접근 토큰의 사용 기록을 최근 순으로 최대 limit개 반환합니다.
+func (s *Store) ListAccessTokenUsage(ctx context.Context, tokenID int64, limit int) ([]*feed.AccessTokenUsage, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT token_id
+		     , path
+		     , remote_ip
+		     , COALESCE(user_agent, '') AS user_agent
+		     , used_date
+		  FROM rss_access_token_usage
+		 WHERE token_id = $1
+		 ORDER BY seq DESC
+		 LIMIT $2
+	`, tokenID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("접근 토큰 사용 기록 조회(ListAccessTokenUsage) 쿼리 실행 실패 (id: %d): %w", tokenID, err)
+	}
+	defer rows.Close()
+
+	usages := make([]*feed.AccessTokenUsage, 0)
+
+	for rows.Next() {
+		var usage feed.AccessTokenUsage
+		var rawUsedDate sql.NullTime
+
+		if err := rows.Scan(&usage.TokenID, &usage.Path, &usage.RemoteIP, &usage.UserAgent, &rawUsedDate); err != nil {
+			return nil, fmt.Errorf("접근 토큰 사용 기록 조회(ListAccessTokenUsage) 결과 행 스캔 실패: %w", err)
+		}
+		usage.UsedAt = localTime(rawUsedDate)
+
+		usages = append(usages, &usage)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("접근 토큰 사용 기록 조회(ListAccessTokenUsage) 결과 행 순회 중 오류 발생: %w", err)
+	}
+
+	return usages, nil
+}
+
+// scanAccessToken 조회 결과 한 행을 feed.AccessToken으로 변환합니다.
+func scanAccessToken(row interface{ Scan(dest ...any) error }) (*feed.AccessToken, error) {
+	var token feed.AccessToken
+	var rawScopes string
+	var rawExpiresDate, rawCreatedDate, rawLastUsedDate, rawRevokedDate sql.NullTime
+
+	if err := row.Scan(&token.ID, &token.Name, &token.Hash, &rawScopes, &rawExpiresDate, &rawCreatedDate, &rawLastUsedDate, &rawRevokedDate); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal([]byte(rawScopes), &token.Scopes); err != nil {
+		return nil, fmt.Errorf("접근 토큰 범위 역직렬화 실패 (id: %d): %w", token.ID, err)
+	}
+	token.ExpiresAt = localTime(rawExpiresDate)
+	token.CreatedAt = localTime(rawCreatedDate)
+	token.LastUsedAt = localTime(rawLastUsedDate)
+	token.RevokedAt = localTime(rawRevokedDate)
+
+	return &token, nil
+}
+
+// nullTime 일시를 저장할 값으로 변환합니다. zero value이면 NULL로 저장되도록 유효하지 않은 값을 반환합니다.
+func nullTime(t time.Time) sql.NullTime {
+	if t.IsZero() {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: t.UTC(), Valid: true}
+}
@@ -0,0 +1,31 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/darkkaiser/rss-feed-server/internal/tracing"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	semconv "go.opentelemetry.io/otel/semconv/v1.39.0"
+)
+
+// Open 주어진 DSN(Data Source Name)을 사용하여 PostgreSQL 데이터베이스에 연결하고,
+// 서버 접속 가능 여부를 검증한 후 초기화된 DB 핸들을 반환한다.
+//
+// DSN에는 비밀번호가 포함될 수 있으므로 에러 메시지에 DSN을 포함하지 않는다.
+func Open(ctx context.Context, dsn string) (*sql.DB, error) {
+	db, err := tracing.OpenDB("pgx", dsn, semconv.DBSystemNamePostgreSQL)
+	if err != nil {
+		return nil, fmt.Errorf("PostgreSQL 연결 초기화 실패: %w", err)
+	}
+
+	// sql.Open은 드라이버 설정만 초기화할 뿐 실제 연결을 맺지 않습니다.
+	// PingContext로 서버 접속과 인증이 정상적으로 이루어지는지 명시적으로 검증합니다.
+	if err = db.PingContext(ctx); err != nil {
+		_ = db.Close() // 핸들 누수 방지
+		return nil, fmt.Errorf("PostgreSQL 연결 상태 검증 실패: %w", err)
+	}
+
+	return db, nil
+}
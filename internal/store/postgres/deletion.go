@@ -0,0 +1,105 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+)
+
+// 컴파일 타임에 인터페이스 구현 여부를 검증합니다.
+var (
+	_ feed.DeletionRepository       = (*Store)(nil)
+	_ feed.VisibleArticleRepository = (*Store)(nil)
+)
+
+// SampleRecentArticles 지정한 공급자에서 since 이후에 작성되었고 아직 삭제가 감지되지 않은 게시글 중 최대 limit개를 무작위로 추출합니다.
+// 본문이 비어 있는 게시글은 원문과 비교할 기준이 없으므로 추출 대상에서 제외합니다.
+func (s *Store) SampleRecentArticles(ctx context.Context, providerID string, since time.Time, limit uint) ([]*feed.Article, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT a.b_id
+		     , b.name AS b_name
+		     , a.id
+		     , a.title
+		     , COALESCE(a.content, '') AS content
+		     , a.link
+		     , COALESCE(a.author, '') AS author
+		     , a.created_date
+		     , a.updated_date
+		  FROM rss_provider_article a
+		       INNER JOIN rss_provider_board b ON ( a.p_id = b.p_id AND a.b_id = b.id )
+		 WHERE a.p_id = $1
+		   AND a.created_date >= $2
+		   AND a.deleted_at IS NULL
+		   AND COALESCE(a.content, '') <> ''
+		 ORDER BY random()
+		 LIMIT $3
+	`, providerID, since.UTC(), int64(limit))
+	if err != nil {
+		return nil, fmt.Errorf("삭제 점검 대상 게시글 추출(SampleRecentArticles) 쿼리 실행 실패 (providerID: %s): %w", providerID, err)
+	}
+	defer rows.Close()
+
+	return scanArticles(rows, "삭제 점검 대상 게시글 추출(SampleRecentArticles)")
+}
+
+// MarkArticleDeleted 지정한 게시글의 삭제 감지 일시와 판정 근거(deleted_reason)를 기록합니다.
+// reason이 비어 있으면 원문 삭제(feed.DeletedReasonRemoved)로 기록합니다.
+// 대상 게시글이 존재하지 않으면 sql.ErrNoRows를 감싼 에러를 반환합니다.
+func (s *Store) MarkArticleDeleted(ctx context.Context, providerID, boardID, articleID string, deletedAt time.Time, reason feed.DeletedReason) error {
+	if deletedAt.IsZero() {
+		deletedAt = time.Now()
+	}
+	if reason == "" {
+		reason = feed.DeletedReasonRemoved
+	}
+
+	result, err := s.db.ExecContext(ctx, `
+		UPDATE rss_provider_article
+		   SET deleted_at = $1
+		     , deleted_reason = $2
+		 WHERE p_id = $3
+		   AND b_id = $4
+		   AND id = $5
+	`, deletedAt.UTC(), string(reason), providerID, boardID, articleID)
+	if err != nil {
+		return fmt.Errorf("게시글 삭제 기록(MarkArticleDeleted) 쿼리 실행 실패 (providerID: %s, boardID: %s, articleID: %s): %w", providerID, boardID, articleID, err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("게시글 삭제 기록(MarkArticleDeleted) 결과 확인 실패 (providerID: %s, articleID: %s): %w", providerID, articleID, err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("게시글 삭제 기록(MarkArticleDeleted) 대상 게시글이 존재하지 않습니다 (providerID: %s, boardID: %s, articleID: %s): %w", providerID, boardID, articleID, sql.ErrNoRows)
+	}
+
+	return nil
+}
+
+// GetVisibleArticles 지정한 공급자(providerID)의 게시판들(boardIDs)에서 삭제가 감지되지 않은 게시글을 최신순으로 최대 limit개 반환합니다.
+func (s *Store) GetVisibleArticles(ctx context.Context, providerID string, boardIDs []string, limit uint) ([]*feed.Article, error) {
+	return s.getArticles(ctx, providerID, boardIDs, limit, true)
+}
+
+// deletedCondition excludeDeleted가 true이면 삭제가 감지된 게시글을 제외하는 WHERE 조건을, 아니면 빈 문자열을 반환합니다.
+func deletedCondition(excludeDeleted bool) string {
+	if !excludeDeleted {
+		return ""
+	}
+	return "\n		   AND a.deleted_at IS NULL"
+}
+
+// deletedReason 조회한 deleted_reason 컬럼 값을 feed.DeletedReason으로 변환합니다.
+// 삭제가 감지되지 않은 게시글은 빈 값을, 근거 컬럼이 추가되기 이전에 삭제가 감지되어 값이 없는 게시글은 원문 삭제로 취급합니다.
+func deletedReason(deletedAt time.Time, raw sql.NullString) feed.DeletedReason {
+	if deletedAt.IsZero() {
+		return ""
+	}
+	if !raw.Valid || raw.String == "" {
+		return feed.DeletedReasonRemoved
+	}
+	return feed.DeletedReason(raw.String)
+}
@@ -0,0 +1,91 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+)
+
+// 컴파일 타임에 인터페이스 구현 여부를 검증합니다.
+var _ feed.ExportRepository = (*Store)(nil)
+
+// ExportArticles 조건에 맞는 게시글을 공급자, 게시판, 작성일시 순으로 한 건씩 fn에 전달합니다.
+func (s *Store) ExportArticles(ctx context.Context, filter feed.ArticleFilter, fn func(providerID string, article *feed.Article) error) error {
+	var (
+		conditions []string
+		args       []any
+	)
+	if len(filter.ProviderIDs) > 0 {
+		args = append(args, filter.ProviderIDs)
+		conditions = append(conditions, fmt.Sprintf("a.p_id = ANY($%d)", len(args)))
+	}
+	if len(filter.BoardIDs) > 0 {
+		args = append(args, filter.BoardIDs)
+		conditions = append(conditions, fmt.Sprintf("a.b_id = ANY($%d)", len(args)))
+	}
+	if !filter.Since.IsZero() {
+		args = append(args, filter.Since.UTC())
+		conditions = append(conditions, fmt.Sprintf("a.created_date >= $%d", len(args)))
+	}
+	if !filter.Until.IsZero() {
+		args = append(args, filter.Until.UTC())
+		conditions = append(conditions, fmt.Sprintf("a.created_date < $%d", len(args)))
+	}
+
+	where := ""
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, "\n		   AND ")
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT a.p_id
+		     , a.b_id
+		     , COALESCE(b.name, '') AS b_name
+		     , a.id
+		     , a.title
+		     , COALESCE(a.content, '') AS content
+		     , a.link
+		     , COALESCE(a.author, '') AS author
+		     , a.created_date
+		     , a.updated_date
+		     , a.deleted_at
+		     , a.deleted_reason
+		  FROM rss_provider_article a
+		       LEFT OUTER JOIN rss_provider_board b ON ( a.p_id = b.p_id AND a.b_id = b.id )
+		`+where+`
+		 ORDER BY a.p_id, a.b_id, a.created_date, a.id
+	`, args...)
+	if err != nil {
+		return fmt.Errorf("게시글 내보내기(ExportArticles) 쿼리 실행 실패: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			providerID                          string
+			article                             feed.Article
+			createdDate, updatedDate, deletedAt sql.NullTime
+			rawDeletedReason                    sql.NullString
+		)
+		if err := rows.Scan(&providerID, &article.BoardID, &article.BoardName, &article.ArticleID, &article.Title, &article.Content, &article.Link, &article.Author, &createdDate, &updatedDate, &deletedAt, &rawDeletedReason); err != nil {
+			return fmt.Errorf("게시글 내보내기(ExportArticles) 결과 행 스캔 실패: %w", err)
+		}
+		article.CreatedAt = localTime(createdDate)
+		article.UpdatedAt = localTime(updatedDate)
+		article.DeletedAt = localTime(deletedAt)
+		article.DeletedReason = deletedReason(article.DeletedAt, rawDeletedReason)
+
+		if err := fn(providerID, &article); err != nil {
+			return err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("게시글 내보내기(ExportArticles) 결과 행 순회 중 오류 발생: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,140 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+)
+
+// 컴파일 타임에 인터페이스 구현 여부를 검증합니다.
+var _ feed.HistoryRepository = (*Store)(nil)
+
+// ListArticles 지정한 providerID의 게시글 중 조건에 맞는 게시글을 (작성일시, 게시판 ID, 게시글 ID) 순서로 최대 query.Limit개 반환합니다.
+func (s *Store) ListArticles(ctx context.Context, providerID string, query feed.ArticlePageQuery) ([]*feed.Article, error) {
+	// 작성일시가 없는 게시글은 커서로 위치를 가리킬 수 없으므로 조회 대상에서 제외합니다.
+	conditions := []string{"a.p_id = $1", "a.created_date IS NOT NULL"}
+	args := []any{providerID}
+
+	if len(query.BoardIDs) > 0 {
+		args = append(args, query.BoardIDs)
+		conditions = append(conditions, fmt.Sprintf("a.b_id = ANY($%d)", len(args)))
+	}
+	if !query.Since.IsZero() {
+		args = append(args, query.Since.UTC())
+		conditions = append(conditions, fmt.Sprintf("a.created_date >= $%d", len(args)))
+	}
+	if !query.Until.IsZero() {
+		args = append(args, query.Until.UTC())
+		conditions = append(conditions, fmt.Sprintf("a.created_date < $%d", len(args)))
+	}
+	if query.ExcludeDeleted {
+		conditions = append(conditions, "a.deleted_at IS NULL")
+	}
+
+	order, op := "DESC", "<"
+	if query.Ascending {
+		order, op = "ASC", ">"
+	}
+	if query.After != nil {
+		args = append(args, query.After.CreatedAt.UTC(), query.After.BoardID, query.After.ArticleID)
+		conditions = append(conditions, fmt.Sprintf("(a.created_date, a.b_id, a.id) %s ($%d, $%d, $%d)", op, len(args)-2, len(args)-1, len(args)))
+	}
+	args = append(args, int64(query.Limit))
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT a.b_id
+		     , COALESCE(b.name, '') AS b_name
+		     , a.id
+		     , a.title
+		     , COALESCE(a.content, '') AS content
+		     , a.link
+		     , COALESCE(a.author, '') AS author
+		     , a.created_date
+		     , a.updated_date
+		     , a.deleted_at
+		     , a.deleted_reason
+		     , a.fingerprint
+		  FROM rss_provider_article a
+		       LEFT OUTER JOIN rss_provider_board b ON ( a.p_id = b.p_id AND a.b_id = b.id )
+		 WHERE `+strings.Join(conditions, "\n		   AND ")+`
+		 ORDER BY a.created_date `+order+`, a.b_id `+order+`, a.id `+order+`
+		 LIMIT `+fmt.Sprintf("$%d", len(args))+`
+	`, args...)
+	if err != nil {
+		return nil, fmt.Errorf("게시글 이력 조회(ListArticles) 쿼리 실행 실패 (providerID: %s): %w", providerID, err)
+	}
+	defer rows.Close()
+
+	articles := make([]*feed.Article, 0, query.Limit)
+
+	for rows.Next() {
+		var (
+			article                             feed.Article
+			createdDate, updatedDate, deletedAt sql.NullTime
+			rawDeletedReason                    sql.NullString
+			fingerprint                         sql.NullInt64
+		)
+		if err := rows.Scan(&article.BoardID, &article.BoardName, &article.ArticleID, &article.Title, &article.Content, &article.Link, &article.Author, &createdDate, &updatedDate, &deletedAt, &rawDeletedReason, &fingerprint); err != nil {
+			return nil, fmt.Errorf("게시글 이력 조회(ListArticles) 결과 행 스캔 실패: %w", err)
+		}
+		article.CreatedAt = localTime(createdDate)
+		article.UpdatedAt = localTime(updatedDate)
+		article.DeletedAt = localTime(deletedAt)
+		article.DeletedReason = deletedReason(article.DeletedAt, rawDeletedReason)
+		article.Fingerprint = uint64(fingerprint.Int64)
+
+		articles = append(articles, &article)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("게시글 이력 조회(ListArticles) 결과 행 순회 중 오류 발생: %w", err)
+	}
+
+	return articles, nil
+}
+
+// GetArticle 지정한 게시글 하나를 본문과 함께 반환합니다. 존재하지 않으면 nil, nil을 반환합니다.
+func (s *Store) GetArticle(ctx context.Context, providerID, boardID, articleID string) (*feed.Article, error) {
+	var (
+		article                             feed.Article
+		createdDate, updatedDate, deletedAt sql.NullTime
+		rawDeletedReason                    sql.NullString
+		fingerprint                         sql.NullInt64
+	)
+	err := s.db.QueryRowContext(ctx, `
+		SELECT a.b_id
+		     , COALESCE(b.name, '') AS b_name
+		     , a.id
+		     , a.title
+		     , COALESCE(a.content, '') AS content
+		     , a.link
+		     , COALESCE(a.author, '') AS author
+		     , a.created_date
+		     , a.updated_date
+		     , a.deleted_at
+		     , a.deleted_reason
+		     , a.fingerprint
+		  FROM rss_provider_article a
+		       LEFT OUTER JOIN rss_provider_board b ON ( a.p_id = b.p_id AND a.b_id = b.id )
+		 WHERE a.p_id = $1
+		   AND a.b_id = $2
+		   AND a.id = $3
+	`, providerID, boardID, articleID).Scan(&article.BoardID, &article.BoardName, &article.ArticleID, &article.Title, &article.Content, &article.Link, &article.Author, &createdDate, &updatedDate, &deletedAt, &rawDeletedReason, &fingerprint)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("게시글 조회(GetArticle) 쿼리 실행 실패 (providerID: %s, boardID: %s, articleID: %s): %w", providerID, boardID, articleID, err)
+	}
+	article.CreatedAt = localTime(createdDate)
+	article.UpdatedAt = localTime(updatedDate)
+	article.DeletedAt = localTime(deletedAt)
+	article.DeletedReason = deletedReason(article.DeletedAt, rawDeletedReason)
+	article.Fingerprint = uint64(fingerprint.Int64)
+
+	return &article, nil
+}
@@ -0,0 +1,54 @@
+package postgres
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"time"
+
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+)
+
+// 컴파일 타임에 인터페이스 구현 여부를 검증합니다.
+var _ feed.JobLockRepository = (*Store)(nil)
+
+// jobUnlockTimeout 작업 잠금을 해제할 때 사용하는 제한 시간입니다.
+// 작업의 Context가 이미 취소된 뒤에도 잠금은 해제해야 하므로 별도의 Context로 해제합니다.
+const jobUnlockTimeout = 5 * time.Second
+
+// TryLockJob 세션 수준 Advisory Lock(pg_try_advisory_lock)으로 작업 잠금 획득을 시도합니다.
+//
+// Advisory Lock은 획득한 세션(커넥션)에 묶이므로, 커넥션 풀에서 커넥션 하나를 꺼내 잠금을 획득하고
+// unlock이 호출될 때까지 그 커넥션을 점유합니다. 프로세스가 비정상 종료되어 커넥션이 끊기면 잠금은 서버가 자동으로 해제합니다.
+// 작업 이름은 hashtextextended로 64비트 잠금 키로 변환합니다.
+func (s *Store) TryLockJob(ctx context.Context, name string) (func(), bool, error) {
+	conn, err := s.db.Conn(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("작업 잠금(TryLockJob)용 커넥션 획득 실패 (name: %s): %w", name, err)
+	}
+
+	var acquired bool
+	if err := conn.QueryRowContext(ctx, `SELECT pg_try_advisory_lock(hashtextextended($1, 0))`, name).Scan(&acquired); err != nil {
+		_ = conn.Close()
+		return nil, false, fmt.Errorf("작업 잠금(TryLockJob) 쿼리 실행 실패 (name: %s): %w", name, err)
+	}
+
+	if !acquired {
+		_ = conn.Close()
+		return nil, false, nil
+	}
+
+	unlock := func() {
+		ctx, cancel := context.WithTimeout(context.Background(), jobUnlockTimeout)
+		defer cancel()
+
+		// 해제에 실패한 커넥션을 그대로 풀에 반환하면 세션과 함께 잠금도 유지되므로,
+		// driver.ErrBadConn으로 커넥션을 폐기하여 세션 종료와 함께 잠금이 해제되도록 합니다.
+		if _, err := conn.ExecContext(ctx, `SELECT pg_advisory_unlock(hashtextextended($1, 0))`, name); err != nil {
+			_ = conn.Raw(func(any) error { return driver.ErrBadConn })
+		}
+		_ = conn.Close()
+	}
+
+	return unlock, true, nil
+}
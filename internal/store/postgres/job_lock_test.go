@@ -0,0 +1,49 @@
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/darkkaiser/rss-feed-server/internal/store/postgres"
+)
+
+// TestTryLockJob 같은 작업의 잠금은 한 번에 하나의 세션만 획득할 수 있고, 해제 후에는 다시 획득할 수 있어야 합니다.
+func TestTryLockJob(t *testing.T) {
+	ctx := context.Background()
+
+	db, err := postgres.Open(ctx, testDSN(t))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	s, err := postgres.New(db)
+	require.NoError(t, err)
+
+	// 같은 데이터베이스를 공유하는 다른 테스트 실행과 잠금 키가 겹치지 않도록 고유한 작업 이름을 사용합니다.
+	job := fmt.Sprintf("test-job-%d", time.Now().UnixNano())
+
+	unlock, acquired, err := s.TryLockJob(ctx, job)
+	require.NoError(t, err)
+	require.True(t, acquired)
+
+	// 다른 인스턴스(다른 세션)는 같은 작업의 잠금을 획득할 수 없습니다.
+	_, acquired, err = s.TryLockJob(ctx, job)
+	require.NoError(t, err)
+	require.False(t, acquired)
+
+	// 다른 작업의 잠금은 영향을 받지 않습니다.
+	unlockOther, acquired, err := s.TryLockJob(ctx, job+"-other")
+	require.NoError(t, err)
+	require.True(t, acquired)
+	unlockOther()
+
+	unlock()
+
+	unlock, acquired, err = s.TryLockJob(ctx, job)
+	require.NoError(t, err)
+	require.True(t, acquired, "해제된 잠금은 다시 획득할 수 있어야 합니다")
+	unlock()
+}
@@ -0,0 +1,108 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+)
+
+// 컴파일 타임에 인터페이스 구현 여부를 검증합니다.
+var _ feed.LayoutStatsRepository = (*Store)(nil)
+
+// GetLayoutStats 지정한 게시판의 레이아웃 지표를 최근 측정 순으로 최대 limit개 반환합니다.
+// 기준선 계산에는 스냅샷이 필요하지 않으므로 용량이 큰 snapshot 컬럼은 조회하지 않습니다.
+func (s *Store) GetLayoutStats(ctx context.Context, providerID, boardID string, limit int) ([]*feed.LayoutStats, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT b_id
+		     , row_count
+		     , empty_field_ratio
+		     , date_parse_failure_ratio
+		     , selector_hit_ratio
+		     , drifted
+		     , recorded_date
+		  FROM rss_board_layout_stats
+		 WHERE p_id = $1
+		   AND b_id = $2
+		 ORDER BY seq DESC
+		 LIMIT $3
+	`, providerID, boardID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("레이아웃 지표 조회(GetLayoutStats) 쿼리 실행 실패 (providerID: %s, boardID: %s): %w", providerID, boardID, err)
+	}
+	defer rows.Close()
+
+	stats := make([]*feed.LayoutStats, 0, limit)
+
+	for rows.Next() {
+		var st feed.LayoutStats
+		var recordedDate sql.NullTime
+
+		if err := rows.Scan(&st.BoardID, &st.RowCount, &st.EmptyFieldRatio, &st.DateParseFailureRatio, &st.SelectorHitRatio, &st.Drifted, &recordedDate); err != nil {
+			return nil, fmt.Errorf("레이아웃 지표 조회(GetLayoutStats) 결과 행 스캔 실패: %w", err)
+		}
+		st.RecordedAt = localTime(recordedDate)
+
+		stats = append(stats, &st)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("레이아웃 지표 조회(GetLayoutStats) 결과 행 순회 중 오류 발생: %w", err)
+	}
+
+	return stats, nil
+}
+
+// SaveLayoutStats 레이아웃 지표를 기록하고, 같은 트랜잭션에서 해당 게시판의 기록이 keep개를 넘지 않도록 오래된 기록을 정리합니다.
+func (s *Store) SaveLayoutStats(ctx context.Context, providerID string, stats *feed.LayoutStats, keep int) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("레이아웃 지표 저장(SaveLayoutStats) 트랜잭션 시작(BeginTx) 실패: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	recordedAt := stats.RecordedAt
+	if recordedAt.IsZero() {
+		recordedAt = time.Now()
+	}
+
+	var snapshot sql.NullString
+	if stats.Snapshot != "" {
+		snapshot = sql.NullString{String: stats.Snapshot, Valid: true}
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO
+			rss_board_layout_stats (p_id, b_id, row_count, empty_field_ratio, date_parse_failure_ratio, selector_hit_ratio, drifted, snapshot, recorded_date)
+		VALUES
+			($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`, providerID, stats.BoardID, stats.RowCount, stats.EmptyFieldRatio, stats.DateParseFailureRatio, stats.SelectorHitRatio, stats.Drifted, snapshot, recordedAt.UTC()); err != nil {
+		return fmt.Errorf("레이아웃 지표 기록(Insert) 쿼리 실행 실패 (providerID: %s, boardID: %s): %w", providerID, stats.BoardID, err)
+	}
+
+	if keep > 0 {
+		if _, err := tx.ExecContext(ctx, `
+			DELETE FROM rss_board_layout_stats
+			 WHERE p_id = $1
+			   AND b_id = $2
+			   AND seq NOT IN ( SELECT seq
+			                      FROM rss_board_layout_stats
+			                     WHERE p_id = $1
+			                       AND b_id = $2
+			                     ORDER BY seq DESC
+			                     LIMIT $3 )
+		`, providerID, stats.BoardID, keep); err != nil {
+			return fmt.Errorf("오래된 레이아웃 지표 정리(Delete) 쿼리 실행 실패 (providerID: %s, boardID: %s): %w", providerID, stats.BoardID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("레이아웃 지표 저장(SaveLayoutStats) 트랜잭션 Commit 실패: %w", err)
+	}
+
+	return nil
+}
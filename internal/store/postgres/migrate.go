@@ -0,0 +1,63 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+
+	"github.com/darkkaiser/rss-feed-server/internal/store/migrate"
+)
+
+// migrationFiles PostgreSQL 스키마 마이그레이션 스크립트입니다.
+// SQLite 저장소와 같은 번호 체계를 따르며, 한쪽에 스키마 변경을 추가하면 다른 쪽에도 같은 번호로 추가해야 합니다.
+//
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+// dialect PostgreSQL에 맞춘 마이그레이션 실행 규칙입니다.
+//
+// 여러 서버 인스턴스가 같은 데이터베이스를 공유하므로, 매 마이그레이션 트랜잭션마다 공급자 동기화와 같은 Advisory Lock을 잡습니다.
+var dialect = migrate.Dialect{
+	Placeholder: func(n int) string { return fmt.Sprintf("$%d", n) },
+	TableExists: func(ctx context.Context, tx *sql.Tx, table string) (bool, error) {
+		// to_regclass는 search_path를 따라 테이블을 찾으므로, 스키마를 분리해 사용하는 환경에서도 올바르게 동작합니다.
+		var exists bool
+		if err := tx.QueryRowContext(ctx, "SELECT to_regclass($1) IS NOT NULL", table).Scan(&exists); err != nil {
+			return false, err
+		}
+		return exists, nil
+	},
+	Lock: func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock($1)", schemaLockKey)
+		return err
+	},
+}
+
+// migrate 내장된 마이그레이션 스크립트 중 아직 적용되지 않은 것을 순서대로 적용하여 스키마를 최신 버전으로 올립니다.
+//
+// 버전 관리 도입 이전에 'IF NOT EXISTS' 방식으로 만들어진 데이터베이스는 기준 스크립트를 한 번 더 실행하여
+// 누락된 항목만 채운 뒤 기준 버전(1)으로 기록합니다.
+func (s *Store) migrate(ctx context.Context) error {
+	migrations, err := migrate.Load(migrationFiles, "migrations")
+	if err != nil {
+		return err
+	}
+
+	baseline := &migrate.Baseline{
+		Version:     1,
+		MarkerTable: "rss_provider",
+		Prepare: func(ctx context.Context, tx *sql.Tx) error {
+			if _, err := tx.ExecContext(ctx, migrations[0].SQL); err != nil {
+				return fmt.Errorf("기준 스키마 스크립트 실행 실패: %w", err)
+			}
+			return nil
+		},
+	}
+
+	if _, err := migrate.New(s.db, dialect, migrations, baseline).Up(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
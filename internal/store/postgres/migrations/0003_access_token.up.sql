@@ -0,0 +1,25 @@
+-- 비공개(private) 피드를 구독할 수 있는 구독자별 접근 토큰입니다. 토큰 원문은 저장하지 않고 SHA-256 해시만 저장합니다.
+-- scopes는 접근 범위 목록('*', '<공급자 ID>', '<공급자 ID>/<게시판 ID>')을 JSON 배열로 저장합니다.
+CREATE TABLE rss_access_token (
+    id             BIGSERIAL    PRIMARY KEY,
+    name           VARCHAR(100) NOT NULL,
+    token_hash     CHAR(64)     NOT NULL UNIQUE,
+    scopes         TEXT         NOT NULL,
+    expires_date   TIMESTAMPTZ,
+    created_date   TIMESTAMPTZ  NOT NULL,
+    last_used_date TIMESTAMPTZ,
+    revoked_date   TIMESTAMPTZ
+);
+
+-- 접근 토큰이 사용된 요청 기록입니다. 토큰별로 최근 기록만 일정 개수 보관합니다.
+CREATE TABLE rss_access_token_usage (
+    seq        BIGSERIAL    PRIMARY KEY,
+    token_id   BIGINT       NOT NULL,
+    path       TEXT         NOT NULL,
+    remote_ip  VARCHAR( 45) NOT NULL,
+    user_agent TEXT,
+    used_date  TIMESTAMPTZ  NOT NULL,
+    FOREIGN KEY (token_id) REFERENCES rss_access_token(id) ON DELETE CASCADE
+);
+
+CREATE INDEX rss_access_token_usage_index01 ON rss_access_token_usage(token_id, seq DESC);
@@ -0,0 +1,181 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+)
+
+// 컴파일 타임에 인터페이스 구현 여부를 검증합니다.
+var _ feed.ParseSnapshotRepository = (*Store)(nil)
+
+// SaveParseSnapshot 파싱 실패 스냅샷을 기록하고 발급된 ID를 반환합니다.
+//
+// 기록과 같은 트랜잭션에서 ttl보다 오래된 스냅샷을 삭제하고, 전체 개수가 keep개를 넘으면 가장 오래된 것부터 삭제합니다.
+// ttl 또는 keep이 0 이하이면 해당 기준의 정리는 수행하지 않습니다.
+func (s *Store) SaveParseSnapshot(ctx context.Context, snapshot *feed.ParseSnapshot, keep int, ttl time.Duration) (int64, error) {
+	var header sql.NullString
+	if len(snapshot.Header) > 0 {
+		raw, err := json.Marshal(snapshot.Header)
+		if err != nil {
+			return 0, fmt.Errorf("파싱 실패 스냅샷의 요청 헤더 직렬화 실패: %w", err)
+		}
+		header = sql.NullString{String: string(raw), Valid: true}
+	}
+
+	createdAt := snapshot.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
+
+	body := snapshot.Body
+	if body == nil {
+		body = []byte{}
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("파싱 실패 스냅샷 저장(SaveParseSnapshot) 트랜잭션 시작(BeginTx) 실패: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	// PostgreSQL 드라이버는 LastInsertId를 지원하지 않으므로 RETURNING 절로 발급된 ID를 돌려받습니다.
+	var id int64
+	if err := tx.QueryRowContext(ctx, `
+		INSERT INTO
+			rss_parse_snapshot (p_id, b_id, page, url, header, content_type, body, truncated, error, created_date)
+		VALUES
+			($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING id
+	`, snapshot.ProviderID, snapshot.BoardID, snapshot.Page, snapshot.URL, header, snapshot.ContentType, body, snapshot.Truncated, snapshot.Error, createdAt.UTC()).Scan(&id); err != nil {
+		return 0, fmt.Errorf("파싱 실패 스냅샷 기록(Insert) 쿼리 실행 실패 (providerID: %s, boardID: %s): %w", snapshot.ProviderID, snapshot.BoardID, err)
+	}
+
+	if ttl > 0 {
+		if _, err := tx.ExecContext(ctx, "DELETE FROM rss_parse_snapshot WHERE created_date < $1", time.Now().Add(-ttl).UTC()); err != nil {
+			return 0, fmt.Errorf("보관 기한이 지난 파싱 실패 스냅샷 정리(Delete) 쿼리 실행 실패: %w", err)
+		}
+	}
+
+	if keep > 0 {
+		if _, err := tx.ExecContext(ctx, `
+			DELETE FROM rss_parse_snapshot
+			 WHERE id NOT IN ( SELECT id
+			                     FROM rss_parse_snapshot
+			                    ORDER BY id DESC
+			                    LIMIT $1 )
+		`, keep); err != nil {
+			return 0, fmt.Errorf("보관 개수를 초과한 파싱 실패 스냅샷 정리(Delete) 쿼리 실행 실패: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("파싱 실패 스냅샷 저장(SaveParseSnapshot) 트랜잭션 Commit 실패: %w", err)
+	}
+
+	return id, nil
+}
+
+// ListParseSnapshots 파싱 실패 스냅샷 목록을 최근 기록 순으로 최대 limit개 반환합니다.
+// 목록 조회에서는 용량이 큰 본문(body)을 읽지 않습니다.
+func (s *Store) ListParseSnapshots(ctx context.Context, providerID string, limit int) ([]*feed.ParseSnapshot, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id
+		     , p_id
+		     , b_id
+		     , page
+		     , url
+		     , header
+		     , content_type
+		     , truncated
+		     , error
+		     , created_date
+		  FROM rss_parse_snapshot
+		 WHERE $1 = '' OR p_id = $1
+		 ORDER BY id DESC
+		 LIMIT $2
+	`, providerID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("파싱 실패 스냅샷 목록 조회(ListParseSnapshots) 쿼리 실행 실패 (providerID: %s): %w", providerID, err)
+	}
+	defer rows.Close()
+
+	snapshots := make([]*feed.ParseSnapshot, 0)
+
+	for rows.Next() {
+		snapshot, err := scanParseSnapshot(rows, false)
+		if err != nil {
+			return nil, fmt.Errorf("파싱 실패 스냅샷 목록 조회(ListParseSnapshots) 결과 행 스캔 실패: %w", err)
+		}
+
+		snapshots = append(snapshots, snapshot)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("파싱 실패 스냅샷 목록 조회(ListParseSnapshots) 결과 행 순회 중 오류 발생: %w", err)
+	}
+
+	return snapshots, nil
+}
+
+// GetParseSnapshot 지정한 ID의 파싱 실패 스냅샷을 본문과 함께 반환합니다. 존재하지 않으면 nil, nil을 반환합니다.
+func (s *Store) GetParseSnapshot(ctx context.Context, id int64) (*feed.ParseSnapshot, error) {
+	row := s.db.QueryRowContext(ctx, `
+		SELECT id
+		     , p_id
+		     , b_id
+		     , page
+		     , url
+		     , header
+		     , content_type
+		     , truncated
+		     , error
+		     , created_date
+		     , body
+		  FROM rss_parse_snapshot
+		 WHERE id = $1
+	`, id)
+
+	snapshot, err := scanParseSnapshot(row, true)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("파싱 실패 스냅샷 조회(GetParseSnapshot) 실패 (id: %d): %w", id, err)
+	}
+
+	return snapshot, nil
+}
+
+// scanParseSnapshot 조회 결과 한 행을 feed.ParseSnapshot으로 변환합니다.
+// withBody가 true이면 마지막 컬럼으로 본문(body)이 조회되었다고 가정합니다.
+func scanParseSnapshot(row interface{ Scan(dest ...any) error }, withBody bool) (*feed.ParseSnapshot, error) {
+	var snapshot feed.ParseSnapshot
+	var rawHeader sql.NullString
+	var createdDate sql.NullTime
+
+	dest := []any{&snapshot.ID, &snapshot.ProviderID, &snapshot.BoardID, &snapshot.Page, &snapshot.URL, &rawHeader, &snapshot.ContentType, &snapshot.Truncated, &snapshot.Error, &createdDate}
+	if withBody {
+		dest = append(dest, &snapshot.Body)
+	}
+
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+
+	if rawHeader.Valid && rawHeader.String != "" {
+		if err := json.Unmarshal([]byte(rawHeader.String), &snapshot.Header); err != nil {
+			return nil, fmt.Errorf("요청 헤더 역직렬화 실패 (id: %d): %w", snapshot.ID, err)
+		}
+	}
+	snapshot.CreatedAt = localTime(createdDate)
+
+	return &snapshot, nil
+}
@@ -0,0 +1,148 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+)
+
+// 컴파일 타임에 인터페이스 구현 여부를 검증합니다.
+var _ feed.RevisionRepository = (*Store)(nil)
+
+// GetRecentArticles 지정한 공급자에서 since 이후에 작성되었고 삭제가 감지되지 않은 게시글을 본문과 함께 최신순으로 반환합니다.
+func (s *Store) GetRecentArticles(ctx context.Context, providerID string, since time.Time) ([]*feed.Article, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT a.b_id
+		     , b.name AS b_name
+		     , a.id
+		     , a.title
+		     , COALESCE(a.content, '') AS content
+		     , a.link
+		     , COALESCE(a.author, '') AS author
+		     , a.created_date
+		     , a.updated_date
+		  FROM rss_provider_article a
+		       INNER JOIN rss_provider_board b ON ( a.p_id = b.p_id AND a.b_id = b.id )
+		 WHERE a.p_id = $1
+		   AND a.created_date >= $2
+		   AND a.deleted_at IS NULL
+		 ORDER BY a.created_date DESC
+	`, providerID, since.UTC())
+	if err != nil {
+		return nil, fmt.Errorf("최근 게시글 목록 조회(GetRecentArticles) 쿼리 실행 실패 (providerID: %s): %w", providerID, err)
+	}
+	defer rows.Close()
+
+	return scanArticles(rows, "최근 게시글 목록 조회(GetRecentArticles)")
+}
+
+// SaveArticleRevision 원문 수정이 감지된 게시글의 새 본문을 수정 이력으로 기록하고 게시글의 본문과 수정일시를 갱신합니다.
+//
+// 처음 수정이 감지된 게시글은 수정 전 본문을 1번 리비전으로 함께 남겨, 이력만으로 원래 본문을 복원할 수 있게 합니다.
+// 같은 게시글에 대한 동시 기록이 리비전 번호를 중복 발급하지 않도록 게시글 행을 FOR UPDATE로 잠근 뒤 처리합니다.
+func (s *Store) SaveArticleRevision(ctx context.Context, providerID string, article *feed.Article) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("게시글 수정 이력 저장(SaveArticleRevision) 트랜잭션 시작(BeginTx) 실패: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	var (
+		storedContent  sql.NullString
+		createdDate    sql.NullTime
+		lastRevisionNo int
+	)
+	err = tx.QueryRowContext(ctx, `
+		SELECT a.content
+		     , a.created_date
+		     , COALESCE(( SELECT MAX(r.revision_no)
+		                    FROM rss_article_revision r
+		                   WHERE r.p_id = a.p_id
+		                     AND r.b_id = a.b_id
+		                     AND r.a_id = a.id ), 0)
+		  FROM rss_provider_article a
+		 WHERE a.p_id = $1
+		   AND a.b_id = $2
+		   AND a.id = $3
+		   FOR UPDATE OF a
+	`, providerID, article.BoardID, article.ArticleID).Scan(&storedContent, &createdDate, &lastRevisionNo)
+	if err != nil {
+		return fmt.Errorf("수정 대상 게시글 조회(Select) 쿼리 실행 실패 (providerID: %s, boardID: %s, articleID: %s): %w", providerID, article.BoardID, article.ArticleID, err)
+	}
+
+	insertQuery := `
+		INSERT INTO
+			rss_article_revision (p_id, b_id, a_id, revision_no, content_hash, content, revised_date)
+		VALUES
+			($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	if lastRevisionNo == 0 {
+		revisedDate := createdDate.Time
+		if !createdDate.Valid {
+			revisedDate = time.Now()
+		}
+
+		if _, err := tx.ExecContext(ctx, insertQuery, providerID, article.BoardID, article.ArticleID, 1, feed.ContentHash(storedContent.String), storedContent.String, revisedDate.UTC()); err != nil {
+			return fmt.Errorf("최초 본문 리비전 기록(Insert) 쿼리 실행 실패 (providerID: %s, articleID: %s): %w", providerID, article.ArticleID, err)
+		}
+		lastRevisionNo = 1
+	}
+
+	updatedAt := article.UpdatedAt
+	if updatedAt.IsZero() {
+		updatedAt = time.Now()
+	}
+
+	if _, err := tx.ExecContext(ctx, insertQuery, providerID, article.BoardID, article.ArticleID, lastRevisionNo+1, feed.ContentHash(article.Content), article.Content, updatedAt.UTC()); err != nil {
+		return fmt.Errorf("수정 본문 리비전 기록(Insert) 쿼리 실행 실패 (providerID: %s, articleID: %s): %w", providerID, article.ArticleID, err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE rss_provider_article
+		   SET content      = $1
+		     , updated_date = $2
+		     , fingerprint  = $3
+		 WHERE p_id = $4
+		   AND b_id = $5
+		   AND id = $6
+	`, article.Content, updatedAt.UTC(), nullFingerprint(feed.Fingerprint(article.Title, article.Content)), providerID, article.BoardID, article.ArticleID); err != nil {
+		return fmt.Errorf("수정된 게시글 본문 갱신(Update) 쿼리 실행 실패 (providerID: %s, articleID: %s): %w", providerID, article.ArticleID, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("게시글 수정 이력 저장(SaveArticleRevision) 트랜잭션 Commit 실패: %w", err)
+	}
+
+	return nil
+}
+
+// scanArticles 본문을 포함한 게시글 조회 결과(게시판 ID, 게시판 이름, ID, 제목, 본문, 링크, 작성자, 작성일시, 수정일시)를 변환합니다.
+// operation은 에러 메시지에 표시할 조회 작업의 이름입니다.
+func scanArticles(rows *sql.Rows, operation string) ([]*feed.Article, error) {
+	articles := make([]*feed.Article, 0)
+
+	for rows.Next() {
+		var article feed.Article
+		var createdDate, updatedDate sql.NullTime
+
+		if err := rows.Scan(&article.BoardID, &article.BoardName, &article.ArticleID, &article.Title, &article.Content, &article.Link, &article.Author, &createdDate, &updatedDate); err != nil {
+			return nil, fmt.Errorf("%s 결과 행 스캔 실패: %w", operation, err)
+		}
+		article.CreatedAt = localTime(createdDate)
+		article.UpdatedAt = localTime(updatedDate)
+
+		articles = append(articles, &article)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s 결과 행 순회 중 오류 발생: %w", operation, err)
+	}
+
+	return articles, nil
+}
@@ -0,0 +1,56 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+)
+
+// 컴파일 타임에 인터페이스 구현 여부를 검증합니다.
+var _ feed.StatsRepository = (*Store)(nil)
+
+// CountArticlesByDay 지정한 기간에 작성된 게시글 수를 loc 시간대의 날짜와 게시판별로 집계합니다.
+//
+// 세션 시간대(TimeZone) 설정에 영향을 받지 않도록 UTC 기준 시 단위로 묶은 뒤, 날짜 구분은 feed.DailyArticleCounter에서 수행합니다.
+func (s *Store) CountArticlesByDay(ctx context.Context, providerID string, since, until time.Time, loc *time.Location) ([]*feed.DailyArticleCount, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT b_id
+		     , to_char(created_date AT TIME ZONE 'UTC', 'YYYY-MM-DD"T"HH24') AS hour
+		     , COUNT(*)
+		  FROM rss_provider_article
+		 WHERE p_id = $1
+		   AND created_date >= $2
+		   AND created_date < $3
+		 GROUP BY b_id, hour
+	`, providerID, since.UTC(), until.UTC())
+	if err != nil {
+		return nil, fmt.Errorf("게시글 수 집계(CountArticlesByDay) 쿼리 실행 실패 (providerID: %s): %w", providerID, err)
+	}
+	defer rows.Close()
+
+	counter := feed.NewDailyArticleCounter(loc)
+
+	for rows.Next() {
+		var (
+			boardID, rawHour string
+			count            int64
+		)
+		if err := rows.Scan(&boardID, &rawHour, &count); err != nil {
+			return nil, fmt.Errorf("게시글 수 집계(CountArticlesByDay) 결과 행 스캔 실패: %w", err)
+		}
+
+		hour, err := time.ParseInLocation("2006-01-02T15", rawHour, time.UTC)
+		if err != nil {
+			return nil, fmt.Errorf("게시글 수 집계(CountArticlesByDay) 작성일시(%s) 해석 실패: %w", rawHour, err)
+		}
+		counter.Add(boardID, hour, count)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("게시글 수 집계(CountArticlesByDay) 결과 행 순회 중 오류 발생: %w", err)
+	}
+
+	return counter.Result(), nil
+}
@@ -0,0 +1,504 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/darkkaiser/rss-feed-server/internal/config"
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+)
+
+// schemaLockKey 스키마 마이그레이션과 공급자 동기화를 직렬화하기 위한 PostgreSQL Advisory Lock 키입니다.
+//
+// 여러 서버 인스턴스가 동시에 기동되면 마이그레이션 스크립트나 마스터 데이터 Upsert가 경쟁하여
+// 고유 제약 위반이나 교착 상태(Deadlock)가 발생할 수 있으므로, 해당 작업은 트랜잭션 단위 Advisory Lock을 잡은 뒤 수행합니다.
+const schemaLockKey int64 = 0x7273_7366_6565_6400 // "rssfeed\x00"
+
+// Store RSS 피드 도메인의 데이터를 PostgreSQL에 영속화하는 저장소입니다.
+// sqlite.Store와 동일하게 feed.Repository와 선택적 저장소 인터페이스들을 구현하며,
+// 여러 API 인스턴스가 하나의 데이터베이스를 공유하는 운영 환경을 위해 사용합니다.
+//
+// 테이블 구조와 의미는 SQLite 저장소와 같고, 일시 컬럼만 TIMESTAMPTZ 타입으로 저장합니다.
+type Store struct {
+	// db 활성화된 PostgreSQL 데이터베이스 커넥션 풀입니다.
+	// 이 커넥션의 라이프사이클(Open/Close)은 Store의 책임이 아니며, 최상위 호출자(Caller) 측에서 관리해야 합니다.
+	db *sql.DB
+}
+
+// 컴파일 타임에 인터페이스 구현 여부를 검증합니다.
+var _ feed.Repository = (*Store)(nil)
+
+// New 주입받은 PostgreSQL 데이터베이스 커넥션(*sql.DB)을 기반으로 새로운 Store 인스턴스를 생성하여 반환합니다.
+//
+// 스키마 생성에는 관여하지 않으므로, 반환된 Store를 서비스에 연동하기 전 반드시 Initialize()를 호출해야 합니다.
+func New(db *sql.DB) (*Store, error) {
+	s := &Store{
+		db: db,
+	}
+
+	return s, nil
+}
+
+// Initialize 어플리케이션 시작 시 데이터베이스가 정상적으로 동작하기 위한 스키마를 준비합니다.
+//
+// SQLite 저장소와 달리 VACUUM은 수행하지 않습니다. PostgreSQL은 Autovacuum 데몬이 삭제된 튜플의 공간을
+// 주기적으로 회수하며, 운영 중인 공유 데이터베이스에서 수동 VACUUM은 다른 인스턴스의 쿼리를 방해할 수 있습니다.
+func (s *Store) Initialize(ctx context.Context) error {
+	if err := s.migrate(ctx); err != nil {
+		return fmt.Errorf("데이터베이스 스키마 초기화 및 마이그레이션 실패: %w", err)
+	}
+
+	return nil
+}
+
+// SyncProviders 애플리케이션 설정(providers)을 단일 정보 원천(Source of Truth)으로 삼아,
+// 데이터베이스에 저장된 공급자(Provider) 및 게시판(Board) 마스터 데이터를 최신 상태로 완전 동기화합니다.
+//
+// 동기화 규칙은 SQLite 저장소와 같습니다 (신규 추가, 변경 덮어쓰기, 제거된 항목 삭제를 하나의 트랜잭션으로 처리).
+// 여러 인스턴스가 동시에 기동되어 같은 마스터 데이터를 갱신하는 경우를 대비해, 스키마 마이그레이션과 같은
+// Advisory Lock을 잡고 동기화를 수행합니다.
+func (s *Store) SyncProviders(ctx context.Context, providers []*config.ProviderConfig) error {
+	// [단계 1] 트랜잭션 시작 및 인스턴스 간 동기화 작업 직렬화
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("공급자 동기화 트랜잭션 시작(BeginTx) 실패: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock($1)", schemaLockKey); err != nil {
+		return fmt.Errorf("공급자 동기화 잠금(Advisory Lock) 획득 실패: %w", err)
+	}
+
+	// [단계 2] 공급자 및 게시판 Upsert 쿼리 사전 컴파일(Prepare)
+	providerStmt, err := tx.PrepareContext(ctx, `
+		INSERT INTO
+			rss_provider (id, site, s_id, s_name, s_description, s_url)
+		VALUES
+			($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (id) DO UPDATE SET
+			site          = excluded.site,
+			s_id          = excluded.s_id,
+			s_name        = excluded.s_name,
+			s_description = excluded.s_description,
+			s_url         = excluded.s_url
+	`)
+	if err != nil {
+		return fmt.Errorf("공급자(Provider) Upsert 쿼리 사전 컴파일(PrepareContext) 실패: %w", err)
+	}
+	defer providerStmt.Close()
+
+	boardStmt, err := tx.PrepareContext(ctx, `
+		INSERT INTO
+			rss_provider_board (p_id, id, name)
+		VALUES
+			($1, $2, $3)
+		ON CONFLICT (p_id, id) DO UPDATE SET
+			name = excluded.name
+	`)
+	if err != nil {
+		return fmt.Errorf("게시판(Board) Upsert 쿼리 사전 컴파일(PrepareContext) 실패: %w", err)
+	}
+	defer boardStmt.Close()
+
+	// [단계 3] 설정 목록 순회 및 Upsert
+	for _, p := range providers {
+		if err := ctx.Err(); err != nil {
+			return fmt.Errorf("공급자 동기화 루프 실행 중 컨텍스트 취소 감지: %w", err)
+		}
+
+		if _, err := providerStmt.ExecContext(ctx, p.ID, p.Site, p.Config.ID, p.Config.Name, p.Config.Description, p.Config.URL); err != nil {
+			return fmt.Errorf("공급자 Upsert 실패 (providerID: %s): %w", p.ID, err)
+		}
+
+		for _, b := range p.Config.Boards {
+			if _, err := boardStmt.ExecContext(ctx, p.ID, b.ID, b.Name); err != nil {
+				return fmt.Errorf("게시판 Upsert 실패 (providerID: %s, boardID: %s): %w", p.ID, b.ID, err)
+			}
+		}
+	}
+
+	// [단계 4] 설정에서 삭제된 공급자/게시판의 레코드 정리
+	if err := s.pruneStaleData(ctx, tx, providers); err != nil {
+		return err
+	}
+
+	// [단계 5] 트랜잭션 커밋
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("공급자 동기화 트랜잭션 영구 반영(Commit) 실패: %w", err)
+	}
+
+	return nil
+}
+
+// pruneStaleData 설정(providers)에서 빠진 공급자(Provider)와 게시판(Board) 레코드를 데이터베이스에서 삭제합니다.
+//
+// SQLite 저장소는 IN 절의 자리표시자를 동적으로 생성하지만, PostgreSQL은 배열 바인딩(= ANY($n))을 지원하므로
+// 공급자/게시판 ID 목록을 배열 하나로 전달합니다. 빈 배열에 대한 '<> ALL'은 참이므로 빈 설정도 별도 분기 없이 처리됩니다.
+// 하위 게시판과 게시글은 FK ON DELETE CASCADE에 의해 함께 삭제됩니다.
+func (s *Store) pruneStaleData(ctx context.Context, tx *sql.Tx, providers []*config.ProviderConfig) error {
+	activeProviderIDs := make([]string, 0, len(providers))
+	for _, p := range providers {
+		activeProviderIDs = append(activeProviderIDs, p.ID)
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM rss_provider WHERE id <> ALL($1)", activeProviderIDs); err != nil {
+		return fmt.Errorf("고립된 공급자(Provider) 레코드 삭제(Delete) 쿼리 실행 실패: %w", err)
+	}
+
+	for _, p := range providers {
+		activeBoardIDs := make([]string, 0, len(p.Config.Boards))
+		for _, b := range p.Config.Boards {
+			activeBoardIDs = append(activeBoardIDs, b.ID)
+		}
+
+		if _, err := tx.ExecContext(ctx, "DELETE FROM rss_provider_board WHERE p_id = $1 AND id <> ALL($2)", p.ID, activeBoardIDs); err != nil {
+			return fmt.Errorf("고립된 게시판(Board) 레코드 삭제(Delete) 쿼리 실행 실패 (providerID: %s): %w", p.ID, err)
+		}
+
+		// b_id=''(공급자 전체에 적용되는 글로벌 메타데이터)는 게시판과 무관하므로 보존합니다.
+		if _, err := tx.ExecContext(ctx, "DELETE FROM rss_provider_site_crawled_data WHERE p_id = $1 AND b_id <> '' AND b_id <> ALL($2)", p.ID, activeBoardIDs); err != nil {
+			return fmt.Errorf("고립된 게시판(Board) 크롤링 메타데이터 삭제(Delete) 쿼리 실행 실패 (providerID: %s): %w", p.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// PurgeOldArticles 환경 설정에 정의된 게시판별 보관 정책에 따라 보관 기간이 지났거나 보관 개수를 초과한 게시글을
+// 공급자 단위로 삭제하고, 게시판별 삭제 내역을 반환합니다. 보관 정책의 적용 규칙은 SQLite 구현과 같습니다.
+//
+// 공급자별로 독립된 트랜잭션을 사용하여 잠금 범위를 좁히고, 특정 공급자의 삭제가 실패하더라도 나머지 공급자는
+// 계속 처리한 뒤 모든 에러를 errors.Join으로 묶어 반환합니다.
+func (s *Store) PurgeOldArticles(ctx context.Context, providers []*config.ProviderConfig) ([]*feed.PurgeResult, error) {
+	var (
+		results []*feed.PurgeResult
+		errs    []error
+	)
+
+	for _, p := range providers {
+		if err := ctx.Err(); err != nil {
+			return results, fmt.Errorf("보관 기한 초과 레코드 일괄 삭제 작업 중 실행 컨텍스트가 취소되었습니다: %w", err)
+		}
+
+		providerResults, err := s.purgeProviderArticles(ctx, p)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("공급자(providerID: %s)의 보관 기한 초과 게시글 삭제 실패: %w", p.ID, err))
+			continue
+		}
+
+		results = append(results, providerResults...)
+	}
+
+	return results, errors.Join(errs...)
+}
+
+// purgeProviderArticles 한 공급자의 게시판별 보관 정책을 하나의 트랜잭션으로 적용하고, 게시글이 삭제된 게시판의 내역만 반환합니다.
+func (s *Store) purgeProviderArticles(ctx context.Context, p *config.ProviderConfig) ([]*feed.PurgeResult, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("보관 기한 초과 레코드 삭제를 위한 독립 트랜잭션 시작(BeginTx) 실패: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	var results []*feed.PurgeResult
+
+	boardIDs := make([]string, 0, len(p.Config.Boards))
+	for _, b := range p.Config.Boards {
+		boardIDs = append(boardIDs, b.ID)
+
+		result := &feed.PurgeResult{ProviderID: p.ID, BoardID: b.ID}
+
+		if days := b.RetentionDays(p.Config.ArchiveDays); days > 0 {
+			res, err := tx.ExecContext(ctx, `
+				DELETE
+				  FROM rss_provider_article
+				 WHERE p_id = $1
+				   AND b_id = $2
+				   AND created_date < now() - make_interval(days => $3)
+			`, p.ID, b.ID, int(days))
+			if err != nil {
+				return nil, fmt.Errorf("보관 기한을 초과한 게시글 삭제 쿼리 실행 실패 (boardID: %s, archiveDays: %d): %w", b.ID, days, err)
+			}
+			if result.Expired, err = res.RowsAffected(); err != nil {
+				return nil, err
+			}
+		}
+
+		if b.MaxRows > 0 {
+			res, err := tx.ExecContext(ctx, `
+				DELETE
+				  FROM rss_provider_article
+				 WHERE p_id = $1
+				   AND b_id = $2
+				   AND id NOT IN ( SELECT id
+				                     FROM rss_provider_article
+				                    WHERE p_id = $1
+				                      AND b_id = $2
+				                    ORDER BY created_date DESC, id DESC
+				                    LIMIT $3 )
+			`, p.ID, b.ID, int64(b.MaxRows))
+			if err != nil {
+				return nil, fmt.Errorf("보관 개수를 초과한 게시글 삭제 쿼리 실행 실패 (boardID: %s, maxRows: %d): %w", b.ID, b.MaxRows, err)
+			}
+			if result.Overflow, err = res.RowsAffected(); err != nil {
+				return nil, err
+			}
+		}
+
+		if result.Total() > 0 {
+			results = append(results, result)
+		}
+	}
+
+	// 설정에서 제거된 게시판의 게시글은 게시판별 정책이 없으므로 공급자의 보관 기간만 적용합니다.
+	// (boardIDs가 비어 있으면 <> ALL 조건은 항상 참이므로 공급자의 모든 게시글이 대상이 됩니다.)
+	if p.Config.ArchiveDays > 0 {
+		res, err := tx.ExecContext(ctx, `
+			DELETE
+			  FROM rss_provider_article
+			 WHERE p_id = $1
+			   AND b_id <> ALL($2)
+			   AND created_date < now() - make_interval(days => $3)
+		`, p.ID, boardIDs, int(p.Config.ArchiveDays))
+		if err != nil {
+			return nil, fmt.Errorf("설정에 없는 게시판의 보관 기한 초과 게시글 삭제 쿼리 실행 실패 (archiveDays: %d): %w", p.Config.ArchiveDays, err)
+		}
+		expired, err := res.RowsAffected()
+		if err != nil {
+			return nil, err
+		}
+		if expired > 0 {
+			results = append(results, &feed.PurgeResult{ProviderID: p.ID, Expired: expired})
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("보관 기한 초과 레코드 삭제 트랜잭션의 영구 반영(Commit) 실패: %w", err)
+	}
+
+	return results, nil
+}
+
+// SaveArticles 게시글 목록을 데이터베이스에 저장하고, 실제로 저장에 성공한 게시글 수를 반환합니다.
+//
+// 이미 있는 게시글은 최신 내용으로 덮어씁니다. PostgreSQL은 트랜잭션 안에서 한 문장이라도 실패하면
+// 이후 문장을 모두 거부하므로, 게시글마다 SAVEPOINT를 두어 실패한 게시글만 되돌리고 나머지는 계속 저장합니다.
+func (s *Store) SaveArticles(ctx context.Context, providerID string, articles []*feed.Article) (int, error) {
+	if len(articles) == 0 {
+		return 0, nil
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("게시글 저장(SaveArticles) 트랜잭션 시작(BeginTx) 실패: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	stmt, err := tx.PrepareContext(ctx, `
+		INSERT INTO
+			rss_provider_article (p_id, b_id, id, title, content, link, author, created_date, fingerprint)
+		VALUES
+			($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (p_id, b_id, id) DO UPDATE SET
+			title        = excluded.title,
+			content      = excluded.content,
+			link         = excluded.link,
+			author       = excluded.author,
+			created_date = excluded.created_date,
+			fingerprint  = excluded.fingerprint
+	`)
+	if err != nil {
+		return 0, fmt.Errorf("게시글(Article) Upsert PrepareContext 실패 (providerID: %s): %w", providerID, err)
+	}
+	defer stmt.Close()
+
+	var errs []error
+	var savedCount int
+
+	for _, article := range articles {
+		if err := ctx.Err(); err != nil {
+			errs = append(errs, fmt.Errorf("게시글 저장(SaveArticles) 컨텍스트 취소로 중단: %w", err))
+			break
+		}
+
+		if _, err := tx.ExecContext(ctx, "SAVEPOINT save_article"); err != nil {
+			errs = append(errs, fmt.Errorf("게시글 저장(SaveArticles) SAVEPOINT 생성 실패: %w", err))
+			break
+		}
+
+		fingerprint := nullFingerprint(feed.Fingerprint(article.Title, article.Content))
+		if _, err := stmt.ExecContext(ctx, providerID, article.BoardID, article.ArticleID, article.Title, article.Content, article.Link, article.Author, article.CreatedAt.UTC(), fingerprint); err != nil {
+			errs = append(errs, fmt.Errorf("게시글(Article) Upsert 쿼리 실행 실패 (providerID: %s, articleID: %s): %w", providerID, article.ArticleID, err))
+
+			if _, err := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT save_article"); err != nil {
+				errs = append(errs, fmt.Errorf("게시글 저장(SaveArticles) SAVEPOINT 복원 실패: %w", err))
+				break
+			}
+			continue
+		}
+
+		savedCount++
+	}
+
+	if err := tx.Commit(); err != nil {
+		if len(errs) > 0 {
+			errs = append(errs, fmt.Errorf("게시글 저장(SaveArticles) 트랜잭션 Commit 실패: %w", err))
+			return 0, errors.Join(errs...)
+		}
+
+		return 0, fmt.Errorf("게시글 저장(SaveArticles) 트랜잭션 Commit 실패: %w", err)
+	}
+
+	if len(errs) > 0 {
+		return savedCount, fmt.Errorf("게시글(Article) 부분 저장 완료 — 일부 Upsert 실패 포함: %w", errors.Join(errs...))
+	}
+
+	return savedCount, nil
+}
+
+// GetArticles 지정한 공급자(providerID)의 게시판들(boardIDs)에서 게시글을 최신순으로 최대 limit개 반환합니다.
+// boardIDs가 비어 있으면 DB를 조회하지 않고 즉시 빈 목록을 반환합니다.
+func (s *Store) GetArticles(ctx context.Context, providerID string, boardIDs []string, limit uint) ([]*feed.Article, error) {
+	return s.getArticles(ctx, providerID, boardIDs, limit, false)
+}
+
+// getArticles GetArticles와 GetVisibleArticles의 공통 구현입니다.
+// excludeDeleted가 true이면 삭제가 감지된 게시글을 LIMIT 적용 전에 조회 조건에서 제외합니다.
+func (s *Store) getArticles(ctx context.Context, providerID string, boardIDs []string, limit uint, excludeDeleted bool) ([]*feed.Article, error) {
+	if len(boardIDs) == 0 {
+		return make([]*feed.Article, 0), nil
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT a.b_id
+		     , b.name AS b_name
+		     , a.id
+		     , a.title
+		     , COALESCE(a.content, '') AS content
+		     , a.link
+		     , COALESCE(a.author, '') AS author
+		     , a.created_date
+		     , a.updated_date
+		     , a.deleted_at
+		     , a.deleted_reason
+		     , a.fingerprint
+		  FROM rss_provider_article a
+		       INNER JOIN rss_provider_board b ON ( a.p_id = b.p_id AND a.b_id = b.id )
+		 WHERE a.p_id = $1
+		   AND a.b_id = ANY($2)`+deletedCondition(excludeDeleted)+`
+		 ORDER BY a.created_date DESC
+		 LIMIT $3
+	`, providerID, boardIDs, int64(limit))
+	if err != nil {
+		return nil, fmt.Errorf("게시글 목록 조회(GetArticles) 쿼리 실행 실패 (providerID: %s): %w", providerID, err)
+	}
+	defer rows.Close()
+
+	articles := make([]*feed.Article, 0, limit)
+
+	for rows.Next() {
+		var article feed.Article
+		var createdDate, updatedDate, deletedAt sql.NullTime
+		var rawDeletedReason sql.NullString
+		var fingerprint sql.NullInt64
+
+		if err = rows.Scan(&article.BoardID, &article.BoardName, &article.ArticleID, &article.Title, &article.Content, &article.Link, &article.Author, &createdDate, &updatedDate, &deletedAt, &rawDeletedReason, &fingerprint); err != nil {
+			return nil, fmt.Errorf("게시글 목록 조회(GetArticles) 결과 행 스캔 실패: %w", err)
+		}
+		article.CreatedAt = localTime(createdDate)
+		article.UpdatedAt = localTime(updatedDate)
+		article.DeletedAt = localTime(deletedAt)
+		article.DeletedReason = deletedReason(article.DeletedAt, rawDeletedReason)
+		article.Fingerprint = uint64(fingerprint.Int64)
+
+		articles = append(articles, &article)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("게시글 목록 조회(GetArticles) 결과 행 순회 중 오류 발생: %w", err)
+	}
+
+	return articles, nil
+}
+
+// GetCrawlingCursor 이전 크롤링에서 마지막으로 수집한 게시글의 ID와 작성일시를 반환합니다.
+// 저장된 커서가 없으면 ID는 빈 문자열(""), 작성일시는 zero value(time.Time{})를 반환합니다.
+//
+// boardID가 빈 문자열("")이면 커서 ID는 공급자 전체 범위(빈 b_id)에서, 최신 작성일시는 게시판 구분 없이 조회합니다.
+func (s *Store) GetCrawlingCursor(ctx context.Context, providerID, boardID string) (string, time.Time, error) {
+	var err error
+	var rawArticleID sql.NullString
+	var createdDate sql.NullTime
+
+	if boardID == "" {
+		err = s.db.QueryRowContext(ctx, `
+			SELECT ( SELECT latest_crawled_article_id
+			           FROM rss_provider_site_crawled_data
+			          WHERE p_id = $1
+			            AND b_id = '' )
+			     , ( SELECT created_date
+			           FROM rss_provider_article
+			          WHERE p_id = $1
+			          ORDER BY created_date DESC
+			          LIMIT 1 )
+		`, providerID).Scan(&rawArticleID, &createdDate)
+	} else {
+		err = s.db.QueryRowContext(ctx, `
+			SELECT ( SELECT latest_crawled_article_id
+			           FROM rss_provider_site_crawled_data
+			          WHERE p_id = $1
+			            AND b_id = $2 )
+			     , ( SELECT created_date
+			           FROM rss_provider_article
+			          WHERE p_id = $1
+			            AND b_id = $2
+			          ORDER BY created_date DESC
+			          LIMIT 1 )
+		`, providerID, boardID).Scan(&rawArticleID, &createdDate)
+	}
+
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return "", time.Time{}, fmt.Errorf("최신 크롤링 메타데이터 조회(Select) 쿼리 실행 실패 (providerID: %s, boardID: %s): %w", providerID, boardID, err)
+	}
+
+	return rawArticleID.String, localTime(createdDate), nil
+}
+
+// UpsertLatestCrawledArticleID 이번 크롤링에서 수집한 가장 최신 게시글의 ID를 저장합니다.
+//
+// boardID를 빈 문자열("")로 전달하면 특정 게시판이 아닌 공급자 전체 범위의 기준 ID로 저장됩니다.
+func (s *Store) UpsertLatestCrawledArticleID(ctx context.Context, providerID, boardID, articleID string) error {
+	query := `
+		INSERT INTO
+			rss_provider_site_crawled_data (p_id, b_id, latest_crawled_article_id)
+		VALUES
+			($1, $2, $3)
+		ON CONFLICT (p_id, b_id) DO UPDATE SET
+			latest_crawled_article_id = excluded.latest_crawled_article_id
+	`
+
+	if _, err := s.db.ExecContext(ctx, query, providerID, boardID, articleID); err != nil {
+		return fmt.Errorf("크롤링 커서(Cursor) Upsert 쿼리 실행 실패 (providerID: %s, boardID: %s): %w", providerID, boardID, err)
+	}
+
+	return nil
+}
+
+// localTime TIMESTAMPTZ 컬럼 값을 로컬 시간대의 time.Time으로 변환합니다. NULL이면 zero value(time.Time{})를 반환합니다.
+// SQLite 저장소의 parseDateTime과 같은 시간대 규칙을 따르도록 맞춥니다.
+func localTime(t sql.NullTime) time.Time {
+	if !t.Valid {
+		return time.Time{}
+	}
+
+	return t.Time.Local()
+}
@@ -0,0 +1,105 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/darkkaiser/rss-feed-server/internal/tracing"
+	"github.com/mattn/go-sqlite3"
+)
+
+const (
+	// backupStepPages 온라인 백업 한 단계에서 복사할 페이지 수입니다.
+	backupStepPages = 256
+
+	// backupStepInterval 백업 단계 사이의 대기 시간입니다.
+	// 단계 사이에 원본 잠금을 잠시 풀어 주어, 백업 중에도 크롤러의 쓰기 작업이 오래 막히지 않도록 합니다.
+	backupStepInterval = 10 * time.Millisecond
+)
+
+// Backup SQLite 온라인 백업 API(sqlite3_backup_*)를 사용하여 운영 중인 데이터베이스를 destPath 파일로 복사합니다.
+//
+// 파일을 직접 복사하면 WAL 파일에만 반영된 최근 변경이 누락되거나 쓰기 도중의 페이지가 섞일 수 있지만,
+// 백업 API는 서버를 멈추지 않고도 일관된 시점의 복사본을 만듭니다. 백업 도중 원본이 변경되면 SQLite가 자동으로 복사를 다시 시작합니다.
+//
+// 기존 파일을 덮어쓰지 않도록 destPath에 파일이 이미 있으면 에러를 반환하며, 실패 시 만들다 만 백업 파일은 삭제합니다.
+func Backup(ctx context.Context, db *sql.DB, destPath string) (err error) {
+	if _, statErr := os.Stat(destPath); statErr == nil {
+		return fmt.Errorf("백업 파일이 이미 존재합니다: '%s'", destPath)
+	} else if !errors.Is(statErr, os.ErrNotExist) {
+		return fmt.Errorf("백업 파일 경로 확인 실패: %w", statErr)
+	}
+
+	destDB, err := sql.Open("sqlite3", destPath)
+	if err != nil {
+		return fmt.Errorf("백업 대상 데이터베이스 연결 초기화 실패: %w", err)
+	}
+	defer func() {
+		_ = destDB.Close()
+		if err != nil {
+			_ = os.Remove(destPath)
+		}
+	}()
+
+	srcConn, err := db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("백업 원본 데이터베이스 커넥션 획득 실패: %w", err)
+	}
+	defer srcConn.Close()
+
+	destConn, err := destDB.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("백업 대상 데이터베이스 커넥션 획득 실패: %w", err)
+	}
+	defer destConn.Close()
+
+	return srcConn.Raw(func(srcDriverConn any) error {
+		return destConn.Raw(func(destDriverConn any) error {
+			// 원본 DB는 추적 계측 래퍼로 열려 있으므로, 백업 API를 호출하려면 원래 드라이버 커넥션을 꺼내야 합니다.
+			src, ok := tracing.UnwrapDriverConn(srcDriverConn).(*sqlite3.SQLiteConn)
+			if !ok {
+				return fmt.Errorf("백업 원본이 SQLite 커넥션이 아닙니다: %T", srcDriverConn)
+			}
+			dest := destDriverConn.(*sqlite3.SQLiteConn)
+
+			return runBackup(ctx, dest, src)
+		})
+	})
+}
+
+// runBackup 백업 핸들을 생성하여 모든 페이지가 복사될 때까지 단계별로 복사를 진행합니다.
+func runBackup(ctx context.Context, dest, src *sqlite3.SQLiteConn) error {
+	backup, err := dest.Backup("main", src, "main")
+	if err != nil {
+		return fmt.Errorf("온라인 백업 시작 실패: %w", err)
+	}
+
+	for {
+		// 원본이 다른 커넥션에 의해 잠겨 있으면(SQLITE_BUSY/LOCKED) Step은 에러 없이 false를 반환하므로, 잠시 후 다시 시도합니다.
+		done, err := backup.Step(backupStepPages)
+		if err != nil {
+			_ = backup.Finish()
+			return fmt.Errorf("온라인 백업 페이지 복사 실패: %w", err)
+		}
+		if done {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			_ = backup.Finish()
+			return fmt.Errorf("온라인 백업 중단: %w", ctx.Err())
+		case <-time.After(backupStepInterval):
+		}
+	}
+
+	if err := backup.Finish(); err != nil {
+		return fmt.Errorf("온라인 백업 완료 처리 실패: %w", err)
+	}
+
+	return nil
+}
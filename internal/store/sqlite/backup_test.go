@@ -0,0 +1,61 @@
+package sqlite
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/darkkaiser/rss-feed-server/internal/config"
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("운영 중인 데이터베이스를 새 파일로 복사한다", func(t *testing.T) {
+		t.Parallel()
+
+		db, store := setupTestDB(t)
+		defer db.Close()
+
+		ctx := context.Background()
+		require.NoError(t, store.SyncProviders(ctx, []*config.ProviderConfig{{
+			ID:     "p1",
+			Config: &config.ProviderDetailConfig{Boards: []*config.BoardConfig{{ID: "b1", Name: "게시판"}}},
+		}}))
+		_, err := store.SaveArticles(ctx, "p1", []*feed.Article{{BoardID: "b1", ArticleID: "1", Title: "백업 대상", CreatedAt: time.Now()}})
+		require.NoError(t, err)
+
+		destPath := filepath.Join(t.TempDir(), "backup.db")
+		require.NoError(t, Backup(ctx, db, destPath))
+
+		backupDB, err := Open(ctx, destPath)
+		require.NoError(t, err)
+		defer backupDB.Close()
+
+		var title string
+		require.NoError(t, backupDB.QueryRow("SELECT title FROM rss_provider_article WHERE id = '1'").Scan(&title))
+		assert.Equal(t, "백업 대상", title)
+	})
+
+	t.Run("이미 존재하는 파일은 덮어쓰지 않는다", func(t *testing.T) {
+		t.Parallel()
+
+		db, _ := setupTestDB(t)
+		defer db.Close()
+
+		destPath := filepath.Join(t.TempDir(), "backup.db")
+		require.NoError(t, os.WriteFile(destPath, []byte("기존 파일"), 0o644))
+
+		err := Backup(context.Background(), db, destPath)
+		require.Error(t, err)
+
+		content, err := os.ReadFile(destPath)
+		require.NoError(t, err)
+		assert.Equal(t, "기존 파일", string(content))
+	})
+}
@@ -0,0 +1,34 @@
+package sqlite_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/darkkaiser/rss-feed-server/internal/store"
+	"github.com/darkkaiser/rss-feed-server/internal/store/sqlite"
+	"github.com/darkkaiser/rss-feed-server/internal/store/storetest"
+)
+
+// TestStore_Conformance SQLite 저장소가 저장소 공통 계약을 지키는지 검증합니다.
+func TestStore_Conformance(t *testing.T) {
+	t.Parallel()
+
+	storetest.Run(t, func(t *testing.T) store.Store {
+		// 시나리오마다 분리된 인메모리 DB를 사용합니다. (하위 테스트 이름의 '/'는 파일 URI에서 경로로 해석되므로 치환합니다.)
+		dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", strings.ReplaceAll(t.Name(), "/", "_"))
+
+		db, err := sqlite.Open(context.Background(), dsn)
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = db.Close() })
+
+		s, err := sqlite.New(db)
+		require.NoError(t, err)
+		require.NoError(t, s.Initialize(context.Background()))
+
+		return s
+	})
+}
@@ -5,13 +5,15 @@ import (
 	"database/sql"
 	"fmt"
 
+	"github.com/darkkaiser/rss-feed-server/internal/tracing"
 	_ "github.com/mattn/go-sqlite3"
+	semconv "go.opentelemetry.io/otel/semconv/v1.39.0"
 )
 
 // Open 주어진 DSN(Data Source Name)을 사용하여 SQLite 데이터베이스에 연결하고,
 // 네트워크 또는 파일 접근의 유효성을 검증한 후 초기화된 DB 핸들을 반환한다.
 func Open(ctx context.Context, dsn string) (*sql.DB, error) {
-	db, err := sql.Open("sqlite3", dsn)
+	db, err := tracing.OpenDB("sqlite3", dsn, semconv.DBSystemNameSQLite)
 	if err != nil {
 		return nil, fmt.Errorf("SQLite 연결 초기화 실패: %w", err)
 	}
@@ -0,0 +1,73 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore_SampleRecentArticles(t *testing.T) {
+	t.Parallel()
+	db, store := setupTestDB(t)
+	defer db.Close()
+
+	ctx := context.Background()
+	now := time.Now().Truncate(time.Second)
+	seedRevisionTestData(t, store, now.Add(-time.Hour))
+
+	// 본문이 비어 있는 게시글과 이미 삭제가 감지된 게시글은 점검 대상에서 제외되어야 합니다.
+	_, err := store.SaveArticles(ctx, "p_1", []*feed.Article{
+		{BoardID: "b_1", ArticleID: "empty", Title: "본문 없음", Link: "https://example.com/empty", CreatedAt: now.Add(-time.Hour)},
+		{BoardID: "b_1", ArticleID: "gone", Title: "삭제된 글", Content: "본문", Link: "https://example.com/gone", CreatedAt: now.Add(-time.Hour)},
+	})
+	require.NoError(t, err)
+	require.NoError(t, store.MarkArticleDeleted(ctx, "p_1", "b_1", "gone", now, feed.DeletedReasonRemoved))
+
+	articles, err := store.SampleRecentArticles(ctx, "p_1", now.AddDate(0, 0, -7), 10)
+	require.NoError(t, err)
+	require.Len(t, articles, 1)
+	assert.Equal(t, "recent", articles[0].ArticleID)
+	assert.Equal(t, "https://example.com/recent", articles[0].Link)
+
+	// limit 개수를 넘지 않아야 합니다.
+	articles, err = store.SampleRecentArticles(ctx, "p_1", now.AddDate(0, 0, -60), 1)
+	require.NoError(t, err)
+	assert.Len(t, articles, 1)
+}
+
+func TestStore_MarkArticleDeleted(t *testing.T) {
+	t.Parallel()
+	db, store := setupTestDB(t)
+	defer db.Close()
+
+	ctx := context.Background()
+	now := time.Now().Truncate(time.Second)
+	seedRevisionTestData(t, store, now.Add(-time.Hour))
+
+	require.NoError(t, store.MarkArticleDeleted(ctx, "p_1", "b_1", "recent", now, feed.DeletedReasonRemoved))
+
+	articles, err := store.GetArticles(ctx, "p_1", []string{"b_1"}, 10)
+	require.NoError(t, err)
+	require.Len(t, articles, 2)
+	assert.True(t, articles[0].DeletedAt.Equal(now), "삭제가 감지된 게시글은 DeletedAt이 기록되어야 합니다")
+	assert.False(t, articles[1].IsDeleted())
+
+	// 삭제가 감지된 게시글은 재검증 대상에서도 제외되어야 합니다.
+	recent, err := store.GetRecentArticles(ctx, "p_1", now.AddDate(0, 0, -7))
+	require.NoError(t, err)
+	assert.Empty(t, recent)
+}
+
+func TestStore_MarkArticleDeleted_NotFound(t *testing.T) {
+	t.Parallel()
+	db, store := setupTestDB(t)
+	defer db.Close()
+
+	err := store.MarkArticleDeleted(context.Background(), "p_1", "b_1", "missing", time.Now(), feed.DeletedReasonRemoved)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
@@ -0,0 +1,70 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+)
+
+// 컴파일 타임에 인터페이스 구현 여부를 검증합니다.
+var _ feed.DuplicateRepository = (*Store)(nil)
+
+// GetFingerprintedArticles since 이후에 작성되고 지문이 있는 게시글 중 excludeProviderID 이외의 공급자 게시글을 최신순으로 최대 limit개 반환합니다.
+func (s *Store) GetFingerprintedArticles(ctx context.Context, since time.Time, excludeProviderID string, limit uint) ([]*feed.SourcedArticle, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT a.p_id
+		     , a.b_id
+		     , b.name AS b_name
+		     , a.id
+		     , a.title
+		     , a.link
+		     , a.created_date
+		     , a.fingerprint
+		  FROM rss_provider_article a
+		       INNER JOIN rss_provider_board b ON ( a.p_id = b.p_id AND a.b_id = b.id )
+		 WHERE a.created_date >= ?
+		   AND a.p_id != ?
+		   AND a.fingerprint IS NOT NULL
+		   AND a.deleted_at IS NULL
+		 ORDER BY a.created_date DESC
+		 LIMIT ?
+	`, since.UTC().Format(time.RFC3339), excludeProviderID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("지문이 있는 게시글 조회(GetFingerprintedArticles) 쿼리 실행 실패: %w", err)
+	}
+	defer rows.Close()
+
+	var articles []*feed.SourcedArticle
+
+	for rows.Next() {
+		var (
+			providerID     string
+			article        feed.Article
+			rawCreatedDate sql.NullString
+			fingerprint    int64
+		)
+
+		if err := rows.Scan(&providerID, &article.BoardID, &article.BoardName, &article.ArticleID, &article.Title, &article.Link, &rawCreatedDate, &fingerprint); err != nil {
+			return nil, fmt.Errorf("지문이 있는 게시글 조회(GetFingerprintedArticles) 결과 행 스캔 실패: %w", err)
+		}
+		article.CreatedAt = parseDateTime(rawCreatedDate)
+		article.Fingerprint = uint64(fingerprint)
+
+		articles = append(articles, &feed.SourcedArticle{ProviderID: providerID, Article: &article})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("지문이 있는 게시글 조회(GetFingerprintedArticles) 결과 행 순회 중 오류 발생: %w", err)
+	}
+
+	return articles, nil
+}
+
+// nullFingerprint 지문을 저장용 값으로 변환합니다. 지문이 없으면(0) NULL로 저장하여 중복 판별 대상에서 제외합니다.
+// 64비트 부호 없는 지문은 SQLite INTEGER(부호 있는 64비트)에 같은 비트 패턴으로 저장됩니다.
+func nullFingerprint(fingerprint uint64) sql.NullInt64 {
+	return sql.NullInt64{Int64: int64(fingerprint), Valid: fingerprint != 0}
+}
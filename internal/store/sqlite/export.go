@@ -0,0 +1,102 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+)
+
+// 컴파일 타임에 인터페이스 구현 여부를 검증합니다.
+var _ feed.ExportRepository = (*Store)(nil)
+
+// ExportArticles 조건에 맞는 게시글을 공급자, 게시판, 작성일시 순으로 한 건씩 fn에 전달합니다.
+//
+// 전체 결과를 메모리에 적재하지 않고 커서를 따라 한 행씩 전달하므로, 게시글 수가 많아도 메모리 사용량이 일정합니다.
+func (s *Store) ExportArticles(ctx context.Context, filter feed.ArticleFilter, fn func(providerID string, article *feed.Article) error) error {
+	var (
+		conditions []string
+		args       []any
+	)
+	if len(filter.ProviderIDs) > 0 {
+		conditions = append(conditions, "a.p_id IN ("+inPlaceholders(len(filter.ProviderIDs))+")")
+		for _, id := range filter.ProviderIDs {
+			args = append(args, id)
+		}
+	}
+	if len(filter.BoardIDs) > 0 {
+		conditions = append(conditions, "a.b_id IN ("+inPlaceholders(len(filter.BoardIDs))+")")
+		for _, id := range filter.BoardIDs {
+			args = append(args, id)
+		}
+	}
+	if !filter.Since.IsZero() {
+		conditions = append(conditions, "a.created_date >= ?")
+		args = append(args, filter.Since.UTC().Format(time.RFC3339))
+	}
+	if !filter.Until.IsZero() {
+		conditions = append(conditions, "a.created_date < ?")
+		args = append(args, filter.Until.UTC().Format(time.RFC3339))
+	}
+
+	where := ""
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, "\n		   AND ")
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT a.p_id
+		     , a.b_id
+		     , IFNULL(b.name, "") AS b_name
+		     , a.id
+		     , a.title
+		     , IFNULL(a.content, "") AS content
+		     , a.link
+		     , IFNULL(a.author, "") AS author
+		     , a.created_date
+		     , a.updated_date
+		     , a.deleted_at
+		     , a.deleted_reason
+		  FROM rss_provider_article a
+		       LEFT OUTER JOIN rss_provider_board b ON ( a.p_id = b.p_id AND a.b_id = b.id )
+		`+where+`
+		 ORDER BY a.p_id, a.b_id, a.created_date, a.id
+	`, args...)
+	if err != nil {
+		return fmt.Errorf("게시글 내보내기(ExportArticles) 쿼리 실행 실패: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			providerID                                                     string
+			article                                                        feed.Article
+			rawCreatedDate, rawUpdatedDate, rawDeletedAt, rawDeletedReason sql.NullString
+		)
+		if err := rows.Scan(&providerID, &article.BoardID, &article.BoardName, &article.ArticleID, &article.Title, &article.Content, &article.Link, &article.Author, &rawCreatedDate, &rawUpdatedDate, &rawDeletedAt, &rawDeletedReason); err != nil {
+			return fmt.Errorf("게시글 내보내기(ExportArticles) 결과 행 스캔 실패: %w", err)
+		}
+		article.CreatedAt = parseDateTime(rawCreatedDate)
+		article.UpdatedAt = parseDateTime(rawUpdatedDate)
+		article.DeletedAt = parseDateTime(rawDeletedAt)
+		article.DeletedReason = deletedReason(article.DeletedAt, rawDeletedReason)
+
+		if err := fn(providerID, &article); err != nil {
+			return err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("게시글 내보내기(ExportArticles) 결과 행 순회 중 오류 발생: %w", err)
+	}
+
+	return nil
+}
+
+// inPlaceholders IN 절에 사용할 n개의 바인딩 자리표시자("?, ?, ...")를 만듭니다.
+func inPlaceholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
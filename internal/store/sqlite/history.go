@@ -0,0 +1,144 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+)
+
+// 컴파일 타임에 인터페이스 구현 여부를 검증합니다.
+var _ feed.HistoryRepository = (*Store)(nil)
+
+// ListArticles 지정한 providerID의 게시글 중 조건에 맞는 게시글을 (작성일시, 게시판 ID, 게시글 ID) 순서로 최대 query.Limit개 반환합니다.
+//
+// 커서 조건은 행 값(Row value) 비교로 작성하여 (p_id, created_date) 인덱스를 따라 커서 위치부터 바로 읽기 시작합니다.
+// 작성일시는 UTC RFC3339 문자열로 저장되어 있으므로 문자열 비교가 곧 시각 비교입니다.
+func (s *Store) ListArticles(ctx context.Context, providerID string, query feed.ArticlePageQuery) ([]*feed.Article, error) {
+	// 작성일시가 없는 게시글은 커서로 위치를 가리킬 수 없으므로 조회 대상에서 제외합니다.
+	conditions := []string{"a.p_id = ?", "a.created_date IS NOT NULL"}
+	args := []any{providerID}
+
+	if len(query.BoardIDs) > 0 {
+		conditions = append(conditions, "a.b_id IN ("+inPlaceholders(len(query.BoardIDs))+")")
+		for _, id := range query.BoardIDs {
+			args = append(args, id)
+		}
+	}
+	if !query.Since.IsZero() {
+		conditions = append(conditions, "a.created_date >= ?")
+		args = append(args, query.Since.UTC().Format(time.RFC3339))
+	}
+	if !query.Until.IsZero() {
+		conditions = append(conditions, "a.created_date < ?")
+		args = append(args, query.Until.UTC().Format(time.RFC3339))
+	}
+	if query.ExcludeDeleted {
+		conditions = append(conditions, "a.deleted_at IS NULL")
+	}
+
+	order, op := "DESC", "<"
+	if query.Ascending {
+		order, op = "ASC", ">"
+	}
+	if query.After != nil {
+		conditions = append(conditions, "(a.created_date, a.b_id, a.id) "+op+" (?, ?, ?)")
+		args = append(args, query.After.CreatedAt.UTC().Format(time.RFC3339), query.After.BoardID, query.After.ArticleID)
+	}
+	args = append(args, query.Limit)
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT a.b_id
+		     , IFNULL(b.name, "") AS b_name
+		     , a.id
+		     , a.title
+		     , IFNULL(a.content, "") AS content
+		     , a.link
+		     , IFNULL(a.author, "") AS author
+		     , a.created_date
+		     , a.updated_date
+		     , a.deleted_at
+		     , a.deleted_reason
+		     , a.fingerprint
+		  FROM rss_provider_article a
+		       LEFT OUTER JOIN rss_provider_board b ON ( a.p_id = b.p_id AND a.b_id = b.id )
+		 WHERE `+strings.Join(conditions, "\n		   AND ")+`
+		 ORDER BY a.created_date `+order+`, a.b_id `+order+`, a.id `+order+`
+		 LIMIT ?
+	`, args...)
+	if err != nil {
+		return nil, fmt.Errorf("게시글 이력 조회(ListArticles) 쿼리 실행 실패 (providerID: %s): %w", providerID, err)
+	}
+	defer rows.Close()
+
+	articles := make([]*feed.Article, 0, query.Limit)
+
+	for rows.Next() {
+		var (
+			article                                                        feed.Article
+			rawCreatedDate, rawUpdatedDate, rawDeletedAt, rawDeletedReason sql.NullString
+			rawFingerprint                                                 sql.NullInt64
+		)
+		if err := rows.Scan(&article.BoardID, &article.BoardName, &article.ArticleID, &article.Title, &article.Content, &article.Link, &article.Author, &rawCreatedDate, &rawUpdatedDate, &rawDeletedAt, &rawDeletedReason, &rawFingerprint); err != nil {
+			return nil, fmt.Errorf("게시글 이력 조회(ListArticles) 결과 행 스캔 실패: %w", err)
+		}
+		article.CreatedAt = parseDateTime(rawCreatedDate)
+		article.UpdatedAt = parseDateTime(rawUpdatedDate)
+		article.DeletedAt = parseDateTime(rawDeletedAt)
+		article.DeletedReason = deletedReason(article.DeletedAt, rawDeletedReason)
+		article.Fingerprint = uint64(rawFingerprint.Int64)
+
+		articles = append(articles, &article)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("게시글 이력 조회(ListArticles) 결과 행 순회 중 오류 발생: %w", err)
+	}
+
+	return articles, nil
+}
+
+// GetArticle 지정한 게시글 하나를 본문과 함께 반환합니다. 존재하지 않으면 nil, nil을 반환합니다.
+func (s *Store) GetArticle(ctx context.Context, providerID, boardID, articleID string) (*feed.Article, error) {
+	var (
+		article                                                        feed.Article
+		rawCreatedDate, rawUpdatedDate, rawDeletedAt, rawDeletedReason sql.NullString
+		rawFingerprint                                                 sql.NullInt64
+	)
+	err := s.db.QueryRowContext(ctx, `
+		SELECT a.b_id
+		     , IFNULL(b.name, "") AS b_name
+		     , a.id
+		     , a.title
+		     , IFNULL(a.content, "") AS content
+		     , a.link
+		     , IFNULL(a.author, "") AS author
+		     , a.created_date
+		     , a.updated_date
+		     , a.deleted_at
+		     , a.deleted_reason
+		     , a.fingerprint
+		  FROM rss_provider_article a
+		       LEFT OUTER JOIN rss_provider_board b ON ( a.p_id = b.p_id AND a.b_id = b.id )
+		 WHERE a.p_id = ?
+		   AND a.b_id = ?
+		   AND a.id = ?
+	`, providerID, boardID, articleID).Scan(&article.BoardID, &article.BoardName, &article.ArticleID, &article.Title, &article.Content, &article.Link, &article.Author, &rawCreatedDate, &rawUpdatedDate, &rawDeletedAt, &rawDeletedReason, &rawFingerprint)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("게시글 조회(GetArticle) 쿼리 실행 실패 (providerID: %s, boardID: %s, articleID: %s): %w", providerID, boardID, articleID, err)
+	}
+	article.CreatedAt = parseDateTime(rawCreatedDate)
+	article.UpdatedAt = parseDateTime(rawUpdatedDate)
+	article.DeletedAt = parseDateTime(rawDeletedAt)
+	article.DeletedReason = deletedReason(article.DeletedAt, rawDeletedReason)
+	article.Fingerprint = uint64(rawFingerprint.Int64)
+
+	return &article, nil
+}
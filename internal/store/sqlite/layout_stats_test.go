@@ -0,0 +1,50 @@
+package sqlite
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore_SaveAndGetLayoutStats(t *testing.T) {
+	t.Parallel()
+	db, store := setupTestDB(t)
+	defer db.Close()
+
+	ctx := context.Background()
+	seedRevisionTestData(t, store, time.Now())
+
+	base := time.Now().Truncate(time.Second)
+	for i := 0; i < 5; i++ {
+		require.NoError(t, store.SaveLayoutStats(ctx, "p_1", &feed.LayoutStats{
+			BoardID:          "b_1",
+			RowCount:         10 + i,
+			SelectorHitRatio: 1,
+			RecordedAt:       base.Add(time.Duration(i) * time.Minute),
+		}, 3))
+	}
+
+	// 다른 게시판의 기록은 정리 대상에 영향을 주지 않아야 합니다.
+	require.NoError(t, store.SaveLayoutStats(ctx, "p_1", &feed.LayoutStats{BoardID: "b_2", RowCount: 7, Drifted: true, Snapshot: "<html/>"}, 3))
+
+	stats, err := store.GetLayoutStats(ctx, "p_1", "b_1", 10)
+	require.NoError(t, err)
+	require.Len(t, stats, 3, "keep 개수를 초과한 오래된 기록은 삭제되어야 합니다")
+	assert.Equal(t, 14, stats[0].RowCount, "가장 최근 기록이 먼저 반환되어야 합니다")
+	assert.Equal(t, 12, stats[2].RowCount)
+	assert.True(t, stats[0].RecordedAt.Equal(base.Add(4*time.Minute)))
+
+	stats, err = store.GetLayoutStats(ctx, "p_1", "b_2", 10)
+	require.NoError(t, err)
+	require.Len(t, stats, 1)
+	assert.True(t, stats[0].Drifted)
+	assert.Empty(t, stats[0].Snapshot, "기준선 조회 시 스냅샷은 조회하지 않아야 합니다")
+
+	var snapshot string
+	require.NoError(t, db.QueryRowContext(ctx, "SELECT snapshot FROM rss_board_layout_stats WHERE p_id = ? AND b_id = ?", "p_1", "b_2").Scan(&snapshot))
+	assert.Equal(t, "<html/>", snapshot)
+}
@@ -0,0 +1,99 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+
+	"github.com/darkkaiser/rss-feed-server/internal/store/migrate"
+)
+
+// migrationFiles SQLite 스키마 마이그레이션 스크립트입니다. 새 스키마 변경은 다음 번호의 파일로 추가합니다.
+//
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+// dialect SQLite에 맞춘 마이그레이션 실행 규칙입니다.
+//
+// SQLite 데이터베이스 파일은 하나의 서버 프로세스만 사용하므로 별도의 인스턴스 간 잠금(Lock)은 두지 않습니다.
+var dialect = migrate.Dialect{
+	Placeholder: func(int) string { return "?" },
+	TableExists: func(ctx context.Context, tx *sql.Tx, table string) (bool, error) {
+		var count int
+		if err := tx.QueryRowContext(ctx, "SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = ?", table).Scan(&count); err != nil {
+			return false, err
+		}
+		return count > 0, nil
+	},
+}
+
+// migrate 내장된 마이그레이션 스크립트 중 아직 적용되지 않은 것을 순서대로 적용하여 스키마를 최신 버전으로 올립니다.
+//
+// 버전 관리 도입 이전에 만들어진 데이터베이스(schema_migrations 테이블 없이 rss_provider 테이블만 존재)는
+// 당시 배포 시점에 따라 일부 테이블이나 컬럼이 없을 수 있으므로, 누락된 항목을 채운 뒤 기준 버전(1)으로 기록합니다.
+func (s *Store) migrate(ctx context.Context) error {
+	migrations, err := migrate.Load(migrationFiles, "migrations")
+	if err != nil {
+		return err
+	}
+
+	baseline := &migrate.Baseline{
+		Version:     1,
+		MarkerTable: "rss_provider",
+		Prepare: func(ctx context.Context, tx *sql.Tx) error {
+			// 기준 스크립트는 'IF NOT EXISTS'로 작성되어 있어, 누락된 테이블과 인덱스만 생성됩니다.
+			if _, err := tx.ExecContext(ctx, migrations[0].SQL); err != nil {
+				return fmt.Errorf("기준 스키마 스크립트 실행 실패: %w", err)
+			}
+
+			// 'IF NOT EXISTS'로 처리할 수 없는, 기존 테이블에 나중에 추가된 컬럼은 별도로 점검합니다.
+			if err := addColumnIfNotExists(ctx, tx, "rss_provider_article", "updated_date", "DATETIME"); err != nil {
+				return err
+			}
+			return addColumnIfNotExists(ctx, tx, "rss_provider_article", "deleted_at", "DATETIME")
+		},
+	}
+
+	if _, err := migrate.New(s.db, dialect, migrations, baseline).Up(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// addColumnIfNotExists 지정한 테이블에 컬럼이 존재하지 않는 경우에만 'ALTER TABLE ... ADD COLUMN'으로 컬럼을 추가합니다.
+// SQLite는 'ADD COLUMN IF NOT EXISTS' 구문을 지원하지 않으므로, PRAGMA table_info로 기존 컬럼 목록을 먼저 확인합니다.
+func addColumnIfNotExists(ctx context.Context, tx *sql.Tx, table, column, definition string) error {
+	rows, err := tx.QueryContext(ctx, fmt.Sprintf("PRAGMA table_info(%s)", table))
+	if err != nil {
+		return fmt.Errorf("테이블(%s) 컬럼 정보 조회(PRAGMA table_info) 실패: %w", table, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			cid          int
+			name         string
+			columnType   string
+			notNull      int
+			defaultValue sql.NullString
+			primaryKey   int
+		)
+		if err := rows.Scan(&cid, &name, &columnType, &notNull, &defaultValue, &primaryKey); err != nil {
+			return fmt.Errorf("테이블(%s) 컬럼 정보 결과 행 스캔 실패: %w", table, err)
+		}
+		if name == column {
+			return nil
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("테이블(%s) 컬럼 정보 결과 행 순회 중 오류 발생: %w", table, err)
+	}
+
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", table, column, definition)); err != nil {
+		return fmt.Errorf("테이블(%s) 컬럼(%s) 추가(ALTER TABLE) 쿼리 실행 실패: %w", table, column, err)
+	}
+
+	return nil
+}
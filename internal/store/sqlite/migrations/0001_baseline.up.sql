@@ -0,0 +1,94 @@
+-- 버전 관리 도입 시점의 전체 스키마입니다.
+-- 버전 관리 이전 데이터베이스를 기준 버전으로 맞출 때에도 그대로 실행되므로, 이 파일만 'IF NOT EXISTS'로 작성합니다.
+-- 이후의 스키마 변경은 이 파일을 고치지 말고 다음 번호(0002_설명.up.sql)의 새 파일로 추가해야 합니다.
+
+CREATE TABLE IF NOT EXISTS rss_provider (
+    id            VARCHAR( 50) PRIMARY KEY NOT NULL UNIQUE,
+    site          VARCHAR( 50) NOT NULL,
+    s_id          VARCHAR( 50) NOT NULL,
+    s_name        VARCHAR(130) NOT NULL,
+    s_description VARCHAR(200),
+    s_url         VARCHAR(100) NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS rss_provider_index01 ON rss_provider(s_id);
+
+CREATE TABLE IF NOT EXISTS rss_provider_board (
+    p_id VARCHAR( 50) NOT NULL,
+    id   VARCHAR( 50) NOT NULL,
+    name VARCHAR(130) NOT NULL,
+    PRIMARY KEY (p_id, id),
+    FOREIGN KEY (p_id) REFERENCES rss_provider(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS rss_provider_article (
+    p_id         VARCHAR( 50) NOT NULL,
+    b_id         VARCHAR( 50) NOT NULL,
+    id           VARCHAR( 50) NOT NULL,
+    title        VARCHAR(400) NOT NULL,
+    content      TEXT,
+    link         VARCHAR(1000) NOT NULL,
+    author       VARCHAR(50),
+    created_date DATETIME,
+    updated_date DATETIME,
+    deleted_at   DATETIME,
+    PRIMARY KEY (p_id, b_id, id),
+    FOREIGN KEY (p_id, b_id) REFERENCES rss_provider_board(p_id, id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS rss_provider_article_index01 ON rss_provider_article(p_id, created_date DESC);
+
+CREATE INDEX IF NOT EXISTS rss_provider_article_index02 ON rss_provider_article(p_id, b_id, created_date DESC);
+
+CREATE TABLE IF NOT EXISTS rss_provider_site_crawled_data (
+    p_id                      VARCHAR( 50) NOT NULL,
+    b_id                      VARCHAR( 50) NOT NULL,
+    latest_crawled_article_id VARCHAR( 50) NOT NULL,
+    PRIMARY KEY (p_id, b_id),
+    FOREIGN KEY (p_id) REFERENCES rss_provider(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS rss_article_revision (
+    p_id          VARCHAR( 50) NOT NULL,
+    b_id          VARCHAR( 50) NOT NULL,
+    a_id          VARCHAR( 50) NOT NULL,
+    revision_no   INTEGER      NOT NULL,
+    content_hash  VARCHAR( 64) NOT NULL,
+    content       TEXT,
+    revised_date  DATETIME     NOT NULL,
+    PRIMARY KEY (p_id, b_id, a_id, revision_no),
+    FOREIGN KEY (p_id, b_id, a_id) REFERENCES rss_provider_article(p_id, b_id, id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS rss_board_layout_stats (
+    seq                      INTEGER      PRIMARY KEY AUTOINCREMENT,
+    p_id                     VARCHAR( 50) NOT NULL,
+    b_id                     VARCHAR( 50) NOT NULL,
+    row_count                INTEGER      NOT NULL,
+    empty_field_ratio        REAL         NOT NULL,
+    date_parse_failure_ratio REAL         NOT NULL,
+    selector_hit_ratio       REAL         NOT NULL,
+    drifted                  INTEGER      NOT NULL DEFAULT 0,
+    snapshot                 TEXT,
+    recorded_date            DATETIME     NOT NULL,
+    FOREIGN KEY (p_id) REFERENCES rss_provider(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS rss_board_layout_stats_index01 ON rss_board_layout_stats(p_id, b_id, seq DESC);
+
+CREATE TABLE IF NOT EXISTS rss_parse_snapshot (
+    id            INTEGER      PRIMARY KEY AUTOINCREMENT,
+    p_id          VARCHAR( 50) NOT NULL,
+    b_id          VARCHAR( 50) NOT NULL,
+    page          INTEGER      NOT NULL,
+    url           TEXT         NOT NULL,
+    header        TEXT,
+    content_type  VARCHAR(100) NOT NULL,
+    body          BLOB         NOT NULL,
+    truncated     INTEGER      NOT NULL DEFAULT 0,
+    error         TEXT         NOT NULL,
+    created_date  DATETIME     NOT NULL,
+    FOREIGN KEY (p_id) REFERENCES rss_provider(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS rss_parse_snapshot_index01 ON rss_parse_snapshot(created_date);
@@ -0,0 +1,6 @@
+-- 근사 중복 게시글을 묶기 위한 지문(SimHash)입니다. 64비트 부호 없는 정수를 같은 비트 패턴의 부호 있는 정수로 저장합니다.
+-- 이 버전 이전에 저장된 게시글은 지문이 없으며(NULL), 다시 저장되기 전까지 중복 판별에서 제외됩니다.
+ALTER TABLE rss_provider_article ADD COLUMN fingerprint INTEGER;
+
+-- 여러 공급자에 걸쳐 최근 게시글의 지문을 조회할 때 사용합니다.
+CREATE INDEX rss_provider_article_index03 ON rss_provider_article(created_date DESC);
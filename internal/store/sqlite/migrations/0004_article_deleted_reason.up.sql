@@ -0,0 +1,3 @@
+-- 원문 삭제 감지(CheckDeleted)가 게시글을 삭제된 것으로 판정한 근거입니다. ('removed': 원문 삭제, 'access_denied': 접근 제한)
+-- 이 버전 이전에 삭제가 감지된 게시글은 근거가 없으며(NULL), 원문 삭제('removed')로 취급합니다.
+ALTER TABLE rss_provider_article ADD COLUMN deleted_reason VARCHAR(20);
@@ -0,0 +1,91 @@
+package sqlite
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore_ParseSnapshot_SaveListGet(t *testing.T) {
+	t.Parallel()
+	db, store := setupTestDB(t)
+	defer db.Close()
+
+	ctx := context.Background()
+	seedRevisionTestData(t, store, time.Now())
+
+	id, err := store.SaveParseSnapshot(ctx, &feed.ParseSnapshot{
+		ProviderID:  "p_1",
+		BoardID:     "b_1",
+		Page:        2,
+		URL:         "https://example.com/list?page=2",
+		Header:      map[string][]string{"Content-Type": {"application/x-www-form-urlencoded"}},
+		ContentType: "text/html; charset=utf-8",
+		Body:        []byte("<html>깨진 목록</html>"),
+		Truncated:   true,
+		Error:       "작성일 파싱 실패",
+	}, 10, time.Hour)
+	require.NoError(t, err)
+	assert.Positive(t, id)
+
+	// 1. 목록 조회: 본문은 포함되지 않아야 합니다.
+	list, err := store.ListParseSnapshots(ctx, "", 10)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, id, list[0].ID)
+	assert.Equal(t, "b_1", list[0].BoardID)
+	assert.Equal(t, 2, list[0].Page)
+	assert.Equal(t, "application/x-www-form-urlencoded", list[0].Header["Content-Type"][0])
+	assert.True(t, list[0].Truncated)
+	assert.False(t, list[0].CreatedAt.IsZero())
+	assert.Nil(t, list[0].Body)
+
+	list, err = store.ListParseSnapshots(ctx, "p_other", 10)
+	require.NoError(t, err)
+	assert.Empty(t, list, "공급자 필터가 적용되어야 합니다")
+
+	// 2. 단건 조회: 본문이 바이트 단위로 보존되어야 합니다.
+	snapshot, err := store.GetParseSnapshot(ctx, id)
+	require.NoError(t, err)
+	require.NotNil(t, snapshot)
+	assert.Equal(t, "<html>깨진 목록</html>", string(snapshot.Body))
+	assert.Equal(t, "작성일 파싱 실패", snapshot.Error)
+
+	// 3. 존재하지 않는 ID는 nil, nil을 반환해야 합니다.
+	snapshot, err = store.GetParseSnapshot(ctx, id+100)
+	require.NoError(t, err)
+	assert.Nil(t, snapshot)
+}
+
+func TestStore_ParseSnapshot_Bounded(t *testing.T) {
+	t.Parallel()
+	db, store := setupTestDB(t)
+	defer db.Close()
+
+	ctx := context.Background()
+	seedRevisionTestData(t, store, time.Now())
+
+	newSnapshot := func(createdAt time.Time) *feed.ParseSnapshot {
+		return &feed.ParseSnapshot{ProviderID: "p_1", BoardID: "b_1", URL: "https://example.com", ContentType: "text/html", Body: []byte("<html/>"), Error: "err", CreatedAt: createdAt}
+	}
+
+	// 보관 기한이 지난 스냅샷은 다음 기록 시 정리되어야 합니다.
+	_, err := store.SaveParseSnapshot(ctx, newSnapshot(time.Now().Add(-48*time.Hour)), 0, 0)
+	require.NoError(t, err)
+
+	for i := 0; i < 4; i++ {
+		_, err := store.SaveParseSnapshot(ctx, newSnapshot(time.Now()), 3, 24*time.Hour)
+		require.NoError(t, err)
+	}
+
+	list, err := store.ListParseSnapshots(ctx, "p_1", 10)
+	require.NoError(t, err)
+	require.Len(t, list, 3, "보관 개수를 초과한 오래된 스냅샷은 삭제되어야 합니다")
+	for _, s := range list {
+		assert.WithinDuration(t, time.Now(), s.CreatedAt, time.Minute)
+	}
+}
@@ -0,0 +1,151 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+)
+
+// 컴파일 타임에 인터페이스 구현 여부를 검증합니다.
+var _ feed.RevisionRepository = (*Store)(nil)
+
+// GetRecentArticles 지정한 공급자(providerID)에서 since 이후에 작성된 게시글을 본문과 함께 최신순으로 반환합니다.
+// 재검증(Revalidation) 작업이 원문과 비교할 기준 본문을 가져오기 위해 사용하며, 게시판 필터와 개수 제한은 적용하지 않습니다.
+// 원문에서 이미 삭제가 감지된 게시글은 다시 수집할 수 없으므로 대상에서 제외합니다.
+func (s *Store) GetRecentArticles(ctx context.Context, providerID string, since time.Time) ([]*feed.Article, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT a.b_id
+		     , b.name AS b_name
+		     , a.id
+		     , a.title
+		     , IFNULL(a.content, "") AS content
+		     , a.link
+		     , IFNULL(a.author, "") AS author
+		     , a.created_date
+		     , a.updated_date
+		  FROM rss_provider_article a
+		       INNER JOIN rss_provider_board b ON ( a.p_id = b.p_id AND a.b_id = b.id )
+		 WHERE a.p_id = ?
+		   AND a.created_date >= ?
+		   AND a.deleted_at IS NULL
+		 ORDER BY a.created_date DESC
+	`, providerID, since.UTC().Format(time.RFC3339))
+	if err != nil {
+		return nil, fmt.Errorf("최근 게시글 목록 조회(GetRecentArticles) 쿼리 실행 실패 (providerID: %s): %w", providerID, err)
+	}
+	defer rows.Close()
+
+	articles := make([]*feed.Article, 0)
+
+	for rows.Next() {
+		var article feed.Article
+		var rawCreatedDate, rawUpdatedDate sql.NullString
+
+		if err := rows.Scan(&article.BoardID, &article.BoardName, &article.ArticleID, &article.Title, &article.Content, &article.Link, &article.Author, &rawCreatedDate, &rawUpdatedDate); err != nil {
+			return nil, fmt.Errorf("최근 게시글 목록 조회(GetRecentArticles) 결과 행 스캔 실패: %w", err)
+		}
+		article.CreatedAt = parseDateTime(rawCreatedDate)
+		article.UpdatedAt = parseDateTime(rawUpdatedDate)
+
+		articles = append(articles, &article)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("최근 게시글 목록 조회(GetRecentArticles) 결과 행 순회 중 오류 발생: %w", err)
+	}
+
+	return articles, nil
+}
+
+// SaveArticleRevision 원문 수정이 감지된 게시글의 새 본문을 rss_article_revision 테이블에 기록하고,
+// 게시글 레코드의 본문(content)과 수정일시(updated_date)를 새 값으로 갱신합니다.
+//
+// 수정 이력이 처음 기록되는 게시글이라면, 최초 수집 당시의 본문을 1번 리비전(작성일시 기준)으로 먼저 보존한 뒤
+// 새 본문을 다음 리비전으로 추가합니다. 이를 통해 수정 전후의 본문을 모두 추적할 수 있습니다.
+//
+// 모든 작업은 하나의 트랜잭션으로 처리되므로, 이력 기록과 게시글 갱신 중 하나만 반영되는 일은 없습니다.
+func (s *Store) SaveArticleRevision(ctx context.Context, providerID string, article *feed.Article) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("게시글 수정 이력 저장(SaveArticleRevision) 트랜잭션 시작(BeginTx) 실패: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	// [단계 1] 현재 저장된 본문과 마지막 리비전 번호를 조회합니다.
+	var (
+		storedContent  sql.NullString
+		rawCreatedDate sql.NullString
+		lastRevisionNo int
+	)
+	err = tx.QueryRowContext(ctx, `
+		SELECT a.content
+		     , a.created_date
+		     , IFNULL(( SELECT MAX(r.revision_no)
+		                  FROM rss_article_revision r
+		                 WHERE r.p_id = a.p_id
+		                   AND r.b_id = a.b_id
+		                   AND r.a_id = a.id ), 0)
+		  FROM rss_provider_article a
+		 WHERE a.p_id = ?
+		   AND a.b_id = ?
+		   AND a.id = ?
+	`, providerID, article.BoardID, article.ArticleID).Scan(&storedContent, &rawCreatedDate, &lastRevisionNo)
+	if err != nil {
+		return fmt.Errorf("수정 대상 게시글 조회(Select) 쿼리 실행 실패 (providerID: %s, boardID: %s, articleID: %s): %w", providerID, article.BoardID, article.ArticleID, err)
+	}
+
+	insertQuery := `
+		INSERT INTO
+			rss_article_revision (p_id, b_id, a_id, revision_no, content_hash, content, revised_date)
+		VALUES
+			(?, ?, ?, ?, ?, ?, ?)
+	`
+
+	// [단계 2] 첫 수정이라면 최초 수집 본문을 1번 리비전으로 보존합니다.
+	if lastRevisionNo == 0 {
+		revisedDate := rawCreatedDate.String
+		if !rawCreatedDate.Valid {
+			revisedDate = time.Now().UTC().Format(time.RFC3339)
+		}
+
+		if _, err := tx.ExecContext(ctx, insertQuery, providerID, article.BoardID, article.ArticleID, 1, feed.ContentHash(storedContent.String), storedContent.String, revisedDate); err != nil {
+			return fmt.Errorf("최초 본문 리비전 기록(Insert) 쿼리 실행 실패 (providerID: %s, articleID: %s): %w", providerID, article.ArticleID, err)
+		}
+		lastRevisionNo = 1
+	}
+
+	updatedAt := article.UpdatedAt
+	if updatedAt.IsZero() {
+		updatedAt = time.Now()
+	}
+	updatedDate := updatedAt.UTC().Format(time.RFC3339)
+
+	// [단계 3] 새 본문을 다음 리비전으로 기록합니다.
+	if _, err := tx.ExecContext(ctx, insertQuery, providerID, article.BoardID, article.ArticleID, lastRevisionNo+1, feed.ContentHash(article.Content), article.Content, updatedDate); err != nil {
+		return fmt.Errorf("수정 본문 리비전 기록(Insert) 쿼리 실행 실패 (providerID: %s, articleID: %s): %w", providerID, article.ArticleID, err)
+	}
+
+	// [단계 4] 게시글 레코드의 본문과 수정일시를 갱신합니다.
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE rss_provider_article
+		   SET content      = ?
+		     , updated_date = ?
+		     , fingerprint  = ?
+		 WHERE p_id = ?
+		   AND b_id = ?
+		   AND id = ?
+	`, article.Content, updatedDate, nullFingerprint(feed.Fingerprint(article.Title, article.Content)), providerID, article.BoardID, article.ArticleID); err != nil {
+		return fmt.Errorf("수정된 게시글 본문 갱신(Update) 쿼리 실행 실패 (providerID: %s, articleID: %s): %w", providerID, article.ArticleID, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("게시글 수정 이력 저장(SaveArticleRevision) 트랜잭션 Commit 실패: %w", err)
+	}
+
+	return nil
+}
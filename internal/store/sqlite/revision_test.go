@@ -0,0 +1,108 @@
+package sqlite
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/darkkaiser/rss-feed-server/internal/config"
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// seedRevisionTestData는 수정 이력 테스트에 필요한 공급자/게시판/게시글 데이터를 준비합니다.
+func seedRevisionTestData(t *testing.T, store *Store, createdAt time.Time) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	err := store.SyncProviders(ctx, []*config.ProviderConfig{
+		{
+			ID:   "p_1",
+			Site: "YeosuCityHall",
+			Config: &config.ProviderDetailConfig{
+				ID:     "s_1",
+				Name:   "Site 1",
+				URL:    "https://example.com",
+				Boards: []*config.BoardConfig{{ID: "b_1", Name: "Board 1"}},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = store.SaveArticles(ctx, "p_1", []*feed.Article{
+		{BoardID: "b_1", ArticleID: "recent", Title: "최근 글", Content: "원본 본문", Link: "https://example.com/recent", CreatedAt: createdAt},
+		{BoardID: "b_1", ArticleID: "old", Title: "오래된 글", Content: "오래된 본문", Link: "https://example.com/old", CreatedAt: createdAt.AddDate(0, 0, -30)},
+	})
+	require.NoError(t, err)
+}
+
+func TestStore_GetRecentArticles(t *testing.T) {
+	t.Parallel()
+	db, store := setupTestDB(t)
+	defer db.Close()
+
+	now := time.Now().Truncate(time.Second)
+	seedRevisionTestData(t, store, now.Add(-time.Hour))
+
+	articles, err := store.GetRecentArticles(context.Background(), "p_1", now.AddDate(0, 0, -7))
+	require.NoError(t, err)
+	require.Len(t, articles, 1, "since 이전에 작성된 게시글은 제외되어야 합니다")
+
+	assert.Equal(t, "recent", articles[0].ArticleID)
+	assert.Equal(t, "Board 1", articles[0].BoardName)
+	assert.Equal(t, "원본 본문", articles[0].Content)
+	assert.True(t, articles[0].UpdatedAt.IsZero(), "수정 이력이 없는 게시글의 UpdatedAt은 zero value여야 합니다")
+}
+
+func TestStore_SaveArticleRevision(t *testing.T) {
+	t.Parallel()
+	db, store := setupTestDB(t)
+	defer db.Close()
+
+	ctx := context.Background()
+	now := time.Now().Truncate(time.Second)
+	seedRevisionTestData(t, store, now.Add(-time.Hour))
+
+	// 1차 수정: 최초 본문(1번)과 수정 본문(2번) 두 개의 리비전이 기록되어야 합니다.
+	firstEdit := &feed.Article{BoardID: "b_1", ArticleID: "recent", Content: "수정된 본문", UpdatedAt: now}
+	require.NoError(t, store.SaveArticleRevision(ctx, "p_1", firstEdit))
+
+	// 2차 수정: 리비전 번호가 이어서 증가해야 합니다.
+	secondEdit := &feed.Article{BoardID: "b_1", ArticleID: "recent", Content: "두 번째 수정 본문", UpdatedAt: now.Add(time.Minute)}
+	require.NoError(t, store.SaveArticleRevision(ctx, "p_1", secondEdit))
+
+	rows, err := db.QueryContext(ctx, "SELECT revision_no, content_hash, content FROM rss_article_revision WHERE p_id = ? AND a_id = ? ORDER BY revision_no", "p_1", "recent")
+	require.NoError(t, err)
+	defer rows.Close()
+
+	var contents []string
+	for rows.Next() {
+		var no int
+		var hash, content string
+		require.NoError(t, rows.Scan(&no, &hash, &content))
+		assert.Equal(t, len(contents)+1, no)
+		assert.Equal(t, feed.ContentHash(content), hash)
+		contents = append(contents, content)
+	}
+	require.NoError(t, rows.Err())
+	assert.Equal(t, []string{"원본 본문", "수정된 본문", "두 번째 수정 본문"}, contents)
+
+	// 게시글 레코드의 본문과 수정일시가 마지막 수정 내용으로 갱신되어야 합니다.
+	articles, err := store.GetArticles(ctx, "p_1", []string{"b_1"}, 10)
+	require.NoError(t, err)
+	require.Len(t, articles, 2)
+	assert.Equal(t, "두 번째 수정 본문", articles[0].Content)
+	assert.True(t, articles[0].UpdatedAt.Equal(now.Add(time.Minute)))
+	assert.True(t, articles[1].UpdatedAt.IsZero())
+}
+
+func TestStore_SaveArticleRevision_NotFound(t *testing.T) {
+	t.Parallel()
+	db, store := setupTestDB(t)
+	defer db.Close()
+
+	err := store.SaveArticleRevision(context.Background(), "p_1", &feed.Article{BoardID: "b_1", ArticleID: "missing", Content: "본문"})
+	assert.Error(t, err, "존재하지 않는 게시글의 수정 이력은 기록할 수 없어야 합니다")
+}
@@ -0,0 +1,56 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+)
+
+// 컴파일 타임에 인터페이스 구현 여부를 검증합니다.
+var _ feed.StatsRepository = (*Store)(nil)
+
+// CountArticlesByDay 지정한 기간에 작성된 게시글 수를 loc 시간대의 날짜와 게시판별로 집계합니다.
+//
+// 작성일시는 UTC RFC3339 문자열("2006-01-02T15:04:05Z")로 저장되므로, 앞 13자리("2006-01-02T15")로 묶으면 UTC 기준 시 단위 집계가 됩니다.
+func (s *Store) CountArticlesByDay(ctx context.Context, providerID string, since, until time.Time, loc *time.Location) ([]*feed.DailyArticleCount, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT b_id
+		     , substr(created_date, 1, 13) AS hour
+		     , COUNT(*)
+		  FROM rss_provider_article
+		 WHERE p_id = ?
+		   AND created_date >= ?
+		   AND created_date < ?
+		 GROUP BY b_id, hour
+	`, providerID, since.UTC().Format(time.RFC3339), until.UTC().Format(time.RFC3339))
+	if err != nil {
+		return nil, fmt.Errorf("게시글 수 집계(CountArticlesByDay) 쿼리 실행 실패 (providerID: %s): %w", providerID, err)
+	}
+	defer rows.Close()
+
+	counter := feed.NewDailyArticleCounter(loc)
+
+	for rows.Next() {
+		var (
+			boardID, rawHour string
+			count            int64
+		)
+		if err := rows.Scan(&boardID, &rawHour, &count); err != nil {
+			return nil, fmt.Errorf("게시글 수 집계(CountArticlesByDay) 결과 행 스캔 실패: %w", err)
+		}
+
+		hour, err := time.ParseInLocation("2006-01-02T15", rawHour, time.UTC)
+		if err != nil {
+			return nil, fmt.Errorf("게시글 수 집계(CountArticlesByDay) 작성일시(%s) 해석 실패: %w", rawHour, err)
+		}
+		counter.Add(boardID, hour, count)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("게시글 수 집계(CountArticlesByDay) 결과 행 순회 중 오류 발생: %w", err)
+	}
+
+	return counter.Result(), nil
+}
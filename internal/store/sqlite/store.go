@@ -44,13 +44,13 @@ func New(db *sql.DB) (*Store, error) {
 
 // Initialize 어플리케이션 시작 시 데이터베이스가 정상적으로 동작하기 위한 필수 준비 작업을 수행합니다.
 // 크게 두 가지 작업을 처리합니다:
-//  1. autoMigrate: 시스템 구동에 필요한 테이블과 인덱스를 확인하고, 누락된 구성요소를 자동으로 생성하여 스키마를 최신 상태로 구성합니다.
+//  1. migrate: 바이너리에 내장된 번호별 마이그레이션 중 아직 적용되지 않은 것을 순서대로 적용하여 스키마를 최신 버전으로 올립니다.
 //  2. vacuum: 보관 기간이 만료되어 삭제된 게시글들이 남긴 빈 공간(Free block)을 회수하고 데이터베이스 파편화를 최적화합니다.
 //
 // 주의: 이 프로세스는 무거운 I/O를 동반할 수 있으므로, 통상적으로 어플리케이션(Store) 초기화 단계에서 1회 호출하는 것을 권장합니다.
 func (s *Store) Initialize(ctx context.Context) error {
-	// 단계 1: 데이터베이스 스키마 마이그레이션 (테이블/인덱스 점검 및 생성)
-	if err := s.autoMigrate(ctx); err != nil {
+	// 단계 1: 데이터베이스 스키마 마이그레이션 (버전 확인 및 미적용 마이그레이션 실행)
+	if err := s.migrate(ctx); err != nil {
 		return fmt.Errorf("데이터베이스 스키마 초기화 및 마이그레이션 실패: %w", err)
 	}
 
@@ -62,78 +62,6 @@ func (s *Store) Initialize(ctx context.Context) error {
 	return nil
 }
 
-// autoMigrate 시스템 구동에 필요한 데이터베이스 테이블 및 인덱스의 존재 여부를 확인하고, 누락된 항목을 일괄 생성합니다.
-// 내부적으로 공급자(Provider), 게시판(Board), 게시글(Article), 크롤링 메타데이터를 위한
-// DDL 스크립트를 단일 트랜잭션 단위로 실행하며, 'IF NOT EXISTS' 조건 덕분에 이미 존재하는
-// 테이블/인덱스는 건드리지 않아 멱등성(Idempotence)이 보장됩니다.
-//
-// 주의: DDL 실행 중 오류가 발생하면 불완전한 스키마 상태를 남기지 않도록 즉시 롤백(Rollback)합니다.
-func (s *Store) autoMigrate(ctx context.Context) error {
-	tx, err := s.db.BeginTx(ctx, nil)
-	if err != nil {
-		return fmt.Errorf("데이터베이스 스키마 마이그레이션 트랜잭션 시작 실패: %w", err)
-	}
-	defer func() {
-		_ = tx.Rollback()
-	}()
-
-	query := `
-		CREATE TABLE IF NOT EXISTS rss_provider (
-			id            VARCHAR( 50) PRIMARY KEY NOT NULL UNIQUE,
-			site          VARCHAR( 50) NOT NULL,
-			s_id          VARCHAR( 50) NOT NULL,
-			s_name        VARCHAR(130) NOT NULL,
-			s_description VARCHAR(200),
-			s_url         VARCHAR(100) NOT NULL
-		);
-
-		CREATE INDEX IF NOT EXISTS rss_provider_index01 ON rss_provider(s_id);
-
-		CREATE TABLE IF NOT EXISTS rss_provider_board (
-			p_id VARCHAR( 50) NOT NULL,
-			id   VARCHAR( 50) NOT NULL,
-			name VARCHAR(130) NOT NULL,
-			PRIMARY KEY (p_id, id),
-			FOREIGN KEY (p_id) REFERENCES rss_provider(id) ON DELETE CASCADE
-		);
-
-		CREATE TABLE IF NOT EXISTS rss_provider_article (
-			p_id         VARCHAR( 50) NOT NULL,
-			b_id         VARCHAR( 50) NOT NULL,
-			id           VARCHAR( 50) NOT NULL,
-			title        VARCHAR(400) NOT NULL,
-			content      TEXT,
-			link         VARCHAR(1000) NOT NULL,
-			author       VARCHAR(50),
-			created_date DATETIME,
-			PRIMARY KEY (p_id, b_id, id),
-			FOREIGN KEY (p_id, b_id) REFERENCES rss_provider_board(p_id, id) ON DELETE CASCADE
-		);
-
-		CREATE INDEX IF NOT EXISTS rss_provider_article_index01 ON rss_provider_article(p_id, created_date DESC);
-
-		CREATE INDEX IF NOT EXISTS rss_provider_article_index02 ON rss_provider_article(p_id, b_id, created_date DESC);
-
-		CREATE TABLE IF NOT EXISTS rss_provider_site_crawled_data (
-			p_id                      VARCHAR( 50) NOT NULL,
-			b_id                      VARCHAR( 50) NOT NULL,
-			latest_crawled_article_id VARCHAR( 50) NOT NULL,
-			PRIMARY KEY (p_id, b_id),
-			FOREIGN KEY (p_id) REFERENCES rss_provider(id) ON DELETE CASCADE
-		);
-	`
-
-	if _, err := tx.ExecContext(ctx, query); err != nil {
-		return fmt.Errorf("데이터베이스 스키마 마이그레이션 쿼리(DDL) 실행 실패: %w", err)
-	}
-
-	if err := tx.Commit(); err != nil {
-		return fmt.Errorf("데이터베이스 스키마 마이그레이션 트랜잭션 커밋 실패: %w", err)
-	}
-
-	return nil
-}
-
 // vacuum SQLite 고유의 최적화 명령어인 'VACUUM'을 실행합니다.
 // DELETE 작업 등으로 비워진 레코드 공간을 실제로 모아 파일 크기를 줄여주며,
 // 내부 B-Tree 구조의 단편화(Fragmentation)를 재정렬해 쿼리 성능을 일정하게 유지합니다.
@@ -335,8 +263,13 @@ func (s *Store) pruneStaleData(ctx context.Context, tx *sql.Tx, providers []*con
 	return nil
 }
 
-// PurgeOldArticles 환경 설정(config.ProviderConfig)에 정의된 보관 기한(ArchiveDays)을 기준으로,
-// 유효 기간이 만료된 과거 크롤링 게시글 레코드들을 데이터베이스에서 일괄 삭제(Purge)합니다.
+// PurgeOldArticles 환경 설정(config.ProviderConfig)에 정의된 보관 정책을 기준으로, 보관 기간이 지났거나
+// 보관 개수를 초과한 과거 크롤링 게시글 레코드들을 데이터베이스에서 일괄 삭제(Purge)하고, 게시판별 삭제 내역을 반환합니다.
+//
+// 보관 정책은 게시판 단위로 적용됩니다.
+//   - 보관 기간: 게시판의 archive_days가 있으면 그 값을, 없으면 공급자의 archive_days를 사용합니다.
+//   - 보관 개수: 게시판의 max_rows를 초과하면 작성일시가 오래된 게시글부터 삭제합니다.
+//   - 설정에서 제거된 게시판에 남아 있는 게시글에는 공급자의 archive_days만 적용하며, 내역의 BoardID는 빈 문자열입니다.
 //
 // 주로 데이터베이스 테이블 용량의 무한 증식을 막기 위한 주기적 생명주기 관리(Lifecycle Management) 용도로 호출됩니다.
 // 하나의 거대한 트랜잭션으로 전체 데이터를 한 번에 삭제할 경우 발생하는 테이블 잠금(Lock)의 장기화 및
@@ -346,69 +279,160 @@ func (s *Store) pruneStaleData(ctx context.Context, tx *sql.Tx, providers []*con
 //  2. 단기 트랜잭션(Short-lived Tx): 익명 함수를 활용하여 각 공급자 단위마다 전용 트랜잭션을 빠르게 맺고 종료합니다.
 //  3. 부분 실패 허용(Fault Tolerant): 특정 공급자의 삭제 쿼리가 실패하더라도 전체 정지(Panic/Return) 없이
 //     나머지 작업을 마저 수행하며, 수집된 모든 에러 궤적은 `errors.Join`으로 묶어 최종 보고합니다.
-func (s *Store) PurgeOldArticles(ctx context.Context, providers []*config.ProviderConfig) error {
-	var errs []error
+//
+// 반환되는 내역에는 실제로 삭제된 게시글이 있는 게시판만 포함되며, 삭제가 실패한 공급자의 내역은 포함되지 않습니다.
+func (s *Store) PurgeOldArticles(ctx context.Context, providers []*config.ProviderConfig) ([]*feed.PurgeResult, error) {
+	var (
+		results []*feed.PurgeResult
+		errs    []error
+	)
 
 	for _, p := range providers {
 		if err := ctx.Err(); err != nil {
-			return fmt.Errorf("보관 기한 초과 레코드 일괄 삭제 작업 중 실행 컨텍스트가 취소되었습니다: %w", err)
+			return results, fmt.Errorf("보관 기한 초과 레코드 일괄 삭제 작업 중 실행 컨텍스트가 취소되었습니다: %w", err)
 		}
 
 		// DB 잠금(Lock) 시간을 최소화하기 위해, 공급자별로 독립된 트랜잭션을 만들어 삭제합니다.
-		err := func() error {
+		providerResults, err := func() ([]*feed.PurgeResult, error) {
 			tx, err := s.db.BeginTx(ctx, nil)
 			if err != nil {
-				return fmt.Errorf("보관 기한 초과 레코드 삭제를 위한 독립 트랜잭션 시작(BeginTx) 실패: %w", err)
+				return nil, fmt.Errorf("보관 기한 초과 레코드 삭제를 위한 독립 트랜잭션 시작(BeginTx) 실패: %w", err)
 			}
 			defer func() {
 				_ = tx.Rollback()
 			}()
 
-			if err := s.deleteOldArticles(ctx, tx, p.ID, p.Config.ArchiveDays); err != nil {
-				return err
+			providerResults, err := purgeProviderArticles(ctx, tx, p)
+			if err != nil {
+				return nil, err
 			}
 
 			if err := tx.Commit(); err != nil {
-				return fmt.Errorf("보관 기한 초과 레코드 삭제 트랜잭션의 영구 반영(Commit) 실패: %w", err)
+				return nil, fmt.Errorf("보관 기한 초과 레코드 삭제 트랜잭션의 영구 반영(Commit) 실패: %w", err)
 			}
 
-			return nil
+			return providerResults, nil
 		}()
 
 		if err != nil {
 			errs = append(errs, fmt.Errorf("공급자(providerID: %s)의 보관 기한 초과 게시글 삭제 실패: %w", p.ID, err))
+			continue
 		}
+
+		results = append(results, providerResults...)
 	}
 
-	return errors.Join(errs...)
+	return results, errors.Join(errs...)
 }
 
-// deleteOldArticles 진행 중인 트랜잭션(tx) 안에서, 특정 공급자의 보관 기한(archiveDays)이 지난
-// 게시글을 데이터베이스에서 영구 삭제하는 내부 헬퍼 함수입니다.
-//
-// PurgeOldArticles에서 공급자별 전용 트랜잭션과 함께 호출되며, SQLite 내장 함수 `strftime`을
-// 활용하여 '현재 시각 - archiveDays일' 이전 게시글을 DB 엔진 레벨에서 직접 필터링합니다.
+// purgeProviderArticles 진행 중인 트랜잭션(tx) 안에서 한 공급자의 게시판별 보관 정책을 적용하고,
+// 게시글이 삭제된 게시판의 내역만 반환하는 내부 헬퍼 함수입니다.
+func purgeProviderArticles(ctx context.Context, tx *sql.Tx, p *config.ProviderConfig) ([]*feed.PurgeResult, error) {
+	var results []*feed.PurgeResult
+
+	boardIDs := make([]string, 0, len(p.Config.Boards))
+	for _, b := range p.Config.Boards {
+		boardIDs = append(boardIDs, b.ID)
+
+		result := &feed.PurgeResult{ProviderID: p.ID, BoardID: b.ID}
+
+		var err error
+		if days := b.RetentionDays(p.Config.ArchiveDays); days > 0 {
+			if result.Expired, err = deleteExpiredArticles(ctx, tx, p.ID, b.ID, days); err != nil {
+				return nil, err
+			}
+		}
+		if b.MaxRows > 0 {
+			if result.Overflow, err = deleteOverflowArticles(ctx, tx, p.ID, b.ID, b.MaxRows); err != nil {
+				return nil, err
+			}
+		}
+
+		if result.Total() > 0 {
+			results = append(results, result)
+		}
+	}
+
+	// 설정에서 제거된 게시판의 게시글은 게시판별 정책이 없으므로 공급자의 보관 기간만 적용합니다.
+	if p.Config.ArchiveDays > 0 {
+		expired, err := deleteExpiredUnlistedArticles(ctx, tx, p.ID, boardIDs, p.Config.ArchiveDays)
+		if err != nil {
+			return nil, err
+		}
+		if expired > 0 {
+			results = append(results, &feed.PurgeResult{ProviderID: p.ID, Expired: expired})
+		}
+	}
+
+	return results, nil
+}
+
+// deleteExpiredArticles 게시판의 게시글 중 작성된 지 archiveDays일이 지난 게시글을 삭제하고, 삭제된 게시글 수를 반환합니다.
 //
-// 최적화: archiveDays가 0이면 '보관 기한 없음(무제한)'을 의미하므로,
-// 불필요한 쿼리 실행 없이 즉시 반환합니다.
-func (s *Store) deleteOldArticles(ctx context.Context, tx *sql.Tx, providerID string, archiveDays uint) error {
-	// archiveDays가 0인 경우는 보관 기간 '무제한(보존)'을 의미하므로, 불필요한 DB 쿼리 오버헤드를 막기 위해 즉시 반환합니다.
-	if archiveDays == 0 {
-		return nil
+// SQLite 내장 함수 `strftime`을 활용하여 '현재 시각 - archiveDays일' 이전 게시글을 DB 엔진 레벨에서 직접 필터링합니다.
+func deleteExpiredArticles(ctx context.Context, tx *sql.Tx, providerID, boardID string, archiveDays uint) (int64, error) {
+	query := `
+		DELETE
+		  FROM rss_provider_article
+		 WHERE p_id = ?
+		   AND b_id = ?
+		   AND created_date < strftime('%Y-%m-%dT%H:%M:%SZ', 'now', ?)
+	`
+
+	res, err := tx.ExecContext(ctx, query, providerID, boardID, fmt.Sprintf("-%d days", archiveDays))
+	if err != nil {
+		return 0, fmt.Errorf("보관 기한을 초과한 게시글 레코드의 영구 삭제(DELETE) 쿼리 실행 실패 (providerID: %s, boardID: %s, archiveDays: %d): %w", providerID, boardID, archiveDays, err)
+	}
+
+	return res.RowsAffected()
+}
+
+// deleteOverflowArticles 게시판의 게시글 중 최신 maxRows건을 제외한 나머지를 삭제하고, 삭제된 게시글 수를 반환합니다.
+func deleteOverflowArticles(ctx context.Context, tx *sql.Tx, providerID, boardID string, maxRows uint) (int64, error) {
+	query := `
+		DELETE
+		  FROM rss_provider_article
+		 WHERE p_id = ?
+		   AND b_id = ?
+		   AND id NOT IN ( SELECT id
+		                     FROM rss_provider_article
+		                    WHERE p_id = ?
+		                      AND b_id = ?
+		                    ORDER BY created_date DESC, id DESC
+		                    LIMIT ? )
+	`
+
+	res, err := tx.ExecContext(ctx, query, providerID, boardID, providerID, boardID, maxRows)
+	if err != nil {
+		return 0, fmt.Errorf("보관 개수를 초과한 게시글 레코드의 영구 삭제(DELETE) 쿼리 실행 실패 (providerID: %s, boardID: %s, maxRows: %d): %w", providerID, boardID, maxRows, err)
 	}
 
+	return res.RowsAffected()
+}
+
+// deleteExpiredUnlistedArticles 공급자의 게시글 중 boardIDs에 속하지 않는 게시판의 게시글 가운데
+// 작성된 지 archiveDays일이 지난 게시글을 삭제하고, 삭제된 게시글 수를 반환합니다.
+func deleteExpiredUnlistedArticles(ctx context.Context, tx *sql.Tx, providerID string, boardIDs []string, archiveDays uint) (int64, error) {
 	query := `
-		DELETE 
+		DELETE
 		  FROM rss_provider_article
 		 WHERE p_id = ?
 		   AND created_date < strftime('%Y-%m-%dT%H:%M:%SZ', 'now', ?)
 	`
+	args := []any{providerID, fmt.Sprintf("-%d days", archiveDays)}
+	if len(boardIDs) > 0 {
+		query += " AND b_id NOT IN (" + inPlaceholders(len(boardIDs)) + ")"
+		for _, id := range boardIDs {
+			args = append(args, id)
+		}
+	}
 
-	if _, err := tx.ExecContext(ctx, query, providerID, fmt.Sprintf("-%d days", archiveDays)); err != nil {
-		return fmt.Errorf("보관 기한을 초과한 게시글 레코드의 영구 삭제(DELETE) 쿼리 실행 실패 (providerID: %s, archiveDays: %d): %w", providerID, archiveDays, err)
+	res, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("설정에 없는 게시판의 보관 기한 초과 게시글 영구 삭제(DELETE) 쿼리 실행 실패 (providerID: %s, archiveDays: %d): %w", providerID, archiveDays, err)
 	}
 
-	return nil
+	return res.RowsAffected()
 }
 
 // SaveArticles 게시글 목록을 데이터베이스에 저장하고, 실제로 저장에 성공한 게시글 수를 반환합니다.
@@ -434,15 +458,16 @@ func (s *Store) SaveArticles(ctx context.Context, providerID string, articles []
 	// 새 게시글은 삽입하고, 이미 있는 게시글은 최신 내용으로 덮어씁니다. (Upsert)
 	stmt, err := tx.PrepareContext(ctx, `
 		INSERT INTO
-			rss_provider_article (p_id, b_id, id, title, content, link, author, created_date)
+			rss_provider_article (p_id, b_id, id, title, content, link, author, created_date, fingerprint)
 		VALUES
-			(?, ?, ?, ?, ?, ?, ?, ?)
+			(?, ?, ?, ?, ?, ?, ?, ?, ?)
 		ON CONFLICT(p_id, b_id, id) DO UPDATE SET
 			title        = excluded.title,
 			content      = excluded.content,
 			link         = excluded.link,
 			author       = excluded.author,
-			created_date = excluded.created_date
+			created_date = excluded.created_date,
+			fingerprint  = excluded.fingerprint
 	`)
 	if err != nil {
 		return 0, fmt.Errorf("게시글(Article) Upsert PrepareContext 실패 (providerID: %s): %w", providerID, err)
@@ -460,7 +485,10 @@ func (s *Store) SaveArticles(ctx context.Context, providerID string, articles []
 			break
 		}
 
-		if _, err := stmt.ExecContext(ctx, providerID, article.BoardID, article.ArticleID, article.Title, article.Content, article.Link, article.Author, article.CreatedAt.UTC().Format(time.RFC3339)); err != nil {
+		// 중복 게시글 판별에 사용할 지문은 저장 시점의 제목과 본문으로 계산합니다.
+		fingerprint := feed.Fingerprint(article.Title, article.Content)
+
+		if _, err := stmt.ExecContext(ctx, providerID, article.BoardID, article.ArticleID, article.Title, article.Content, article.Link, article.Author, article.CreatedAt.UTC().Format(time.RFC3339), nullFingerprint(fingerprint)); err != nil {
 			errs = append(errs, fmt.Errorf("게시글(Article) Upsert 쿼리 실행 실패 (providerID: %s, articleID: %s): %w", providerID, article.ArticleID, err))
 			continue
 		}
@@ -490,6 +518,12 @@ func (s *Store) SaveArticles(ctx context.Context, providerID string, articles []
 // GetArticles 지정한 공급자(providerID)의 게시판들(boardIDs)에서 게시글을 최신순으로 최대 limit개 반환합니다.
 // boardIDs가 비어 있으면 DB를 조회하지 않고 즉시 빈 목록을 반환합니다.
 func (s *Store) GetArticles(ctx context.Context, providerID string, boardIDs []string, limit uint) ([]*feed.Article, error) {
+	return s.getArticles(ctx, providerID, boardIDs, limit, false)
+}
+
+// getArticles GetArticles와 GetVisibleArticles의 공통 구현입니다.
+// excludeDeleted가 true이면 삭제가 감지된 게시글을 LIMIT 적용 전에 조회 조건에서 제외합니다.
+func (s *Store) getArticles(ctx context.Context, providerID string, boardIDs []string, limit uint, excludeDeleted bool) ([]*feed.Article, error) {
 	// 조회할 게시판이 없으면 DB 통신 없이 즉시 빈 목록을 반환합니다.
 	if len(boardIDs) == 0 {
 		return make([]*feed.Article, 0), nil
@@ -510,13 +544,17 @@ func (s *Store) GetArticles(ctx context.Context, providerID string, boardIDs []s
 		     , a.link
 		     , IFNULL(a.author, "") AS author
 		     , a.created_date
+		     , a.updated_date
+		     , a.deleted_at
+		     , a.deleted_reason
+		     , a.fingerprint
 		  FROM rss_provider_article a
 		       INNER JOIN rss_provider_board b ON ( a.p_id = b.p_id AND a.b_id = b.id )
 		 WHERE a.p_id = ?
-		   AND a.b_id IN (%s)
+		   AND a.b_id IN (%s)%s
 		 ORDER BY a.created_date DESC
 		 LIMIT ?
-	`, strings.Join(placeholders, ", "))
+	`, strings.Join(placeholders, ", "), deletedCondition(excludeDeleted))
 
 	// 쿼리 실행에 바인딩할 인자를 순서대로 조립합니다: providerID → boardIDs → limit
 	args := make([]any, 0, 2+len(boardIDs))
@@ -537,16 +575,17 @@ func (s *Store) GetArticles(ctx context.Context, providerID string, boardIDs []s
 	// 조회 결과를 한 행씩 순회하며 Article 구조체로 변환합니다.
 	for rows.Next() {
 		var article feed.Article
-		var rawCreatedDate sql.NullString
+		var rawCreatedDate, rawUpdatedDate, rawDeletedAt, rawDeletedReason sql.NullString
+		var rawFingerprint sql.NullInt64
 
-		if err = rows.Scan(&article.BoardID, &article.BoardName, &article.ArticleID, &article.Title, &article.Content, &article.Link, &article.Author, &rawCreatedDate); err != nil {
+		if err = rows.Scan(&article.BoardID, &article.BoardName, &article.ArticleID, &article.Title, &article.Content, &article.Link, &article.Author, &rawCreatedDate, &rawUpdatedDate, &rawDeletedAt, &rawDeletedReason, &rawFingerprint); err != nil {
 			return nil, fmt.Errorf("게시글 목록 조회(GetArticles) 결과 행 스캔 실패: %w", err)
 		}
-		if rawCreatedDate.Valid {
-			if parsed, err := time.Parse(time.RFC3339, rawCreatedDate.String); err == nil {
-				article.CreatedAt = parsed.Local()
-			}
-		}
+		article.CreatedAt = parseDateTime(rawCreatedDate)
+		article.UpdatedAt = parseDateTime(rawUpdatedDate)
+		article.DeletedAt = parseDateTime(rawDeletedAt)
+		article.DeletedReason = deletedReason(article.DeletedAt, rawDeletedReason)
+		article.Fingerprint = uint64(rawFingerprint.Int64)
 
 		articles = append(articles, &article)
 	}
@@ -644,3 +683,18 @@ func (s *Store) UpsertLatestCrawledArticleID(ctx context.Context, providerID, bo
 
 	return nil
 }
+
+// parseDateTime DB에 RFC3339(UTC) 문자열로 저장된 일시 컬럼 값을 로컬 시간대의 time.Time으로 변환합니다.
+// 값이 NULL이거나 형식이 올바르지 않으면 zero value(time.Time{})를 반환합니다.
+func parseDateTime(raw sql.NullString) time.Time {
+	if !raw.Valid {
+		return time.Time{}
+	}
+
+	parsed, err := time.Parse(time.RFC3339, raw.String)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return parsed.Local()
+}
@@ -3,12 +3,14 @@ package sqlite
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"testing"
 	"time"
 
 	"github.com/darkkaiser/rss-feed-server/internal/config"
 	"github.com/darkkaiser/rss-feed-server/internal/feed"
+	"github.com/darkkaiser/rss-feed-server/internal/store/migrate"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -46,6 +48,61 @@ func TestStore_Initialize(t *testing.T) {
 	assert.NoError(t, err, "Initialize 메서드는 여러 번 호출되어도 에러가 발생하지 않아야 합니다.")
 }
 
+// TestStore_Initialize_LegacySchema는 버전 관리 도입 이전에 만들어진 데이터베이스가 기준 버전으로 인식되는지 검증합니다.
+func TestStore_Initialize_LegacySchema(t *testing.T) {
+	t.Parallel()
+
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name())
+	db, err := Open(context.Background(), dsn)
+	require.NoError(t, err)
+	defer db.Close()
+
+	// 최초 배포 당시의 스키마 (수정일시/삭제일시 컬럼과 이후 추가된 테이블이 없음)
+	_, err = db.Exec(`
+		CREATE TABLE rss_provider (id VARCHAR(50) PRIMARY KEY NOT NULL UNIQUE, site VARCHAR(50) NOT NULL, s_id VARCHAR(50) NOT NULL, s_name VARCHAR(130) NOT NULL, s_description VARCHAR(200), s_url VARCHAR(100) NOT NULL);
+		CREATE TABLE rss_provider_board (p_id VARCHAR(50) NOT NULL, id VARCHAR(50) NOT NULL, name VARCHAR(130) NOT NULL, PRIMARY KEY (p_id, id));
+		CREATE TABLE rss_provider_article (p_id VARCHAR(50) NOT NULL, b_id VARCHAR(50) NOT NULL, id VARCHAR(50) NOT NULL, title VARCHAR(400) NOT NULL, content TEXT, link VARCHAR(1000) NOT NULL, author VARCHAR(50), created_date DATETIME, PRIMARY KEY (p_id, b_id, id));
+		INSERT INTO rss_provider VALUES ('p1', 'site', 's1', '사이트', '', 'https://example.com');
+		INSERT INTO rss_provider_board VALUES ('p1', 'b1', '게시판');
+		INSERT INTO rss_provider_article VALUES ('p1', 'b1', 'a1', '기존 게시글', '', 'https://example.com/a1', '', '2024-01-01 00:00:00');
+	`)
+	require.NoError(t, err)
+
+	store, err := New(db)
+	require.NoError(t, err)
+	require.NoError(t, store.Initialize(context.Background()))
+
+	var version int
+	var baseline bool
+	require.NoError(t, db.QueryRow("SELECT version, baseline FROM schema_migrations ORDER BY version LIMIT 1").Scan(&version, &baseline))
+	assert.Equal(t, 1, version)
+	assert.True(t, baseline, "기존 데이터베이스는 기준 버전으로 기록되어야 합니다")
+
+	var title string
+	var deletedAt sql.NullString
+	require.NoError(t, db.QueryRow("SELECT title, deleted_at FROM rss_provider_article WHERE id = 'a1'").Scan(&title, &deletedAt))
+	assert.Equal(t, "기존 게시글", title, "기존 데이터는 보존되어야 합니다")
+	assert.False(t, deletedAt.Valid)
+
+	var count int
+	require.NoError(t, db.QueryRow("SELECT COUNT(*) FROM sqlite_master WHERE name = 'rss_parse_snapshot'").Scan(&count))
+	assert.Equal(t, 1, count, "이후 추가된 테이블이 생성되어야 합니다")
+}
+
+// TestStore_Initialize_NewerSchema는 더 새로운 서버가 올린 스키마에서는 기동을 거부하는지 검증합니다.
+func TestStore_Initialize_NewerSchema(t *testing.T) {
+	t.Parallel()
+	db, store := setupTestDB(t)
+	defer db.Close()
+
+	_, err := db.Exec("INSERT INTO schema_migrations (version, name) VALUES (9999, 'future')")
+	require.NoError(t, err)
+
+	err = store.Initialize(context.Background())
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, migrate.ErrSchemaTooNew))
+}
+
 // TestStore_SyncProviders는 Config -> DB 동기화 파이프라인의 삽입/수정 및 연쇄 삭제 로직을 검증합니다.
 func TestStore_SyncProviders(t *testing.T) {
 	t.Parallel()
@@ -0,0 +1,71 @@
+// Package store 환경설정에 따라 게시글 데이터를 저장할 저장소 구현체(SQLite, PostgreSQL)를 선택하고 생성합니다.
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/darkkaiser/rss-feed-server/internal/config"
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+	"github.com/darkkaiser/rss-feed-server/internal/store/postgres"
+	"github.com/darkkaiser/rss-feed-server/internal/store/sqlite"
+)
+
+// Store 애플리케이션이 사용하는 저장소가 공통으로 제공해야 하는 기능의 집합입니다.
+//
+// 서비스 계층이 사용하는 feed.Repository 외에, 애플리케이션 기동 시 한 번씩 호출되는
+// 스키마 준비(Initialize), 마스터 데이터 동기화(SyncProviders), 보관 기한 정리(PurgeOldArticles)와
+// 관리 명령어가 사용하는 게시글 내보내기(feed.ExportRepository)를 포함합니다.
+type Store interface {
+	feed.Repository
+	feed.ExportRepository
+
+	// Initialize 저장소가 정상적으로 동작하기 위한 스키마를 준비합니다.
+	Initialize(ctx context.Context) error
+
+	// SyncProviders 설정에 정의된 공급자와 게시판을 저장소의 마스터 데이터에 반영합니다.
+	SyncProviders(ctx context.Context, providers []*config.ProviderConfig) error
+
+	// PurgeOldArticles 게시판별 보관 정책(보관 기간, 보관 개수)을 벗어난 게시글을 삭제하고 게시판별 삭제 내역을 반환합니다.
+	PurgeOldArticles(ctx context.Context, providers []*config.ProviderConfig) ([]*feed.PurgeResult, error)
+}
+
+// 컴파일 타임에 인터페이스 구현 여부를 검증합니다.
+var (
+	_ Store = (*sqlite.Store)(nil)
+	_ Store = (*postgres.Store)(nil)
+)
+
+// Open 설정된 데이터베이스 종류에 맞는 드라이버로 데이터베이스에 연결합니다.
+// SQLite에서 DSN을 생략하면 config.DefaultSQLiteDSN을 사용합니다.
+func Open(ctx context.Context, cfg *config.DatabaseConfig) (*sql.DB, error) {
+	switch cfg.DriverOrDefault() {
+	case config.DatabaseDriverSQLite:
+		dsn := cfg.DSN
+		if dsn == "" {
+			dsn = config.DefaultSQLiteDSN
+		}
+		return sqlite.Open(ctx, dsn)
+
+	case config.DatabaseDriverPostgres:
+		return postgres.Open(ctx, cfg.DSN)
+
+	default:
+		return nil, fmt.Errorf("지원하지 않는 데이터베이스 종류입니다: '%s'", cfg.Driver)
+	}
+}
+
+// New 설정된 데이터베이스 종류에 맞는 저장소를 생성합니다. db는 Open으로 연결한 같은 종류의 커넥션이어야 합니다.
+func New(driver config.DatabaseDriver, db *sql.DB) (Store, error) {
+	switch driver {
+	case "", config.DatabaseDriverSQLite:
+		return sqlite.New(db)
+
+	case config.DatabaseDriverPostgres:
+		return postgres.New(db)
+
+	default:
+		return nil, fmt.Errorf("지원하지 않는 데이터베이스 종류입니다: '%s'", driver)
+	}
+}
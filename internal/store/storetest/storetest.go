@@ -0,0 +1,912 @@
+// Package storetest 저장소 구현체(SQLite, PostgreSQL)가 공통으로 지켜야 하는 동작 계약을 검증하는 적합성(Conformance) 테스트 모음을 제공합니다.
+//
+// 각 저장소 패키지의 테스트에서 Run을 호출하면, 같은 시나리오가 모든 구현체에 동일하게 적용되어
+// 저장소를 교체하더라도 서비스 계층이 관찰하는 동작이 달라지지 않음을 보장합니다.
+package storetest
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/darkkaiser/rss-feed-server/internal/config"
+	"github.com/darkkaiser/rss-feed-server/internal/feed"
+	"github.com/darkkaiser/rss-feed-server/internal/store"
+)
+
+// Factory 검증에 사용할 저장소를 생성합니다.
+// 호출될 때마다 다른 테스트와 데이터를 공유하지 않는, 스키마가 준비된(Initialize 완료) 빈 저장소를 반환해야 합니다.
+type Factory func(t *testing.T) store.Store
+
+// Run 모든 적합성 시나리오를 newStore로 생성한 저장소에 대해 실행합니다.
+// 선택적 저장소 인터페이스(feed.RevisionRepository 등)는 저장소가 구현하는 경우에만 검증합니다.
+func Run(t *testing.T, newStore Factory) {
+	t.Run("Initialize는 멱등적이다", func(t *testing.T) { testInitializeIdempotent(t, newStore) })
+	t.Run("SyncProviders", func(t *testing.T) { testSyncProviders(t, newStore) })
+	t.Run("SaveArticles와 GetArticles", func(t *testing.T) { testSaveAndGetArticles(t, newStore) })
+	t.Run("SaveArticles 부분 실패", func(t *testing.T) { testSaveArticlesPartialFailure(t, newStore) })
+	t.Run("CrawlingCursor", func(t *testing.T) { testCrawlingCursor(t, newStore) })
+	t.Run("PurgeOldArticles", func(t *testing.T) { testPurgeOldArticles(t, newStore) })
+	t.Run("PurgeOldArticlesPerBoard", func(t *testing.T) { testPurgeOldArticlesPerBoard(t, newStore) })
+	t.Run("ExportArticles", func(t *testing.T) { testExportArticles(t, newStore) })
+	t.Run("RevisionRepository", func(t *testing.T) { testRevisionRepository(t, newStore) })
+	t.Run("DeletionRepository", func(t *testing.T) { testDeletionRepository(t, newStore) })
+	t.Run("LayoutStatsRepository", func(t *testing.T) { testLayoutStatsRepository(t, newStore) })
+	t.Run("ParseSnapshotRepository", func(t *testing.T) { testParseSnapshotRepository(t, newStore) })
+	t.Run("DuplicateRepository", func(t *testing.T) { testDuplicateRepository(t, newStore) })
+	t.Run("HistoryRepository", func(t *testing.T) { testHistoryRepository(t, newStore) })
+	t.Run("StatsRepository", func(t *testing.T) { testStatsRepository(t, newStore) })
+	t.Run("AccessTokenRepository", func(t *testing.T) { testAccessTokenRepository(t, newStore) })
+}
+
+// =============================================================================
+// Test Helpers
+// =============================================================================
+
+// newProvider 테스트용 공급자 설정을 생성합니다.
+func newProvider(id string, archiveDays uint, boardIDs ...string) *config.ProviderConfig {
+	boards := make([]*config.BoardConfig, 0, len(boardIDs))
+	for _, b := range boardIDs {
+		boards = append(boards, &config.BoardConfig{ID: b, Name: "게시판 " + b})
+	}
+
+	return &config.ProviderConfig{
+		ID:   id,
+		Site: string(config.ProviderSiteYeosuCityHall),
+		Config: &config.ProviderDetailConfig{
+			ID:          id + "_site",
+			Name:        "사이트 " + id,
+			Description: "설명 " + id,
+			URL:         "https://example.com/" + id,
+			ArchiveDays: archiveDays,
+			Boards:      boards,
+		},
+	}
+}
+
+// newArticle 테스트용 게시글을 생성합니다.
+func newArticle(boardID, articleID string, createdAt time.Time) *feed.Article {
+	return &feed.Article{
+		BoardID:   boardID,
+		ArticleID: articleID,
+		Title:     "제목 " + articleID,
+		Content:   "본문 " + articleID,
+		Link:      "https://example.com/" + boardID + "/" + articleID,
+		Author:    "작성자",
+		CreatedAt: createdAt,
+	}
+}
+
+// seed 공급자 p1(게시판 b1, b2)을 동기화하고 주어진 게시글을 저장합니다.
+func seed(t *testing.T, s store.Store, articles ...*feed.Article) {
+	t.Helper()
+
+	ctx := context.Background()
+	require.NoError(t, s.SyncProviders(ctx, []*config.ProviderConfig{newProvider("p1", 0, "b1", "b2")}))
+
+	if len(articles) > 0 {
+		n, err := s.SaveArticles(ctx, "p1", articles)
+		require.NoError(t, err)
+		require.Equal(t, len(articles), n)
+	}
+}
+
+// articleIDs 게시글 목록에서 게시글 ID만 추출합니다.
+func articleIDs(articles []*feed.Article) []string {
+	ids := make([]string, 0, len(articles))
+	for _, a := range articles {
+		ids = append(ids, a.ArticleID)
+	}
+	return ids
+}
+
+// baseTime 초 단위로 절삭한 기준 시각을 반환합니다.
+// SQLite 저장소는 일시를 초 단위(RFC3339)로 저장하므로, 구현체 간 비교가 가능하도록 초 미만 값을 제거합니다.
+func baseTime() time.Time {
+	return time.Now().Truncate(time.Second)
+}
+
+// =============================================================================
+// feed.Repository 및 기동 작업
+// =============================================================================
+
+func testInitializeIdempotent(t *testing.T, newStore Factory) {
+	s := newStore(t)
+	require.NoError(t, s.Initialize(context.Background()), "이미 초기화된 저장소를 다시 초기화해도 오류가 없어야 합니다")
+}
+
+func testSyncProviders(t *testing.T, newStore Factory) {
+	ctx := context.Background()
+	s := newStore(t)
+	now := baseTime()
+
+	require.NoError(t, s.SyncProviders(ctx, []*config.ProviderConfig{
+		newProvider("p1", 0, "b1", "b2"),
+		newProvider("p2", 0, "b1"),
+	}))
+
+	_, err := s.SaveArticles(ctx, "p1", []*feed.Article{newArticle("b1", "1", now), newArticle("b2", "2", now)})
+	require.NoError(t, err)
+	_, err = s.SaveArticles(ctx, "p2", []*feed.Article{newArticle("b1", "3", now)})
+	require.NoError(t, err)
+	require.NoError(t, s.UpsertLatestCrawledArticleID(ctx, "p1", "", "global"))
+	require.NoError(t, s.UpsertLatestCrawledArticleID(ctx, "p1", "b2", "2"))
+
+	// 공급자 p2와 게시판 p1/b2를 설정에서 제거하고, 게시판 이름을 변경합니다.
+	p1 := newProvider("p1", 0, "b1")
+	p1.Config.Boards[0].Name = "변경된 이름"
+	require.NoError(t, s.SyncProviders(ctx, []*config.ProviderConfig{p1}))
+
+	articles, err := s.GetArticles(ctx, "p1", []string{"b1", "b2"}, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1"}, articleIDs(articles), "제거된 게시판의 게시글은 함께 삭제되어야 합니다")
+	assert.Equal(t, "변경된 이름", articles[0].BoardName, "게시판 이름 변경이 반영되어야 합니다")
+
+	articles, err = s.GetArticles(ctx, "p2", []string{"b1"}, 10)
+	require.NoError(t, err)
+	assert.Empty(t, articles, "제거된 공급자의 게시글은 함께 삭제되어야 합니다")
+
+	id, _, err := s.GetCrawlingCursor(ctx, "p1", "")
+	require.NoError(t, err)
+	assert.Equal(t, "global", id, "공급자 전체 범위의 커서는 보존되어야 합니다")
+
+	id, _, err = s.GetCrawlingCursor(ctx, "p1", "b2")
+	require.NoError(t, err)
+	assert.Empty(t, id, "제거된 게시판의 커서는 삭제되어야 합니다")
+
+	// 설정이 비어 있으면 모든 공급자가 삭제되어야 합니다.
+	require.NoError(t, s.SyncProviders(ctx, nil))
+	articles, err = s.GetArticles(ctx, "p1", []string{"b1"}, 10)
+	require.NoError(t, err)
+	assert.Empty(t, articles)
+}
+
+func testSaveAndGetArticles(t *testing.T, newStore Factory) {
+	ctx := context.Background()
+	s := newStore(t)
+	now := baseTime()
+
+	seed(t, s,
+		newArticle("b1", "1", now.Add(-3*time.Hour)),
+		newArticle("b1", "2", now.Add(-2*time.Hour)),
+		newArticle("b2", "3", now.Add(-1*time.Hour)),
+	)
+
+	t.Run("최신 작성일시 순으로 limit개를 반환한다", func(t *testing.T) {
+		articles, err := s.GetArticles(ctx, "p1", []string{"b1", "b2"}, 2)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"3", "2"}, articleIDs(articles))
+	})
+
+	t.Run("지정한 게시판의 게시글만 반환한다", func(t *testing.T) {
+		articles, err := s.GetArticles(ctx, "p1", []string{"b1"}, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"2", "1"}, articleIDs(articles))
+	})
+
+	t.Run("게시판 목록이 비어 있으면 빈 목록을 반환한다", func(t *testing.T) {
+		articles, err := s.GetArticles(ctx, "p1", nil, 10)
+		require.NoError(t, err)
+		assert.NotNil(t, articles)
+		assert.Empty(t, articles)
+	})
+
+	t.Run("모든 필드가 보존된다", func(t *testing.T) {
+		articles, err := s.GetArticles(ctx, "p1", []string{"b2"}, 10)
+		require.NoError(t, err)
+		require.Len(t, articles, 1)
+
+		a := articles[0]
+		assert.Equal(t, "b2", a.BoardID)
+		assert.Equal(t, "게시판 b2", a.BoardName)
+		assert.Equal(t, "제목 3", a.Title)
+		assert.Equal(t, "본문 3", a.Content)
+		assert.Equal(t, "https://example.com/b2/3", a.Link)
+		assert.Equal(t, "작성자", a.Author)
+		assert.True(t, now.Add(-1*time.Hour).Equal(a.CreatedAt), "작성일시가 보존되어야 합니다: %v", a.CreatedAt)
+		assert.Equal(t, time.Local, a.CreatedAt.Location(), "일시는 로컬 시간대로 반환되어야 합니다")
+		assert.True(t, a.UpdatedAt.IsZero())
+		assert.True(t, a.DeletedAt.IsZero())
+	})
+
+	t.Run("이미 있는 게시글은 최신 내용으로 덮어쓴다", func(t *testing.T) {
+		updated := newArticle("b1", "1", now.Add(-3*time.Hour))
+		updated.Title = "수정된 제목"
+		updated.Author = ""
+
+		n, err := s.SaveArticles(ctx, "p1", []*feed.Article{updated})
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+
+		articles, err := s.GetArticles(ctx, "p1", []string{"b1"}, 10)
+		require.NoError(t, err)
+		require.Len(t, articles, 2)
+		assert.Equal(t, "수정된 제목", articles[1].Title)
+		assert.Empty(t, articles[1].Author)
+	})
+
+	t.Run("저장할 게시글이 없으면 0을 반환한다", func(t *testing.T) {
+		n, err := s.SaveArticles(ctx, "p1", nil)
+		require.NoError(t, err)
+		assert.Zero(t, n)
+	})
+}
+
+func testSaveArticlesPartialFailure(t *testing.T, newStore Factory) {
+	ctx := context.Background()
+	s := newStore(t)
+	now := baseTime()
+	seed(t, s)
+
+	// 등록되지 않은 게시판(b_unknown)의 게시글은 외래 키 제약으로 실패하지만, 나머지 게시글은 저장되어야 합니다.
+	n, err := s.SaveArticles(ctx, "p1", []*feed.Article{
+		newArticle("b1", "1", now),
+		newArticle("b_unknown", "2", now),
+		newArticle("b1", "3", now.Add(time.Minute)),
+	})
+	require.Error(t, err)
+	assert.Equal(t, 2, n)
+
+	articles, err := s.GetArticles(ctx, "p1", []string{"b1", "b_unknown"}, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"3", "1"}, articleIDs(articles))
+}
+
+func testCrawlingCursor(t *testing.T, newStore Factory) {
+	ctx := context.Background()
+	s := newStore(t)
+	now := baseTime()
+	seed(t, s)
+
+	id, createdAt, err := s.GetCrawlingCursor(ctx, "p1", "b1")
+	require.NoError(t, err)
+	assert.Empty(t, id, "저장된 커서가 없으면 빈 문자열이어야 합니다")
+	assert.True(t, createdAt.IsZero())
+
+	_, err = s.SaveArticles(ctx, "p1", []*feed.Article{newArticle("b1", "1", now.Add(-time.Hour)), newArticle("b2", "2", now)})
+	require.NoError(t, err)
+	require.NoError(t, s.UpsertLatestCrawledArticleID(ctx, "p1", "b1", "1"))
+	require.NoError(t, s.UpsertLatestCrawledArticleID(ctx, "p1", "b1", "10"))
+	require.NoError(t, s.UpsertLatestCrawledArticleID(ctx, "p1", "", "20"))
+
+	id, createdAt, err = s.GetCrawlingCursor(ctx, "p1", "b1")
+	require.NoError(t, err)
+	assert.Equal(t, "10", id, "커서는 최신 값으로 덮어써야 합니다")
+	assert.True(t, now.Add(-time.Hour).Equal(createdAt), "게시판의 최신 게시글 작성일시를 반환해야 합니다: %v", createdAt)
+
+	id, createdAt, err = s.GetCrawlingCursor(ctx, "p1", "")
+	require.NoError(t, err)
+	assert.Equal(t, "20", id)
+	assert.True(t, now.Equal(createdAt), "게시판 구분 없이 최신 게시글 작성일시를 반환해야 합니다: %v", createdAt)
+}
+
+func testPurgeOldArticles(t *testing.T, newStore Factory) {
+	ctx := context.Background()
+	s := newStore(t)
+	now := baseTime()
+
+	providers := []*config.ProviderConfig{newProvider("p1", 7, "b1"), newProvider("p2", 0, "b1")}
+	require.NoError(t, s.SyncProviders(ctx, providers))
+
+	old := now.AddDate(0, 0, -30)
+	_, err := s.SaveArticles(ctx, "p1", []*feed.Article{newArticle("b1", "old", old), newArticle("b1", "new", now)})
+	require.NoError(t, err)
+	_, err = s.SaveArticles(ctx, "p2", []*feed.Article{newArticle("b1", "old", old)})
+	require.NoError(t, err)
+
+	results, err := s.PurgeOldArticles(ctx, providers)
+	require.NoError(t, err)
+	assert.Equal(t, []*feed.PurgeResult{{ProviderID: "p1", BoardID: "b1", Expired: 1}}, results, "게시글이 삭제된 게시판만 보고되어야 합니다")
+
+	articles, err := s.GetArticles(ctx, "p1", []string{"b1"}, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"new"}, articleIDs(articles), "보관 기한이 지난 게시글만 삭제되어야 합니다")
+
+	articles, err = s.GetArticles(ctx, "p2", []string{"b1"}, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"old"}, articleIDs(articles), "보관 기한이 0이면 게시글을 삭제하지 않아야 합니다")
+}
+
+func testPurgeOldArticlesPerBoard(t *testing.T, newStore Factory) {
+	ctx := context.Background()
+	s := newStore(t)
+	now := baseTime()
+
+	// b3은 과거에 수집되었다가 설정에서 제거된 게시판입니다.
+	require.NoError(t, s.SyncProviders(ctx, []*config.ProviderConfig{newProvider("p1", 60, "b1", "b2", "b3")}))
+	_, err := s.SaveArticles(ctx, "p1", []*feed.Article{
+		newArticle("b1", "1", now.AddDate(0, 0, -40)),
+		newArticle("b1", "2", now.AddDate(0, 0, -10)),
+		newArticle("b1", "3", now),
+		newArticle("b2", "4", now.AddDate(0, 0, -90)),
+		newArticle("b2", "5", now.AddDate(0, 0, -40)),
+		newArticle("b2", "6", now.Add(-2*time.Hour)),
+		newArticle("b2", "7", now.Add(-1*time.Hour)),
+		newArticle("b2", "8", now),
+		newArticle("b3", "9", now.AddDate(0, 0, -90)),
+		newArticle("b3", "10", now),
+	})
+	require.NoError(t, err)
+
+	providers := []*config.ProviderConfig{newProvider("p1", 60, "b1", "b2")}
+	providers[0].Config.Boards[0].ArchiveDays = 30 // 공급자(60일)보다 짧은 게시판 보관 기간
+	providers[0].Config.Boards[1].MaxRows = 2      // 공급자 보관 기간(60일) + 최신 2건만 보관
+
+	results, err := s.PurgeOldArticles(ctx, providers)
+	require.NoError(t, err)
+	assert.Equal(t, []*feed.PurgeResult{
+		{ProviderID: "p1", BoardID: "b1", Expired: 1},
+		{ProviderID: "p1", BoardID: "b2", Expired: 1, Overflow: 2},
+		{ProviderID: "p1", BoardID: "", Expired: 1},
+	}, results)
+
+	articles, err := s.GetArticles(ctx, "p1", []string{"b1", "b2", "b3"}, 20)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"2", "3", "7", "8", "10"}, articleIDs(articles))
+
+	results, err = s.PurgeOldArticles(ctx, providers)
+	require.NoError(t, err)
+	assert.Empty(t, results, "삭제할 게시글이 없으면 내역이 비어 있어야 합니다")
+}
+
+func testExportArticles(t *testing.T, newStore Factory) {
+	ctx := context.Background()
+	s := newStore(t)
+	now := baseTime()
+
+	require.NoError(t, s.SyncProviders(ctx, []*config.ProviderConfig{
+		newProvider("p1", 0, "b1", "b2"),
+		newProvider("p2", 0, "b1"),
+	}))
+	_, err := s.SaveArticles(ctx, "p1", []*feed.Article{
+		newArticle("b2", "3", now.Add(-1*time.Hour)),
+		newArticle("b1", "2", now.Add(-2*time.Hour)),
+		newArticle("b1", "1", now.Add(-3*time.Hour)),
+	})
+	require.NoError(t, err)
+	_, err = s.SaveArticles(ctx, "p2", []*feed.Article{newArticle("b1", "9", now.Add(-5*time.Hour))})
+	require.NoError(t, err)
+
+	export := func(filter feed.ArticleFilter) []string {
+		t.Helper()
+
+		var keys []string
+		require.NoError(t, s.ExportArticles(ctx, filter, func(providerID string, a *feed.Article) error {
+			keys = append(keys, providerID+"/"+a.BoardID+"/"+a.ArticleID)
+			return nil
+		}))
+		return keys
+	}
+
+	t.Run("조건이 없으면 전체 게시글을 공급자, 게시판, 작성일시 순으로 전달한다", func(t *testing.T) {
+		assert.Equal(t, []string{"p1/b1/1", "p1/b1/2", "p1/b2/3", "p2/b1/9"}, export(feed.ArticleFilter{}))
+	})
+
+	t.Run("공급자, 게시판, 작성일시 조건을 함께 적용한다", func(t *testing.T) {
+		assert.Equal(t, []string{"p2/b1/9"}, export(feed.ArticleFilter{ProviderIDs: []string{"p2"}}))
+		assert.Equal(t, []string{"p1/b1/1", "p1/b1/2", "p2/b1/9"}, export(feed.ArticleFilter{BoardIDs: []string{"b1"}}))
+		assert.Equal(t, []string{"p1/b1/2"}, export(feed.ArticleFilter{
+			ProviderIDs: []string{"p1"},
+			BoardIDs:    []string{"b1"},
+			Since:       now.Add(-2 * time.Hour),
+			Until:       now.Add(-1 * time.Hour),
+		}))
+	})
+
+	t.Run("모든 필드가 보존된다", func(t *testing.T) {
+		var exported *feed.Article
+		require.NoError(t, s.ExportArticles(ctx, feed.ArticleFilter{ProviderIDs: []string{"p1"}, BoardIDs: []string{"b2"}}, func(_ string, a *feed.Article) error {
+			exported = a
+			return nil
+		}))
+		require.NotNil(t, exported)
+		assert.Equal(t, "게시판 b2", exported.BoardName)
+		assert.Equal(t, "본문 3", exported.Content)
+		assert.True(t, now.Add(-1*time.Hour).Equal(exported.CreatedAt))
+	})
+
+	t.Run("콜백이 에러를 반환하면 중단하고 해당 에러를 반환한다", func(t *testing.T) {
+		stop := errors.New("중단")
+		calls := 0
+		err := s.ExportArticles(ctx, feed.ArticleFilter{}, func(string, *feed.Article) error {
+			calls++
+			return stop
+		})
+		assert.ErrorIs(t, err, stop)
+		assert.Equal(t, 1, calls)
+	})
+}
+
+// =============================================================================
+// 선택적 저장소 인터페이스
+// =============================================================================
+
+func testRevisionRepository(t *testing.T, newStore Factory) {
+	ctx := context.Background()
+	s := newStore(t)
+	repo, ok := s.(feed.RevisionRepository)
+	if !ok {
+		t.Skip("저장소가 feed.RevisionRepository를 구현하지 않습니다")
+	}
+
+	now := baseTime()
+	seed(t, s, newArticle("b1", "recent", now), newArticle("b1", "old", now.AddDate(0, 0, -30)))
+
+	recent, err := repo.GetRecentArticles(ctx, "p1", now.AddDate(0, 0, -7))
+	require.NoError(t, err)
+	require.Equal(t, []string{"recent"}, articleIDs(recent))
+	assert.Equal(t, "본문 recent", recent[0].Content)
+
+	edited := *recent[0]
+	edited.Content = "수정된 본문"
+	edited.UpdatedAt = now.Add(time.Minute)
+	require.NoError(t, repo.SaveArticleRevision(ctx, "p1", &edited))
+
+	edited.Content = "두 번째 수정"
+	edited.UpdatedAt = now.Add(2 * time.Minute)
+	require.NoError(t, repo.SaveArticleRevision(ctx, "p1", &edited))
+
+	articles, err := s.GetArticles(ctx, "p1", []string{"b1"}, 1)
+	require.NoError(t, err)
+	require.Len(t, articles, 1)
+	assert.Equal(t, "두 번째 수정", articles[0].Content)
+	assert.True(t, now.Add(2*time.Minute).Equal(articles[0].UpdatedAt), "수정일시가 갱신되어야 합니다: %v", articles[0].UpdatedAt)
+
+	err = repo.SaveArticleRevision(ctx, "p1", newArticle("b1", "missing", now))
+	assert.Error(t, err, "존재하지 않는 게시글의 수정 이력은 기록할 수 없어야 합니다")
+}
+
+func testDeletionRepository(t *testing.T, newStore Factory) {
+	ctx := context.Background()
+	s := newStore(t)
+	repo, ok := s.(feed.DeletionRepository)
+	if !ok {
+		t.Skip("저장소가 feed.DeletionRepository를 구현하지 않습니다")
+	}
+
+	now := baseTime()
+	noContent := newArticle("b1", "empty", now)
+	noContent.Content = ""
+	seed(t, s, newArticle("b1", "1", now), newArticle("b2", "2", now), newArticle("b1", "old", now.AddDate(0, 0, -30)), noContent)
+
+	sampled, err := repo.SampleRecentArticles(ctx, "p1", now.AddDate(0, 0, -7), 10)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"1", "2"}, articleIDs(sampled), "기간 내의 본문이 있는 게시글만 추출되어야 합니다")
+
+	sampled, err = repo.SampleRecentArticles(ctx, "p1", now.AddDate(0, 0, -7), 1)
+	require.NoError(t, err)
+	assert.Len(t, sampled, 1)
+
+	require.NoError(t, repo.MarkArticleDeleted(ctx, "p1", "b1", "1", now, feed.DeletedReasonAccessDenied))
+
+	sampled, err = repo.SampleRecentArticles(ctx, "p1", now.AddDate(0, 0, -7), 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"2"}, articleIDs(sampled), "삭제가 감지된 게시글은 다시 추출되지 않아야 합니다")
+
+	articles, err := s.GetArticles(ctx, "p1", []string{"b1"}, 10)
+	require.NoError(t, err)
+	for _, a := range articles {
+		if a.ArticleID == "1" {
+			assert.True(t, now.Equal(a.DeletedAt), "삭제 감지 일시가 조회되어야 합니다: %v", a.DeletedAt)
+			assert.Equal(t, feed.DeletedReasonAccessDenied, a.DeletedReason, "삭제 판정 근거가 조회되어야 합니다")
+		} else {
+			assert.Empty(t, a.DeletedReason, "삭제되지 않은 게시글은 판정 근거가 없어야 합니다")
+		}
+	}
+
+	err = repo.MarkArticleDeleted(ctx, "p1", "b1", "missing", now, feed.DeletedReasonRemoved)
+	assert.True(t, errors.Is(err, sql.ErrNoRows), "존재하지 않는 게시글은 sql.ErrNoRows로 알려야 합니다: %v", err)
+
+	visibleRepo, ok := s.(feed.VisibleArticleRepository)
+	if !ok {
+		return
+	}
+
+	// 가장 최근 게시글("1")이 삭제되었으므로 limit 1로 조회해도 그다음 게시글이 채워져야 합니다.
+	visible, err := visibleRepo.GetVisibleArticles(ctx, "p1", []string{"b1"}, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"empty"}, articleIDs(visible), "삭제된 게시글은 LIMIT 적용 전에 제외되어야 합니다")
+
+	visible, err = visibleRepo.GetVisibleArticles(ctx, "p1", []string{"b1", "b2"}, 10)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"2", "empty", "old"}, articleIDs(visible))
+}
+
+func testLayoutStatsRepository(t *testing.T, newStore Factory) {
+	ctx := context.Background()
+	s := newStore(t)
+	repo, ok := s.(feed.LayoutStatsRepository)
+	if !ok {
+		t.Skip("저장소가 feed.LayoutStatsRepository를 구현하지 않습니다")
+	}
+	seed(t, s)
+
+	for i := 1; i <= 4; i++ {
+		require.NoError(t, repo.SaveLayoutStats(ctx, "p1", &feed.LayoutStats{
+			BoardID:          "b1",
+			RowCount:         i,
+			EmptyFieldRatio:  0.25,
+			SelectorHitRatio: 1,
+			Drifted:          i == 4,
+			Snapshot:         "<table/>",
+		}, 3))
+	}
+
+	stats, err := repo.GetLayoutStats(ctx, "p1", "b1", 10)
+	require.NoError(t, err)
+	require.Len(t, stats, 3, "게시판별 보관 개수를 넘는 오래된 기록은 삭제되어야 합니다")
+	assert.Equal(t, 4, stats[0].RowCount, "최근 측정 순으로 반환되어야 합니다")
+	assert.True(t, stats[0].Drifted)
+	assert.False(t, stats[1].Drifted)
+	assert.InDelta(t, 0.25, stats[0].EmptyFieldRatio, 1e-9)
+	assert.False(t, stats[0].RecordedAt.IsZero())
+	assert.Empty(t, stats[0].Snapshot, "목록 조회에는 스냅샷이 포함되지 않아야 합니다")
+
+	stats, err = repo.GetLayoutStats(ctx, "p1", "b2", 10)
+	require.NoError(t, err)
+	assert.Empty(t, stats)
+}
+
+func testParseSnapshotRepository(t *testing.T, newStore Factory) {
+	ctx := context.Background()
+	s := newStore(t)
+	repo, ok := s.(feed.ParseSnapshotRepository)
+	if !ok {
+		t.Skip("저장소가 feed.ParseSnapshotRepository를 구현하지 않습니다")
+	}
+	seed(t, s)
+
+	newSnapshot := func(createdAt time.Time) *feed.ParseSnapshot {
+		return &feed.ParseSnapshot{
+			ProviderID:  "p1",
+			BoardID:     "b1",
+			Page:        1,
+			URL:         "https://example.com/p1/b1",
+			Header:      map[string][]string{"Content-Type": {"application/x-www-form-urlencoded"}},
+			ContentType: "text/html; charset=utf-8",
+			Body:        []byte("<html>깨진 목록</html>"),
+			Error:       "작성일 파싱 실패",
+			CreatedAt:   createdAt,
+		}
+	}
+
+	_, err := repo.SaveParseSnapshot(ctx, newSnapshot(baseTime().Add(-48*time.Hour)), 0, 0)
+	require.NoError(t, err)
+
+	var lastID int64
+	for i := 0; i < 3; i++ {
+		id, err := repo.SaveParseSnapshot(ctx, newSnapshot(baseTime()), 2, 24*time.Hour)
+		require.NoError(t, err)
+		assert.Greater(t, id, lastID, "발급되는 ID는 증가해야 합니다")
+		lastID = id
+	}
+
+	list, err := repo.ListParseSnapshots(ctx, "", 10)
+	require.NoError(t, err)
+	require.Len(t, list, 2, "보관 기한과 보관 개수를 넘는 스냅샷은 삭제되어야 합니다")
+	assert.Equal(t, lastID, list[0].ID)
+	assert.Nil(t, list[0].Body, "목록에는 본문이 포함되지 않아야 합니다")
+	assert.Equal(t, "application/x-www-form-urlencoded", list[0].Header["Content-Type"][0])
+
+	list, err = repo.ListParseSnapshots(ctx, "p_other", 10)
+	require.NoError(t, err)
+	assert.Empty(t, list)
+
+	snapshot, err := repo.GetParseSnapshot(ctx, lastID)
+	require.NoError(t, err)
+	require.NotNil(t, snapshot)
+	assert.Equal(t, "<html>깨진 목록</html>", string(snapshot.Body))
+	assert.Equal(t, "작성일 파싱 실패", snapshot.Error)
+
+	snapshot, err = repo.GetParseSnapshot(ctx, lastID+100)
+	require.NoError(t, err)
+	assert.Nil(t, snapshot)
+}
+
+func testDuplicateRepository(t *testing.T, newStore Factory) {
+	ctx := context.Background()
+	s := newStore(t)
+	repo, ok := s.(feed.DuplicateRepository)
+	if !ok {
+		t.Skip("저장소가 feed.DuplicateRepository를 구현하지 않습니다")
+	}
+
+	now := baseTime()
+	require.NoError(t, s.SyncProviders(ctx, []*config.ProviderConfig{
+		newProvider("p1", 0, "b1"),
+		newProvider("p2", 0, "b1"),
+		newProvider("p3", 0, "b1"),
+	}))
+
+	noText := newArticle("b1", "empty", now)
+	noText.Title, noText.Content = "", ""
+	_, err := s.SaveArticles(ctx, "p1", []*feed.Article{newArticle("b1", "1", now)})
+	require.NoError(t, err)
+	_, err = s.SaveArticles(ctx, "p2", []*feed.Article{newArticle("b1", "2", now), newArticle("b1", "old", now.AddDate(0, 0, -30)), noText})
+	require.NoError(t, err)
+	_, err = s.SaveArticles(ctx, "p3", []*feed.Article{newArticle("b1", "3", now.Add(-time.Hour))})
+	require.NoError(t, err)
+
+	articles, err := s.GetArticles(ctx, "p1", []string{"b1"}, 10)
+	require.NoError(t, err)
+	require.Len(t, articles, 1)
+	assert.Equal(t, feed.Fingerprint("제목 1", "본문 1"), articles[0].Fingerprint, "저장 시 계산한 지문이 조회되어야 합니다")
+
+	found, err := repo.GetFingerprintedArticles(ctx, now.AddDate(0, 0, -7), "p1", 10)
+	require.NoError(t, err)
+	require.Len(t, found, 2, "다른 공급자의 기간 내 지문이 있는 게시글만 조회되어야 합니다")
+	assert.Equal(t, "p2", found[0].ProviderID, "최신순으로 조회되어야 합니다")
+	assert.Equal(t, "2", found[0].ArticleID)
+	assert.Equal(t, "게시판 b1", found[0].BoardName)
+	assert.Equal(t, feed.Fingerprint("제목 2", "본문 2"), found[0].Fingerprint)
+	assert.Equal(t, "p3", found[1].ProviderID)
+
+	found, err = repo.GetFingerprintedArticles(ctx, now.AddDate(0, 0, -7), "p1", 1)
+	require.NoError(t, err)
+	assert.Len(t, found, 1)
+
+	if deletion, ok := s.(feed.DeletionRepository); ok {
+		require.NoError(t, deletion.MarkArticleDeleted(ctx, "p3", "b1", "3", now, feed.DeletedReasonRemoved))
+
+		found, err = repo.GetFingerprintedArticles(ctx, now.AddDate(0, 0, -7), "p1", 10)
+		require.NoError(t, err)
+		assert.Len(t, found, 1, "삭제가 감지된 게시글은 제외되어야 합니다")
+	}
+
+	if revision, ok := s.(feed.RevisionRepository); ok {
+		edited := newArticle("b1", "1", now)
+		edited.Content = "완전히 새로 작성된 본문"
+		require.NoError(t, revision.SaveArticleRevision(ctx, "p1", edited))
+
+		articles, err = s.GetArticles(ctx, "p1", []string{"b1"}, 10)
+		require.NoError(t, err)
+		assert.Equal(t, feed.Fingerprint("제목 1", "완전히 새로 작성된 본문"), articles[0].Fingerprint, "본문이 수정되면 지문도 갱신되어야 합니다")
+	}
+}
+
+func testHistoryRepository(t *testing.T, newStore Factory) {
+	ctx := context.Background()
+	s := newStore(t)
+	repo, ok := s.(feed.HistoryRepository)
+	if !ok {
+		t.Skip("저장소가 feed.HistoryRepository를 구현하지 않습니다")
+	}
+
+	now := baseTime()
+	seed(t, s,
+		newArticle("b1", "1", now.Add(-3*time.Hour)),
+		newArticle("b1", "2", now.Add(-2*time.Hour)),
+		newArticle("b2", "2", now.Add(-2*time.Hour)), // 다른 게시판의 같은 작성일시, 같은 ID
+		newArticle("b1", "3", now.Add(-time.Hour)),
+		newArticle("b2", "4", now),
+	)
+	require.NoError(t, s.SyncProviders(ctx, []*config.ProviderConfig{newProvider("p1", 0, "b1", "b2"), newProvider("p2", 0, "b1")}))
+	_, err := s.SaveArticles(ctx, "p2", []*feed.Article{newArticle("b1", "9", now)})
+	require.NoError(t, err)
+
+	// readAll 커서를 따라 끝까지 페이지를 넘기며 읽은 게시글을 "게시판/ID" 형식으로 반환합니다.
+	readAll := func(t *testing.T, query feed.ArticlePageQuery) []string {
+		t.Helper()
+
+		var keys []string
+		for {
+			page, err := repo.ListArticles(ctx, "p1", query)
+			require.NoError(t, err)
+			for _, a := range page {
+				keys = append(keys, a.BoardID+"/"+a.ArticleID)
+			}
+			if uint(len(page)) < query.Limit {
+				return keys
+			}
+			cursor := feed.CursorOf(page[len(page)-1])
+			query.After = &cursor
+		}
+	}
+
+	t.Run("최신순으로 페이지를 넘기면 모든 게시글을 한 번씩 읽는다", func(t *testing.T) {
+		assert.Equal(t, []string{"b2/4", "b1/3", "b2/2", "b1/2", "b1/1"}, readAll(t, feed.ArticlePageQuery{Limit: 2}))
+	})
+
+	t.Run("오래된 순으로도 페이지를 넘길 수 있다", func(t *testing.T) {
+		assert.Equal(t, []string{"b1/1", "b1/2", "b2/2", "b1/3", "b2/4"}, readAll(t, feed.ArticlePageQuery{Ascending: true, Limit: 2}))
+	})
+
+	t.Run("게시판과 작성일시 조건을 함께 적용한다", func(t *testing.T) {
+		query := feed.ArticlePageQuery{BoardIDs: []string{"b1"}, Since: now.Add(-2 * time.Hour), Until: now, Limit: 1}
+		assert.Equal(t, []string{"b1/3", "b1/2"}, readAll(t, query))
+	})
+
+	t.Run("모든 필드가 보존된다", func(t *testing.T) {
+		page, err := repo.ListArticles(ctx, "p1", feed.ArticlePageQuery{Limit: 1})
+		require.NoError(t, err)
+		require.Len(t, page, 1)
+
+		want := newArticle("b2", "4", now)
+		got := page[0]
+		assert.Equal(t, "게시판 b2", got.BoardName)
+		assert.Equal(t, want.Title, got.Title)
+		assert.Equal(t, want.Content, got.Content)
+		assert.Equal(t, want.Link, got.Link)
+		assert.Equal(t, want.Author, got.Author)
+		assert.True(t, want.CreatedAt.Equal(got.CreatedAt))
+	})
+
+	t.Run("ExcludeDeleted는 삭제가 감지된 게시글을 LIMIT 적용 전에 제외한다", func(t *testing.T) {
+		deletionRepo, ok := s.(feed.DeletionRepository)
+		if !ok {
+			t.Skip("저장소가 feed.DeletionRepository를 구현하지 않습니다")
+		}
+		// 이후 시나리오는 삭제 여부와 무관한 게시글만 조회하므로 삭제 표시를 되돌리지 않습니다.
+		require.NoError(t, deletionRepo.MarkArticleDeleted(ctx, "p1", "b2", "4", now, ""))
+
+		page, err := repo.ListArticles(ctx, "p1", feed.ArticlePageQuery{ExcludeDeleted: true, Limit: 1})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"3"}, articleIDs(page), "가장 최근 게시글이 삭제되었으므로 그다음 게시글이 조회되어야 합니다")
+
+		deleted, err := repo.GetArticle(ctx, "p1", "b2", "4")
+		require.NoError(t, err)
+		require.NotNil(t, deleted)
+		assert.Equal(t, feed.DeletedReasonRemoved, deleted.DeletedReason, "판정 근거 없이 기록된 삭제는 원문 삭제로 조회되어야 합니다")
+
+		assert.Equal(t, []string{"b2/4", "b1/3", "b2/2", "b1/2", "b1/1"}, readAll(t, feed.ArticlePageQuery{Limit: 2}), "ExcludeDeleted가 false이면 삭제된 게시글도 포함되어야 합니다")
+	})
+
+	t.Run("GetArticle은 게시판과 ID가 모두 일치하는 게시글을 반환한다", func(t *testing.T) {
+		got, err := repo.GetArticle(ctx, "p1", "b2", "2")
+		require.NoError(t, err)
+		require.NotNil(t, got)
+		assert.Equal(t, "b2", got.BoardID)
+		assert.Equal(t, "본문 2", got.Content)
+		assert.True(t, now.Add(-2*time.Hour).Equal(got.CreatedAt))
+	})
+
+	t.Run("GetArticle은 게시글이 없으면 nil을 반환한다", func(t *testing.T) {
+		got, err := repo.GetArticle(ctx, "p2", "b1", "1")
+		require.NoError(t, err)
+		assert.Nil(t, got)
+	})
+}
+
+func testStatsRepository(t *testing.T, newStore Factory) {
+	ctx := context.Background()
+	s := newStore(t)
+	repo, ok := s.(feed.StatsRepository)
+	if !ok {
+		t.Skip("저장소가 feed.StatsRepository를 구현하지 않습니다")
+	}
+
+	kst := time.FixedZone("KST", 9*60*60)
+	day := time.Date(2024, 3, 15, 0, 0, 0, 0, kst)
+	seed(t, s,
+		newArticle("b1", "1", day.Add(-time.Minute)), // 전날 23:59
+		newArticle("b1", "2", day),
+		newArticle("b1", "3", day.Add(23*time.Hour+59*time.Minute)),
+		newArticle("b2", "4", day.Add(12*time.Hour)),
+		newArticle("b2", "5", day.AddDate(0, 0, 1)), // 조회 기간 밖
+	)
+
+	counts, err := repo.CountArticlesByDay(ctx, "p1", day.AddDate(0, 0, -1), day.AddDate(0, 0, 1), kst)
+	require.NoError(t, err)
+	require.Len(t, counts, 3)
+
+	assert.Equal(t, "b1", counts[0].BoardID)
+	assert.True(t, day.AddDate(0, 0, -1).Equal(counts[0].Date))
+	assert.Equal(t, int64(1), counts[0].Count)
+
+	assert.Equal(t, "b1", counts[1].BoardID)
+	assert.True(t, day.Equal(counts[1].Date))
+	assert.Equal(t, int64(2), counts[1].Count, "시간대 기준 같은 날짜의 게시글은 함께 집계되어야 합니다")
+
+	assert.Equal(t, "b2", counts[2].BoardID)
+	assert.Equal(t, int64(1), counts[2].Count)
+
+	counts, err = repo.CountArticlesByDay(ctx, "p2", day.AddDate(0, 0, -1), day.AddDate(0, 0, 1), kst)
+	require.NoError(t, err)
+	assert.Empty(t, counts)
+}
+
+func testAccessTokenRepository(t *testing.T, newStore Factory) {
+	ctx := context.Background()
+	s := newStore(t)
+	repo, ok := s.(feed.AccessTokenRepository)
+	if !ok {
+		t.Skip("저장소가 feed.AccessTokenRepository를 구현하지 않습니다")
+	}
+
+	now := baseTime()
+	id1, err := repo.CreateAccessToken(ctx, &feed.AccessToken{
+		Name:      "구독자 1",
+		Hash:      feed.HashAccessToken("secret-1"),
+		Scopes:    []string{"p1", "p2/b1"},
+		ExpiresAt: now.AddDate(0, 1, 0),
+		CreatedAt: now,
+	})
+	require.NoError(t, err)
+	id2, err := repo.CreateAccessToken(ctx, &feed.AccessToken{Name: "구독자 2", Hash: feed.HashAccessToken("secret-2"), Scopes: []string{"*"}})
+	require.NoError(t, err)
+	assert.NotEqual(t, id1, id2)
+
+	_, err = repo.CreateAccessToken(ctx, &feed.AccessToken{Name: "중복", Hash: feed.HashAccessToken("secret-1"), Scopes: []string{"*"}})
+	assert.Error(t, err, "같은 해시의 토큰은 저장할 수 없어야 합니다")
+
+	t.Run("모든 필드가 보존된다", func(t *testing.T) {
+		token, err := repo.GetAccessTokenByHash(ctx, feed.HashAccessToken("secret-1"))
+		require.NoError(t, err)
+		require.NotNil(t, token)
+
+		assert.Equal(t, id1, token.ID)
+		assert.Equal(t, "구독자 1", token.Name)
+		assert.Equal(t, []string{"p1", "p2/b1"}, token.Scopes)
+		assert.True(t, now.AddDate(0, 1, 0).Equal(token.ExpiresAt))
+		assert.True(t, now.Equal(token.CreatedAt))
+		assert.True(t, token.LastUsedAt.IsZero())
+		assert.True(t, token.RevokedAt.IsZero())
+	})
+
+	t.Run("해시가 없으면 nil을 반환한다", func(t *testing.T) {
+		token, err := repo.GetAccessTokenByHash(ctx, feed.HashAccessToken("unknown"))
+		require.NoError(t, err)
+		assert.Nil(t, token)
+	})
+
+	t.Run("발급 순으로 목록을 반환한다", func(t *testing.T) {
+		tokens, err := repo.ListAccessTokens(ctx)
+		require.NoError(t, err)
+		require.Len(t, tokens, 2)
+		assert.Equal(t, id1, tokens[0].ID)
+		assert.Equal(t, id2, tokens[1].ID)
+		assert.True(t, tokens[1].ExpiresAt.IsZero(), "만료 일시를 지정하지 않으면 zero value로 조회되어야 합니다")
+	})
+
+	t.Run("사용 기록은 최근 keep개만 보관한다", func(t *testing.T) {
+		for i := range 3 {
+			require.NoError(t, repo.RecordAccessTokenUsage(ctx, &feed.AccessTokenUsage{
+				TokenID:   id1,
+				Path:      "/p1.xml",
+				RemoteIP:  "127.0.0.1",
+				UserAgent: "reader",
+				UsedAt:    now.Add(time.Duration(i) * time.Minute),
+			}, 2))
+		}
+
+		usages, err := repo.ListAccessTokenUsage(ctx, id1, 10)
+		require.NoError(t, err)
+		require.Len(t, usages, 2)
+		assert.True(t, now.Add(2*time.Minute).Equal(usages[0].UsedAt), "최근 순으로 조회되어야 합니다")
+		assert.Equal(t, "/p1.xml", usages[0].Path)
+		assert.Equal(t, "127.0.0.1", usages[0].RemoteIP)
+		assert.Equal(t, "reader", usages[0].UserAgent)
+
+		usages, err = repo.ListAccessTokenUsage(ctx, id2, 10)
+		require.NoError(t, err)
+		assert.Empty(t, usages)
+
+		token, err := repo.GetAccessTokenByHash(ctx, feed.HashAccessToken("secret-1"))
+		require.NoError(t, err)
+		assert.True(t, now.Add(2*time.Minute).Equal(token.LastUsedAt), "마지막 사용 일시가 갱신되어야 합니다")
+	})
+
+	t.Run("폐기는 한 번만 성공한다", func(t *testing.T) {
+		revoked, err := repo.RevokeAccessToken(ctx, id2)
+		require.NoError(t, err)
+		assert.True(t, revoked)
+
+		revoked, err = repo.RevokeAccessToken(ctx, id2)
+		require.NoError(t, err)
+		assert.False(t, revoked, "이미 폐기된 토큰은 false를 반환해야 합니다")
+
+		revoked, err = repo.RevokeAccessToken(ctx, 9999)
+		require.NoError(t, err)
+		assert.False(t, revoked)
+
+		token, err := repo.GetAccessTokenByHash(ctx, feed.HashAccessToken("secret-2"))
+		require.NoError(t, err)
+		assert.False(t, token.RevokedAt.IsZero())
+		assert.False(t, token.IsActive(time.Now()))
+	})
+}
@@ -0,0 +1,72 @@
+package tracing
+
+import (
+	"context"
+
+	applog "github.com/darkkaiser/notify-server/pkg/log"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// LogFieldTraceID 로그에 기록되는 추적 ID 필드 이름입니다.
+	LogFieldTraceID = "trace_id"
+
+	// LogFieldSpanID 로그에 기록되는 Span ID 필드 이름입니다.
+	LogFieldSpanID = "span_id"
+)
+
+// logHook 로그 항목에 연결된 컨텍스트(Entry.Context)에 활성 Span이 있으면 trace_id와 span_id 필드를 추가하는 로그 Hook입니다.
+//
+// 로그를 남길 때 applog.WithContext(ctx) 또는 Entry.WithContext(ctx)로 요청 컨텍스트를 연결하면,
+// 로그 검색 도구에서 trace_id로 해당 요청의 모든 로그를 모으거나 추적 화면으로 바로 이동할 수 있습니다.
+type logHook struct{}
+
+// Levels 모든 로그 레벨에 적용합니다.
+func (logHook) Levels() []applog.Level {
+	return applog.AllLevels
+}
+
+// Fire 로그 항목에 추적 ID 필드를 추가합니다.
+func (logHook) Fire(entry *applog.Entry) error {
+	for k, v := range LogFields(entry.Context) {
+		entry.Data[k] = v
+	}
+	return nil
+}
+
+// LogFields 컨텍스트에 활성 Span이 있으면 trace_id와 span_id를 담은 로그 필드를 반환합니다. 없으면 nil을 반환합니다.
+func LogFields(ctx context.Context) applog.Fields {
+	if ctx == nil {
+		return nil
+	}
+
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return nil
+	}
+
+	return applog.Fields{
+		LogFieldTraceID: sc.TraceID().String(),
+		LogFieldSpanID:  sc.SpanID().String(),
+	}
+}
+
+// InstallLogHook 로거에 추적 ID 로그 Hook을 등록합니다. 이미 등록되어 있으면 아무 작업도 하지 않습니다.
+//
+// 로거에 등록된 Hook은 등록 순서대로 실행되며, 로그 시스템(applog.Setup)이 등록한 Hook이 그 자리에서 로그를 포맷팅하여 기록합니다.
+// 뒤에 추가한 Hook이 필드를 덧붙이면 이미 기록된 로그에는 반영되지 않으므로, 이 Hook을 모든 레벨에서 가장 먼저 실행되도록 맨 앞에 배치합니다.
+func InstallLogHook(logger *applog.Logger) {
+	hooks := make(map[applog.Level][]applog.Hook, len(applog.AllLevels))
+	for _, level := range applog.AllLevels {
+		existing := logger.Hooks[level]
+		for _, h := range existing {
+			if _, ok := h.(logHook); ok {
+				return
+			}
+		}
+
+		hooks[level] = append([]applog.Hook{logHook{}}, existing...)
+	}
+
+	logger.ReplaceHooks(hooks)
+}
@@ -0,0 +1,46 @@
+package tracing
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+
+	"github.com/XSAM/otelsql"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// OpenDB 쿼리·트랜잭션마다 Span을 기록하도록 계측된 DB 핸들을 엽니다.
+//
+// 매개변수:
+//   - driverName: database/sql 드라이버 이름 (예: "sqlite3")
+//   - dsn: 데이터베이스 연결 문자열
+//   - dbSystem: Span에 기록할 DB 종류 속성 (예: semconv.DBSystemNameSQLite)
+//
+// 주의사항:
+//   - 상위 Span(API 요청, 크롤링 실행 등)이 없는 컨텍스트의 호출은 기록하지 않습니다.
+//     마이그레이션이나 주기적인 정리 작업의 쿼리가 각각 독립된 추적으로 쌓여 추적 저장소를 채우는 것을 막기 위함입니다.
+//   - 결과 행 순회(rows.Next)와 커넥션 생성·초기화 Span은 건수가 많고 분석 가치가 낮아 기록하지 않습니다.
+//   - 반환된 핸들의 드라이버 커넥션(sql.Conn.Raw)은 계측 래퍼이므로, 원래 드라이버 커넥션이 필요하면 UnwrapDriverConn을 사용해야 합니다.
+func OpenDB(driverName, dsn string, dbSystem attribute.KeyValue) (*sql.DB, error) {
+	return otelsql.Open(driverName, dsn,
+		otelsql.WithAttributes(dbSystem),
+		otelsql.WithSpanOptions(otelsql.SpanOptions{
+			OmitRows:             true,
+			OmitConnResetSession: true,
+			OmitConnectorConnect: true,
+			SpanFilter: func(ctx context.Context, _ otelsql.Method, _ string, _ []driver.NamedValue) bool {
+				return trace.SpanContextFromContext(ctx).IsValid()
+			},
+		}),
+	)
+}
+
+// UnwrapDriverConn OpenDB가 감싼 계측 래퍼를 벗겨 원래 드라이버 커넥션을 반환합니다.
+// 계측되지 않은 커넥션은 그대로 반환합니다.
+func UnwrapDriverConn(conn any) any {
+	if w, ok := conn.(interface{ Raw() driver.Conn }); ok {
+		return w.Raw()
+	}
+	return conn
+}
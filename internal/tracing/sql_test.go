@@ -0,0 +1,55 @@
+package tracing
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.39.0"
+)
+
+func TestOpenDB(t *testing.T) {
+	restoreGlobals(t)
+
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	db, err := OpenDB("sqlite3", ":memory:", semconv.DBSystemNameSQLite)
+	require.NoError(t, err)
+	defer db.Close()
+
+	// 상위 Span이 없는 호출은 기록하지 않습니다.
+	_, err = db.ExecContext(context.Background(), "CREATE TABLE t (id INTEGER)")
+	require.NoError(t, err)
+	assert.Empty(t, recorder.Ended())
+
+	ctx, parent := Tracer().Start(context.Background(), "crawl.run")
+	_, err = db.ExecContext(ctx, "INSERT INTO t (id) VALUES (1)")
+	require.NoError(t, err)
+	parent.End()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	assert.Equal(t, parent.SpanContext().SpanID(), spans[0].Parent().SpanID())
+	assert.Contains(t, spans[0].Attributes(), semconv.DBSystemNameSQLite)
+
+	// 계측 래퍼를 벗기면 원래 드라이버 커넥션을 얻을 수 있습니다.
+	conn, err := db.Conn(context.Background())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	require.NoError(t, conn.Raw(func(driverConn any) error {
+		assert.NotEqual(t, driverConn, UnwrapDriverConn(driverConn))
+		assert.IsType(t, &sqlite3.SQLiteConn{}, UnwrapDriverConn(driverConn))
+		return nil
+	}))
+
+	var plain any = &sql.DB{}
+	assert.Same(t, plain, UnwrapDriverConn(plain), "계측되지 않은 값은 그대로 반환해야 합니다")
+}
@@ -0,0 +1,160 @@
+// Package tracing OpenTelemetry 기반 분산 추적(Distributed Tracing)의 초기화와 공통 도구를 제공합니다.
+//
+// 크롤링이 느려진 원인을 찾으려면 지금까지는 request_id나 공급자 ID로 많은 로그를 모아 시간 순서를 맞춰 봐야 했습니다.
+// 추적을 활성화하면 하나의 API 요청 또는 크롤링 실행이 아래 계층의 작업과 부모-자식 관계의 구간(Span)으로 이어지므로,
+// 어느 페이지의 어느 HTTP 요청에서(재시도 포함) 시간이 쓰였는지를 추적 화면 하나로 확인할 수 있습니다.
+//
+//   - API: 요청 하나당 서버 Span (middleware.Tracing)
+//   - 크롤링: 실행 하나당 Span과 그 아래 목록 페이지·게시글 본문 수집 Span (provider.Base)
+//   - HTTP: 크롤링 요청 하나당 클라이언트 Span과 재시도 이벤트 (fetcher.TracingFetcher)
+//   - 저장소: SQLite/PostgreSQL 쿼리·트랜잭션 Span (OpenDB)
+//
+// 추적이 비활성화된 경우에도 각 계층의 계측 코드는 OpenTelemetry의 기본(No-op) 구현을 사용하므로 별도의 분기 없이 안전하게 동작합니다.
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	applog "github.com/darkkaiser/notify-server/pkg/log"
+	"github.com/darkkaiser/rss-feed-server/internal/config"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.39.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// component 로깅용 컴포넌트 이름
+const component = "tracing"
+
+// instrumentationName 이 애플리케이션이 직접 생성하는 Span의 계측 라이브러리 이름입니다.
+const instrumentationName = "github.com/darkkaiser/rss-feed-server"
+
+// ShutdownFunc 버퍼에 남은 Span을 모두 내보내고 추적 리소스를 정리하는 함수입니다.
+type ShutdownFunc func(ctx context.Context) error
+
+// Tracer 애플리케이션 공용 Tracer를 반환합니다.
+//
+// 전역 TracerProvider를 매번 조회하므로, Setup 이전에 생성된 객체가 호출하더라도 Setup 이후에는 설정된 내보내기 대상으로 Span이 기록됩니다.
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// Setup 설정에 따라 전역 TracerProvider와 전파기(Propagator)를 구성합니다.
+//
+// 추적이 비활성화되어 있으면 전파기만 등록하고 아무 작업도 하지 않는 ShutdownFunc를 반환합니다.
+// 활성화되어 있으면 로그에 trace_id와 span_id를 기록하는 로그 Hook도 함께 등록합니다.
+// 반환된 ShutdownFunc는 애플리케이션 종료 시 반드시 호출해야 버퍼에 남은 Span이 유실되지 않습니다.
+func Setup(ctx context.Context, cfg *config.TracingConfig, serviceVersion string) (ShutdownFunc, error) {
+	// 추적 여부와 관계없이 W3C Trace Context(traceparent) 헤더를 해석할 수 있도록 전파기를 등록합니다.
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	if !cfg.Enabled() {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, closer, err := newExporter(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	res := resource.NewWithAttributes(semconv.SchemaURL,
+		semconv.ServiceName(config.AppName),
+		semconv.ServiceVersion(serviceVersion),
+	)
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatioOrDefault()))),
+	)
+	otel.SetTracerProvider(tp)
+
+	InstallLogHook(applog.StandardLogger())
+
+	applog.WithComponentAndFields(component, applog.Fields{
+		"exporter":     cfg.ExporterOrDefault(),
+		"endpoint":     cfg.Endpoint,
+		"file_path":    cfg.FilePath,
+		"sample_ratio": cfg.SampleRatioOrDefault(),
+	}).Info("분산 추적(OpenTelemetry)을 활성화했습니다")
+
+	return func(ctx context.Context) error {
+		err := tp.Shutdown(ctx)
+		if closer != nil {
+			err = errors.Join(err, closer.Close())
+		}
+		return err
+	}, nil
+}
+
+// newExporter 설정된 내보내기 대상에 맞는 SpanExporter를 생성합니다.
+// file 대상은 종료 시 닫아야 하는 파일을 함께 반환합니다.
+func newExporter(ctx context.Context, cfg *config.TracingConfig) (sdktrace.SpanExporter, io.Closer, error) {
+	switch cfg.ExporterOrDefault() {
+	case config.TracingExporterOTLP:
+		var opts []otlptracehttp.Option
+		if cfg.Endpoint != "" {
+			// 주소의 스킴(http/https)에 따라 TLS 사용 여부도 함께 결정됩니다.
+			opts = append(opts, otlptracehttp.WithEndpointURL(cfg.Endpoint))
+		}
+
+		exporter, err := otlptracehttp.New(ctx, opts...)
+		if err != nil {
+			return nil, nil, fmt.Errorf("OTLP 추적 내보내기 생성 실패: %w", err)
+		}
+		return exporter, nil, nil
+
+	case config.TracingExporterStdout:
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(os.Stdout), stdouttrace.WithPrettyPrint())
+		if err != nil {
+			return nil, nil, fmt.Errorf("표준 출력 추적 내보내기 생성 실패: %w", err)
+		}
+		return exporter, nil, nil
+
+	case config.TracingExporterFile:
+		f, err := os.OpenFile(cfg.FilePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			return nil, nil, fmt.Errorf("추적 기록 파일('%s') 열기 실패: %w", cfg.FilePath, err)
+		}
+
+		// 한 줄에 Span 하나씩 기록하여 jq 등으로 바로 걸러 볼 수 있게 합니다.
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(f))
+		if err != nil {
+			_ = f.Close()
+			return nil, nil, fmt.Errorf("파일 추적 내보내기 생성 실패: %w", err)
+		}
+		return exporter, f, nil
+
+	default:
+		return nil, nil, fmt.Errorf("지원하지 않는 추적 내보내기 대상입니다: '%s'", cfg.Exporter)
+	}
+}
+
+// EndSpan 작업 결과(err)를 Span의 상태에 기록하고 Span을 종료합니다.
+//
+// err가 nil이 아니면 에러 이벤트를 남기고 상태를 Error로 설정합니다.
+// 컨텍스트 취소처럼 작업 자체의 실패가 아닌 경우도 구분 없이 기록되므로, 호출자가 기록하지 않을 에러는 nil로 넘겨야 합니다.
+func EndSpan(span trace.Span, err error) {
+	RecordError(span, err)
+	span.End()
+}
+
+// RecordError 에러 이벤트를 남기고 Span의 상태를 Error로 설정합니다. err가 nil이면 아무 작업도 하지 않습니다.
+//
+// Span을 직접 종료하지 않는 호출자(상위에서 시작된 Span에 실패 사실만 남기는 경우 등)가 사용합니다.
+func RecordError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
@@ -0,0 +1,159 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	applog "github.com/darkkaiser/notify-server/pkg/log"
+	"github.com/darkkaiser/rss-feed-server/internal/config"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// restoreGlobals 테스트가 변경한 전역 TracerProvider와 로그 Hook을 테스트 종료 시 원래대로 되돌립니다.
+func restoreGlobals(t *testing.T) {
+	t.Helper()
+
+	tp := otel.GetTracerProvider()
+	hooks := make(map[applog.Level][]applog.Hook)
+	for level, hs := range applog.StandardLogger().Hooks {
+		hooks[level] = append([]applog.Hook(nil), hs...)
+	}
+
+	t.Cleanup(func() {
+		otel.SetTracerProvider(tp)
+		applog.StandardLogger().ReplaceHooks(hooks)
+	})
+}
+
+func TestSetup_Disabled(t *testing.T) {
+	restoreGlobals(t)
+
+	before := otel.GetTracerProvider()
+
+	shutdown, err := Setup(context.Background(), &config.TracingConfig{}, "1.0.0")
+	require.NoError(t, err)
+	assert.NoError(t, shutdown(context.Background()))
+
+	// 비활성화 상태에서는 전역 TracerProvider를 교체하지 않습니다.
+	assert.Equal(t, before, otel.GetTracerProvider())
+}
+
+func TestSetup_FileExporter(t *testing.T) {
+	restoreGlobals(t)
+
+	path := filepath.Join(t.TempDir(), "trace.jsonl")
+	shutdown, err := Setup(context.Background(), &config.TracingConfig{Exporter: config.TracingExporterFile, FilePath: path}, "1.2.3")
+	require.NoError(t, err)
+
+	ctx, parent := Tracer().Start(context.Background(), "crawl.run")
+	_, child := Tracer().Start(ctx, "crawl.page")
+	EndSpan(child, errors.New("boom"))
+	EndSpan(parent, nil)
+
+	// 종료 시 버퍼에 남은 Span이 모두 파일에 기록됩니다.
+	require.NoError(t, shutdown(context.Background()))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Len(t, lines, 2)
+	assert.Contains(t, lines[0], `"Name":"crawl.page"`)
+	assert.Contains(t, lines[1], `"Name":"crawl.run"`)
+	assert.Contains(t, lines[1], config.AppName)
+	assert.Contains(t, lines[1], "1.2.3")
+}
+
+func TestSetup_FileExporterOpenFailure(t *testing.T) {
+	restoreGlobals(t)
+
+	path := filepath.Join(t.TempDir(), "missing", "trace.jsonl")
+	_, err := Setup(context.Background(), &config.TracingConfig{Exporter: config.TracingExporterFile, FilePath: path}, "1.0.0")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "추적 기록 파일")
+}
+
+func TestEndSpan(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tracer := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test")
+
+	_, ok := tracer.Start(context.Background(), "ok")
+	EndSpan(ok, nil)
+
+	_, failed := tracer.Start(context.Background(), "failed")
+	EndSpan(failed, errors.New("timeout"))
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+
+	assert.Equal(t, codes.Unset, spans[0].Status().Code)
+	assert.Empty(t, spans[0].Events())
+
+	assert.Equal(t, codes.Error, spans[1].Status().Code)
+	assert.Equal(t, "timeout", spans[1].Status().Description)
+	require.Len(t, spans[1].Events(), 1)
+	assert.Equal(t, "exception", spans[1].Events()[0].Name)
+}
+
+func TestLogFields(t *testing.T) {
+	var nilCtx context.Context
+	assert.Nil(t, LogFields(nilCtx))
+	assert.Nil(t, LogFields(context.Background()))
+
+	tracer := sdktrace.NewTracerProvider().Tracer("test")
+	ctx, span := tracer.Start(context.Background(), "op")
+	defer span.End()
+
+	fields := LogFields(ctx)
+	assert.Equal(t, span.SpanContext().TraceID().String(), fields[LogFieldTraceID])
+	assert.Equal(t, span.SpanContext().SpanID().String(), fields[LogFieldSpanID])
+}
+
+// captureHook 로그 시스템의 기록 Hook을 흉내 내어, 실행 시점의 로그 필드를 저장합니다.
+type captureHook struct {
+	data []applog.Fields
+}
+
+func (h *captureHook) Levels() []applog.Level { return applog.AllLevels }
+
+func (h *captureHook) Fire(entry *applog.Entry) error {
+	fields := make(applog.Fields, len(entry.Data))
+	for k, v := range entry.Data {
+		fields[k] = v
+	}
+	h.data = append(h.data, fields)
+	return nil
+}
+
+func TestInstallLogHook(t *testing.T) {
+	logger := logrus.New()
+	capture := &captureHook{}
+	logger.AddHook(capture)
+
+	// 기록 Hook보다 나중에 설치해도 먼저 실행되어야 필드가 기록에 반영됩니다.
+	InstallLogHook(logger)
+	InstallLogHook(logger) // 중복 설치는 무시됩니다.
+	require.Len(t, logger.Hooks[applog.InfoLevel], 2)
+
+	tracer := sdktrace.NewTracerProvider().Tracer("test")
+	ctx, span := tracer.Start(context.Background(), "op")
+	defer span.End()
+
+	logger.WithContext(ctx).Info("컨텍스트가 있는 로그")
+	logger.Info("컨텍스트가 없는 로그")
+
+	require.Len(t, capture.data, 2)
+	assert.Equal(t, span.SpanContext().TraceID().String(), capture.data[0][LogFieldTraceID])
+	assert.Equal(t, span.SpanContext().SpanID().String(), capture.data[0][LogFieldSpanID])
+	assert.NotContains(t, capture.data[1], LogFieldTraceID)
+}